@@ -0,0 +1,84 @@
+// relay 엣지 릴레이 노드
+// 해외 청중 가까이에 배치해 리스너 WebSocket을 받고, 백엔드에서 gRPC로 받은 Room 방송을 전달
+//
+//	RELAY_BACKEND_ADDR=api.eum.internal:9090 RELAY_REGION=us-east-1 go run ./cmd/relay
+//
+// 클라이언트는 백엔드 GET /api/relays?region= 으로 릴레이 목록을 받고, 각 릴레이의 /health 응답
+// 시간을 재서 가장 가까운 곳의 /ws/room 으로 접속 (백엔드에서 받은 Room 토큰을 ?token= 으로 전달)
+// RELAY_SECRET(백엔드와 같은 값)과 JWT_SECRET(Room 토큰 검증)이 없으면 시작하지 않음
+package main
+
+import (
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+	"github.com/joho/godotenv"
+
+	"realtime-backend/internal/relay"
+)
+
+func main() {
+	// .env 파일 로드 (없어도 에러 무시)
+	_ = godotenv.Load()
+
+	hostname, _ := os.Hostname()
+	cfg := relay.Config{
+		ID:           getEnv("RELAY_ID", hostname),
+		Region:       getEnv("RELAY_REGION", ""),
+		BackendAddr:  getEnv("RELAY_BACKEND_ADDR", "localhost:9090"),
+		Secret:       getEnv("RELAY_SECRET", ""),
+		JWTSecret:    getEnv("JWT_SECRET", ""),
+		WriteTimeout: 5 * time.Second,
+	}
+	port := getEnv("RELAY_PORT", ":8081")
+
+	node, err := relay.NewNode(cfg)
+	if err != nil {
+		log.Fatalf("❌ Failed to start relay: %v", err)
+	}
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+
+	// 상태 확인 겸 지연시간 측정용
+	app.Get("/health", func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{
+			"status": "ok",
+			"relay":  node.Stats(),
+		})
+	})
+
+	app.Get("/ws/room", func(c *fiber.Ctx) error {
+		if !websocket.IsWebSocketUpgrade(c) {
+			return fiber.ErrUpgradeRequired
+		}
+		return c.Next()
+	}, node.Authenticate, websocket.New(node.HandleWebSocket))
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-quit
+		log.Println("🛑 Shutting down relay...")
+		node.Close()
+		if err := app.ShutdownWithTimeout(10 * time.Second); err != nil {
+			log.Printf("Relay shutdown error: %v", err)
+		}
+	}()
+
+	log.Printf("🛰️ Relay %s (%s) starting on %s, backend %s", cfg.ID, cfg.Region, port, cfg.BackendAddr)
+	if err := app.Listen(port); err != nil {
+		log.Fatalf("Relay failed to start: %v", err)
+	}
+}
+
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
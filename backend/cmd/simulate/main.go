@@ -0,0 +1,482 @@
+// simulate 스크립트로 작성한 다국어 대화를 Room에 흘려보내고
+// 각 참가자가 자기 언어로 번역된 자막을 받았는지 검증하는 E2E 회귀 도구
+//
+//	go run ./cmd/simulate -script cmd/simulate/example.json
+//	go run ./cmd/simulate -script my.json -url ws://staging:8080 -timeout 90s
+//
+// 대사의 오디오는 PCM 파일(16kHz mono 16-bit LE)을 쓰거나, 없으면 Polly로 합성함 (AWS 설정 필요)
+// 하나라도 기대한 번역이 도착하지 않으면 종료 코드 1
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fasthttp/websocket"
+
+	awsai "realtime-backend/internal/aws"
+	"realtime-backend/internal/config"
+)
+
+// 오디오 전송 설정 (서버 Room 프로토콜과 동일)
+const (
+	sampleRate      = 16000
+	bytesPerSecond  = sampleRate * 2
+	chunkDuration   = 100 * time.Millisecond
+	chunkSize       = bytesPerSecond / 10
+	speakerIDLength = 36 // [speakerId(36 bytes)][sourceLang(2 bytes)][audio data]
+	trailingSilence = 1500 * time.Millisecond
+	defaultPause    = 500 * time.Millisecond
+	pollInterval    = 250 * time.Millisecond
+	handshakeWait   = 10 * time.Second
+)
+
+// Scenario 시뮬레이션 스크립트
+type Scenario struct {
+	Name         string        `json:"name"`
+	Participants []Participant `json:"participants"`
+	Lines        []Line        `json:"lines"`
+}
+
+// Participant 합성 참가자 (lang으로 말하고 lang으로 들음)
+type Participant struct {
+	ID       string `json:"id"`
+	Nickname string `json:"nickname"`
+	Lang     string `json:"lang"`
+}
+
+// Line 대사 한 줄
+type Line struct {
+	Speaker string `json:"speaker"`
+	Text    string `json:"text"`  // audio가 없으면 Polly로 합성
+	Audio   string `json:"audio"` // PCM fixture (스크립트 파일 기준 상대 경로)
+	PauseMs int    `json:"pauseMs"`
+
+	// 언어별로 자막에 포함돼야 하는 키워드 (하나라도 있으면 통과, 대소문자 무시)
+	// 화자 언어는 원문, 그 외 언어는 번역문과 비교. 없는 언어는 비어 있지 않은 자막만 확인
+	Expect map[string][]string `json:"expect"`
+
+	pcm []byte
+}
+
+// caption 리스너가 받은 final 자막
+type caption struct {
+	SpeakerID  string
+	Original   string
+	Translated string
+}
+
+// client 참가자 한 명의 Room WebSocket 연결
+type client struct {
+	Participant
+	conn *websocket.Conn
+
+	mu       sync.Mutex
+	captions []caption
+	ttsClips int
+}
+
+func main() {
+	serverURL := flag.String("url", "ws://localhost:8080", "backend WebSocket base URL")
+	scriptPath := flag.String("script", "cmd/simulate/example.json", "scenario JSON file")
+	roomID := flag.String("room", "", "room ID (default: sim-<timestamp>)")
+	timeout := flag.Duration("timeout", 60*time.Second, "how long to wait for translations after the last line")
+	flag.Parse()
+
+	scenario, err := loadScenario(*scriptPath)
+	if err != nil {
+		log.Fatalf("❌ Failed to load scenario: %v", err)
+	}
+	if *roomID == "" {
+		*roomID = fmt.Sprintf("sim-%d", time.Now().Unix())
+	}
+
+	if err := prepareAudio(scenario, filepath.Dir(*scriptPath)); err != nil {
+		log.Fatalf("❌ Failed to prepare audio: %v", err)
+	}
+
+	fmt.Printf("🎬 Scenario %q: %d participants, %d lines, room %s\n",
+		scenario.Name, len(scenario.Participants), len(scenario.Lines), *roomID)
+
+	clients := make(map[string]*client, len(scenario.Participants))
+	for _, p := range scenario.Participants {
+		c, err := connect(*serverURL, *roomID, p)
+		if err != nil {
+			log.Fatalf("❌ Failed to connect %s: %v", p.ID, err)
+		}
+		clients[p.ID] = c
+		go c.readLoop()
+		fmt.Printf("🔌 %s joined (%s)\n", p.ID, p.Lang)
+	}
+
+	for i, line := range scenario.Lines {
+		speaker := clients[line.Speaker]
+		fmt.Printf("🗣️  [%d] %s: %s\n", i+1, speaker.ID, lineLabel(line))
+		if err := speaker.speak(line.pcm); err != nil {
+			log.Fatalf("❌ Failed to send audio for line %d: %v", i+1, err)
+		}
+		pause := defaultPause
+		if line.PauseMs > 0 {
+			pause = time.Duration(line.PauseMs) * time.Millisecond
+		}
+		time.Sleep(pause)
+	}
+
+	fmt.Printf("⏳ Waiting up to %s for translations...\n", *timeout)
+	deadline := time.Now().Add(*timeout)
+	for !allSatisfied(scenario, clients) && time.Now().Before(deadline) {
+		time.Sleep(pollInterval)
+	}
+
+	for _, c := range clients {
+		c.close()
+	}
+	if !report(scenario, clients) {
+		os.Exit(1)
+	}
+}
+
+// loadScenario 스크립트 파일 읽기 및 검증
+func loadScenario(path string) (*Scenario, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s Scenario
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, fmt.Errorf("invalid scenario JSON: %w", err)
+	}
+
+	if len(s.Participants) < 2 {
+		return nil, errors.New("at least two participants are required")
+	}
+	ids := make(map[string]bool, len(s.Participants))
+	for i := range s.Participants {
+		p := &s.Participants[i]
+		p.Lang = awsai.NormalizeLanguage(p.Lang)
+		switch {
+		case p.ID == "" || len(p.ID) > speakerIDLength:
+			return nil, fmt.Errorf("participant id %q must be 1-%d bytes", p.ID, speakerIDLength)
+		case ids[p.ID]:
+			return nil, fmt.Errorf("duplicate participant id %q", p.ID)
+		case len(p.Lang) != 2 || !awsai.IsSupportedLanguage(p.Lang):
+			return nil, fmt.Errorf("participant %s: unsupported language %q", p.ID, p.Lang)
+		}
+		ids[p.ID] = true
+		if p.Nickname == "" {
+			p.Nickname = p.ID
+		}
+	}
+
+	if len(s.Lines) == 0 {
+		return nil, errors.New("scenario has no lines")
+	}
+	for i, line := range s.Lines {
+		if !ids[line.Speaker] {
+			return nil, fmt.Errorf("line %d: unknown speaker %q", i+1, line.Speaker)
+		}
+		if line.Text == "" && line.Audio == "" {
+			return nil, fmt.Errorf("line %d: text or audio is required", i+1)
+		}
+	}
+	return &s, nil
+}
+
+// prepareAudio 대사별 PCM 준비 (fixture 파일 또는 Polly 합성) 후 final이 나오도록 끝에 무음 추가
+func prepareAudio(s *Scenario, baseDir string) error {
+	langs := make(map[string]string, len(s.Participants))
+	for _, p := range s.Participants {
+		langs[p.ID] = p.Lang
+	}
+
+	var polly *awsai.PollyClient
+	pcm16k := &awsai.VoicePreference{Output: &awsai.AudioProfile{Format: awsai.AudioFormatPCM, SampleRate: sampleRate}}
+	silence := make([]byte, int(trailingSilence.Seconds()*bytesPerSecond))
+
+	for i := range s.Lines {
+		line := &s.Lines[i]
+		if line.Audio != "" {
+			path := line.Audio
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(baseDir, path)
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("line %d: %w", i+1, err)
+			}
+			line.pcm = append(data, silence...)
+			continue
+		}
+
+		if polly == nil {
+			fmt.Println("🔊 Synthesizing lines with Polly...")
+			pool, err := awsai.NewAWSClientPool(context.Background(), config.Load(), nil)
+			if err != nil {
+				return err
+			}
+			polly = pool.Polly
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		result, err := polly.SynthesizeWithVoice(ctx, line.Text, langs[line.Speaker], pcm16k)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("line %d: %w", i+1, err)
+		}
+		if len(result.AudioData) == 0 {
+			return fmt.Errorf("line %d: Polly has no voice for %s", i+1, langs[line.Speaker])
+		}
+		line.pcm = append(result.AudioData, silence...)
+	}
+	return nil
+}
+
+// connect Room WebSocket 접속 후 ready 응답까지 대기하고 화자 정보 등록
+func connect(serverURL, roomID string, p Participant) (*client, error) {
+	query := url.Values{}
+	query.Set("roomId", roomID)
+	query.Set("listenerId", p.ID)
+	query.Set("targetLang", p.Lang)
+	query.Set("history", "0")
+
+	conn, _, err := websocket.DefaultDialer.Dial(strings.TrimRight(serverURL, "/")+"/ws/room?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	conn.SetReadDeadline(time.Now().Add(handshakeWait))
+	for {
+		messageType, msg, err := conn.ReadMessage()
+		if err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("no ready response: %w", err)
+		}
+		if messageType != websocket.TextMessage {
+			continue
+		}
+		var resp struct {
+			Status  string `json:"status"`
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(msg, &resp) != nil {
+			continue
+		}
+		if resp.Status == "error" {
+			conn.Close()
+			return nil, fmt.Errorf("server rejected join: %s", resp.Message)
+		}
+		if resp.Status == "ready" {
+			break
+		}
+	}
+	conn.SetReadDeadline(time.Time{})
+
+	info, _ := json.Marshal(map[string]string{
+		"type":       "speaker_info",
+		"speakerId":  p.ID,
+		"sourceLang": p.Lang,
+		"nickname":   p.Nickname,
+	})
+	if err := conn.WriteMessage(websocket.TextMessage, info); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &client{Participant: p, conn: conn}, nil
+}
+
+// readLoop final 자막과 TTS 클립 수집 (연결이 끊기면 종료)
+func (c *client) readLoop() {
+	for {
+		messageType, msg, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if messageType == websocket.BinaryMessage {
+			c.mu.Lock()
+			c.ttsClips++
+			c.mu.Unlock()
+			continue
+		}
+
+		var bm struct {
+			Type      string `json:"type"`
+			SpeakerID string `json:"speakerId"`
+			Data      struct {
+				ParticipantID string `json:"participantId"`
+				Original      string `json:"original"`
+				Translated    string `json:"translated"`
+				IsFinal       bool   `json:"isFinal"`
+			} `json:"data"`
+		}
+		if json.Unmarshal(msg, &bm) != nil || bm.Type != "transcript" || !bm.Data.IsFinal {
+			continue
+		}
+		speakerID := bm.SpeakerID
+		if speakerID == "" {
+			speakerID = bm.Data.ParticipantID
+		}
+		c.mu.Lock()
+		c.captions = append(c.captions, caption{
+			SpeakerID:  speakerID,
+			Original:   bm.Data.Original,
+			Translated: bm.Data.Translated,
+		})
+		c.mu.Unlock()
+	}
+}
+
+// speak PCM을 실시간 속도로 100ms씩 전송
+func (c *client) speak(pcm []byte) error {
+	header := make([]byte, speakerIDLength+2)
+	copy(header, fmt.Sprintf("%-*s", speakerIDLength, c.ID))
+	copy(header[speakerIDLength:], c.Lang)
+
+	ticker := time.NewTicker(chunkDuration)
+	defer ticker.Stop()
+	for off := 0; off < len(pcm); off += chunkSize {
+		end := min(off+chunkSize, len(pcm))
+		frame := append(append([]byte{}, header...), pcm[off:end]...)
+		if err := c.conn.WriteMessage(websocket.BinaryMessage, frame); err != nil {
+			return err
+		}
+		<-ticker.C
+	}
+	return nil
+}
+
+func (c *client) close() {
+	leave, _ := json.Marshal(map[string]string{"type": "speaker_leave", "speakerId": c.ID})
+	c.conn.WriteMessage(websocket.TextMessage, leave)
+	c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	c.conn.Close()
+}
+
+// heard 리스너가 speakerID에게서 받은 자막 전체 (원문/번역문 중 리스너 언어에 해당하는 것)
+func (c *client) heard(speakerID string, sameLang bool) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var parts []string
+	for _, cp := range c.captions {
+		if cp.SpeakerID != speakerID {
+			continue
+		}
+		text := cp.Translated
+		if sameLang || text == "" {
+			text = cp.Original
+		}
+		if text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// check 리스너가 대사를 기대한 대로 받았는지
+// 한 대사가 여러 final로 나뉠 수 있어 화자의 자막 전체에서 키워드를 찾음
+func check(line Line, speaker, listener *client) (bool, string) {
+	sameLang := speaker.Lang == listener.Lang
+	text := listener.heard(speaker.ID, sameLang)
+	if text == "" {
+		return false, text
+	}
+	// 다른 언어 리스너가 번역 없이 원문만 받았으면 실패
+	if !sameLang && !listener.hasTranslation(speaker.ID) {
+		return false, text
+	}
+	keywords := line.Expect[listener.Lang]
+	if len(keywords) == 0 {
+		return true, text
+	}
+	lower := strings.ToLower(text)
+	for _, kw := range keywords {
+		if strings.Contains(lower, strings.ToLower(kw)) {
+			return true, text
+		}
+	}
+	return false, text
+}
+
+func (c *client) hasTranslation(speakerID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, cp := range c.captions {
+		if cp.SpeakerID == speakerID && cp.Translated != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func allSatisfied(s *Scenario, clients map[string]*client) bool {
+	for _, line := range s.Lines {
+		speaker := clients[line.Speaker]
+		for _, listener := range clients {
+			if listener == speaker {
+				continue
+			}
+			if ok, _ := check(line, speaker, listener); !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// report 대사 x 리스너 결과 출력 (모두 통과하면 true)
+func report(s *Scenario, clients map[string]*client) bool {
+	passed, failed := 0, 0
+	fmt.Println()
+	fmt.Println("📋 Results")
+	for i, line := range s.Lines {
+		speaker := clients[line.Speaker]
+		fmt.Printf("  [%d] %s (%s): %s\n", i+1, speaker.ID, speaker.Lang, lineLabel(line))
+		for _, p := range s.Participants {
+			listener := clients[p.ID]
+			if listener == speaker {
+				continue
+			}
+			ok, text := check(line, speaker, listener)
+			mark := "✅"
+			if ok {
+				passed++
+			} else {
+				failed++
+				mark = "❌"
+				if text == "" {
+					text = "(nothing received)"
+				}
+			}
+			fmt.Printf("      %s %s (%s): %s\n", mark, listener.ID, listener.Lang, text)
+		}
+	}
+
+	fmt.Println()
+	for _, p := range s.Participants {
+		c := clients[p.ID]
+		c.mu.Lock()
+		fmt.Printf("  🎧 %s: %d final captions, %d TTS clips\n", c.ID, len(c.captions), c.ttsClips)
+		c.mu.Unlock()
+	}
+	if failed > 0 {
+		fmt.Printf("\n❌ %d/%d checks failed\n", failed, passed+failed)
+		return false
+	}
+	fmt.Printf("\n✅ All %d checks passed\n", passed)
+	return true
+}
+
+func lineLabel(line Line) string {
+	if line.Text != "" {
+		return line.Text
+	}
+	return filepath.Base(line.Audio)
+}
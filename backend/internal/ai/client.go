@@ -46,6 +46,12 @@ type TranscriptMessage struct {
 	IsFinal          bool
 	TimestampMs      uint64
 	Confidence       float32
+	TTSSkipped       map[string]string // targetLang -> reason TTS was skipped (e.g. Polly budget)
+	Crosstalk        bool              // Utterance overlapped sustained multi-speaker crosstalk (lower STT quality)
+	Moderated        bool              // Flagged by moderation (profanity masked or toxic); clients may blur/hide it
+	ModerationLabels []string          // Why it was flagged (WORD_LIST, PROFANITY, INSULT, ...)
+	Degraded         bool              // Translate unavailable: some targets only got the original text
+	Sequence         uint64            // Per-room order of final transcripts (Sequencer; 0 = not assigned)
 }
 
 // AudioMessage TTS 오디오 메시지
@@ -53,6 +59,7 @@ type AudioMessage struct {
 	TranscriptID         string
 	TargetLanguage       string
 	TargetParticipantIDs []string
+	VoiceKey             string // Listener voice preference key ("" = default voice)
 	AudioData            []byte
 	Format               string
 	SampleRate           uint32
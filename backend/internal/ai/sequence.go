@@ -0,0 +1,71 @@
+package ai
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+)
+
+// sharedSequenceTimeout 공유 순번 발급 한 번의 제한 시간 (넘으면 인스턴스 내 순번 사용)
+const sharedSequenceTimeout = 500 * time.Millisecond
+
+// SharedSequence 같은 Room을 처리하는 모든 인스턴스가 공유하는 순번 발급 (cache.RedisClient.NextRoomSeq)
+// floor 이상이면서 이전에 발급한 값보다 큰 값을 돌려줌
+type SharedSequence func(ctx context.Context, floor uint64) (uint64, error)
+
+// Sequencer 방 단위 final 자막 순번 발급기 (Thread-Safe)
+// 순번은 마이크로초 단위 현재 시각 이상이면서 직전 값보다 항상 커서, 파이프라인이 다시 만들어지거나
+// 순번이 없던 기존 자막(시각으로 대신 비교)과 섞여도 발화 순서가 유지됨.
+// 공유 발급기가 있으면 Redis 카운터로 발급해 여러 인스턴스가 한 Room을 처리해도 순번이 겹치지 않음
+type Sequencer struct {
+	last   atomic.Uint64
+	shared SharedSequence
+}
+
+// SetShared 공유 순번 발급기 지정 (첫 순번을 발급하기 전에 호출, nil이면 인스턴스 내 순번만 사용)
+func (s *Sequencer) SetShared(shared SharedSequence) {
+	s.shared = shared
+}
+
+// Next 다음 순번 (공유 발급기 실패 시 인스턴스 내 순번)
+func (s *Sequencer) Next() uint64 {
+	local := s.nextLocal()
+	if s.shared == nil {
+		return local
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sharedSequenceTimeout)
+	defer cancel()
+	seq, err := s.shared(ctx, local)
+	if err != nil {
+		log.Printf("[Sequencer] ⚠️ Shared sequence failed, using local %d: %v", local, err)
+		return local
+	}
+	s.raise(seq)
+	return seq
+}
+
+// nextLocal 직전 값보다 크고 현재 시각(마이크로초) 이상인 인스턴스 내 순번
+func (s *Sequencer) nextLocal() uint64 {
+	for {
+		last := s.last.Load()
+		next := last + 1
+		if now := uint64(time.Now().UnixMicro()); now > next {
+			next = now
+		}
+		if s.last.CompareAndSwap(last, next) {
+			return next
+		}
+	}
+}
+
+// raise 공유 순번을 받은 뒤 인스턴스 내 순번이 그보다 작아지지 않게 함
+func (s *Sequencer) raise(seq uint64) {
+	for {
+		last := s.last.Load()
+		if last >= seq || s.last.CompareAndSwap(last, seq) {
+			return
+		}
+	}
+}
@@ -83,6 +83,29 @@ func AuthMiddleware(jwtManager *JWTManager) fiber.Handler {
 	}
 }
 
+// RequireAdmin 운영자 전용 미들웨어 (AuthMiddleware 뒤에서 사용, 이메일이 adminEmails에 있어야 통과)
+func RequireAdmin(adminEmails []string) fiber.Handler {
+	admins := make(map[string]bool, len(adminEmails))
+	for _, email := range adminEmails {
+		admins[strings.ToLower(strings.TrimSpace(email))] = true
+	}
+
+	return func(c *fiber.Ctx) error {
+		claims, err := GetClaimsFromContext(c)
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "unauthorized",
+			})
+		}
+		if !admins[strings.ToLower(claims.Email)] {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "admin access required",
+			})
+		}
+		return c.Next()
+	}
+}
+
 // OptionalAuthMiddleware 선택적 인증 미들웨어 (인증 실패해도 계속 진행)
 func OptionalAuthMiddleware(jwtManager *JWTManager) fiber.Handler {
 	return func(c *fiber.Ctx) error {
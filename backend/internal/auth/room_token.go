@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Room 토큰 역할 (WebSocket에서 서버가 강제)
+const (
+	RoomRoleSpeaker  = "speaker"  // 오디오 전송 + 수신
+	RoomRoleListener = "listener" // 자막/TTS 수신만
+)
+
+// roomTokenAudience 액세스 토큰과 Room 토큰을 구분하는 aud 값
+const roomTokenAudience = "eum-room"
+
+// ErrInvalidRoomRole 알 수 없는 Room 역할
+var ErrInvalidRoomRole = errors.New("invalid room role")
+
+// RoomClaims 회의 입장 시 발급되는 Room WebSocket 토큰 클레임
+type RoomClaims struct {
+	UserID        int64    `json:"user_id"`
+	ParticipantID string   `json:"participant_id"` // WebSocket의 listenerId로 고정
+	RoomID        string   `json:"room_id"`
+	Role          string   `json:"role"`
+	MeetingID     int64    `json:"meeting_id,omitempty"` // 회의 입장 토큰으로 발급된 경우
+	Languages     []string `json:"languages,omitempty"`  // 수신 가능한 번역 언어 (비어 있으면 제한 없음)
+	jwt.RegisteredClaims
+}
+
+// CanSpeak 오디오를 보낼 수 있는 역할인지 확인
+func (c *RoomClaims) CanSpeak() bool {
+	return c.Role == RoomRoleSpeaker
+}
+
+// AllowsLanguage 토큰으로 해당 번역 언어를 받을 수 있는지 확인
+func (c *RoomClaims) AllowsLanguage(lang string) bool {
+	if len(c.Languages) == 0 {
+		return true
+	}
+	for _, allowed := range c.Languages {
+		if allowed == lang {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidRoomRole Room 역할 값 확인
+func IsValidRoomRole(role string) bool {
+	return role == RoomRoleSpeaker || role == RoomRoleListener
+}
+
+// GenerateRoomToken Room WebSocket 토큰 생성 (ttl 동안 유효)
+func (m *JWTManager) GenerateRoomToken(userID int64, participantID, roomID, role string, ttl time.Duration) (string, error) {
+	return m.GenerateJoinToken(RoomClaims{
+		UserID:        userID,
+		ParticipantID: participantID,
+		RoomID:        roomID,
+		Role:          role,
+	}, ttl)
+}
+
+// GenerateJoinToken 회의 입장 토큰 생성 (회의 ID, 허용 언어 포함, ttl 동안 유효)
+// UserID, ParticipantID, RoomID, Role, MeetingID, Languages만 사용하고 등록 클레임은 새로 채움
+func (m *JWTManager) GenerateJoinToken(params RoomClaims, ttl time.Duration) (string, error) {
+	if !IsValidRoomRole(params.Role) {
+		return "", ErrInvalidRoomRole
+	}
+
+	now := time.Now()
+	claims := &RoomClaims{
+		UserID:        params.UserID,
+		ParticipantID: params.ParticipantID,
+		RoomID:        params.RoomID,
+		Role:          params.Role,
+		MeetingID:     params.MeetingID,
+		Languages:     params.Languages,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Issuer:    "eum-api",
+			Subject:   strconv.FormatInt(params.UserID, 10),
+			Audience:  jwt.ClaimStrings{roomTokenAudience},
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(m.secretKey)
+}
+
+// ValidateRoomToken Room WebSocket 토큰 검증 (액세스 토큰은 aud가 달라 거부됨)
+func (m *JWTManager) ValidateRoomToken(tokenString string) (*RoomClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &RoomClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, ErrInvalidToken
+		}
+		return m.secretKey, nil
+	}, jwt.WithAudience(roomTokenAudience))
+
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrExpiredToken
+		}
+		return nil, ErrInvalidToken
+	}
+
+	claims, ok := token.Claims.(*RoomClaims)
+	if !ok || !token.Valid || claims.RoomID == "" || claims.ParticipantID == "" || !IsValidRoomRole(claims.Role) {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+// GetRoomClaimsFromContext 컨텍스트에서 Room 토큰 클레임 추출 (인증 없이 허용된 연결이면 nil)
+func GetRoomClaimsFromContext(c *fiber.Ctx) *RoomClaims {
+	claims, _ := c.Locals("roomClaims").(*RoomClaims)
+	return claims
+}
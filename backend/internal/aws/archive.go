@@ -0,0 +1,121 @@
+package aws
+
+import (
+	"sync"
+	"time"
+)
+
+// Archive batching constants
+const (
+	DefaultArchiveBatchInterval = 3 * time.Minute
+	MaxArchiveBufferEntries     = 5000 // Oldest entries are dropped beyond this
+)
+
+// ArchiveEntry is a final transcript waiting to be translated into archive-only languages
+type ArchiveEntry struct {
+	TranscriptID string
+	SpeakerID    string
+	SpeakerName  string
+	SourceLang   string
+	Text         string
+	TargetLangs  []string // Archive languages that had no live listeners when the final arrived
+	CapturedAt   time.Time
+}
+
+// ArchiveTranslation is a batch-translated final for one archive language
+type ArchiveTranslation struct {
+	Entry          *ArchiveEntry
+	TargetLang     string
+	TranslatedText string
+}
+
+// ArchiveBatcher accumulates finals for "archive-only" target languages (no live listeners).
+// Instead of translating every final live, entries are drained periodically and
+// translated with batched Translate calls.
+type ArchiveBatcher struct {
+	languages []string
+	entries   []*ArchiveEntry
+	dropped   int64
+	mu        sync.Mutex
+}
+
+// NewArchiveBatcher creates a new archive batcher for the given languages
+func NewArchiveBatcher(languages []string) *ArchiveBatcher {
+	return &ArchiveBatcher{
+		languages: languages,
+		entries:   make([]*ArchiveEntry, 0),
+	}
+}
+
+// PendingLanguages returns archive languages not covered by the live target languages
+func (b *ArchiveBatcher) PendingLanguages(liveTargets []string, sourceLang string) []string {
+	if b == nil || len(b.languages) == 0 {
+		return nil
+	}
+
+	live := make(map[string]bool, len(liveTargets))
+	for _, lang := range liveTargets {
+		live[lang] = true
+	}
+
+	pending := make([]string, 0, len(b.languages))
+	for _, lang := range b.languages {
+		if lang == sourceLang || live[lang] {
+			continue
+		}
+		pending = append(pending, lang)
+	}
+	return pending
+}
+
+// Add queues a final transcript for batch translation
+func (b *ArchiveBatcher) Add(entry *ArchiveEntry) {
+	if b == nil || len(entry.TargetLangs) == 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.entries) >= MaxArchiveBufferEntries {
+		b.entries = b.entries[1:]
+		b.dropped++
+	}
+	b.entries = append(b.entries, entry)
+}
+
+// Drain removes and returns all queued entries
+func (b *ArchiveBatcher) Drain() []*ArchiveEntry {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	entries := b.entries
+	b.entries = make([]*ArchiveEntry, 0)
+	return entries
+}
+
+// Pending returns the number of queued entries
+func (b *ArchiveBatcher) Pending() int {
+	if b == nil {
+		return 0
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.entries)
+}
+
+// Dropped returns the number of entries dropped due to buffer overflow
+func (b *ArchiveBatcher) Dropped() int64 {
+	if b == nil {
+		return 0
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.dropped
+}
@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// TTS output formats Polly can produce
+const (
+	AudioFormatMP3 = "mp3"
+	AudioFormatOgg = "ogg_vorbis"
+	AudioFormatPCM = "pcm" // 16-bit signed little-endian mono
+
+	DefaultAudioSampleRate = 24000
+)
+
+// pollySampleRates are the sample rates Polly supports per output format, best first
+var pollySampleRates = map[string][]int{
+	AudioFormatMP3: {24000, 22050, 16000, 8000},
+	AudioFormatOgg: {24000, 22050, 16000, 8000},
+	AudioFormatPCM: {16000, 8000},
+}
+
+// ErrNoAudioProfile is returned when none of a client's accepted formats can be produced
+var ErrNoAudioProfile = errors.New("no supported audio format within the client's constraints")
+
+// AudioProfile is the TTS output format a listener receives.
+// nil means Polly's default (mp3 at 24kHz), which every client accepts.
+type AudioProfile struct {
+	Format     string `json:"format"`
+	SampleRate int    `json:"sampleRate"`
+}
+
+// IsDefault reports whether the profile is equivalent to the default mp3 output
+func (a *AudioProfile) IsDefault() bool {
+	return a == nil || ((a.Format == "" || a.Format == AudioFormatMP3) && (a.SampleRate == 0 || a.SampleRate == DefaultAudioSampleRate))
+}
+
+// Key identifies the profile for caching and audio routing ("" for the default output)
+func (a *AudioProfile) Key() string {
+	if a.IsDefault() {
+		return ""
+	}
+	format, sampleRate := a.Output()
+	return format + "@" + strconv.Itoa(int(sampleRate))
+}
+
+// Output returns the Polly output format and sample rate of the profile
+func (a *AudioProfile) Output() (string, int32) {
+	if a.IsDefault() {
+		return AudioFormatMP3, DefaultAudioSampleRate
+	}
+	format := a.Format
+	if format == "" {
+		format = AudioFormatMP3
+	}
+	sampleRate := a.SampleRate
+	if sampleRate == 0 {
+		sampleRate = pollySampleRates[format][0]
+	}
+	return format, int32(sampleRate)
+}
+
+// EstimateAudioBitrate estimates the bitrate (bits/sec) of Polly output.
+// pcm is uncompressed 16-bit mono; Polly's mp3/ogg use about 2 bits per sample (48kbps at 24kHz).
+func EstimateAudioBitrate(format string, sampleRate int) int {
+	if sampleRate <= 0 {
+		sampleRate = DefaultAudioSampleRate
+	}
+	if format == AudioFormatPCM {
+		return sampleRate * 16
+	}
+	return sampleRate * 2
+}
+
+// NegotiateAudioProfile picks the best output a client accepts. Codecs are in the client's order of
+// preference (empty = mp3); within a codec the highest sample rate that the client accepts
+// (empty = any) and that fits maxBitrate (0 = unlimited) wins. Unknown codecs are ignored.
+func NegotiateAudioProfile(codecs []string, sampleRates []int, maxBitrate int) (*AudioProfile, error) {
+	if len(codecs) == 0 {
+		codecs = []string{AudioFormatMP3}
+	}
+
+	accepted := make(map[int]bool, len(sampleRates))
+	for _, rate := range sampleRates {
+		accepted[rate] = true
+	}
+
+	for _, codec := range codecs {
+		format := normalizeAudioFormat(codec)
+		for _, rate := range pollySampleRates[format] {
+			if len(accepted) > 0 && !accepted[rate] {
+				continue
+			}
+			if maxBitrate > 0 && EstimateAudioBitrate(format, rate) > maxBitrate {
+				continue
+			}
+			profile := &AudioProfile{Format: format, SampleRate: rate}
+			if profile.IsDefault() {
+				return nil, nil
+			}
+			return profile, nil
+		}
+	}
+	return nil, ErrNoAudioProfile
+}
+
+// normalizeAudioFormat maps codec names clients commonly send to Polly formats ("" if unsupported)
+func normalizeAudioFormat(codec string) string {
+	switch strings.ToLower(strings.TrimSpace(codec)) {
+	case "mp3", "mpeg", "audio/mpeg":
+		return AudioFormatMP3
+	case "ogg", "vorbis", "ogg_vorbis", "audio/ogg":
+		return AudioFormatOgg
+	case "pcm", "l16", "s16le", "audio/l16":
+		return AudioFormatPCM
+	}
+	return ""
+}
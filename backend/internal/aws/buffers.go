@@ -0,0 +1,44 @@
+package aws
+
+// Default channel capacities (the "medium" buffer profile)
+const (
+	DefaultTranscriptBuffer       = 100
+	DefaultAudioBuffer            = 200
+	DefaultStreamTranscriptBuffer = 100
+	DefaultStreamAudioBuffer      = 200
+)
+
+// BufferSizes are the channel capacities of a pipeline and its Transcribe streams.
+// Zero fields use the defaults.
+type BufferSizes struct {
+	TranscriptChan    int // Transcripts waiting for the room
+	AudioChan         int // TTS audio waiting for the room
+	StreamTranscripts int // Transcribe results waiting for the pipeline (per stream)
+	StreamAudioIn     int // Speaker audio waiting to be sent to Transcribe (per stream)
+}
+
+// withDefaults returns a copy with every unset size filled in (nil = all defaults)
+func (b *BufferSizes) withDefaults() BufferSizes {
+	sizes := BufferSizes{
+		TranscriptChan:    DefaultTranscriptBuffer,
+		AudioChan:         DefaultAudioBuffer,
+		StreamTranscripts: DefaultStreamTranscriptBuffer,
+		StreamAudioIn:     DefaultStreamAudioBuffer,
+	}
+	if b == nil {
+		return sizes
+	}
+	if b.TranscriptChan > 0 {
+		sizes.TranscriptChan = b.TranscriptChan
+	}
+	if b.AudioChan > 0 {
+		sizes.AudioChan = b.AudioChan
+	}
+	if b.StreamTranscripts > 0 {
+		sizes.StreamTranscripts = b.StreamTranscripts
+	}
+	if b.StreamAudioIn > 0 {
+		sizes.StreamAudioIn = b.StreamAudioIn
+	}
+	return sizes
+}
@@ -1,33 +1,155 @@
 package aws
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"log"
-	"sync"
+	"sync/atomic"
 	"time"
+	"unicode/utf8"
 )
 
+// Estimated on-demand prices used for the cost savings report (USD per character).
+// Polly uses the neural voice price since most rooms use neural voices.
+const (
+	TranslateCostPerChar = 15.0 / 1_000_000
+	PollyCostPerChar     = 16.0 / 1_000_000
+)
+
+// CacheMetrics reports how much work the cache saved compared to live API calls
+type CacheMetrics struct {
+	TranslationHits     int64   `json:"translationHits"`
+	TranslationMisses   int64   `json:"translationMisses"`
+	TranslationHitRate  float64 `json:"translationHitRate"`
+	TranslateCharsSaved int64   `json:"translateCharsSaved"`
+	TTSHits             int64   `json:"ttsHits"`
+	TTSMisses           int64   `json:"ttsMisses"`
+	TTSHitRate          float64 `json:"ttsHitRate"`
+	PollyCharsSaved     int64   `json:"pollyCharsSaved"`
+	TTSBytesServed      int64   `json:"ttsBytesServed"`
+	SharedHits          int64   `json:"sharedHits"` // Hits served by the shared (Redis) tier
+	SharedErrors        int64   `json:"sharedErrors"`
+	TranslationEvicted  int64   `json:"translationEvicted"` // Entries evicted by the LRU bounds (not TTL)
+	TTSEvicted          int64   `json:"ttsEvicted"`
+	EstimatedSavingsUSD float64 `json:"estimatedSavingsUsd"`
+}
+
+// cacheCounters are the raw counters behind CacheMetrics
+type cacheCounters struct {
+	translationHits     int64
+	translationMisses   int64
+	translateCharsSaved int64
+	ttsHits             int64
+	ttsMisses           int64
+	pollyCharsSaved     int64
+	ttsBytesServed      int64
+	sharedHits          int64
+	sharedErrors        int64
+	translationEvicted  int64
+	ttsEvicted          int64
+}
+
+// globalCacheCounters aggregate every PipelineCache (including closed ones) since startup
+var globalCacheCounters cacheCounters
+
+func (c *cacheCounters) translationHit(chars int) {
+	atomic.AddInt64(&c.translationHits, 1)
+	atomic.AddInt64(&c.translateCharsSaved, int64(chars))
+}
+
+func (c *cacheCounters) ttsHit(chars, bytes int) {
+	atomic.AddInt64(&c.ttsHits, 1)
+	atomic.AddInt64(&c.pollyCharsSaved, int64(chars))
+	atomic.AddInt64(&c.ttsBytesServed, int64(bytes))
+}
+
+func (c *cacheCounters) metrics() *CacheMetrics {
+	m := &CacheMetrics{
+		TranslationHits:     atomic.LoadInt64(&c.translationHits),
+		TranslationMisses:   atomic.LoadInt64(&c.translationMisses),
+		TranslateCharsSaved: atomic.LoadInt64(&c.translateCharsSaved),
+		TTSHits:             atomic.LoadInt64(&c.ttsHits),
+		TTSMisses:           atomic.LoadInt64(&c.ttsMisses),
+		PollyCharsSaved:     atomic.LoadInt64(&c.pollyCharsSaved),
+		TTSBytesServed:      atomic.LoadInt64(&c.ttsBytesServed),
+		SharedHits:          atomic.LoadInt64(&c.sharedHits),
+		SharedErrors:        atomic.LoadInt64(&c.sharedErrors),
+		TranslationEvicted:  atomic.LoadInt64(&c.translationEvicted),
+		TTSEvicted:          atomic.LoadInt64(&c.ttsEvicted),
+	}
+	if total := m.TranslationHits + m.TranslationMisses; total > 0 {
+		m.TranslationHitRate = float64(m.TranslationHits) / float64(total)
+	}
+	if total := m.TTSHits + m.TTSMisses; total > 0 {
+		m.TTSHitRate = float64(m.TTSHits) / float64(total)
+	}
+	m.EstimatedSavingsUSD = float64(m.TranslateCharsSaved)*TranslateCostPerChar +
+		float64(m.PollyCharsSaved)*PollyCostPerChar
+	return m
+}
+
+// GlobalCacheMetrics returns cache metrics aggregated over all pipelines since startup
+func GlobalCacheMetrics() *CacheMetrics {
+	return globalCacheCounters.metrics()
+}
+
 // CacheEntry represents a cached item with expiration
 type CacheEntry struct {
 	Value     interface{}
 	ExpiresAt time.Time
 }
 
-// PipelineCache provides caching for Translation and TTS results
+// In-process cache bounds (per pipeline)
+const (
+	DefaultMaxTranslationEntries = 5000
+	DefaultMaxTTSBytes           = 16 * 1024 * 1024
+)
+
+// Shared cache constants
+const (
+	SharedCacheKeyPrefix       = "aicache:"
+	SharedCacheTimeout         = 100 * time.Millisecond // A slow shared tier must not delay captions/TTS
+	DefaultSharedCacheTTL      = 24 * time.Hour
+	DefaultSharedCacheMaxBytes = 256 * 1024
+)
+
+// SharedCache is a second cache tier shared by all rooms and backend instances (e.g. Redis).
+// GetBytes returns nil without error on a miss.
+type SharedCache interface {
+	GetBytes(ctx context.Context, key string) ([]byte, error)
+	SetBytes(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// PipelineCache provides caching for Translation and TTS results.
+// Misses in the in-process tier fall through to the optional shared tier.
 type PipelineCache struct {
-	translationCache sync.Map // key: "text:srcLang:tgtLang" → TranslationResult
-	ttsCache         sync.Map // key: "text:lang" → []byte (audio)
+	translationCache *lruCache // key: "text:srcLang:tgtLang" → TranslationResult (bounded by entries)
+	ttsCache         *lruCache // key: "text:lang:voice" → []byte audio (bounded by bytes)
 
 	ttl             time.Duration
 	cleanupInterval time.Duration
 	stopCleanup     chan struct{}
+
+	shared         SharedCache
+	sharedTTL      time.Duration
+	sharedMaxBytes int
+	sharedScope    atomic.Value // string: translations differ per terminology, so they're only shared within a scope
+
+	counters cacheCounters
 }
 
 // CacheConfig configuration for cache
 type CacheConfig struct {
 	TTL             time.Duration // Cache entry lifetime (default: 5 minutes)
 	CleanupInterval time.Duration // Cleanup interval (default: 1 minute)
+
+	MaxTranslationEntries int   // Translations kept in-process (default: 5000)
+	MaxTTSBytes           int64 // Total TTS audio kept in-process (default: 16MB)
+
+	Shared         SharedCache   // Optional shared tier (nil = in-process only)
+	SharedTTL      time.Duration // Shared entry lifetime (default: 24 hours)
+	SharedMaxBytes int           // Entries larger than this stay in-process only (default: 256KB)
 }
 
 // DefaultCacheConfig returns default cache configuration
@@ -44,16 +166,38 @@ func NewPipelineCache(cfg *CacheConfig) *PipelineCache {
 		cfg = DefaultCacheConfig()
 	}
 
+	maxTranslations := cfg.MaxTranslationEntries
+	if maxTranslations <= 0 {
+		maxTranslations = DefaultMaxTranslationEntries
+	}
+	maxTTSBytes := cfg.MaxTTSBytes
+	if maxTTSBytes <= 0 {
+		maxTTSBytes = DefaultMaxTTSBytes
+	}
+
 	cache := &PipelineCache{
-		ttl:             cfg.TTL,
-		cleanupInterval: cfg.CleanupInterval,
-		stopCleanup:     make(chan struct{}),
+		translationCache: newLRUCache(maxTranslations, 0),
+		ttsCache:         newLRUCache(0, maxTTSBytes),
+		ttl:              cfg.TTL,
+		cleanupInterval:  cfg.CleanupInterval,
+		stopCleanup:      make(chan struct{}),
+		shared:           cfg.Shared,
+		sharedTTL:        cfg.SharedTTL,
+		sharedMaxBytes:   cfg.SharedMaxBytes,
+	}
+	if cache.sharedTTL <= 0 {
+		cache.sharedTTL = DefaultSharedCacheTTL
+	}
+	if cache.sharedMaxBytes <= 0 {
+		cache.sharedMaxBytes = DefaultSharedCacheMaxBytes
 	}
+	cache.sharedScope.Store("")
 
 	// Start cleanup goroutine
 	go cache.cleanupLoop()
 
-	log.Printf("[Cache] Initialized with TTL=%v, cleanup interval=%v", cfg.TTL, cfg.CleanupInterval)
+	log.Printf("[Cache] Initialized with TTL=%v, cleanup interval=%v, max translations=%d, max TTS bytes=%d, shared=%v",
+		cfg.TTL, cfg.CleanupInterval, maxTranslations, maxTTSBytes, cfg.Shared != nil)
 
 	return cache
 }
@@ -87,16 +231,31 @@ func hashKey(text string) string {
 func (c *PipelineCache) GetTranslation(text, srcLang, tgtLang string) (*TranslationResult, bool) {
 	key := generateKey(hashKey(text), srcLang, tgtLang)
 
-	if entry, ok := c.translationCache.Load(key); ok {
-		cached := entry.(*CacheEntry)
-		if time.Now().Before(cached.ExpiresAt) {
-			log.Printf("[Cache] Translation HIT: %s→%s", srcLang, tgtLang)
-			return cached.Value.(*TranslationResult), true
+	if cached, ok := c.translationCache.Get(key, time.Now()); ok {
+		log.Printf("[Cache] Translation HIT: %s→%s", srcLang, tgtLang)
+		chars := utf8.RuneCountInString(text)
+		c.counters.translationHit(chars)
+		globalCacheCounters.translationHit(chars)
+		return cached.Value.(*TranslationResult), true
+	}
+
+	if data := c.getShared(c.sharedTranslationKey(text, srcLang, tgtLang)); data != nil {
+		result := &TranslationResult{
+			SourceText:     text,
+			SourceLanguage: srcLang,
+			TargetLanguage: tgtLang,
+			TranslatedText: string(data),
 		}
-		// Expired, delete it
-		c.translationCache.Delete(key)
+		c.storeTranslation(key, result)
+		log.Printf("[Cache] Translation shared HIT: %s→%s", srcLang, tgtLang)
+		chars := utf8.RuneCountInString(text)
+		c.counters.translationHit(chars)
+		globalCacheCounters.translationHit(chars)
+		return result, true
 	}
 
+	atomic.AddInt64(&c.counters.translationMisses, 1)
+	atomic.AddInt64(&globalCacheCounters.translationMisses, 1)
 	return nil, false
 }
 
@@ -104,10 +263,8 @@ func (c *PipelineCache) GetTranslation(text, srcLang, tgtLang string) (*Translat
 func (c *PipelineCache) SetTranslation(text, srcLang, tgtLang string, result *TranslationResult) {
 	key := generateKey(hashKey(text), srcLang, tgtLang)
 
-	c.translationCache.Store(key, &CacheEntry{
-		Value:     result,
-		ExpiresAt: time.Now().Add(c.ttl),
-	})
+	c.storeTranslation(key, result)
+	c.setShared(c.sharedTranslationKey(text, srcLang, tgtLang), []byte(result.TranslatedText))
 
 	log.Printf("[Cache] Translation SET: %s→%s", srcLang, tgtLang)
 }
@@ -116,35 +273,126 @@ func (c *PipelineCache) SetTranslation(text, srcLang, tgtLang string, result *Tr
 // TTS Cache
 // =============================================================================
 
-// GetTTS retrieves cached TTS audio
-func (c *PipelineCache) GetTTS(text, lang string) ([]byte, bool) {
-	key := generateKey(hashKey(text), lang)
+// GetTTS retrieves cached TTS audio for a voice ("" = default voice)
+func (c *PipelineCache) GetTTS(text, lang, voiceKey string) ([]byte, bool) {
+	key := generateKey(hashKey(text), lang, voiceKey)
+
+	if cached, ok := c.ttsCache.Get(key, time.Now()); ok {
+		audio := cached.Value.([]byte)
+		log.Printf("[Cache] TTS HIT: lang=%s, size=%d bytes", lang, len(audio))
+		chars := utf8.RuneCountInString(text)
+		c.counters.ttsHit(chars, len(audio))
+		globalCacheCounters.ttsHit(chars, len(audio))
+		return audio, true
+	}
 
-	if entry, ok := c.ttsCache.Load(key); ok {
-		cached := entry.(*CacheEntry)
-		if time.Now().Before(cached.ExpiresAt) {
-			log.Printf("[Cache] TTS HIT: lang=%s, size=%d bytes", lang, len(cached.Value.([]byte)))
-			return cached.Value.([]byte), true
-		}
-		// Expired, delete it
-		c.ttsCache.Delete(key)
+	if audio := c.getShared(sharedTTSKey(text, lang, voiceKey)); audio != nil {
+		c.storeTTS(key, audio)
+		log.Printf("[Cache] TTS shared HIT: lang=%s, size=%d bytes", lang, len(audio))
+		chars := utf8.RuneCountInString(text)
+		c.counters.ttsHit(chars, len(audio))
+		globalCacheCounters.ttsHit(chars, len(audio))
+		return audio, true
 	}
 
+	atomic.AddInt64(&c.counters.ttsMisses, 1)
+	atomic.AddInt64(&globalCacheCounters.ttsMisses, 1)
 	return nil, false
 }
 
-// SetTTS stores TTS audio in cache
-func (c *PipelineCache) SetTTS(text, lang string, audioData []byte) {
-	key := generateKey(hashKey(text), lang)
+// SetTTS stores TTS audio in cache for a voice ("" = default voice)
+func (c *PipelineCache) SetTTS(text, lang, voiceKey string, audioData []byte) {
+	key := generateKey(hashKey(text), lang, voiceKey)
 
-	c.ttsCache.Store(key, &CacheEntry{
-		Value:     audioData,
-		ExpiresAt: time.Now().Add(c.ttl),
-	})
+	c.storeTTS(key, audioData)
+	c.setShared(sharedTTSKey(text, lang, voiceKey), audioData)
 
 	log.Printf("[Cache] TTS SET: lang=%s, size=%d bytes", lang, len(audioData))
 }
 
+// storeTranslation stores a translation in the in-process LRU
+func (c *PipelineCache) storeTranslation(key string, result *TranslationResult) {
+	size := int64(len(result.SourceText) + len(result.TranslatedText))
+	if evicted := c.translationCache.Set(key, &CacheEntry{Value: result, ExpiresAt: time.Now().Add(c.ttl)}, size); evicted > 0 {
+		atomic.AddInt64(&c.counters.translationEvicted, int64(evicted))
+		atomic.AddInt64(&globalCacheCounters.translationEvicted, int64(evicted))
+	}
+}
+
+// storeTTS stores audio in the in-process LRU (bounded by total audio bytes)
+func (c *PipelineCache) storeTTS(key string, audio []byte) {
+	if evicted := c.ttsCache.Set(key, &CacheEntry{Value: audio, ExpiresAt: time.Now().Add(c.ttl)}, int64(len(audio))); evicted > 0 {
+		atomic.AddInt64(&c.counters.ttsEvicted, int64(evicted))
+		atomic.AddInt64(&globalCacheCounters.ttsEvicted, int64(evicted))
+	}
+}
+
+// =============================================================================
+// Shared tier
+// =============================================================================
+
+// SetSharedScope sets the namespace of shared translations (e.g. the custom terminology in use)
+func (c *PipelineCache) SetSharedScope(scope string) {
+	c.sharedScope.Store(scope)
+}
+
+// sharedHash hashes the full text (keys must not collide across instances and must not store raw text)
+func sharedHash(text string) string {
+	hash := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(hash[:])
+}
+
+func (c *PipelineCache) sharedTranslationKey(text, srcLang, tgtLang string) string {
+	return SharedCacheKeyPrefix + generateKey("tr", c.sharedScope.Load().(string), srcLang, tgtLang, sharedHash(text))
+}
+
+func sharedTTSKey(text, lang, voiceKey string) string {
+	return SharedCacheKeyPrefix + generateKey("tts", lang, voiceKey, sharedHash(text))
+}
+
+// getShared looks up the shared tier (nil on miss, error or when disabled)
+func (c *PipelineCache) getShared(key string) []byte {
+	if c.shared == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), SharedCacheTimeout)
+	defer cancel()
+
+	data, err := c.shared.GetBytes(ctx, key)
+	if err != nil {
+		c.sharedError(err)
+		return nil
+	}
+	if data != nil {
+		atomic.AddInt64(&c.counters.sharedHits, 1)
+		atomic.AddInt64(&globalCacheCounters.sharedHits, 1)
+	}
+	return data
+}
+
+// setShared writes to the shared tier in the background (entries over the size cap are skipped)
+func (c *PipelineCache) setShared(key string, value []byte) {
+	if c.shared == nil || len(value) == 0 || len(value) > c.sharedMaxBytes {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		if err := c.shared.SetBytes(ctx, key, value, c.sharedTTL); err != nil {
+			c.sharedError(err)
+		}
+	}()
+}
+
+func (c *PipelineCache) sharedError(err error) {
+	atomic.AddInt64(&c.counters.sharedErrors, 1)
+	if atomic.AddInt64(&globalCacheCounters.sharedErrors, 1)%100 == 1 {
+		log.Printf("[Cache] Shared cache error (logged every 100): %v", err)
+	}
+}
+
 // =============================================================================
 // Cleanup
 // =============================================================================
@@ -167,26 +415,8 @@ func (c *PipelineCache) cleanupLoop() {
 // cleanup removes expired entries from all caches
 func (c *PipelineCache) cleanup() {
 	now := time.Now()
-	translationCleaned := 0
-	ttsCleaned := 0
-
-	c.translationCache.Range(func(key, value interface{}) bool {
-		entry := value.(*CacheEntry)
-		if now.After(entry.ExpiresAt) {
-			c.translationCache.Delete(key)
-			translationCleaned++
-		}
-		return true
-	})
-
-	c.ttsCache.Range(func(key, value interface{}) bool {
-		entry := value.(*CacheEntry)
-		if now.After(entry.ExpiresAt) {
-			c.ttsCache.Delete(key)
-			ttsCleaned++
-		}
-		return true
-	})
+	translationCleaned := c.translationCache.RemoveExpired(now)
+	ttsCleaned := c.ttsCache.RemoveExpired(now)
 
 	if translationCleaned > 0 || ttsCleaned > 0 {
 		log.Printf("[Cache] Cleanup: removed %d translations, %d TTS entries",
@@ -200,15 +430,37 @@ func (c *PipelineCache) Close() {
 	log.Printf("[Cache] Closed")
 }
 
+// CacheStats is the current size of the in-process tier plus hit/miss/eviction counters
+type CacheStats struct {
+	TranslationEntries   int   `json:"translationEntries"`
+	TranslationHits      int64 `json:"translationHits"`
+	TranslationMisses    int64 `json:"translationMisses"`
+	TranslationEvictions int64 `json:"translationEvictions"`
+	TTSEntries           int   `json:"ttsEntries"`
+	TTSBytes             int64 `json:"ttsBytes"`
+	TTSHits              int64 `json:"ttsHits"`
+	TTSMisses            int64 `json:"ttsMisses"`
+	TTSEvictions         int64 `json:"ttsEvictions"`
+}
+
 // Stats returns cache statistics
-func (c *PipelineCache) Stats() (translationCount, ttsCount int) {
-	c.translationCache.Range(func(_, _ interface{}) bool {
-		translationCount++
-		return true
-	})
-	c.ttsCache.Range(func(_, _ interface{}) bool {
-		ttsCount++
-		return true
-	})
-	return
+func (c *PipelineCache) Stats() CacheStats {
+	translationEntries, _, translationEvictions := c.translationCache.Stats()
+	ttsEntries, ttsBytes, ttsEvictions := c.ttsCache.Stats()
+	return CacheStats{
+		TranslationEntries:   translationEntries,
+		TranslationHits:      atomic.LoadInt64(&c.counters.translationHits),
+		TranslationMisses:    atomic.LoadInt64(&c.counters.translationMisses),
+		TranslationEvictions: translationEvictions,
+		TTSEntries:           ttsEntries,
+		TTSBytes:             ttsBytes,
+		TTSHits:              atomic.LoadInt64(&c.counters.ttsHits),
+		TTSMisses:            atomic.LoadInt64(&c.counters.ttsMisses),
+		TTSEvictions:         ttsEvictions,
+	}
+}
+
+// Metrics returns hit/miss counters and estimated savings for this cache
+func (c *PipelineCache) Metrics() *CacheMetrics {
+	return c.counters.metrics()
 }
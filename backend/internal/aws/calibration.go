@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Noise gate calibration constants
+const (
+	CalibrationDuration    = 5 * time.Second // Audio per speaker used to establish the noise floor
+	CalibrationFrameMs     = 20              // RMS is measured over 20ms frames
+	CalibrationSampleRate  = 16000           // Incoming audio is 16kHz mono 16-bit PCM
+	NoiseFloorPercentile   = 0.2             // Quietest 20% of frames approximates background noise
+	QuietNoiseFloorDBFS    = -60.0           // At or below: clean mic, trust low-confidence speech
+	NoisyNoiseFloorDBFS    = -30.0           // At or above: noisy room, require high confidence
+	MinDynamicConfidence   = 0.35
+	MaxDynamicConfidence   = 0.7
+	silenceFloorDBFS       = -96.0 // 16-bit digital silence
+	calibrationBytesPerSec = CalibrationSampleRate * 2
+)
+
+// SpeakerNoiseProfile is the calibration result for a single speaker
+type SpeakerNoiseProfile struct {
+	NoiseFloorDBFS      float64 `json:"noiseFloorDbfs"`
+	ConfidenceThreshold float32 `json:"confidenceThreshold"`
+	Calibrated          bool    `json:"calibrated"`
+}
+
+type speakerCalibration struct {
+	frameRMS  []float64 // dBFS per frame collected during calibration
+	remainder []byte    // Partial frame carried over between chunks
+	bytesSeen int
+	profile   SpeakerNoiseProfile
+}
+
+// NoiseCalibrator measures each speaker's background noise during their first
+// CalibrationDuration of audio and derives a per-speaker confidence threshold:
+// quiet speakers get a lower bar, noisy rooms a higher one. Until calibration
+// finishes the global MinConfidenceThreshold applies.
+type NoiseCalibrator struct {
+	speakers map[string]*speakerCalibration
+	mu       sync.Mutex
+}
+
+// NewNoiseCalibrator creates a new noise calibrator
+func NewNoiseCalibrator() *NoiseCalibrator {
+	return &NoiseCalibrator{
+		speakers: make(map[string]*speakerCalibration),
+	}
+}
+
+// Observe feeds a chunk of a speaker's PCM audio into calibration.
+// Chunks after calibration has completed are ignored.
+func (c *NoiseCalibrator) Observe(speakerID string, audioData []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cal, ok := c.speakers[speakerID]
+	if !ok {
+		cal = &speakerCalibration{
+			profile: SpeakerNoiseProfile{ConfidenceThreshold: MinConfidenceThreshold},
+		}
+		c.speakers[speakerID] = cal
+	}
+	if cal.profile.Calibrated {
+		return
+	}
+
+	frameBytes := CalibrationSampleRate * CalibrationFrameMs / 1000 * 2
+	data := append(cal.remainder, audioData...)
+	for len(data) >= frameBytes {
+		cal.frameRMS = append(cal.frameRMS, frameDBFS(data[:frameBytes]))
+		data = data[frameBytes:]
+	}
+	cal.remainder = append([]byte(nil), data...)
+	cal.bytesSeen += len(audioData)
+
+	if time.Duration(cal.bytesSeen)*time.Second/calibrationBytesPerSec >= CalibrationDuration {
+		cal.finish()
+	}
+}
+
+// ConfidenceThreshold returns the minimum transcript confidence for a speaker
+func (c *NoiseCalibrator) ConfidenceThreshold(speakerID string) float32 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cal, ok := c.speakers[speakerID]; ok && cal.profile.Calibrated {
+		return cal.profile.ConfidenceThreshold
+	}
+	return MinConfidenceThreshold
+}
+
+// Profiles returns a snapshot of all speaker noise profiles
+func (c *NoiseCalibrator) Profiles() map[string]SpeakerNoiseProfile {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	profiles := make(map[string]SpeakerNoiseProfile, len(c.speakers))
+	for id, cal := range c.speakers {
+		profiles[id] = cal.profile
+	}
+	return profiles
+}
+
+// Profile returns a single speaker's noise profile
+func (c *NoiseCalibrator) Profile(speakerID string) (SpeakerNoiseProfile, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cal, ok := c.speakers[speakerID]
+	if !ok {
+		return SpeakerNoiseProfile{}, false
+	}
+	return cal.profile, true
+}
+
+// Reset discards a speaker's calibration (recalibrates on their next audio)
+func (c *NoiseCalibrator) Reset(speakerID string) {
+	c.mu.Lock()
+	delete(c.speakers, speakerID)
+	c.mu.Unlock()
+}
+
+// finish computes the noise floor and threshold from collected frames
+func (cal *speakerCalibration) finish() {
+	cal.profile.Calibrated = true
+	cal.remainder = nil
+	if len(cal.frameRMS) == 0 {
+		return
+	}
+
+	sort.Float64s(cal.frameRMS)
+	idx := int(float64(len(cal.frameRMS)-1) * NoiseFloorPercentile)
+	floor := cal.frameRMS[idx]
+	cal.frameRMS = nil
+
+	cal.profile.NoiseFloorDBFS = floor
+	cal.profile.ConfidenceThreshold = thresholdForNoiseFloor(floor)
+}
+
+// thresholdForNoiseFloor maps a noise floor linearly onto the dynamic confidence range
+func thresholdForNoiseFloor(floorDBFS float64) float32 {
+	switch {
+	case floorDBFS <= QuietNoiseFloorDBFS:
+		return MinDynamicConfidence
+	case floorDBFS >= NoisyNoiseFloorDBFS:
+		return MaxDynamicConfidence
+	}
+	ratio := (floorDBFS - QuietNoiseFloorDBFS) / (NoisyNoiseFloorDBFS - QuietNoiseFloorDBFS)
+	return float32(MinDynamicConfidence + ratio*(MaxDynamicConfidence-MinDynamicConfidence))
+}
+
+// frameDBFS returns the RMS level of a 16-bit little-endian PCM frame in dBFS
+func frameDBFS(frame []byte) float64 {
+	samples := len(frame) / 2
+	if samples == 0 {
+		return silenceFloorDBFS
+	}
+
+	var sum float64
+	for i := 0; i < samples; i++ {
+		s := float64(int16(binary.LittleEndian.Uint16(frame[i*2:]))) / 32768
+		sum += s * s
+	}
+	rms := math.Sqrt(sum / float64(samples))
+	if rms == 0 {
+		return silenceFloorDBFS
+	}
+	return math.Max(20*math.Log10(rms), silenceFloorDBFS)
+}
@@ -1,9 +1,12 @@
 package aws
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"time"
+
+	"realtime-backend/internal/retry"
 )
 
 // Circuit Breaker States
@@ -235,3 +238,58 @@ func (cb *CircuitBreaker) ForceClose() {
 	defer cb.mu.Unlock()
 	cb.reset()
 }
+
+// Available reports whether a call would currently be attempted, without changing state.
+// Use it to skip work (e.g. acquiring a semaphore) while the breaker is open.
+func (cb *CircuitBreaker) Available() bool {
+	cb.mu.RLock()
+	defer cb.mu.RUnlock()
+
+	switch cb.state {
+	case StateOpen:
+		return time.Since(cb.openTime) > cb.cooldownPeriod
+	case StateHalfOpen:
+		return cb.halfOpenRequests < cb.maxHalfOpen
+	default:
+		return true
+	}
+}
+
+// breakerFailure returns the error a wrapped call should report to its breaker: nil unless
+// the service itself looks unhealthy (throttling, 5xx or transport failures).
+// Cancelled/expired contexts and rejected requests say nothing about the service, and the
+// breakers are shared by every room, so they must not count.
+func breakerFailure(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() != nil || errors.Is(err, ErrUnsupportedLanguage) {
+		return nil
+	}
+	if retry.DefaultClassify(err) == retry.Permanent {
+		return nil
+	}
+	return err
+}
+
+// ServiceBreakers holds one circuit breaker per AWS service used by the pipeline
+type ServiceBreakers struct {
+	Transcribe *CircuitBreaker
+	Translate  *CircuitBreaker
+	Polly      *CircuitBreaker
+}
+
+// NewServiceBreakers creates breakers with the default configuration
+func NewServiceBreakers() *ServiceBreakers {
+	return &ServiceBreakers{
+		Transcribe: NewCircuitBreaker(DefaultCircuitBreakerConfig("transcribe")),
+		Translate:  NewCircuitBreaker(DefaultCircuitBreakerConfig("translate")),
+		Polly:      NewCircuitBreaker(DefaultCircuitBreakerConfig("polly")),
+	}
+}
+
+// States returns the state of each breaker (service -> closed/open/half-open)
+func (b *ServiceBreakers) States() map[string]string {
+	return map[string]string{
+		"transcribe": b.Transcribe.State(),
+		"translate":  b.Translate.State(),
+		"polly":      b.Polly.State(),
+	}
+}
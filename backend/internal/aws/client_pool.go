@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -20,10 +21,19 @@ type AWSClientPool struct {
 	Transcribe *TranscribeClient
 	Translate  *TranslateClient
 	Polly      *PollyClient
+	Comprehend *ComprehendClient
+
+	// Per-service circuit breakers shared by every pipeline using this pool
+	Breakers *ServiceBreakers
 
 	awsConfig  aws.Config
 	sampleRate int32
 
+	// Per-language Transcribe regions (e.g. ja -> ap-northeast-1); clients are created lazily
+	transcribeRegions  map[string]string
+	regionalTranscribe map[string]*TranscribeClient
+	regionalMu         sync.Mutex
+
 	mu       sync.RWMutex
 	closed   bool
 	refCount int32 // Track active pipelines using this pool
@@ -66,18 +76,70 @@ func NewAWSClientPool(ctx context.Context, cfg *appconfig.Config, poolCfg *AWSCl
 		Transcribe: NewTranscribeClient(awsCfg, poolCfg.SampleRate),
 		Translate:  NewTranslateClient(awsCfg),
 		Polly:      NewPollyClient(awsCfg),
+		Comprehend: NewComprehendClient(awsCfg),
+		Breakers:   NewServiceBreakers(),
 		awsConfig:  awsCfg,
 		sampleRate: poolCfg.SampleRate,
 		closed:     false,
 		refCount:   0,
+
+		transcribeRegions:  ParseLanguageRegions(cfg.AI.TranscribeRegions),
+		regionalTranscribe: make(map[string]*TranscribeClient),
 	}
 
-	log.Printf("[AWSClientPool] Created shared client pool (region=%s, sampleRate=%d)",
-		cfg.S3.Region, poolCfg.SampleRate)
+	log.Printf("[AWSClientPool] Created shared client pool (region=%s, sampleRate=%d, transcribeRegions=%v)",
+		cfg.S3.Region, poolCfg.SampleRate, pool.transcribeRegions)
 
 	return pool, nil
 }
 
+// ParseLanguageRegions parses "lang:region" specs (e.g. "ja:ap-northeast-1").
+// Invalid specs are skipped.
+func ParseLanguageRegions(specs []string) map[string]string {
+	regions := make(map[string]string, len(specs))
+	for _, spec := range specs {
+		lang, region, ok := strings.Cut(strings.TrimSpace(spec), ":")
+		lang = NormalizeLanguage(strings.TrimSpace(lang))
+		region = strings.TrimSpace(region)
+		if !ok || lang == "" || region == "" {
+			log.Printf("[AWSClientPool] ⚠️ Ignoring invalid Transcribe region %q", spec)
+			continue
+		}
+		regions[lang] = region
+	}
+	return regions
+}
+
+// TranscribeRegion returns the region Transcribe streams for a source language use
+func (p *AWSClientPool) TranscribeRegion(sourceLang string) string {
+	if region, ok := p.transcribeRegions[NormalizeLanguage(sourceLang)]; ok {
+		return region
+	}
+	return p.awsConfig.Region
+}
+
+// TranscribeFor returns the Transcribe client for a source language.
+// Languages mapped to another region get their own client, created on first use.
+func (p *AWSClientPool) TranscribeFor(sourceLang string) *TranscribeClient {
+	region := p.TranscribeRegion(sourceLang)
+	if region == p.awsConfig.Region {
+		return p.Transcribe
+	}
+
+	p.regionalMu.Lock()
+	defer p.regionalMu.Unlock()
+
+	if client, ok := p.regionalTranscribe[region]; ok {
+		return client
+	}
+	regionalCfg := p.awsConfig.Copy()
+	regionalCfg.Region = region
+	client := NewTranscribeClient(regionalCfg, p.sampleRate)
+	p.regionalTranscribe[region] = client
+	log.Printf("[AWSClientPool] Created Transcribe client for region %s (lang=%s)", region, sourceLang)
+	return client
+}
+
 // Acquire increments the reference count when a pipeline starts using this pool
 func (p *AWSClientPool) Acquire() {
 	p.mu.Lock()
@@ -139,9 +201,16 @@ func (p *AWSClientPool) Stats() map[string]interface{} {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
 
+	p.regionalMu.Lock()
+	regionalClients := len(p.regionalTranscribe)
+	p.regionalMu.Unlock()
+
 	return map[string]interface{}{
-		"closed":     p.closed,
-		"refCount":   p.refCount,
-		"sampleRate": p.sampleRate,
+		"closed":            p.closed,
+		"refCount":          p.refCount,
+		"sampleRate":        p.sampleRate,
+		"transcribeRegions": p.transcribeRegions,
+		"regionalClients":   regionalClients,
+		"breakers":          p.Breakers.States(),
 	}
 }
@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+// ComprehendToxicityLanguages are the languages DetectToxicContent supports
+var ComprehendToxicityLanguages = map[string]bool{
+	"en": true,
+}
+
+// ToxicityResult is the toxicity analysis of one text segment
+type ToxicityResult struct {
+	Toxicity float32            // Overall toxicity score (0..1)
+	Labels   map[string]float32 // Category (PROFANITY, HATE_SPEECH, INSULT, ...) -> score
+}
+
+// ComprehendClient wraps Amazon Comprehend toxicity detection.
+// The SDK service module isn't vendored, so requests are signed with SigV4 directly.
+type ComprehendClient struct {
+	awsCfg     aws.Config
+	region     string
+	httpClient *http.Client
+	signer     *v4.Signer
+}
+
+// NewComprehendClient creates a new Comprehend client
+func NewComprehendClient(cfg aws.Config) *ComprehendClient {
+	return &ComprehendClient{
+		awsCfg:     cfg,
+		region:     cfg.Region,
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+		signer:     v4.NewSigner(),
+	}
+}
+
+// SupportsToxicity reports whether toxicity detection is available for a language
+func (c *ComprehendClient) SupportsToxicity(lang string) bool {
+	return ComprehendToxicityLanguages[NormalizeLanguage(lang)]
+}
+
+type toxicContentRequest struct {
+	TextSegments []struct {
+		Text string `json:"Text"`
+	} `json:"TextSegments"`
+	LanguageCode string `json:"LanguageCode"`
+}
+
+type toxicContentResponse struct {
+	ResultList []struct {
+		Labels []struct {
+			Name  string  `json:"Name"`
+			Score float32 `json:"Score"`
+		} `json:"Labels"`
+		Toxicity float32 `json:"Toxicity"`
+	} `json:"ResultList"`
+	Message string `json:"message"` // Error responses
+}
+
+// DetectToxicity runs DetectToxicContent on a single text segment
+func (c *ComprehendClient) DetectToxicity(ctx context.Context, text, lang string) (*ToxicityResult, error) {
+	if !c.SupportsToxicity(lang) {
+		return nil, fmt.Errorf("toxicity detection not supported for %s", lang)
+	}
+
+	var reqBody toxicContentRequest
+	reqBody.TextSegments = append(reqBody.TextSegments, struct {
+		Text string `json:"Text"`
+	}{Text: text})
+	reqBody.LanguageCode = NormalizeLanguage(lang)
+
+	payload, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("https://comprehend.%s.amazonaws.com/", c.region)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "Comprehend_20171127.DetectToxicContent")
+
+	creds, err := c.awsCfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve AWS credentials: %w", err)
+	}
+	hash := sha256.Sum256(payload)
+	if err := c.signer.SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), "comprehend", c.region, time.Now()); err != nil {
+		return nil, fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var out toxicContentResponse
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, fmt.Errorf("invalid Comprehend response (status %d): %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("comprehend returned status %d: %s", resp.StatusCode, out.Message)
+	}
+	if len(out.ResultList) == 0 {
+		return &ToxicityResult{Labels: map[string]float32{}}, nil
+	}
+
+	result := &ToxicityResult{
+		Toxicity: out.ResultList[0].Toxicity,
+		Labels:   make(map[string]float32, len(out.ResultList[0].Labels)),
+	}
+	for _, label := range out.ResultList[0].Labels {
+		result.Labels[label.Name] = label.Score
+	}
+	return result, nil
+}
@@ -0,0 +1,195 @@
+package aws
+
+import (
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Crosstalk detection constants
+const (
+	CrosstalkVoiceMarginDB    = 10.0                    // Chunk must exceed the speaker's noise floor by this much to count as voice
+	CrosstalkDefaultVoiceDBFS = -45.0                   // Voice threshold until the speaker's noise floor is calibrated
+	CrosstalkActiveWindow     = 300 * time.Millisecond  // A speaker stays "active" this long after their last voiced chunk
+	CrosstalkMinDuration      = 1500 * time.Millisecond // Overlap must persist this long before it counts as crosstalk
+	CrosstalkReleaseAfter     = 1 * time.Second         // Crosstalk ends after this long without overlap
+	CrosstalkTranscriptWindow = 5 * time.Second         // Finals overlapping crosstalk within this window are flagged
+	crosstalkHistoryRetention = 2 * time.Minute
+)
+
+// CrosstalkEvent is emitted on CrosstalkChan when sustained overlapping speech starts or ends
+type CrosstalkEvent struct {
+	Active     bool      `json:"active"`
+	SpeakerIDs []string  `json:"speakerIds"`
+	StartedAt  time.Time `json:"startedAt"`
+	DurationMs int64     `json:"durationMs,omitempty"` // Set when the crosstalk ends
+}
+
+type crosstalkInterval struct {
+	start time.Time
+	end   time.Time // zero while ongoing
+}
+
+// CrosstalkDetector tracks which speakers currently have voice energy and reports
+// sustained periods where two or more of them talk at once. STT quality drops sharply
+// in these segments, so finals that overlap them are flagged.
+type CrosstalkDetector struct {
+	noiseGate *NoiseCalibrator
+
+	lastVoiced   map[string]time.Time // speakerID -> last chunk above the voice threshold
+	overlapSince time.Time            // first moment of the current (not yet confirmed) overlap
+	lastOverlap  time.Time
+	active       bool
+	speakers     map[string]struct{} // speakers involved in the current crosstalk
+	history      []crosstalkInterval
+	mu           sync.Mutex
+}
+
+// NewCrosstalkDetector creates a detector that uses the calibrator's noise floors as voice thresholds
+func NewCrosstalkDetector(noiseGate *NoiseCalibrator) *CrosstalkDetector {
+	return &CrosstalkDetector{
+		noiseGate:  noiseGate,
+		lastVoiced: make(map[string]time.Time),
+		speakers:   make(map[string]struct{}),
+	}
+}
+
+// Observe feeds a speaker's PCM chunk. It returns an event when crosstalk starts or ends, nil otherwise.
+func (d *CrosstalkDetector) Observe(speakerID string, audioData []byte, now time.Time) *CrosstalkEvent {
+	voiced := len(audioData) > 0 && frameDBFS(audioData) >= d.voiceThreshold(speakerID)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if voiced {
+		d.lastVoiced[speakerID] = now
+	}
+
+	activeSpeakers := d.activeSpeakers(now)
+	if len(activeSpeakers) >= 2 {
+		if d.overlapSince.IsZero() {
+			d.overlapSince = now
+		}
+		d.lastOverlap = now
+		if d.active {
+			for _, id := range activeSpeakers {
+				d.speakers[id] = struct{}{}
+			}
+			return nil
+		}
+		if now.Sub(d.overlapSince) < CrosstalkMinDuration {
+			return nil
+		}
+
+		d.active = true
+		for _, id := range activeSpeakers {
+			d.speakers[id] = struct{}{}
+		}
+		d.history = append(d.history, crosstalkInterval{start: d.overlapSince})
+		d.pruneHistory(now)
+		log.Printf("[Crosstalk] ⚠️ Overlapping speech from %v for %v", activeSpeakers, now.Sub(d.overlapSince).Round(time.Millisecond))
+		return &CrosstalkEvent{
+			Active:     true,
+			SpeakerIDs: activeSpeakers,
+			StartedAt:  d.overlapSince,
+		}
+	}
+
+	if !d.active {
+		// Overlap was too short to count; start over on the next one
+		if !d.lastOverlap.IsZero() && now.Sub(d.lastOverlap) >= CrosstalkActiveWindow {
+			d.overlapSince = time.Time{}
+		}
+		return nil
+	}
+	if now.Sub(d.lastOverlap) < CrosstalkReleaseAfter {
+		return nil
+	}
+
+	event := &CrosstalkEvent{
+		Active:     false,
+		SpeakerIDs: d.involvedSpeakers(),
+		StartedAt:  d.overlapSince,
+		DurationMs: d.lastOverlap.Sub(d.overlapSince).Milliseconds(),
+	}
+	d.history[len(d.history)-1].end = d.lastOverlap
+	d.active = false
+	d.overlapSince = time.Time{}
+	d.speakers = make(map[string]struct{})
+	log.Printf("[Crosstalk] ✅ Overlapping speech ended after %dms", event.DurationMs)
+	return event
+}
+
+// Overlaps reports whether any crosstalk overlapped the given time range
+func (d *CrosstalkDetector) Overlaps(from, to time.Time) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, iv := range d.history {
+		end := iv.end
+		if end.IsZero() {
+			end = to
+		}
+		if !iv.start.After(to) && !end.Before(from) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsActive reports whether crosstalk is currently in progress
+func (d *CrosstalkDetector) IsActive() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.active
+}
+
+// Remove forgets a speaker (e.g. when their stream is removed)
+func (d *CrosstalkDetector) Remove(speakerID string) {
+	d.mu.Lock()
+	delete(d.lastVoiced, speakerID)
+	d.mu.Unlock()
+}
+
+// voiceThreshold returns the dBFS level a chunk must reach to count as voice for this speaker
+func (d *CrosstalkDetector) voiceThreshold(speakerID string) float64 {
+	if d.noiseGate != nil {
+		if profile, ok := d.noiseGate.Profile(speakerID); ok && profile.Calibrated {
+			return profile.NoiseFloorDBFS + CrosstalkVoiceMarginDB
+		}
+	}
+	return CrosstalkDefaultVoiceDBFS
+}
+
+// activeSpeakers returns speakers with voice energy inside the active window (sorted)
+func (d *CrosstalkDetector) activeSpeakers(now time.Time) []string {
+	ids := make([]string, 0, len(d.lastVoiced))
+	for id, last := range d.lastVoiced {
+		if now.Sub(last) <= CrosstalkActiveWindow {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (d *CrosstalkDetector) involvedSpeakers() []string {
+	ids := make([]string, 0, len(d.speakers))
+	for id := range d.speakers {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// pruneHistory drops finished intervals that can no longer match a transcript
+func (d *CrosstalkDetector) pruneHistory(now time.Time) {
+	kept := d.history[:0]
+	for _, iv := range d.history {
+		if iv.end.IsZero() || now.Sub(iv.end) < crosstalkHistoryRetention {
+			kept = append(kept, iv)
+		}
+	}
+	d.history = kept
+}
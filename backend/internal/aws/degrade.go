@@ -0,0 +1,115 @@
+package aws
+
+import (
+	"log"
+	"sync/atomic"
+	"time"
+)
+
+// Automatic downgrade constants
+const (
+	DefaultDowngradeAfter    = 1 * time.Minute // Unhealthy this long → disable optional features
+	DefaultRecoverAfter      = 1 * time.Minute // Healthy this long → re-enable them
+	DegradedMaxLiveLanguages = 2               // Live translation fan-out while downgraded
+)
+
+// Optional features disabled while the pipeline is downgraded
+const (
+	FeaturePartialTTS    = "partial_tts"
+	FeatureMultiLanguage = "multi_language_fanout"
+)
+
+// PipelineModeChange is emitted on ModeChan when optional features are disabled or restored
+type PipelineModeChange struct {
+	Downgraded       bool           `json:"downgraded"`
+	Status           PipelineStatus `json:"status"`
+	DisabledFeatures []string       `json:"disabledFeatures,omitempty"`
+	MaxLiveLanguages int            `json:"maxLiveLanguages,omitempty"`
+	Reason           string         `json:"reason"`
+}
+
+// IsDowngraded reports whether optional features are currently disabled
+func (p *Pipeline) IsDowngraded() bool {
+	return atomic.LoadInt32(&p.downgraded) == 1
+}
+
+// evaluateDowngrade acts on the computed status: a persistently unhealthy pipeline drops
+// partial TTS and limits fan-out; features return after health has been restored for a while.
+// Called from updateHealth with the new status.
+func (p *Pipeline) evaluateDowngrade(status PipelineStatus) {
+	now := time.Now()
+
+	p.statusMu.Lock()
+	if status == PipelineStatusUnhealthy {
+		if p.unhealthySince.IsZero() {
+			p.unhealthySince = now
+		}
+		p.healthySince = time.Time{}
+	} else {
+		p.unhealthySince = time.Time{}
+		if status == PipelineStatusHealthy && p.healthySince.IsZero() {
+			p.healthySince = now
+		} else if status != PipelineStatusHealthy {
+			p.healthySince = time.Time{}
+		}
+	}
+	unhealthyFor := durationSince(p.unhealthySince, now)
+	healthyFor := durationSince(p.healthySince, now)
+	p.statusMu.Unlock()
+
+	switch {
+	case !p.IsDowngraded() && status == PipelineStatusUnhealthy && unhealthyFor >= p.downgradeAfter:
+		atomic.StoreInt32(&p.downgraded, 1)
+		log.Printf("[AWS Pipeline] ⬇️ Unhealthy for %v, disabling partial TTS and limiting fan-out to %d languages",
+			unhealthyFor.Round(time.Second), DegradedMaxLiveLanguages)
+		p.sendModeChange(&PipelineModeChange{
+			Downgraded:       true,
+			Status:           status,
+			DisabledFeatures: []string{FeaturePartialTTS, FeatureMultiLanguage},
+			MaxLiveLanguages: DegradedMaxLiveLanguages,
+			Reason:           "pipeline unhealthy for " + unhealthyFor.Round(time.Second).String(),
+		})
+
+	case p.IsDowngraded() && healthyFor >= p.recoverAfter:
+		atomic.StoreInt32(&p.downgraded, 0)
+		log.Printf("[AWS Pipeline] ⬆️ Healthy for %v, re-enabling optional features", healthyFor.Round(time.Second))
+		p.sendModeChange(&PipelineModeChange{
+			Downgraded: false,
+			Status:     status,
+			Reason:     "pipeline healthy for " + healthyFor.Round(time.Second).String(),
+		})
+	}
+}
+
+// sendModeChange notifies the room of a mode change (non-blocking)
+func (p *Pipeline) sendModeChange(change *PipelineModeChange) {
+	if atomic.LoadInt32(&p.closed) == 1 {
+		return
+	}
+	select {
+	case p.ModeChan <- change:
+	default:
+		log.Printf("[AWS Pipeline] Mode channel full, dropping mode change")
+	}
+}
+
+// liveTargetLanguages returns the target languages to translate live, limited while downgraded.
+// Target languages are ordered by listener count, so the most-listened languages are kept.
+func (p *Pipeline) liveTargetLanguages() []string {
+	p.targetLangsMu.RLock()
+	targetLangs := make([]string, len(p.targetLanguages))
+	copy(targetLangs, p.targetLanguages)
+	p.targetLangsMu.RUnlock()
+
+	if p.IsDowngraded() && len(targetLangs) > DegradedMaxLiveLanguages {
+		targetLangs = targetLangs[:DegradedMaxLiveLanguages]
+	}
+	return targetLangs
+}
+
+func durationSince(t, now time.Time) time.Duration {
+	if t.IsZero() {
+		return 0
+	}
+	return now.Sub(t)
+}
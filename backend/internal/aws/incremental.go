@@ -0,0 +1,235 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Incremental translation constants
+const (
+	MinIncrementalDeltaRunes = 2  // Shorter deltas are held until more text arrives
+	MaxIncrementalChunkRunes = 40 // Unterminated text this long is flushed at the last clause/word break
+	IncrementalPairWildcard  = "*"
+	incrementalPairSeparator = ":"
+)
+
+// DefaultIncrementalPairs keeps the original KO→JA real-time behaviour
+var DefaultIncrementalPairs = []string{"ko:ja"}
+
+// LanguagePair is a source→target pair for incremental (partial) translation and TTS.
+// Either side may be "*" to match any language.
+type LanguagePair struct {
+	Source string
+	Target string
+}
+
+// ParseLanguagePairs parses "src:tgt" specs (e.g. "ko:ja", "en:*", "*").
+// A bare "*" enables incremental mode for every pair in the room.
+func ParseLanguagePairs(specs []string) []LanguagePair {
+	pairs := make([]LanguagePair, 0, len(specs))
+	for _, spec := range specs {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+		if spec == IncrementalPairWildcard {
+			pairs = append(pairs, LanguagePair{Source: IncrementalPairWildcard, Target: IncrementalPairWildcard})
+			continue
+		}
+
+		src, tgt, ok := strings.Cut(spec, incrementalPairSeparator)
+		if !ok {
+			continue
+		}
+		pairs = append(pairs, LanguagePair{Source: normalizePairSide(src), Target: normalizePairSide(tgt)})
+	}
+	return pairs
+}
+
+// Language pair validation errors
+var (
+	ErrInvalidLanguagePair   = errors.New("invalid language pair")
+	ErrDuplicateLanguagePair = errors.New("duplicate language pair")
+)
+
+// ValidateLanguagePairs checks "src:tgt" specs against the supported languages and
+// returns them normalized. Unsupported languages, malformed specs and duplicates are rejected.
+func ValidateLanguagePairs(specs []string) ([]string, error) {
+	normalized := make([]string, 0, len(specs))
+	seen := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		spec = strings.TrimSpace(spec)
+		if spec != IncrementalPairWildcard {
+			src, tgt, ok := strings.Cut(spec, incrementalPairSeparator)
+			if !ok || !isPairSideSupported(src) || !isPairSideSupported(tgt) {
+				return nil, fmt.Errorf("%w: %q", ErrInvalidLanguagePair, spec)
+			}
+			spec = LanguagePair{Source: normalizePairSide(src), Target: normalizePairSide(tgt)}.String()
+		}
+		if seen[spec] {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateLanguagePair, spec)
+		}
+		seen[spec] = true
+		normalized = append(normalized, spec)
+	}
+	return normalized, nil
+}
+
+func isPairSideSupported(lang string) bool {
+	lang = strings.TrimSpace(lang)
+	return lang == IncrementalPairWildcard || IsSupportedLanguage(lang)
+}
+
+func normalizePairSide(lang string) string {
+	lang = strings.TrimSpace(lang)
+	if lang == IncrementalPairWildcard {
+		return lang
+	}
+	return NormalizeLanguage(lang)
+}
+
+// Matches reports whether the pair covers source→target
+func (lp LanguagePair) Matches(source, target string) bool {
+	return (lp.Source == IncrementalPairWildcard || lp.Source == source) &&
+		(lp.Target == IncrementalPairWildcard || lp.Target == target)
+}
+
+// String formats the pair as "src:tgt"
+func (lp LanguagePair) String() string {
+	return lp.Source + incrementalPairSeparator + lp.Target
+}
+
+// IncrementalMode selects which language pairs get low-latency partial translation+TTS
+type IncrementalMode struct {
+	pairs []LanguagePair
+	mu    sync.RWMutex
+}
+
+// NewIncrementalMode creates an incremental mode for the given pairs
+func NewIncrementalMode(pairs []LanguagePair) *IncrementalMode {
+	return &IncrementalMode{pairs: pairs}
+}
+
+// SetPairs replaces the enabled pairs
+func (m *IncrementalMode) SetPairs(pairs []LanguagePair) {
+	m.mu.Lock()
+	m.pairs = pairs
+	m.mu.Unlock()
+}
+
+// Pairs returns the enabled pairs
+func (m *IncrementalMode) Pairs() []LanguagePair {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]LanguagePair(nil), m.pairs...)
+}
+
+// Targets returns the target languages that get incremental output for a source language
+// (same-language passthrough is excluded: it has nothing to translate early)
+func (m *IncrementalMode) Targets(sourceLang string, targetLangs []string) []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var targets []string
+	for _, tgt := range targetLangs {
+		if tgt == sourceLang {
+			continue
+		}
+		for _, pair := range m.pairs {
+			if pair.Matches(sourceLang, tgt) {
+				targets = append(targets, tgt)
+				break
+			}
+		}
+	}
+	return targets
+}
+
+// SentenceDelta tracks how much of a speaker's current utterance has already been
+// sent for incremental translation, releasing only completed sentences (or long
+// clauses) so translations are not built from half-recognized words.
+type SentenceDelta struct {
+	committed int // Runes of the utterance already emitted
+}
+
+// Next returns newly completed text in a partial transcript, or "" if nothing is ready
+func (d *SentenceDelta) Next(partial string) string {
+	runes := []rune(strings.TrimSpace(partial))
+
+	// Transcribe may revise earlier words; already emitted text cannot be recalled,
+	// so continue from the same offset
+	start := d.committed
+	if start > len(runes) {
+		start = len(runes)
+	}
+	pending := runes[start:]
+
+	end := lastSentenceBoundary(pending)
+	if end <= 0 && len(pending) >= MaxIncrementalChunkRunes {
+		end = lastClauseBreak(pending)
+	}
+	if end <= 0 {
+		return ""
+	}
+
+	delta := strings.TrimSpace(string(pending[:end]))
+	if len([]rune(delta)) < MinIncrementalDeltaRunes {
+		return ""
+	}
+
+	d.committed = start + end
+	return delta
+}
+
+// Remainder returns the part of the final transcript not yet emitted and resets the tracker
+func (d *SentenceDelta) Remainder(final string) string {
+	runes := []rune(strings.TrimSpace(final))
+	start := d.committed
+	if start > len(runes) {
+		start = len(runes)
+	}
+	d.Reset()
+	return strings.TrimSpace(string(runes[start:]))
+}
+
+// Started reports whether any part of the current utterance was emitted
+func (d *SentenceDelta) Started() bool {
+	return d.committed > 0
+}
+
+// Reset starts a new utterance
+func (d *SentenceDelta) Reset() {
+	d.committed = 0
+}
+
+// lastSentenceBoundary returns the index just past the last sentence terminator, or -1.
+// A '.' only counts when followed by whitespace so decimals ("3.5") are not split.
+func lastSentenceBoundary(runes []rune) int {
+	for i := len(runes) - 1; i >= 0; i-- {
+		switch runes[i] {
+		case '?', '!', '。', '？', '！', '…':
+			return i + 1
+		case '.':
+			if i+1 < len(runes) && unicode.IsSpace(runes[i+1]) {
+				return i + 1
+			}
+		}
+	}
+	return -1
+}
+
+// lastClauseBreak returns the index just past the last comma or space, or -1
+func lastClauseBreak(runes []rune) int {
+	for i := len(runes) - 1; i > 0; i-- {
+		switch {
+		case runes[i] == ',' || runes[i] == '、' || runes[i] == '，':
+			return i + 1
+		case unicode.IsSpace(runes[i]):
+			return i
+		}
+	}
+	return -1
+}
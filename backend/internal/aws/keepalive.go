@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"log"
+	"sync/atomic"
+	"time"
+)
+
+// Adaptive keep-alive: the longer a stream has been idle the less often it is sent
+// silence, up to just under Transcribe's no-audio timeout. A hibernated pipeline
+// stops keep-alive entirely and lets Transcribe close its streams.
+const (
+	MaxKeepAliveInterval  = 14 * time.Second // Transcribe ends a stream after 15s without audio
+	KeepAliveRampDuration = 5 * time.Minute  // Idle time over which the interval grows to the max
+	DefaultHibernateAfter = 10 * time.Minute // Pipeline idle time before keep-alive is suspended
+	keepAliveSlack        = 500 * time.Millisecond
+)
+
+// silenceChunk is shared by every stream's keep-alive (read-only; all zeros = silence in PCM)
+var silenceChunk = make([]byte, SilenceChunkSize)
+
+// keepAliveInterval grows linearly from KeepAliveInterval to MaxKeepAliveInterval with idle time
+func keepAliveInterval(idle time.Duration) time.Duration {
+	if idle <= 0 {
+		return KeepAliveInterval
+	}
+	if idle >= KeepAliveRampDuration {
+		return MaxKeepAliveInterval
+	}
+	extra := time.Duration(float64(MaxKeepAliveInterval-KeepAliveInterval) * float64(idle) / float64(KeepAliveRampDuration))
+	return KeepAliveInterval + extra
+}
+
+// keepAliveLoop sends silence to keep the stream alive while the speaker is quiet.
+// It sleeps until the next keep-alive is due instead of ticking at a fixed rate,
+// and blocks without a timer while keep-alive is suspended.
+func (ts *TranscribeStream) keepAliveLoop() {
+	timer := time.NewTimer(KeepAliveInterval)
+	defer timer.Stop()
+
+	for {
+		// Reconnects replace ts.ctx, so wait on the parent context (Close wakes the loop)
+		select {
+		case <-ts.parentCtx.Done():
+			return
+		case <-ts.keepAliveWake:
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+		case <-timer.C:
+		}
+
+		if ts.IsClosed() {
+			return
+		}
+		if ts.KeepAliveSuspended() {
+			continue
+		}
+
+		now := time.Now()
+		ts.keepAliveMu.Lock()
+		interval := keepAliveInterval(now.Sub(ts.lastAudioTime))
+		sinceSend := now.Sub(ts.lastSendTime)
+		ts.keepAliveMu.Unlock()
+
+		// Only send silence if nothing was sent recently and not reconnecting
+		if sinceSend >= interval-keepAliveSlack &&
+			atomic.LoadInt32(&ts.isReconnecting) == 0 && atomic.LoadInt32(&ts.audioInClosed) == 0 {
+			select {
+			case ts.audioIn <- silenceChunk:
+				atomic.AddInt64(&ts.keepAlivesSent, 1)
+				ts.keepAliveMu.Lock()
+				ts.lastSendTime = now
+				ts.keepAliveMu.Unlock()
+				sinceSend = 0
+			default:
+				// Buffer full, real audio is flowing anyway
+			}
+		}
+
+		next := interval - sinceSend
+		if next <= 0 {
+			next = KeepAliveInterval
+		}
+		timer.Reset(next)
+	}
+}
+
+// wakeKeepAlive nudges the keep-alive loop without blocking
+func (ts *TranscribeStream) wakeKeepAlive() {
+	select {
+	case ts.keepAliveWake <- struct{}{}:
+	default:
+	}
+}
+
+// SuspendKeepAlive stops sending silence. Transcribe then closes the stream after its
+// no-audio timeout and the stream ends without reconnecting.
+func (ts *TranscribeStream) SuspendKeepAlive() {
+	atomic.StoreInt32(&ts.keepAliveSuspended, 1)
+}
+
+// ResumeKeepAlive restarts keep-alive (called automatically when audio arrives)
+func (ts *TranscribeStream) ResumeKeepAlive() {
+	if atomic.CompareAndSwapInt32(&ts.keepAliveSuspended, 1, 0) {
+		ts.wakeKeepAlive()
+	}
+}
+
+// KeepAliveSuspended reports whether keep-alive is suspended (pipeline hibernated)
+func (ts *TranscribeStream) KeepAliveSuspended() bool {
+	return atomic.LoadInt32(&ts.keepAliveSuspended) == 1
+}
+
+// =============================================================================
+// Pipeline hibernation
+// =============================================================================
+
+// transcribeStreams returns every open Transcribe stream of the pipeline
+func (p *Pipeline) transcribeStreams() []*TranscribeStream {
+	var streams []*TranscribeStream
+	if p.useStreamManager && p.streamManager != nil {
+		for _, stream := range p.streamManager.Streams() {
+			streams = append(streams, stream)
+		}
+	}
+	p.streamsMu.RLock()
+	for _, stream := range p.speakerStreams {
+		streams = append(streams, stream)
+	}
+	p.streamsMu.RUnlock()
+	return streams
+}
+
+// markAudio records speaker audio and wakes the pipeline if it was hibernated
+func (p *Pipeline) markAudio() {
+	atomic.StoreInt64(&p.lastAudioAt, time.Now().UnixNano())
+	if atomic.CompareAndSwapInt32(&p.hibernated, 1, 0) {
+		streams := p.transcribeStreams()
+		for _, stream := range streams {
+			stream.ResumeKeepAlive()
+		}
+		log.Printf("[AWS Pipeline] ☀️ Woke from hibernation (%d streams)", len(streams))
+	}
+}
+
+// checkHibernation suspends keep-alive on every stream once no speaker has sent audio
+// for hibernateAfter, so long-idle rooms stop sending silence to Transcribe
+func (p *Pipeline) checkHibernation() {
+	if p.hibernateAfter <= 0 || atomic.LoadInt32(&p.hibernated) == 1 {
+		return
+	}
+	idle := time.Since(time.Unix(0, atomic.LoadInt64(&p.lastAudioAt)))
+	if idle < p.hibernateAfter || !atomic.CompareAndSwapInt32(&p.hibernated, 0, 1) {
+		return
+	}
+
+	streams := p.transcribeStreams()
+	for _, stream := range streams {
+		stream.SuspendKeepAlive()
+	}
+	log.Printf("[AWS Pipeline] 💤 Hibernating after %v without audio (%d streams)", idle.Round(time.Second), len(streams))
+}
+
+// Hibernated reports whether keep-alive is suspended for the whole pipeline
+func (p *Pipeline) Hibernated() bool {
+	return atomic.LoadInt32(&p.hibernated) == 1
+}
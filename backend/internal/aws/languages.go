@@ -0,0 +1,178 @@
+package aws
+
+import (
+	"sort"
+	"strings"
+
+	pollytypes "github.com/aws/aws-sdk-go-v2/service/polly/types"
+	transcribetypes "github.com/aws/aws-sdk-go-v2/service/transcribestreaming/types"
+)
+
+// LanguageInfo describes a language and its support in each AWS service.
+// An empty service field means the service does not support the language.
+type LanguageInfo struct {
+	Code           string                       `json:"code"` // Short code used by clients (ISO 639-1, e.g. "es")
+	Name           string                       `json:"name"`
+	TranscribeCode transcribetypes.LanguageCode `json:"-"` // Transcribe Streaming locale
+	TranslateCode  string                       `json:"-"` // Amazon Translate code
+	PollyVoice     pollytypes.VoiceId           `json:"-"`
+	PollyEngine    pollytypes.Engine            `json:"-"`
+}
+
+// SupportsTranscribe reports whether speech in this language can be transcribed
+func (l LanguageInfo) SupportsTranscribe() bool { return l.TranscribeCode != "" }
+
+// SupportsTranslate reports whether text can be translated to/from this language
+func (l LanguageInfo) SupportsTranslate() bool { return l.TranslateCode != "" }
+
+// SupportsTTS reports whether Polly has a voice for this language
+func (l LanguageInfo) SupportsTTS() bool { return l.PollyVoice != "" }
+
+// languageRegistry lists every language usable in a room.
+// Voices prefer the Neural engine; Standard is used where no Neural voice exists.
+var languageRegistry = map[string]LanguageInfo{
+	"ko": {Code: "ko", Name: "한국어", TranscribeCode: transcribetypes.LanguageCodeKoKr, TranslateCode: "ko", PollyVoice: pollytypes.VoiceIdSeoyeon, PollyEngine: pollytypes.EngineNeural},
+	"en": {Code: "en", Name: "English", TranscribeCode: transcribetypes.LanguageCodeEnUs, TranslateCode: "en", PollyVoice: pollytypes.VoiceIdJoanna, PollyEngine: pollytypes.EngineNeural},
+	"ja": {Code: "ja", Name: "日本語", TranscribeCode: transcribetypes.LanguageCodeJaJp, TranslateCode: "ja", PollyVoice: pollytypes.VoiceIdMizuki, PollyEngine: pollytypes.EngineStandard}, // Mizuki는 Standard만 지원
+	"zh": {Code: "zh", Name: "中文", TranscribeCode: transcribetypes.LanguageCodeZhCn, TranslateCode: "zh", PollyVoice: pollytypes.VoiceIdZhiyu, PollyEngine: pollytypes.EngineNeural},
+
+	"es": {Code: "es", Name: "Español", TranscribeCode: transcribetypes.LanguageCodeEsEs, TranslateCode: "es", PollyVoice: pollytypes.VoiceIdLucia, PollyEngine: pollytypes.EngineNeural},
+	"fr": {Code: "fr", Name: "Français", TranscribeCode: transcribetypes.LanguageCodeFrFr, TranslateCode: "fr", PollyVoice: pollytypes.VoiceIdLea, PollyEngine: pollytypes.EngineNeural},
+	"de": {Code: "de", Name: "Deutsch", TranscribeCode: transcribetypes.LanguageCodeDeDe, TranslateCode: "de", PollyVoice: pollytypes.VoiceIdVicki, PollyEngine: pollytypes.EngineNeural},
+	"it": {Code: "it", Name: "Italiano", TranscribeCode: transcribetypes.LanguageCodeItIt, TranslateCode: "it", PollyVoice: pollytypes.VoiceIdBianca, PollyEngine: pollytypes.EngineNeural},
+	"pt": {Code: "pt", Name: "Português", TranscribeCode: transcribetypes.LanguageCodePtBr, TranslateCode: "pt", PollyVoice: pollytypes.VoiceIdCamila, PollyEngine: pollytypes.EngineNeural},
+	"nl": {Code: "nl", Name: "Nederlands", TranscribeCode: transcribetypes.LanguageCodeNlNl, TranslateCode: "nl", PollyVoice: pollytypes.VoiceIdLaura, PollyEngine: pollytypes.EngineNeural},
+	"pl": {Code: "pl", Name: "Polski", TranscribeCode: transcribetypes.LanguageCodePlPl, TranslateCode: "pl", PollyVoice: pollytypes.VoiceIdOla, PollyEngine: pollytypes.EngineNeural},
+	"sv": {Code: "sv", Name: "Svenska", TranscribeCode: transcribetypes.LanguageCodeSvSe, TranslateCode: "sv", PollyVoice: pollytypes.VoiceIdElin, PollyEngine: pollytypes.EngineNeural},
+	"da": {Code: "da", Name: "Dansk", TranscribeCode: transcribetypes.LanguageCodeDaDk, TranslateCode: "da", PollyVoice: pollytypes.VoiceIdSofie, PollyEngine: pollytypes.EngineNeural},
+	"fi": {Code: "fi", Name: "Suomi", TranscribeCode: transcribetypes.LanguageCodeFiFi, TranslateCode: "fi", PollyVoice: pollytypes.VoiceIdSuvi, PollyEngine: pollytypes.EngineNeural},
+	"no": {Code: "no", Name: "Norsk", TranscribeCode: transcribetypes.LanguageCodeNoNo, TranslateCode: "no", PollyVoice: pollytypes.VoiceIdIda, PollyEngine: pollytypes.EngineNeural},
+	"tr": {Code: "tr", Name: "Türkçe", TranscribeCode: transcribetypes.LanguageCodeTrTr, TranslateCode: "tr", PollyVoice: pollytypes.VoiceIdBurcu, PollyEngine: pollytypes.EngineNeural},
+	"cs": {Code: "cs", Name: "Čeština", TranscribeCode: transcribetypes.LanguageCodeCsCz, TranslateCode: "cs", PollyVoice: pollytypes.VoiceIdJitka, PollyEngine: pollytypes.EngineNeural},
+	"ca": {Code: "ca", Name: "Català", TranscribeCode: transcribetypes.LanguageCodeCaEs, TranslateCode: "ca", PollyVoice: pollytypes.VoiceIdArlet, PollyEngine: pollytypes.EngineNeural},
+	"ar": {Code: "ar", Name: "العربية", TranscribeCode: transcribetypes.LanguageCodeArSa, TranslateCode: "ar", PollyVoice: pollytypes.VoiceIdHala, PollyEngine: pollytypes.EngineNeural},
+	"hi": {Code: "hi", Name: "हिन्दी", TranscribeCode: transcribetypes.LanguageCodeHiIn, TranslateCode: "hi", PollyVoice: pollytypes.VoiceIdKajal, PollyEngine: pollytypes.EngineNeural},
+	"ru": {Code: "ru", Name: "Русский", TranscribeCode: transcribetypes.LanguageCodeRuRu, TranslateCode: "ru", PollyVoice: pollytypes.VoiceIdTatyana, PollyEngine: pollytypes.EngineStandard},
+	"ro": {Code: "ro", Name: "Română", TranscribeCode: transcribetypes.LanguageCodeRoRo, TranslateCode: "ro", PollyVoice: pollytypes.VoiceIdCarmen, PollyEngine: pollytypes.EngineStandard},
+	"is": {Code: "is", Name: "Íslenska", TranscribeCode: transcribetypes.LanguageCodeIsIs, TranslateCode: "is", PollyVoice: pollytypes.VoiceIdDora, PollyEngine: pollytypes.EngineStandard},
+	"cy": {Code: "cy", Name: "Cymraeg", TranscribeCode: transcribetypes.LanguageCodeCyWl, TranslateCode: "cy", PollyVoice: pollytypes.VoiceIdGwyneth, PollyEngine: pollytypes.EngineStandard},
+
+	// No Polly voice: captions only
+	"vi": {Code: "vi", Name: "Tiếng Việt", TranscribeCode: transcribetypes.LanguageCodeViVn, TranslateCode: "vi"},
+	"th": {Code: "th", Name: "ไทย", TranscribeCode: transcribetypes.LanguageCodeThTh, TranslateCode: "th"},
+	"id": {Code: "id", Name: "Bahasa Indonesia", TranscribeCode: transcribetypes.LanguageCodeIdId, TranslateCode: "id"},
+	"ms": {Code: "ms", Name: "Bahasa Melayu", TranscribeCode: transcribetypes.LanguageCodeMsMy, TranslateCode: "ms"},
+	"tl": {Code: "tl", Name: "Tagalog", TranscribeCode: transcribetypes.LanguageCodeTlPh, TranslateCode: "tl"},
+	"uk": {Code: "uk", Name: "Українська", TranscribeCode: transcribetypes.LanguageCodeUkUa, TranslateCode: "uk"},
+	"he": {Code: "he", Name: "עברית", TranscribeCode: transcribetypes.LanguageCodeHeIl, TranslateCode: "he"},
+	"el": {Code: "el", Name: "Ελληνικά", TranscribeCode: transcribetypes.LanguageCodeElGr, TranslateCode: "el"},
+	"hu": {Code: "hu", Name: "Magyar", TranscribeCode: transcribetypes.LanguageCodeHuHu, TranslateCode: "hu"},
+	"bg": {Code: "bg", Name: "Български", TranscribeCode: transcribetypes.LanguageCodeBgBg, TranslateCode: "bg"},
+	"hr": {Code: "hr", Name: "Hrvatski", TranscribeCode: transcribetypes.LanguageCodeHrHr, TranslateCode: "hr"},
+	"sk": {Code: "sk", Name: "Slovenčina", TranscribeCode: transcribetypes.LanguageCodeSkSk, TranslateCode: "sk"},
+	"sl": {Code: "sl", Name: "Slovenščina", TranscribeCode: transcribetypes.LanguageCodeSlSi, TranslateCode: "sl"},
+	"sr": {Code: "sr", Name: "Српски", TranscribeCode: transcribetypes.LanguageCodeSrRs, TranslateCode: "sr"},
+	"lv": {Code: "lv", Name: "Latviešu", TranscribeCode: transcribetypes.LanguageCodeLvLv, TranslateCode: "lv"},
+	"lt": {Code: "lt", Name: "Lietuvių", TranscribeCode: transcribetypes.LanguageCodeLtLt, TranslateCode: "lt"},
+	"fa": {Code: "fa", Name: "فارسی", TranscribeCode: transcribetypes.LanguageCodeFaIr, TranslateCode: "fa"},
+	"bn": {Code: "bn", Name: "বাংলা", TranscribeCode: transcribetypes.LanguageCodeBnIn, TranslateCode: "bn"},
+	"ta": {Code: "ta", Name: "தமிழ்", TranscribeCode: transcribetypes.LanguageCodeTaIn, TranslateCode: "ta"},
+	"te": {Code: "te", Name: "తెలుగు", TranscribeCode: transcribetypes.LanguageCodeTeIn, TranslateCode: "te"},
+	"ur": {Code: "ur", Name: "اردو", TranslateCode: "ur"}, // Not supported by Transcribe Streaming
+	"sw": {Code: "sw", Name: "Kiswahili", TranscribeCode: transcribetypes.LanguageCodeSwKe, TranslateCode: "sw"},
+}
+
+// languageAliases maps regional or legacy codes to registry codes
+var languageAliases = map[string]string{
+	"ko-kr": "ko",
+	"en-us": "en",
+	"en-gb": "en",
+	"ja-jp": "ja",
+	"zh-cn": "zh",
+	"zh-tw": "zh",
+	"es-es": "es",
+	"es-us": "es",
+	"es-mx": "es",
+	"fr-fr": "fr",
+	"fr-ca": "fr",
+	"de-de": "de",
+	"pt-br": "pt",
+	"pt-pt": "pt",
+	"nb":    "no",
+	"iw":    "he", // Legacy Hebrew code
+	"fil":   "tl",
+}
+
+// LookupLanguage resolves a language code (e.g. "es", "es-MX", "EN") to its registry entry
+func LookupLanguage(code string) (LanguageInfo, bool) {
+	code = strings.ToLower(strings.TrimSpace(code))
+	if code == "" {
+		return LanguageInfo{}, false
+	}
+
+	if info, ok := languageRegistry[code]; ok {
+		return info, true
+	}
+	if alias, ok := languageAliases[code]; ok {
+		return languageRegistry[alias], true
+	}
+
+	// Try just the primary subtag (e.g. "it-CH" -> "it")
+	if idx := strings.IndexAny(code, "-_"); idx > 0 {
+		if info, ok := languageRegistry[code[:idx]]; ok {
+			return info, true
+		}
+	}
+	return LanguageInfo{}, false
+}
+
+// NormalizeLanguage returns the registry code for a language, or "" if unknown
+func NormalizeLanguage(code string) string {
+	info, ok := LookupLanguage(code)
+	if !ok {
+		return ""
+	}
+	return info.Code
+}
+
+// IsSupportedLanguage reports whether a language can be selected in a room
+// (at minimum, captions can be translated into it)
+func IsSupportedLanguage(code string) bool {
+	info, ok := LookupLanguage(code)
+	return ok && info.SupportsTranslate()
+}
+
+// SupportsTranscribe reports whether speech in the language can be transcribed
+func SupportsTranscribe(code string) bool {
+	info, ok := LookupLanguage(code)
+	return ok && info.SupportsTranscribe()
+}
+
+// SupportsTTS reports whether Polly can speak the language
+func SupportsTTS(code string) bool {
+	info, ok := LookupLanguage(code)
+	return ok && info.SupportsTTS()
+}
+
+// SupportedLanguages returns all registry languages sorted by code
+func SupportedLanguages() []LanguageInfo {
+	languages := make([]LanguageInfo, 0, len(languageRegistry))
+	for _, info := range languageRegistry {
+		languages = append(languages, info)
+	}
+	sort.Slice(languages, func(i, j int) bool {
+		return languages[i].Code < languages[j].Code
+	})
+	return languages
+}
+
+// TranslateLanguages returns the Translate codes of all registry languages
+func TranslateLanguages() []string {
+	codes := make([]string, 0, len(languageRegistry))
+	for _, info := range SupportedLanguages() {
+		if info.SupportsTranslate() {
+			codes = append(codes, info.TranslateCode)
+		}
+	}
+	return codes
+}
@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// lruItem is an entry of an lruCache
+type lruItem struct {
+	key   string
+	entry *CacheEntry
+	size  int64
+}
+
+// lruCache is a TTL cache bounded by entry count and/or total size.
+// The least recently used entries are evicted first once a bound is exceeded.
+type lruCache struct {
+	maxEntries int   // 0 = unbounded
+	maxBytes   int64 // 0 = unbounded
+
+	ll        *list.List // front = most recently used
+	items     map[string]*list.Element
+	bytes     int64
+	evictions int64
+	mu        sync.Mutex
+}
+
+// newLRUCache creates an LRU cache with the given bounds (0 = unbounded)
+func newLRUCache(maxEntries int, maxBytes int64) *lruCache {
+	return &lruCache{
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+// Get returns a live entry and marks it recently used (expired entries are removed)
+func (c *lruCache) Get(key string, now time.Time) (*CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	item := elem.Value.(*lruItem)
+	if now.After(item.entry.ExpiresAt) {
+		c.removeElement(elem)
+		return nil, false
+	}
+	c.ll.MoveToFront(elem)
+	return item.entry, true
+}
+
+// Set stores an entry of the given size and evicts until the bounds hold, returning
+// the number of evicted entries. An entry larger than maxBytes on its own is not stored.
+func (c *lruCache) Set(key string, entry *CacheEntry, size int64) (evicted int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.maxBytes > 0 && size > c.maxBytes {
+		return 0
+	}
+
+	if elem, ok := c.items[key]; ok {
+		item := elem.Value.(*lruItem)
+		c.bytes += size - item.size
+		item.entry = entry
+		item.size = size
+		c.ll.MoveToFront(elem)
+	} else {
+		c.items[key] = c.ll.PushFront(&lruItem{key: key, entry: entry, size: size})
+		c.bytes += size
+	}
+
+	for c.overLimit() {
+		c.removeElement(c.ll.Back())
+		c.evictions++
+		evicted++
+	}
+	return evicted
+}
+
+// RemoveExpired deletes expired entries and returns how many were removed
+func (c *lruCache) RemoveExpired(now time.Time) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := 0
+	for elem := c.ll.Back(); elem != nil; {
+		prev := elem.Prev()
+		if now.After(elem.Value.(*lruItem).entry.ExpiresAt) {
+			c.removeElement(elem)
+			removed++
+		}
+		elem = prev
+	}
+	return removed
+}
+
+// Stats returns the entry count, total size and number of evictions
+func (c *lruCache) Stats() (entries int, bytes int64, evictions int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len(), c.bytes, c.evictions
+}
+
+func (c *lruCache) overLimit() bool {
+	if c.ll.Len() == 0 {
+		return false
+	}
+	return (c.maxEntries > 0 && c.ll.Len() > c.maxEntries) || (c.maxBytes > 0 && c.bytes > c.maxBytes)
+}
+
+func (c *lruCache) removeElement(elem *list.Element) {
+	item := elem.Value.(*lruItem)
+	c.ll.Remove(elem)
+	delete(c.items, item.key)
+	c.bytes -= item.size
+}
@@ -0,0 +1,212 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+)
+
+// Moderation constants
+const (
+	DefaultToxicityThreshold = 0.7
+	ModerationCallTimeout    = 800 * time.Millisecond // Toxicity check is on the caption path, keep it short
+	ModerationLabelWordList  = "WORD_LIST"
+	moderationMaskRune       = '*'
+)
+
+// ModerationConfig configures the moderation stage between STT and broadcast
+type ModerationConfig struct {
+	WordLists         map[string][]string // Flagged terms per language ("*" = all languages)
+	Toxicity          bool                // Check final transcripts with Amazon Comprehend
+	ToxicityThreshold float32             // Minimum toxicity score to flag (0 = default)
+}
+
+// ModerationResult is the outcome of moderating one transcript
+type ModerationResult struct {
+	Text    string   // Text with flagged terms masked
+	Flagged bool     // Clients should blur/hide the caption
+	Labels  []string // Why it was flagged (WORD_LIST, PROFANITY, INSULT, ...)
+}
+
+// ParseModerationWords parses "lang:term" specs (e.g. "en:damn", "*:spoiler").
+// Invalid specs are skipped.
+func ParseModerationWords(specs []string) map[string][]string {
+	lists := make(map[string][]string)
+	for _, spec := range specs {
+		lang, term, ok := strings.Cut(strings.TrimSpace(spec), ":")
+		term = strings.TrimSpace(term)
+		if !ok || term == "" {
+			log.Printf("[Moderation] ⚠️ Ignoring invalid moderation term %q", spec)
+			continue
+		}
+		lang = strings.TrimSpace(lang)
+		if lang != "*" {
+			lang = NormalizeLanguage(lang)
+		}
+		if lang == "" {
+			log.Printf("[Moderation] ⚠️ Ignoring invalid moderation term %q", spec)
+			continue
+		}
+		lists[lang] = append(lists[lang], term)
+	}
+	return lists
+}
+
+// Moderator masks flagged terms and optionally checks toxicity with Comprehend
+type Moderator struct {
+	comprehend        *ComprehendClient // nil = word lists only
+	toxicityThreshold float32
+
+	patterns map[string]*regexp.Regexp // language -> compiled word list
+	mu       sync.RWMutex
+
+	maskedCount  int64
+	flaggedCount int64
+}
+
+// NewModerator creates a moderator; comprehend may be nil to disable toxicity detection
+func NewModerator(cfg *ModerationConfig, comprehend *ComprehendClient) *Moderator {
+	m := &Moderator{
+		toxicityThreshold: DefaultToxicityThreshold,
+		patterns:          make(map[string]*regexp.Regexp),
+	}
+	if cfg == nil {
+		return m
+	}
+	if cfg.Toxicity {
+		m.comprehend = comprehend
+	}
+	if cfg.ToxicityThreshold > 0 && cfg.ToxicityThreshold <= 1 {
+		m.toxicityThreshold = cfg.ToxicityThreshold
+	}
+	m.SetWordLists(cfg.WordLists)
+	return m
+}
+
+// SetWordLists replaces the flagged terms
+func (m *Moderator) SetWordLists(lists map[string][]string) {
+	patterns := make(map[string]*regexp.Regexp, len(lists))
+	for lang, terms := range lists {
+		if re := compileModerationTerms(terms); re != nil {
+			patterns[lang] = re
+		}
+	}
+
+	m.mu.Lock()
+	m.patterns = patterns
+	m.mu.Unlock()
+}
+
+// compileModerationTerms builds one case-insensitive pattern for a word list.
+// Latin terms only match whole words so "ass" doesn't mask "class"; CJK terms match anywhere.
+func compileModerationTerms(terms []string) *regexp.Regexp {
+	// Longest first so overlapping terms mask the full match
+	sorted := make([]string, 0, len(terms))
+	for _, t := range terms {
+		if t = strings.TrimSpace(t); t != "" {
+			sorted = append(sorted, t)
+		}
+	}
+	if len(sorted) == 0 {
+		return nil
+	}
+	sort.Slice(sorted, func(i, j int) bool { return len(sorted[i]) > len(sorted[j]) })
+
+	alternatives := make([]string, len(sorted))
+	for i, t := range sorted {
+		quoted := regexp.QuoteMeta(t)
+		if isASCII(t) {
+			quoted = `\b` + quoted + `\b`
+		}
+		alternatives[i] = quoted
+	}
+	return regexp.MustCompile(`(?i)(?:` + strings.Join(alternatives, "|") + `)`)
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// Mask replaces flagged terms of the language (and "*") with asterisks.
+// Returns the masked text and whether anything was masked.
+func (m *Moderator) Mask(text, lang string) (string, bool) {
+	if m == nil {
+		return text, false
+	}
+
+	m.mu.RLock()
+	patterns := []*regexp.Regexp{m.patterns[NormalizeLanguage(lang)], m.patterns["*"]}
+	m.mu.RUnlock()
+
+	masked := false
+	for _, re := range patterns {
+		if re == nil {
+			continue
+		}
+		text = re.ReplaceAllStringFunc(text, func(match string) string {
+			masked = true
+			return strings.Repeat(string(moderationMaskRune), utf8.RuneCountInString(match))
+		})
+	}
+	if masked {
+		atomic.AddInt64(&m.maskedCount, 1)
+	}
+	return text, masked
+}
+
+// Moderate masks flagged terms and, for supported languages, flags toxic text via Comprehend.
+// Comprehend failures are logged and the word-list result is returned (fail open).
+func (m *Moderator) Moderate(ctx context.Context, text, lang string) *ModerationResult {
+	result := &ModerationResult{Text: text}
+	if m == nil {
+		return result
+	}
+
+	var masked bool
+	result.Text, masked = m.Mask(text, lang)
+	if masked {
+		result.Flagged = true
+		result.Labels = append(result.Labels, ModerationLabelWordList)
+	}
+
+	if m.comprehend != nil && m.comprehend.SupportsToxicity(lang) {
+		callCtx, cancel := context.WithTimeout(ctx, ModerationCallTimeout)
+		toxicity, err := m.comprehend.DetectToxicity(callCtx, text, lang)
+		cancel()
+		if err != nil {
+			log.Printf("[Moderation] ⚠️ Toxicity check failed: %v", err)
+		} else if toxicity.Toxicity >= m.toxicityThreshold {
+			result.Flagged = true
+			for label, score := range toxicity.Labels {
+				if score >= m.toxicityThreshold {
+					result.Labels = append(result.Labels, label)
+				}
+			}
+			sort.Strings(result.Labels)
+		}
+	}
+
+	if result.Flagged {
+		atomic.AddInt64(&m.flaggedCount, 1)
+	}
+	return result
+}
+
+// Stats returns how many transcripts were masked and flagged
+func (m *Moderator) Stats() (masked, flagged int64) {
+	if m == nil {
+		return 0, 0
+	}
+	return atomic.LoadInt64(&m.maskedCount), atomic.LoadInt64(&m.flaggedCount)
+}
@@ -0,0 +1,369 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Noise filtering constants
+const (
+	MinTextLengthForTranslation = 2
+	MinConfidenceThreshold      = 0.5 // Default until a speaker's noise floor is calibrated (see calibration.go)
+	MaxNoiseRulePatternLength   = 200
+
+	DefaultPartialMinLength = 2  // Minimum characters before a partial caption is sent
+	MaxPartialMinLength     = 20 // Upper bound for configured partial minimum lengths
+)
+
+// DefaultPartialMinLengths are per-language partial minimums that reduce choppy caption updates.
+// Japanese tends to have more granular partials, so it requires more characters.
+var DefaultPartialMinLengths = map[string]int{
+	"ja": 4,
+	"en": 3,
+	"zh": 3,
+}
+
+// NoiseRule is a custom noise pattern. Phrase rules match the whole (or nearly the whole)
+// transcript; regex rules match anywhere unless anchored.
+type NoiseRule struct {
+	ID       string `json:"id"`
+	Language string `json:"language,omitempty"` // "" = all languages
+	Pattern  string `json:"pattern"`
+	Regex    bool   `json:"regex"`
+}
+
+// NoiseFilterConfig holds the rules a NoiseFilter starts with
+type NoiseFilterConfig struct {
+	Phrases            map[string][]string // Built-in phrases per language (checked against all languages)
+	Rules              []NoiseRule         // Custom rules (room/workspace)
+	LanguageThresholds map[string]float32  // Minimum confidence per source language
+	PartialMinLengths  map[string]int      // Minimum partial length per source language (nil = defaults)
+	MinTextLength      int
+}
+
+// DefaultNoisePhrases are common noise words/phrases that are often hallucinated by STT
+var DefaultNoisePhrases = map[string][]string{
+	"ko": {
+		"네", "예", "아", "어", "음", "응", "흠", "에", "으", "이",
+		"그", "저", "뭐", "좀", "자", "서", "거", "게", "요", "야",
+		"MBC 뉴스", "KBS 뉴스", "SBS 뉴스", "YTN", "JTBC",
+		"자막 제공", "자막 협찬", "자막", "제공", "협찬",
+		"구독", "좋아요", "알림", "시청", "감사",
+	},
+	"en": {
+		"um", "uh", "ah", "oh", "eh", "hm", "hmm", "yeah", "yep", "nope",
+		"like", "so", "well", "okay", "ok", "right", "you know",
+		"subscribe", "like and subscribe", "thanks for watching",
+		"MBC News", "KBS News", "breaking news",
+	},
+	"ja": {
+		"えー", "あー", "うん", "ええ", "はい", "ねえ", "まあ",
+		"字幕", "提供", "ニュース",
+	},
+	"zh": {
+		"嗯", "啊", "哦", "呃", "好", "对", "是",
+		"字幕", "新闻", "订阅",
+	},
+}
+
+// DefaultNoiseFilterConfig returns the built-in noise filter configuration
+func DefaultNoiseFilterConfig() *NoiseFilterConfig {
+	return &NoiseFilterConfig{
+		Phrases:           DefaultNoisePhrases,
+		PartialMinLengths: DefaultPartialMinLengths,
+		MinTextLength:     MinTextLengthForTranslation,
+	}
+}
+
+// ParseLanguageThresholds parses "lang:confidence" specs (e.g. "ko:0.6", "en:0.45").
+// Invalid specs and values outside 0..1 are skipped.
+func ParseLanguageThresholds(specs []string) map[string]float32 {
+	thresholds := make(map[string]float32, len(specs))
+	for _, spec := range specs {
+		lang, value, ok := strings.Cut(strings.TrimSpace(spec), ":")
+		if !ok {
+			continue
+		}
+		lang = NormalizeLanguage(strings.TrimSpace(lang))
+		t, err := strconv.ParseFloat(strings.TrimSpace(value), 32)
+		if lang == "" || err != nil || t < 0 || t > 1 {
+			log.Printf("[NoiseFilter] ⚠️ Ignoring invalid threshold %q", spec)
+			continue
+		}
+		thresholds[lang] = float32(t)
+	}
+	return thresholds
+}
+
+// ParsePartialMinLengths parses "lang:length" specs (e.g. "ja:4", "zh:2").
+// Invalid specs and values outside 1..MaxPartialMinLength are skipped.
+func ParsePartialMinLengths(specs []string) map[string]int {
+	lengths := make(map[string]int, len(specs))
+	for _, spec := range specs {
+		lang, value, ok := strings.Cut(strings.TrimSpace(spec), ":")
+		if !ok {
+			continue
+		}
+		lang = NormalizeLanguage(strings.TrimSpace(lang))
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if lang == "" || err != nil || ValidatePartialMinLength(n) != nil {
+			log.Printf("[NoiseFilter] ⚠️ Ignoring invalid partial min length %q", spec)
+			continue
+		}
+		lengths[lang] = n
+	}
+	return lengths
+}
+
+// ValidatePartialMinLength checks a configured partial minimum length
+func ValidatePartialMinLength(n int) error {
+	if n < 1 || n > MaxPartialMinLength {
+		return fmt.Errorf("partial min length must be between 1 and %d", MaxPartialMinLength)
+	}
+	return nil
+}
+
+type compiledNoiseRule struct {
+	NoiseRule
+	re *regexp.Regexp
+}
+
+// NoiseFilter decides whether a final transcript is noise/hallucination.
+// Custom rules and per-language thresholds can be replaced at runtime.
+type NoiseFilter struct {
+	phrases       map[string][]string
+	minTextLength int
+
+	rules          []compiledNoiseRule
+	thresholds     map[string]float32
+	partialMinLens map[string]int
+	mu             sync.RWMutex
+}
+
+// NewNoiseFilter creates a noise filter; invalid custom rules are skipped with a log line
+func NewNoiseFilter(cfg *NoiseFilterConfig) *NoiseFilter {
+	if cfg == nil {
+		cfg = DefaultNoiseFilterConfig()
+	}
+	f := &NoiseFilter{
+		phrases:       cfg.Phrases,
+		minTextLength: cfg.MinTextLength,
+		thresholds:    make(map[string]float32),
+	}
+	if f.minTextLength <= 0 {
+		f.minTextLength = MinTextLengthForTranslation
+	}
+	f.SetLanguageThresholds(cfg.LanguageThresholds)
+	if cfg.PartialMinLengths != nil {
+		f.SetPartialMinLengths(cfg.PartialMinLengths)
+	} else {
+		f.SetPartialMinLengths(DefaultPartialMinLengths)
+	}
+	f.SetRules(cfg.Rules)
+	return f
+}
+
+// ValidateNoiseRule checks a custom rule (pattern length, regex syntax)
+func ValidateNoiseRule(rule NoiseRule) error {
+	pattern := strings.TrimSpace(rule.Pattern)
+	if pattern == "" {
+		return fmt.Errorf("pattern is empty")
+	}
+	if len([]rune(pattern)) > MaxNoiseRulePatternLength {
+		return fmt.Errorf("pattern is longer than %d characters", MaxNoiseRulePatternLength)
+	}
+	if rule.Regex {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid regex: %w", err)
+		}
+	}
+	return nil
+}
+
+// SetRules replaces the custom rules
+func (f *NoiseFilter) SetRules(rules []NoiseRule) {
+	compiled := make([]compiledNoiseRule, 0, len(rules))
+	for _, rule := range rules {
+		if err := ValidateNoiseRule(rule); err != nil {
+			log.Printf("[NoiseFilter] ⚠️ Skipping rule %s: %v", rule.ID, err)
+			continue
+		}
+		rule.Pattern = strings.TrimSpace(rule.Pattern)
+		c := compiledNoiseRule{NoiseRule: rule}
+		if rule.Regex {
+			c.re = regexp.MustCompile(rule.Pattern)
+		}
+		compiled = append(compiled, c)
+	}
+
+	f.mu.Lock()
+	f.rules = compiled
+	f.mu.Unlock()
+}
+
+// SetLanguageThresholds replaces the per-language minimum confidence
+func (f *NoiseFilter) SetLanguageThresholds(thresholds map[string]float32) {
+	copied := make(map[string]float32, len(thresholds))
+	for lang, t := range thresholds {
+		copied[NormalizeLanguage(lang)] = t
+	}
+
+	f.mu.Lock()
+	f.thresholds = copied
+	f.mu.Unlock()
+}
+
+// SetMinTextLength replaces the minimum transcript length (<= 0 restores the default)
+func (f *NoiseFilter) SetMinTextLength(n int) {
+	if n <= 0 {
+		n = MinTextLengthForTranslation
+	}
+
+	f.mu.Lock()
+	f.minTextLength = n
+	f.mu.Unlock()
+}
+
+// SetPartialMinLengths replaces the per-language partial minimum lengths
+func (f *NoiseFilter) SetPartialMinLengths(lengths map[string]int) {
+	copied := make(map[string]int, len(lengths))
+	for lang, n := range lengths {
+		if ValidatePartialMinLength(n) != nil {
+			continue
+		}
+		copied[NormalizeLanguage(lang)] = n
+	}
+
+	f.mu.Lock()
+	f.partialMinLens = copied
+	f.mu.Unlock()
+}
+
+// PartialMinLength returns the minimum partial length (in characters) for a source language
+func (f *NoiseFilter) PartialMinLength(lang string) int {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if n, ok := f.partialMinLens[NormalizeLanguage(lang)]; ok {
+		return n
+	}
+	return DefaultPartialMinLength
+}
+
+// Rules returns the current custom rules
+func (f *NoiseFilter) Rules() []NoiseRule {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	rules := make([]NoiseRule, len(f.rules))
+	for i, r := range f.rules {
+		rules[i] = r.NoiseRule
+	}
+	return rules
+}
+
+// confidenceThreshold combines the speaker's calibrated threshold with the language floor
+func (f *NoiseFilter) confidenceThreshold(lang string, speakerThreshold float32) float32 {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if t, ok := f.thresholds[NormalizeLanguage(lang)]; ok && t > speakerThreshold {
+		return t
+	}
+	return speakerThreshold
+}
+
+// IsNoise checks if text is likely noise/hallucination.
+// minConfidence is the speaker's calibrated confidence threshold.
+func (f *NoiseFilter) IsNoise(text string, sourceLang string, confidence, minConfidence float32) bool {
+	text = strings.TrimSpace(text)
+	runes := []rune(text)
+
+	// Empty or too short
+	f.mu.RLock()
+	minTextLength := f.minTextLength
+	f.mu.RUnlock()
+	if len(runes) < minTextLength {
+		return true
+	}
+
+	// Low confidence
+	if confidence > 0 && confidence < f.confidenceThreshold(sourceLang, minConfidence) {
+		return true
+	}
+
+	// Check for repeated characters (e.g., "아아아아", "ㅋㅋㅋ")
+	if len(runes) >= 3 {
+		allSame := true
+		for i := 1; i < len(runes); i++ {
+			if runes[i] != runes[0] {
+				allSame = false
+				break
+			}
+		}
+		if allSame {
+			return true
+		}
+	}
+
+	// Check for punctuation/whitespace only
+	hasAlphanumeric := false
+	for _, r := range runes {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') ||
+			(r >= '0' && r <= '9') ||
+			(r >= 0xAC00 && r <= 0xD7AF) || // Korean Hangul
+			(r >= 0x3040 && r <= 0x30FF) || // Japanese Hiragana/Katakana
+			(r >= 0x4E00 && r <= 0x9FFF) { // Chinese characters
+			hasAlphanumeric = true
+			break
+		}
+	}
+	if !hasAlphanumeric {
+		return true
+	}
+
+	textLower := strings.ToLower(text)
+
+	// Check built-in phrases of all languages (hallucinations can come in wrong language)
+	for _, patterns := range f.phrases {
+		for _, pattern := range patterns {
+			if matchesNoisePhrase(textLower, len(runes), pattern) {
+				return true
+			}
+		}
+	}
+
+	// Custom rules: language-specific ones only apply to that source language
+	lang := NormalizeLanguage(sourceLang)
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	for _, rule := range f.rules {
+		if rule.Language != "" && NormalizeLanguage(rule.Language) != lang {
+			continue
+		}
+		if rule.re != nil {
+			if rule.re.MatchString(text) {
+				return true
+			}
+		} else if matchesNoisePhrase(textLower, len(runes), rule.Pattern) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchesNoisePhrase reports whether text is just the noise phrase (allowing minor variations)
+func matchesNoisePhrase(textLower string, textRunes int, pattern string) bool {
+	patternLower := strings.ToLower(pattern)
+	// Exact match or text is just the noise pattern
+	if textLower == patternLower {
+		return true
+	}
+	// Text starts and ends with noise pattern (allowing for minor variations)
+	return textRunes <= len([]rune(pattern))+2 && strings.Contains(textLower, patternLower)
+}
@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"regexp"
+	"strings"
+)
+
+// PII placeholders that replace redacted spans
+const (
+	PIIEmailPlaceholder = "[EMAIL]"
+	PIIPhonePlaceholder = "[PHONE]"
+	PIICardPlaceholder  = "[CARD]"
+)
+
+var (
+	piiEmailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	// 13-19 digits, optionally grouped with spaces or dashes (validated with Luhn)
+	piiCardPattern = regexp.MustCompile(`\b(?:\d[ \-]?){12,18}\d\b`)
+	// International (+82 10 1234 5678), Korean (010-1234-5678, 02-123-4567) and NANP ((555) 123-4567) formats
+	piiPhonePattern = regexp.MustCompile(`(?:\+\d{1,3}[ \-.]?)?(?:\(\d{2,4}\)|\b\d{2,4})[ \-.]?\d{3,4}[ \-.]?\d{4}\b`)
+)
+
+// RedactPII masks email addresses, card numbers and phone numbers in a transcript.
+// Transcribe's built-in PII redaction only covers en-US, so this runs as a post-processor
+// for every language. Returns the redacted text and whether anything was replaced.
+func RedactPII(text string) (string, bool) {
+	redacted := false
+
+	text = piiEmailPattern.ReplaceAllStringFunc(text, func(string) string {
+		redacted = true
+		return PIIEmailPlaceholder
+	})
+
+	// Cards before phones: a grouped card number also looks like a phone number
+	text = piiCardPattern.ReplaceAllStringFunc(text, func(match string) string {
+		if !luhnValid(match) {
+			return match
+		}
+		redacted = true
+		return PIICardPlaceholder
+	})
+
+	text = piiPhonePattern.ReplaceAllStringFunc(text, func(string) string {
+		redacted = true
+		return PIIPhonePlaceholder
+	})
+
+	return text, redacted
+}
+
+// luhnValid checks the card number checksum (ignores spaces and dashes)
+func luhnValid(number string) bool {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(number)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if d < 0 || d > 9 {
+			return false
+		}
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
@@ -2,8 +2,10 @@ package aws
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -15,6 +17,7 @@ import (
 
 	"realtime-backend/internal/ai"
 	appconfig "realtime-backend/internal/config"
+	"realtime-backend/internal/metrics"
 	"realtime-backend/pb"
 )
 
@@ -40,16 +43,29 @@ const (
 )
 
 // PipelineHealth contains health information for the entire pipeline
+
 type PipelineHealth struct {
-	Status            PipelineStatus           `json:"status"`
-	ActiveStreams     int                      `json:"activeStreams"`
-	HealthyStreams    int                      `json:"healthyStreams"`
-	DegradedStreams   int                      `json:"degradedStreams"`
-	TotalTranscripts  int64                    `json:"totalTranscripts"`
-	TotalErrors       int64                    `json:"totalErrors"`
-	Uptime            time.Duration            `json:"uptime"`
-	StreamHealths     map[string]*StreamHealth `json:"streamHealths"`
-	BackpressureLevel float64                  `json:"backpressureLevel"`
+	Status             PipelineStatus                 `json:"status"`
+	ActiveStreams      int                            `json:"activeStreams"`
+	HealthyStreams     int                            `json:"healthyStreams"`
+	DegradedStreams    int                            `json:"degradedStreams"`
+	TotalTranscripts   int64                          `json:"totalTranscripts"`
+	TotalErrors        int64                          `json:"totalErrors"`
+	Uptime             time.Duration                  `json:"uptime"`
+	StreamHealths      map[string]*StreamHealth       `json:"streamHealths"`
+	BackpressureLevel  float64                        `json:"backpressureLevel"`
+	SuppressedPartials int64                          `json:"suppressedPartials"`
+	CoalescedPartials  int64                          `json:"coalescedPartials"`
+	SlowMode           bool                           `json:"slowMode"`
+	Hibernated         bool                           `json:"hibernated"`
+	ArchivePending     int                            `json:"archivePending"`
+	NoiseProfiles      map[string]SpeakerNoiseProfile `json:"noiseProfiles"`
+	TTSBudget          *TTSBudgetStats                `json:"ttsBudget,omitempty"`
+	Downgraded         bool                           `json:"downgraded"`
+	Crosstalk          bool                           `json:"crosstalk"`
+	Breakers           map[string]string              `json:"breakers"` // service -> closed/open/half-open
+	Cache              *CacheMetrics                  `json:"cache"`
+	Usage              UsageSnapshot                  `json:"usage"`
 }
 
 // Pipeline orchestrates STT -> Translate -> TTS flow using AWS services
@@ -63,6 +79,23 @@ type Pipeline struct {
 	// Client pool reference (for shared clients mode)
 	clientPool *AWSClientPool
 
+	// Per-service circuit breakers (shared through the client pool)
+	breakers *ServiceBreakers
+
+	// Billable AWS usage of this pipeline (per-room cost attribution)
+	usage *UsageMeter
+
+	// Per language pair STT confidence, translation and TTS outcomes (quality dashboard)
+	quality *QualityMeter
+
+	// Monthly workspace quota (nil = unlimited)
+	quota *UsageQuota
+
+	// Newer finals superseding older ones still playing (see supersession.go)
+	supersession   *SupersessionTracker
+	ttsInterrupt   string
+	ttsInterruptMu sync.RWMutex
+
 	// Stream manager for language-based stream pooling
 	streamManager *StreamManager
 
@@ -76,6 +109,21 @@ type Pipeline struct {
 	AudioChan      chan *ai.AudioMessage
 	ErrChan        chan error
 
+	// Batch-translated finals for archive-only languages (no live listeners)
+	ArchiveChan chan []*ArchiveTranslation
+
+	// Automatic downgrade/recovery notifications (see degrade.go)
+	ModeChan chan *PipelineModeChange
+
+	// Sustained overlapping speech start/end notifications (see crosstalk.go)
+	CrosstalkChan chan *CrosstalkEvent
+
+	// Workspace usage quota hits (sent once per resource per month)
+	QuotaChan chan *QuotaExceededEvent
+
+	// Older finals whose TTS was superseded by a newer final of the same speaker
+	SupersededChan chan *TTSSupersededEvent
+
 	// Target languages for this room
 	targetLanguages []string
 	targetLangsMu   sync.RWMutex
@@ -110,6 +158,83 @@ type Pipeline struct {
 	speakerMeta   map[string]*SpeakerMeta
 	speakerMetaMu sync.RWMutex
 
+	// Partial suppression while a speaker's TTS is still playing at listeners
+	playback                  *PlaybackTracker
+	suppressPartialsDuringTTS int32 // atomic flag
+	suppressedPartials        int64
+
+	// Per-speaker noise floor calibration (dynamic confidence thresholds)
+	noiseGate *NoiseCalibrator
+
+	// Overlapping speech detection (uses noiseGate's noise floors)
+	crosstalk *CrosstalkDetector
+
+	// Noise/hallucination filter for finals (built-in phrases + custom rules)
+	noiseFilter *NoiseFilter
+
+	// Profanity masking / toxicity flagging between STT and broadcast (nil = disabled)
+	moderator *Moderator
+
+	// Mask emails, phone and card numbers in transcripts (workspace compliance setting)
+	redactPII int32
+
+	// Polly character budget for this room (nil = unlimited)
+	ttsBudget *TTSBudget
+
+	// Target languages whose TTS the host turned off (captions still sent)
+	ttsToggle ttsToggle
+
+	// Low-priority lane for passthrough (source == target) TTS, see tts_passthrough.go
+	passthroughQueue    chan *passthroughJob
+	passthroughAudience func(lang, speakerID string) bool
+
+	// Orders final transcripts of this room across speaker streams and worker goroutines
+	sequencer ai.Sequencer
+
+	// High watermarks of TranscriptChan/AudioChan (queue depth API)
+	queues pipelineWatermarks
+
+	// Channel capacities (TranscriptChan/AudioChan and each Transcribe stream)
+	buffers BufferSizes
+
+	// TTS syntheses still running (graceful shutdown waits for them)
+	ttsInFlight int64 // atomic
+
+	// Slow mode: coalesced partials and no partial TTS (mobile-heavy audiences)
+	slowMode          slowMode
+	coalescedPartials int64
+
+	// Language pairs that get partial (incremental) translation+TTS
+	incremental *IncrementalMode
+
+	// Hibernation: keep-alive suspended after hibernateAfter without speaker audio (see keepalive.go)
+	hibernated     int32 // atomic flag
+	lastAudioAt    int64 // atomic, unix nanoseconds
+	hibernateAfter time.Duration
+
+	// Automatic downgrade when unhealthy persists (see degrade.go); times guarded by statusMu
+	downgraded     int32 // atomic flag
+	downgradeAfter time.Duration
+	recoverAfter   time.Duration
+	unhealthySince time.Time
+	healthySince   time.Time
+
+	// Base TTS prosody; with autoProsody the rate is raised under backpressure
+	prosody     *Prosody
+	autoProsody bool
+
+	// Custom vocabulary (Transcribe) and terminology (Translate) for this room
+	vocabulary   *Vocabulary
+	vocabularyMu sync.RWMutex
+
+	// Listener voice preferences per target language (nil entry = default voice)
+	voicePrefs   map[string][]*VoicePreference
+	voicePrefsMu sync.RWMutex
+
+	// Archive-only languages: finals are accumulated and batch-translated periodically
+	archive              *ArchiveBatcher
+	archiveBatchInterval time.Duration
+
 	// Lifecycle
 	closed int32 // atomic flag to prevent double-close panics
 
@@ -129,6 +254,91 @@ type PipelineConfig struct {
 	SampleRate       int32
 	UseStreamManager bool // Enable language-based stream pooling
 	UseWorkerPools   bool // Enable worker pools for translation/TTS
+
+	// Hold back new partials for a speaker while their TTS clip is still playing
+	SuppressPartialsDuringTTS bool
+
+	// Custom vocabulary/terminology so company and product names survive STT and translation
+	Vocabulary *Vocabulary
+
+	// Languages kept only in the archive: translated in batches instead of live
+	// unless a listener selects them
+	ArchiveLanguages     []string
+	ArchiveBatchInterval time.Duration
+
+	// Polly characters this room may synthesize (0 = unlimited). Near the limit only
+	// questions and direct addresses are voiced.
+	PollyCharBudget int64
+
+	// Target languages that get captions only (no TTS); changed at runtime with SetTTSDisabledLanguages
+	TTSDisabledLanguages []string
+
+	// TTS prosody (rate/pitch/volume). AutoProsody speeds up speech when the
+	// audio queue backs up so TTS latency stays bounded.
+	Prosody     *Prosody
+	AutoProsody bool
+
+	// Language pairs that translate and voice completed sentences of partials
+	// ("ko:ja", "en:*", "*"); nil uses DefaultIncrementalPairs
+	IncrementalPairs []LanguagePair
+
+	// Slow mode coalesces partials per speaker (SlowModePartialInterval, 0 = default)
+	// and voices each utterance once at its final; changed at runtime with SetSlowMode
+	SlowMode                bool
+	SlowModePartialInterval time.Duration
+
+	// Final transcript order shared by every instance serving the room (Redis counter);
+	// nil orders finals within this process only
+	Sequence ai.SharedSequence
+
+	// How long the pipeline must stay unhealthy before optional features are disabled,
+	// and healthy before they are restored (0 = defaults)
+	DowngradeAfter time.Duration
+	RecoverAfter   time.Duration
+
+	// Speaker silence before keep-alive is suspended for every stream (0 = default, < 0 = never)
+	HibernateAfter time.Duration
+
+	// Noise filter rules and per-language confidence thresholds (nil = built-in defaults)
+	NoiseFilter *NoiseFilterConfig
+
+	// Moderation stage: masks flagged terms and flags toxic finals (nil = disabled)
+	Moderation *ModerationConfig
+
+	// Mask PII (emails, phone and card numbers) in transcripts and translations
+	RedactPII bool
+
+	// Monthly workspace usage quota (nil = unlimited)
+	Quota *UsageQuota
+
+	// What happens to older TTS when a newer final supersedes it (none | signal | drop, "" = signal)
+	TTSInterrupt string
+
+	// Reports whether a listener other than the speaker uses the speaker's language; passthrough
+	// TTS is skipped when it returns false (nil = always voice passthrough)
+	PassthroughAudience func(lang, speakerID string) bool
+
+	// Translation/TTS cache settings, including the optional shared tier (nil = defaults, in-process only)
+	Cache *CacheConfig
+
+	// Channel capacities of the pipeline and its Transcribe streams (nil = defaults)
+	Buffers *BufferSizes
+}
+
+// bufferSizes returns the channel capacities of the pipeline config (defaults if unset)
+func (c *PipelineConfig) bufferSizes() BufferSizes {
+	if c == nil {
+		return (*BufferSizes)(nil).withDefaults()
+	}
+	return c.Buffers.withDefaults()
+}
+
+// cacheConfig returns the cache settings of the pipeline config (defaults if unset)
+func (c *PipelineConfig) cacheConfig() *CacheConfig {
+	if c == nil || c.Cache == nil {
+		return DefaultCacheConfig()
+	}
+	return c.Cache
 }
 
 // NewPipeline creates a new AWS AI pipeline
@@ -161,29 +371,92 @@ func NewPipeline(ctx context.Context, cfg *appconfig.Config, pipelineCfg *Pipeli
 	log.Printf("[AWS Pipeline] Initializing with region=%s, sampleRate=%d, targetLangs=%v",
 		cfg.S3.Region, sampleRate, targetLangs)
 
+	buffers := pipelineCfg.bufferSizes()
+
 	pipeline := &Pipeline{
 		transcribe:       NewTranscribeClient(awsCfg, sampleRate),
 		translate:        NewTranslateClient(awsCfg),
 		polly:            NewPollyClient(awsCfg),
-		cache:            NewPipelineCache(DefaultCacheConfig()),
+		breakers:         NewServiceBreakers(),
+		cache:            NewPipelineCache(pipelineCfg.cacheConfig()),
+		usage:            NewUsageMeter(sampleRate),
+		quality:          NewQualityMeter(),
 		speakerStreams:   make(map[string]*TranscribeStream),
 		streamLastActive: make(map[string]time.Time),
-		TranscriptChan:   make(chan *ai.TranscriptMessage, 100), // Increased buffer
-		AudioChan:        make(chan *ai.AudioMessage, 200),      // Increased buffer
+		TranscriptChan:   make(chan *ai.TranscriptMessage, buffers.TranscriptChan),
+		AudioChan:        make(chan *ai.AudioMessage, buffers.AudioChan),
 		ErrChan:          make(chan error, 20),
+		ArchiveChan:      make(chan []*ArchiveTranslation, 10),
+		ModeChan:         make(chan *PipelineModeChange, 5),
+		CrosstalkChan:    make(chan *CrosstalkEvent, 5),
+		QuotaChan:        make(chan *QuotaExceededEvent, 5),
+		SupersededChan:   make(chan *TTSSupersededEvent, 20),
 		targetLanguages:  targetLangs,
 		startTime:        time.Now(),
 		status:           PipelineStatusHealthy,
 		translateSem:     make(chan struct{}, MaxConcurrentTranslate), // Limit concurrent translations
 		ttsSem:           make(chan struct{}, MaxConcurrentTTS),       // Limit concurrent TTS
 		speakerMeta:      make(map[string]*SpeakerMeta),
+		playback:         NewPlaybackTracker(),
+		supersession:     NewSupersessionTracker(),
+		ttsInterrupt:     TTSInterruptSignal,
+		passthroughQueue: make(chan *passthroughJob, passthroughQueueSize),
+		buffers:          buffers,
+		noiseGate:        NewNoiseCalibrator(),
+		incremental:      NewIncrementalMode(ParseLanguagePairs(DefaultIncrementalPairs)),
+		downgradeAfter:   DefaultDowngradeAfter,
+		recoverAfter:     DefaultRecoverAfter,
+		lastAudioAt:      time.Now().UnixNano(),
+		hibernateAfter:   DefaultHibernateAfter,
 		ctx:              pCtx,
 		cancel:           cancel,
 	}
+	pipeline.crosstalk = NewCrosstalkDetector(pipeline.noiseGate)
+	pipeline.noiseFilter = NewNoiseFilter(nil)
+	if pipelineCfg != nil {
+		if pipelineCfg.NoiseFilter != nil {
+			pipeline.noiseFilter = NewNoiseFilter(pipelineCfg.NoiseFilter)
+		}
+		if pipelineCfg.Moderation != nil {
+			pipeline.moderator = NewModerator(pipelineCfg.Moderation, NewComprehendClient(awsCfg))
+		}
+		pipeline.SetPartialSuppression(pipelineCfg.SuppressPartialsDuringTTS)
+		pipeline.SetPIIRedaction(pipelineCfg.RedactPII)
+		pipeline.quota = pipelineCfg.Quota
+		if pipelineCfg.TTSInterrupt != "" {
+			pipeline.SetTTSInterruptPolicy(pipelineCfg.TTSInterrupt)
+		}
+		pipeline.vocabulary = pipelineCfg.Vocabulary
+		pipeline.cache.SetSharedScope(strings.Join(pipelineCfg.Vocabulary.TerminologyNames(), ","))
+		pipeline.configureArchive(pipelineCfg)
+		pipeline.ttsBudget = NewTTSBudget(pipelineCfg.PollyCharBudget)
+		pipeline.ttsToggle.set(pipelineCfg.TTSDisabledLanguages)
+		pipeline.passthroughAudience = pipelineCfg.PassthroughAudience
+		pipeline.sequencer.SetShared(pipelineCfg.Sequence)
+		pipeline.slowMode.set(pipelineCfg.SlowMode, pipelineCfg.SlowModePartialInterval)
+		pipeline.prosody = pipelineCfg.Prosody.Normalize()
+		pipeline.autoProsody = pipelineCfg.AutoProsody
+		if pipelineCfg.IncrementalPairs != nil {
+			pipeline.incremental.SetPairs(pipelineCfg.IncrementalPairs)
+		}
+		if pipelineCfg.DowngradeAfter > 0 {
+			pipeline.downgradeAfter = pipelineCfg.DowngradeAfter
+		}
+		if pipelineCfg.RecoverAfter > 0 {
+			pipeline.recoverAfter = pipelineCfg.RecoverAfter
+		}
+		if pipelineCfg.HibernateAfter != 0 {
+			pipeline.hibernateAfter = pipelineCfg.HibernateAfter
+		}
+	}
 
 	// Start background goroutines
 	go pipeline.streamTimeoutChecker()
 	go pipeline.healthCheckLoop()
+	go pipeline.passthroughLoop()
+	if pipeline.archive != nil {
+		go pipeline.archiveLoop()
+	}
 
 	log.Printf("[AWS Pipeline] Pipeline initialized successfully")
 
@@ -204,6 +477,8 @@ func NewPipelineWithClientPool(ctx context.Context, clientPool *AWSClientPool, p
 		targetLangs = pipelineCfg.TargetLanguages
 	}
 
+	buffers := pipelineCfg.bufferSizes()
+
 	// Acquire reference to client pool
 	clientPool.Acquire()
 
@@ -212,12 +487,20 @@ func NewPipelineWithClientPool(ctx context.Context, clientPool *AWSClientPool, p
 		translate:        clientPool.Translate,
 		polly:            clientPool.Polly,
 		clientPool:       clientPool,
-		cache:            NewPipelineCache(DefaultCacheConfig()),
+		breakers:         clientPool.Breakers,
+		cache:            NewPipelineCache(pipelineCfg.cacheConfig()),
+		usage:            NewUsageMeter(clientPool.GetSampleRate()),
+		quality:          NewQualityMeter(),
 		speakerStreams:   make(map[string]*TranscribeStream),
 		streamLastActive: make(map[string]time.Time),
-		TranscriptChan:   make(chan *ai.TranscriptMessage, 100),
-		AudioChan:        make(chan *ai.AudioMessage, 200),
+		TranscriptChan:   make(chan *ai.TranscriptMessage, buffers.TranscriptChan),
+		AudioChan:        make(chan *ai.AudioMessage, buffers.AudioChan),
 		ErrChan:          make(chan error, 20),
+		ArchiveChan:      make(chan []*ArchiveTranslation, 10),
+		ModeChan:         make(chan *PipelineModeChange, 5),
+		CrosstalkChan:    make(chan *CrosstalkEvent, 5),
+		QuotaChan:        make(chan *QuotaExceededEvent, 5),
+		SupersededChan:   make(chan *TTSSupersededEvent, 20),
 		targetLanguages:  targetLangs,
 		startTime:        time.Now(),
 		status:           PipelineStatusHealthy,
@@ -226,9 +509,58 @@ func NewPipelineWithClientPool(ctx context.Context, clientPool *AWSClientPool, p
 		speakerMeta:      make(map[string]*SpeakerMeta),
 		useStreamManager: pipelineCfg != nil && pipelineCfg.UseStreamManager,
 		useWorkerPools:   pipelineCfg != nil && pipelineCfg.UseWorkerPools,
+		playback:         NewPlaybackTracker(),
+		supersession:     NewSupersessionTracker(),
+		ttsInterrupt:     TTSInterruptSignal,
+		passthroughQueue: make(chan *passthroughJob, passthroughQueueSize),
+		buffers:          buffers,
+		noiseGate:        NewNoiseCalibrator(),
+		incremental:      NewIncrementalMode(ParseLanguagePairs(DefaultIncrementalPairs)),
+		downgradeAfter:   DefaultDowngradeAfter,
+		recoverAfter:     DefaultRecoverAfter,
+		lastAudioAt:      time.Now().UnixNano(),
+		hibernateAfter:   DefaultHibernateAfter,
 		ctx:              pCtx,
 		cancel:           cancel,
 	}
+	pipeline.crosstalk = NewCrosstalkDetector(pipeline.noiseGate)
+	pipeline.noiseFilter = NewNoiseFilter(nil)
+	if pipelineCfg != nil {
+		if pipelineCfg.NoiseFilter != nil {
+			pipeline.noiseFilter = NewNoiseFilter(pipelineCfg.NoiseFilter)
+		}
+		if pipelineCfg.Moderation != nil {
+			pipeline.moderator = NewModerator(pipelineCfg.Moderation, clientPool.Comprehend)
+		}
+		pipeline.SetPartialSuppression(pipelineCfg.SuppressPartialsDuringTTS)
+		pipeline.SetPIIRedaction(pipelineCfg.RedactPII)
+		pipeline.quota = pipelineCfg.Quota
+		if pipelineCfg.TTSInterrupt != "" {
+			pipeline.SetTTSInterruptPolicy(pipelineCfg.TTSInterrupt)
+		}
+		pipeline.vocabulary = pipelineCfg.Vocabulary
+		pipeline.cache.SetSharedScope(strings.Join(pipelineCfg.Vocabulary.TerminologyNames(), ","))
+		pipeline.configureArchive(pipelineCfg)
+		pipeline.ttsBudget = NewTTSBudget(pipelineCfg.PollyCharBudget)
+		pipeline.ttsToggle.set(pipelineCfg.TTSDisabledLanguages)
+		pipeline.passthroughAudience = pipelineCfg.PassthroughAudience
+		pipeline.sequencer.SetShared(pipelineCfg.Sequence)
+		pipeline.slowMode.set(pipelineCfg.SlowMode, pipelineCfg.SlowModePartialInterval)
+		pipeline.prosody = pipelineCfg.Prosody.Normalize()
+		pipeline.autoProsody = pipelineCfg.AutoProsody
+		if pipelineCfg.IncrementalPairs != nil {
+			pipeline.incremental.SetPairs(pipelineCfg.IncrementalPairs)
+		}
+		if pipelineCfg.DowngradeAfter > 0 {
+			pipeline.downgradeAfter = pipelineCfg.DowngradeAfter
+		}
+		if pipelineCfg.RecoverAfter > 0 {
+			pipeline.recoverAfter = pipelineCfg.RecoverAfter
+		}
+		if pipelineCfg.HibernateAfter != 0 {
+			pipeline.hibernateAfter = pipelineCfg.HibernateAfter
+		}
+	}
 
 	// Initialize StreamManager for language-based pooling if enabled
 	if pipeline.useStreamManager {
@@ -252,6 +584,10 @@ func NewPipelineWithClientPool(ctx context.Context, clientPool *AWSClientPool, p
 		go pipeline.streamTimeoutChecker()
 	}
 	go pipeline.healthCheckLoop()
+	go pipeline.passthroughLoop()
+	if pipeline.archive != nil {
+		go pipeline.archiveLoop()
+	}
 
 	log.Printf("[AWS Pipeline] Pipeline initialized with shared clients (streamManager=%v, workerPools=%v)",
 		pipeline.useStreamManager, pipeline.useWorkerPools)
@@ -316,6 +652,7 @@ func (p *Pipeline) healthCheckLoop() {
 			return
 		case <-ticker.C:
 			p.updateHealth()
+			p.checkHibernation()
 		}
 	}
 }
@@ -340,6 +677,19 @@ func (p *Pipeline) updateHealth() {
 	}
 	p.streamsMu.RUnlock()
 
+	// Language-pooled streams (StreamManager mode) count towards health as well
+	if p.streamManager != nil {
+		for _, health := range p.streamManager.StreamHealths() {
+			streamCount++
+			switch health.Status {
+			case StreamStatusHealthy:
+				healthyCount++
+			case StreamStatusDegraded:
+				degradedCount++
+			}
+		}
+	}
+
 	// Calculate backpressure level based on channel usage
 	transcriptUsage := float64(len(p.TranscriptChan)) / float64(cap(p.TranscriptChan))
 	audioUsage := float64(len(p.AudioChan)) / float64(cap(p.AudioChan))
@@ -364,7 +714,17 @@ func (p *Pipeline) updateHealth() {
 	} else {
 		p.status = PipelineStatusUnhealthy
 	}
+	status := p.status
 	p.statusMu.Unlock()
+
+	p.evaluateDowngrade(status)
+}
+
+// Status returns the overall pipeline status from the last health check
+func (p *Pipeline) Status() PipelineStatus {
+	p.statusMu.RLock()
+	defer p.statusMu.RUnlock()
+	return p.status
 }
 
 // GetHealth returns the current health status of the pipeline
@@ -408,7 +768,89 @@ func (p *Pipeline) GetHealth() *PipelineHealth {
 		Uptime:            time.Since(p.startTime),
 		StreamHealths:     streamHealths,
 		BackpressureLevel: backpressureLevel,
+		SuppressedPartials: atomic.LoadInt64(&p.suppressedPartials),
+		CoalescedPartials:  atomic.LoadInt64(&p.coalescedPartials),
+		SlowMode:           p.SlowModeEnabled(),
+		Hibernated:         p.Hibernated(),
+		ArchivePending:     p.archive.Pending(),
+		NoiseProfiles:      p.noiseGate.Profiles(),
+		TTSBudget:          p.ttsBudget.Stats(),
+		Downgraded:         p.IsDowngraded(),
+		Crosstalk:          p.crosstalk.IsActive(),
+		Breakers:           p.breakers.States(),
+		Cache:              p.cache.Metrics(),
+		Usage:              p.usage.Snapshot(),
+	}
+}
+
+// StreamSpeakers returns the source languages each speaker has an open Transcribe stream for
+func (p *Pipeline) StreamSpeakers() map[string][]string {
+	speakers := make(map[string][]string)
+	if p.useStreamManager && p.streamManager != nil {
+		for speakerID, lang := range p.streamManager.SpeakerLanguages() {
+			speakers[speakerID] = append(speakers[speakerID], lang)
+		}
+		return speakers
+	}
+
+	p.streamsMu.RLock()
+	defer p.streamsMu.RUnlock()
+	for key := range p.speakerStreams {
+		speakerID, lang, _ := strings.Cut(key, ":")
+		speakers[speakerID] = append(speakers[speakerID], lang)
+	}
+	return speakers
+}
+
+// ReapWorkerPools closes worker pools whose context was cancelled but were never closed.
+// Returns how many pools were closed.
+func (p *Pipeline) ReapWorkerPools() int {
+	reaped := 0
+	for _, pool := range []*WorkerPool{p.translatePool, p.ttsPool} {
+		if pool != nil && pool.Stale() {
+			pool.Close()
+			reaped++
+		}
+	}
+	return reaped
+}
+
+// StreamManagerStats returns the language-pooled stream statistics (nil without a stream manager)
+func (p *Pipeline) StreamManagerStats() map[string]interface{} {
+	if p.streamManager == nil {
+		return nil
 	}
+	return p.streamManager.GetStats()
+}
+
+// WorkerPoolStats returns translate/TTS worker pool statistics keyed by pool name (nil without worker pools)
+func (p *Pipeline) WorkerPoolStats() map[string]map[string]interface{} {
+	var stats map[string]map[string]interface{}
+	for _, pool := range []*WorkerPool{p.translatePool, p.ttsPool} {
+		if pool == nil {
+			continue
+		}
+		if stats == nil {
+			stats = make(map[string]map[string]interface{})
+		}
+		stats[pool.name] = pool.Stats()
+	}
+	return stats
+}
+
+// Usage returns the cumulative billable AWS usage of this pipeline
+func (p *Pipeline) Usage() UsageSnapshot {
+	return p.usage.Snapshot()
+}
+
+// DrainUsage returns the usage accumulated since the previous call (for persistence)
+func (p *Pipeline) DrainUsage() UsageSnapshot {
+	return p.usage.Drain()
+}
+
+// DrainQuality returns the per language pair quality recorded since the previous call (for persistence)
+func (p *Pipeline) DrainQuality() []PairQuality {
+	return p.quality.Drain()
 }
 
 // IsBackpressureActive returns whether backpressure is currently active
@@ -425,6 +867,13 @@ func (p *Pipeline) ProcessAudio(speakerID, sourceLang, speakerName, profileImg s
 		return nil
 	}
 
+	// Workspace transcription quota used up: stop feeding Transcribe (listeners were notified)
+	if p.checkQuota(QuotaResourceTranscribe) != nil {
+		return nil
+	}
+
+	p.markAudio()
+
 	// Store speaker metadata for use in transcript messages
 	p.speakerMetaMu.Lock()
 	p.speakerMeta[speakerID] = &SpeakerMeta{
@@ -433,6 +882,14 @@ func (p *Pipeline) ProcessAudio(speakerID, sourceLang, speakerName, profileImg s
 	}
 	p.speakerMetaMu.Unlock()
 
+	// Feed the speaker's noise floor calibration (first few seconds only)
+	p.noiseGate.Observe(speakerID, audioData)
+
+	// Detect sustained overlapping speech across speakers
+	if event := p.crosstalk.Observe(speakerID, audioData, time.Now()); event != nil {
+		p.sendCrosstalkEvent(event)
+	}
+
 	stream, err := p.getOrCreateStream(speakerID, sourceLang)
 	if err != nil {
 		log.Printf("[AWS Pipeline] ERROR getting/creating stream: %v", err)
@@ -451,6 +908,7 @@ func (p *Pipeline) ProcessAudio(speakerID, sourceLang, speakerName, profileImg s
 		atomic.AddInt64(&p.totalErrors, 1)
 		return err
 	}
+	p.usage.AddTranscribeAudio(len(audioData))
 
 	return nil
 }
@@ -466,7 +924,7 @@ func (p *Pipeline) getSpeakerMeta(speakerID string) *SpeakerMeta {
 func (p *Pipeline) getOrCreateStream(speakerID, sourceLang string) (*TranscribeStream, error) {
 	// Use StreamManager for language-based pooling if enabled
 	if p.useStreamManager && p.streamManager != nil {
-		stream, err := p.streamManager.GetOrCreateStream(speakerID, sourceLang)
+		stream, err := p.streamManager.GetOrCreateStreamWithOptions(speakerID, sourceLang, p.streamOptions(sourceLang))
 		if err != nil {
 			atomic.AddInt64(&p.totalErrors, 1)
 			return nil, err
@@ -510,7 +968,14 @@ func (p *Pipeline) getOrCreateStream(speakerID, sourceLang string) (*TranscribeS
 	}
 
 	// Create new stream (still holding write lock to prevent concurrent creation)
-	stream, err := p.transcribe.StartStream(p.ctx, speakerID, sourceLang)
+	var startErr error
+	err := p.breakers.Transcribe.Execute(func() error {
+		stream, startErr = p.transcribeFor(sourceLang).StartStreamWithOptions(p.ctx, speakerID, sourceLang, p.streamOptions(sourceLang))
+		return breakerFailure(p.ctx, startErr)
+	})
+	if err == nil {
+		err = startErr
+	}
 	if err != nil {
 		log.Printf("[AWS Pipeline] Failed to create Transcribe stream for speaker %s: %v", speakerID, err)
 		atomic.AddInt64(&p.totalErrors, 1)
@@ -579,9 +1044,10 @@ func (p *Pipeline) processTranscriptsOnce(stream *TranscribeStream, sourceLang s
 func (p *Pipeline) processTranscripts(stream *TranscribeStream, sourceLang string) {
 	log.Printf("[AWS Pipeline] 🔄 processTranscripts started for stream (sourceLang: %s)", sourceLang)
 
-	// Track last partial text for delta TTS (only send new portion)
-	var lastPartialText string
-	var lastTTSSentText string
+	// Track the portion of the current utterance already sent for incremental translation+TTS
+	var delta SentenceDelta
+	incrementalSent := make(map[string]bool) // targetLang -> partial TTS sent for this utterance
+	var lastPartialAt time.Time              // Last partial emitted (slow mode coalescing)
 
 	for result := range stream.TranscriptChan {
 		// Increment transcript counter
@@ -590,41 +1056,29 @@ func (p *Pipeline) processTranscripts(stream *TranscribeStream, sourceLang strin
 		log.Printf("[AWS Pipeline] 📨 Received transcript: '%s' (isFinal: %v, confidence: %.2f, lang: %s)",
 			result.Text, result.IsFinal, result.Confidence, sourceLang)
 
-		// For Korean→Japanese: translate and TTS partials immediately for real-time experience
-		if sourceLang == "ko" && !result.IsFinal {
-			text := strings.TrimSpace(result.Text)
+		// Incremental mode: translate and TTS completed sentences of partials immediately
+		if !result.IsFinal {
+			// Slow mode: drop partials inside the interval; the next one carries the newer text
+			if p.slowMode.throttled(lastPartialAt) {
+				atomic.AddInt64(&p.coalescedPartials, 1)
+				continue
+			}
+			lastPartialAt = time.Now()
 			sentTranslatedPartial := false
 
-			// Only process if text is long enough and different from last
-			if len([]rune(text)) >= 3 && text != lastPartialText {
-				// Check if Japanese is in target languages
-				p.targetLangsMu.RLock()
-				hasJapaneseTarget := false
-				for _, lang := range p.targetLanguages {
-					if lang == "ja" {
-						hasJapaneseTarget = true
-						break
-					}
-				}
-				p.targetLangsMu.RUnlock()
-
-				if hasJapaneseTarget {
-					// Calculate delta (new portion only)
-					deltaText := text
-					if strings.HasPrefix(text, lastTTSSentText) && len(text) > len(lastTTSSentText) {
-						deltaText = strings.TrimSpace(text[len(lastTTSSentText):])
-					}
-
-					// Only send TTS if delta is meaningful (at least 2 characters)
-					if len([]rune(deltaText)) >= 2 {
-						lastTTSSentText = text
-						// Process delta with translation AND TTS for Japanese
-						// This already sends transcript, so don't send again
-						go p.processPartialWithTranslationAndTTS(result, sourceLang, "ja", deltaText)
-						sentTranslatedPartial = true
+			if targets := p.incrementalTargets(sourceLang); len(targets) > 0 {
+				if deltaText := delta.Next(result.Text); deltaText != "" {
+					// Slow mode captions deltas only; the final voices the whole utterance
+					withTTS := !p.SlowModeEnabled()
+					for _, tgt := range targets {
+						if withTTS {
+							incrementalSent[tgt] = true
+						}
+						// This already sends a transcript, so don't send again
+						go p.processIncrementalDelta(result, sourceLang, tgt, deltaText, true, withTTS)
 					}
+					sentTranslatedPartial = true
 				}
-				lastPartialText = text
 			}
 
 			// Only send regular partial if we didn't already send a translated partial
@@ -634,38 +1088,26 @@ func (p *Pipeline) processTranscripts(stream *TranscribeStream, sourceLang strin
 			continue
 		}
 
-		// For other languages: send partial without translation
-		if !result.IsFinal {
-			p.sendPartialTranscript(result)
-			continue
-		}
-
-		// For Korean→Japanese: skip TTS in final since we already sent chunk TTS
-		// Check if we sent any partial TTS
-		sentPartialTTS := lastTTSSentText != ""
+		lastPartialAt = time.Time{}
+		// Finals are translated concurrently, so record utterance order before handing off
+		result.Sequence = p.sequencer.Next()
 
-		// Reset partial tracking for final result
-		lastPartialText = ""
-		lastTTSSentText = ""
+		// Final: languages that already received partial TTS only get TTS for the unsent tail
+		remainder := delta.Remainder(result.Text)
+		skipTTSLangs := make([]string, 0, len(incrementalSent))
+		for lang := range incrementalSent {
+			skipTTSLangs = append(skipTTSLangs, lang)
+		}
+		incrementalSent = make(map[string]bool)
 
-		// Process final result: Translate + TTS (skip TTS if we already sent partials for KO→JA)
-		if sourceLang == "ko" && sentPartialTTS {
-			// Check if Japanese is in targets
-			p.targetLangsMu.RLock()
-			hasJapaneseTarget := false
-			for _, lang := range p.targetLanguages {
-				if lang == "ja" {
-					hasJapaneseTarget = true
-					break
+		if len(skipTTSLangs) > 0 {
+			go p.processFinalTranscriptNoTTS(result, sourceLang, skipTTSLangs)
+			if len([]rune(remainder)) >= MinIncrementalDeltaRunes {
+				for _, tgt := range skipTTSLangs {
+					go p.processIncrementalDelta(result, sourceLang, tgt, remainder, false, true)
 				}
 			}
-			p.targetLangsMu.RUnlock()
-
-			if hasJapaneseTarget {
-				// Skip TTS for Japanese since we already sent chunk TTS
-				go p.processFinalTranscriptNoTTS(result, sourceLang, "ja")
-				continue
-			}
+			continue
 		}
 
 		// Process final result: Translate + TTS
@@ -674,9 +1116,11 @@ func (p *Pipeline) processTranscripts(stream *TranscribeStream, sourceLang strin
 	log.Printf("[AWS Pipeline] 🔚 processTranscripts ended for stream")
 }
 
-// processPartialWithTranslationAndTTS handles partial transcripts with translation AND TTS (for Korean→Japanese real-time)
-// deltaText is the new portion of text (not already sent for TTS)
-func (p *Pipeline) processPartialWithTranslationAndTTS(result *TranscriptResult, sourceLang, targetLang, deltaText string) {
+// processIncrementalDelta translates a completed sentence of an in-progress utterance and
+// sends its TTS right away (incremental mode). deltaText is the portion not yet sent for TTS;
+// sendTranscript is false for the final's tail, whose caption comes with the final transcript;
+// withTTS is false in slow mode, where only the final is voiced.
+func (p *Pipeline) processIncrementalDelta(result *TranscriptResult, sourceLang, targetLang, deltaText string, sendTranscript, withTTS bool) {
 	ctx, cancel := context.WithTimeout(p.ctx, 5*time.Second)
 	defer cancel()
 
@@ -684,10 +1128,14 @@ func (p *Pipeline) processPartialWithTranslationAndTTS(result *TranscriptResult,
 		return
 	}
 
-	log.Printf("[AWS Pipeline] 🇯🇵 Processing delta chunk: '%s'", deltaText)
+	log.Printf("[AWS Pipeline] ⚡ Processing delta chunk %s→%s: '%s'", sourceLang, targetLang, deltaText)
+
+	// Mask PII and flagged terms before the delta is translated and voiced
+	originalText, masked := p.moderator.Mask(p.redactText(result.Text), sourceLang)
+	deltaText, _ = p.moderator.Mask(p.redactText(deltaText), sourceLang)
 
 	// Translate the delta text
-	trans, err := p.translate.Translate(ctx, deltaText, sourceLang, targetLang)
+	trans, err := p.translateText(ctx, deltaText, sourceLang, targetLang)
 	if err != nil {
 		log.Printf("[AWS Pipeline] Partial translation error: %v", err)
 		return
@@ -710,7 +1158,7 @@ func (p *Pipeline) processPartialWithTranslationAndTTS(result *TranscriptResult,
 	// Build transcript message (with full original text for display)
 	transcriptMsg := &ai.TranscriptMessage{
 		ID:               uuid.New().String(),
-		OriginalText:     result.Text, // Full text for display
+		OriginalText:     originalText, // Full text for display
 		OriginalLanguage: sourceLang,
 		IsPartial:        true,
 		IsFinal:          false,
@@ -722,66 +1170,117 @@ func (p *Pipeline) processPartialWithTranslationAndTTS(result *TranscriptResult,
 				TranslatedText: trans.TranslatedText, // Delta translation
 			},
 		},
-		Speaker: speakerInfo,
+		Speaker:   speakerInfo,
+		Moderated: masked,
+	}
+	if masked {
+		transcriptMsg.ModerationLabels = []string{ModerationLabelWordList}
 	}
 
 	// Send transcript
-	select {
-	case p.TranscriptChan <- transcriptMsg:
-		log.Printf("[AWS Pipeline] 🇯🇵 KO→JA chunk: '%s' → '%s'", deltaText, trans.TranslatedText)
-	default:
-		log.Printf("[AWS Pipeline] Transcript channel full (KO→JA partial)")
+	if sendTranscript {
+		select {
+		case p.TranscriptChan <- transcriptMsg:
+			p.queues.transcripts.Observe(len(p.TranscriptChan))
+			metrics.TranscriptsTotal.Inc("incremental")
+			log.Printf("[AWS Pipeline] ⚡ %s→%s chunk: '%s' → '%s'", sourceLang, targetLang, deltaText, trans.TranslatedText)
+		default:
+			metrics.DroppedMessages.Inc(metrics.DropTranscriptChannel)
+			log.Printf("[AWS Pipeline] Transcript channel full (incremental partial)")
+		}
 	}
 
-	// Generate TTS immediately for the delta translation
-	audio, err := p.polly.Synthesize(ctx, trans.TranslatedText, targetLang)
-	if err != nil {
-		log.Printf("[AWS Pipeline] Partial TTS error: %v", err)
+	// Generate TTS immediately for the delta translation (once per listener voice)
+	if !withTTS || !p.TTSEnabled(targetLang) {
 		return
 	}
-
-	if len(audio.AudioData) == 0 {
+	voices := p.voicesFor(targetLang)
+	priority := ClassifySentence(deltaText, sourceLang, p.speakerNicknames(result.SpeakerID))
+	if reason := p.ttsBudget.Reserve(len([]rune(trans.TranslatedText))*len(voices), priority); reason != "" {
+		log.Printf("[AWS Pipeline] Skipping partial TTS (%s): '%s'", reason, trans.TranslatedText)
 		return
 	}
+	if p.checkQuota(QuotaResourcePolly) != nil {
+		return
+	}
+	prosody := p.ttsProsody()
+	for _, voice := range voices {
+		audio, err := p.synthesize(ctx, trans.TranslatedText, targetLang, voice, prosody)
+		if err != nil {
+			log.Printf("[AWS Pipeline] Partial TTS error: %v", err)
+			continue
+		}
+
+		if len(audio.AudioData) == 0 {
+			continue
+		}
+
+		// Send TTS audio
+		audioMsg := &ai.AudioMessage{
+			TranscriptID:         transcriptMsg.ID,
+			TargetLanguage:       targetLang,
+			VoiceKey:             voice.Key(),
+			AudioData:            audio.AudioData,
+			Format:               audio.Format,
+			SampleRate:           uint32(audio.SampleRate),
+			SpeakerParticipantID: result.SpeakerID,
+		}
+
+		select {
+		case p.AudioChan <- audioMsg:
+			p.queues.audio.Observe(len(p.AudioChan))
+			p.recordPlayback(audioMsg)
+			log.Printf("[AWS Pipeline] 🔊 %s→%s chunk TTS: '%s' (%d bytes)", sourceLang, targetLang, trans.TranslatedText, len(audio.AudioData))
+		default:
+			log.Printf("[AWS Pipeline] Audio channel full (incremental partial)")
+		}
+	}
+}
 
-	// Send TTS audio
-	audioMsg := &ai.AudioMessage{
-		TranscriptID:         transcriptMsg.ID,
-		TargetLanguage:       targetLang,
-		AudioData:            audio.AudioData,
-		Format:               audio.Format,
-		SampleRate:           uint32(audio.SampleRate),
-		SpeakerParticipantID: result.SpeakerID,
+// SetIncrementalPairs changes which language pairs get incremental translation+TTS
+func (p *Pipeline) SetIncrementalPairs(pairs []LanguagePair) {
+	p.incremental.SetPairs(pairs)
+	log.Printf("[AWS Pipeline] Incremental translation pairs: %v", pairs)
+}
+
+// incrementalTargets returns current target languages using incremental mode for a source language
+func (p *Pipeline) incrementalTargets(sourceLang string) []string {
+	// Partial TTS is an optional feature dropped while downgraded
+	if p.IsDowngraded() {
+		return nil
 	}
+	return p.incremental.Targets(sourceLang, p.liveTargetLanguages())
+}
 
-	select {
-	case p.AudioChan <- audioMsg:
-		log.Printf("[AWS Pipeline] 🔊 KO→JA chunk TTS: '%s' (%d bytes)", trans.TranslatedText, len(audio.AudioData))
-	default:
-		log.Printf("[AWS Pipeline] Audio channel full (KO→JA partial)")
+// SetPartialSuppression enables or disables partial suppression during TTS playback
+func (p *Pipeline) SetPartialSuppression(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
 	}
+	atomic.StoreInt32(&p.suppressPartialsDuringTTS, v)
+	log.Printf("[AWS Pipeline] Partial suppression during TTS playback: %v", enabled)
+}
+
+// IsPartialSuppressionEnabled returns whether partials are held back during TTS playback
+func (p *Pipeline) IsPartialSuppressionEnabled() bool {
+	return atomic.LoadInt32(&p.suppressPartialsDuringTTS) == 1
 }
 
 // sendPartialTranscript sends a partial transcript without translation
 func (p *Pipeline) sendPartialTranscript(result *TranscriptResult) {
+	// Captions would run ahead of the audio listeners are still hearing
+	if p.IsPartialSuppressionEnabled() && p.playback.IsPlaying(result.SpeakerID) {
+		atomic.AddInt64(&p.suppressedPartials, 1)
+		return
+	}
+
 	// Apply lighter noise filtering for partials (allow lower confidence for real-time feedback)
 	text := strings.TrimSpace(result.Text)
 	runes := []rune(text)
 
-	// Language-specific minimum length to reduce choppy updates
-	// Japanese tends to have more granular partials, so require more characters
-	minLen := 2
-	switch result.Language {
-	case "ja":
-		minLen = 4 // Japanese: require at least 4 characters to reduce word-by-word updates
-	case "en":
-		minLen = 3 // English: require at least 3 characters
-	case "zh":
-		minLen = 3 // Chinese: require at least 3 characters
-	}
-
-	// Skip too short partials
-	if len(runes) < minLen {
+	// Skip too short partials (language-specific minimum to reduce choppy updates)
+	if len(runes) < p.noiseFilter.PartialMinLength(result.Language) {
 		return
 	}
 
@@ -814,135 +1313,45 @@ func (p *Pipeline) sendPartialTranscript(result *TranscriptResult) {
 		speakerInfo.ProfileImg = meta.ProfileImg
 	}
 
+	// Partials only get word-list masking (toxicity is checked once on the final)
+	maskedText, masked := p.moderator.Mask(p.redactText(result.Text), result.Language)
+
 	msg := &ai.TranscriptMessage{
 		ID:               uuid.New().String(),
-		OriginalText:     result.Text,
+		OriginalText:     maskedText,
 		OriginalLanguage: result.Language,
 		IsPartial:        true,
 		IsFinal:          false,
 		TimestampMs:      result.TimestampMs,
 		Confidence:       result.Confidence,
 		Speaker:          speakerInfo,
+		Moderated:        masked,
+	}
+	if masked {
+		msg.ModerationLabels = []string{ModerationLabelWordList}
 	}
 
 	select {
 	case p.TranscriptChan <- msg:
+		p.queues.transcripts.Observe(len(p.TranscriptChan))
+		metrics.TranscriptsTotal.Inc("partial")
 	default:
+		metrics.DroppedMessages.Inc(metrics.DropTranscriptChannel)
 		log.Printf("[AWS Pipeline] Transcript channel full (partial)")
 	}
 }
 
-// Noise filtering constants
-const (
-	MinTextLengthForTranslation = 2
-	MinConfidenceThreshold      = 0.5 // Lowered from 0.65 to reduce false filtering
-)
-
-// Common noise words/phrases that are often hallucinated by STT
-var noisePatterns = map[string][]string{
-	"ko": {
-		"네", "예", "아", "어", "음", "응", "흠", "에", "으", "이",
-		"그", "저", "뭐", "좀", "자", "서", "거", "게", "요", "야",
-		"MBC 뉴스", "KBS 뉴스", "SBS 뉴스", "YTN", "JTBC",
-		"자막 제공", "자막 협찬", "자막", "제공", "협찬",
-		"구독", "좋아요", "알림", "시청", "감사",
-	},
-	"en": {
-		"um", "uh", "ah", "oh", "eh", "hm", "hmm", "yeah", "yep", "nope",
-		"like", "so", "well", "okay", "ok", "right", "you know",
-		"subscribe", "like and subscribe", "thanks for watching",
-		"MBC News", "KBS News", "breaking news",
-	},
-	"ja": {
-		"えー", "あー", "うん", "ええ", "はい", "ねえ", "まあ",
-		"字幕", "提供", "ニュース",
-	},
-	"zh": {
-		"嗯", "啊", "哦", "呃", "好", "对", "是",
-		"字幕", "新闻", "订阅",
-	},
-}
-
-// isNoiseText checks if text is likely noise/hallucination
-func isNoiseText(text string, sourceLang string, confidence float32) bool {
-	text = strings.TrimSpace(text)
-	runes := []rune(text)
-
-	// Empty or too short
-	if len(runes) < MinTextLengthForTranslation {
-		return true
-	}
-
-	// Low confidence
-	if confidence > 0 && confidence < MinConfidenceThreshold {
-		return true
-	}
-
-	// Check for repeated characters (e.g., "아아아아", "ㅋㅋㅋ")
-	if len(runes) >= 3 {
-		allSame := true
-		for i := 1; i < len(runes); i++ {
-			if runes[i] != runes[0] {
-				allSame = false
-				break
-			}
-		}
-		if allSame {
-			return true
-		}
-	}
-
-	// Check for punctuation/whitespace only
-	hasAlphanumeric := false
-	for _, r := range runes {
-		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') ||
-			(r >= '0' && r <= '9') ||
-			(r >= 0xAC00 && r <= 0xD7AF) || // Korean Hangul
-			(r >= 0x3040 && r <= 0x30FF) || // Japanese Hiragana/Katakana
-			(r >= 0x4E00 && r <= 0x9FFF) { // Chinese characters
-			hasAlphanumeric = true
-			break
-		}
-	}
-	if !hasAlphanumeric {
-		return true
-	}
-
-	// Check against noise patterns for the source language
-	textLower := strings.ToLower(text)
-
-	// Check all languages (hallucinations can come in wrong language)
-	for _, patterns := range noisePatterns {
-		for _, pattern := range patterns {
-			patternLower := strings.ToLower(pattern)
-			// Exact match or text is just the noise pattern
-			if textLower == patternLower {
-				return true
-			}
-			// Text starts and ends with noise pattern (allowing for minor variations)
-			if len(runes) <= len([]rune(pattern))+2 && strings.Contains(textLower, patternLower) {
-				return true
-			}
-		}
-	}
-
-	return false
-}
-
 // processFinalTranscript handles translation and TTS for final transcripts
 func (p *Pipeline) processFinalTranscript(result *TranscriptResult, sourceLang string) {
 	ctx, cancel := context.WithTimeout(p.ctx, 15*time.Second)
 	defer cancel()
 
 	// Get target languages
-	p.targetLangsMu.RLock()
-	targetLangs := make([]string, len(p.targetLanguages))
-	copy(targetLangs, p.targetLanguages)
-	p.targetLangsMu.RUnlock()
+	targetLangs := p.liveTargetLanguages()
 
 	// Enhanced noise filtering
 	text := strings.TrimSpace(result.Text)
-	if isNoiseText(text, sourceLang, result.Confidence) {
+	if p.noiseFilter.IsNoise(text, sourceLang, result.Confidence, p.noiseGate.ConfidenceThreshold(result.SpeakerID)) {
 		// Only log if it's not a super short text to reduce log spam
 		if len([]rune(text)) >= 2 {
 			log.Printf("[AWS Pipeline] Filtering noise: '%s' (confidence: %.2f)", text, result.Confidence)
@@ -950,15 +1359,24 @@ func (p *Pipeline) processFinalTranscript(result *TranscriptResult, sourceLang s
 		return
 	}
 
+	// Mask PII and flagged terms before translation/TTS so they never reach listeners
+	result = p.redactTranscript(result)
+	result, moderation := p.moderateTranscript(ctx, result, sourceLang)
+
 	log.Printf("[AWS Pipeline] Processing final transcript from %s: '%s' (lang: %s, confidence: %.2f, targetLangs: %v)",
 		result.SpeakerID, result.Text, sourceLang, result.Confidence, targetLangs)
 
+	transcriptID := uuid.New().String()
+	p.queueArchive(transcriptID, result, sourceLang, targetLangs)
+	p.beginFinal(result.SpeakerID, transcriptID, result.TimestampMs)
+
 	// Translate to all target languages (with caching and semaphore)
 	translations := make(map[string]*TranslationResult)
 	var translateWg sync.WaitGroup
 	var translateMu sync.Mutex
 
 	for _, targetLang := range targetLangs {
+		p.quality.RecordFinal(sourceLang, targetLang, float64(result.Confidence))
 		// FIX: Don't skip same language - generate passthrough TTS so listeners always receive audio
 		// This ensures bidirectional communication even when source == target
 		if targetLang == sourceLang {
@@ -981,12 +1399,22 @@ func (p *Pipeline) processFinalTranscript(result *TranscriptResult, sourceLang s
 
 			// Check cache first (before acquiring semaphore)
 			if cached, ok := p.cache.GetTranslation(result.Text, sourceLang, tgtLang); ok {
+				p.quality.RecordTranslation(sourceLang, tgtLang, result.Text, cached.TranslatedText, true)
 				translateMu.Lock()
 				translations[tgtLang] = cached
 				translateMu.Unlock()
 				return
 			}
 
+			// Translate outage: don't wait on the semaphore, send the original text only
+			if !p.breakers.Translate.Available() {
+				p.quality.RecordTranslateFailure(sourceLang, tgtLang)
+				translateMu.Lock()
+				translations[tgtLang] = degradedTranslation(result.Text, sourceLang, tgtLang)
+				translateMu.Unlock()
+				return
+			}
+
 			// Acquire translate semaphore with timeout
 			select {
 			case p.translateSem <- struct{}{}:
@@ -1000,12 +1428,21 @@ func (p *Pipeline) processFinalTranscript(result *TranscriptResult, sourceLang s
 			apiCtx, apiCancel := context.WithTimeout(ctx, APICallTimeout)
 			defer apiCancel()
 
-			trans, err := p.translate.Translate(apiCtx, result.Text, sourceLang, tgtLang)
+			trans, err := p.translateText(apiCtx, result.Text, sourceLang, tgtLang)
+			if errors.Is(err, ErrCircuitOpen) {
+				p.quality.RecordTranslateFailure(sourceLang, tgtLang)
+				translateMu.Lock()
+				translations[tgtLang] = degradedTranslation(result.Text, sourceLang, tgtLang)
+				translateMu.Unlock()
+				return
+			}
 			if err != nil {
 				log.Printf("[AWS Pipeline] Translation error for %s: %v", tgtLang, err)
 				atomic.AddInt64(&p.totalErrors, 1)
+				p.quality.RecordTranslateFailure(sourceLang, tgtLang)
 				return
 			}
+			p.quality.RecordTranslation(sourceLang, tgtLang, result.Text, trans.TranslatedText, false)
 
 			// Store in cache
 			p.cache.SetTranslation(result.Text, sourceLang, tgtLang, trans)
@@ -1029,15 +1466,22 @@ func (p *Pipeline) processFinalTranscript(result *TranscriptResult, sourceLang s
 
 	// Build transcript message with translations
 	transcriptMsg := &ai.TranscriptMessage{
-		ID:               uuid.New().String(),
+		ID:               transcriptID,
 		OriginalText:     result.Text,
 		OriginalLanguage: sourceLang,
 		IsPartial:        false,
 		IsFinal:          true,
 		TimestampMs:      result.TimestampMs,
+		Sequence:         result.Sequence,
 		Confidence:       result.Confidence,
 		Translations:     make([]*pb.TranslationEntry, 0),
 		Speaker:          speakerInfo,
+		Crosstalk:        p.inCrosstalk(result),
+		Degraded:         hasDegradedTranslation(translations),
+	}
+	if moderation != nil && moderation.Flagged {
+		transcriptMsg.Moderated = true
+		transcriptMsg.ModerationLabels = moderation.Labels
 	}
 
 	for lang, trans := range translations {
@@ -1049,82 +1493,210 @@ func (p *Pipeline) processFinalTranscript(result *TranscriptResult, sourceLang s
 		}
 	}
 
+	// Charge the Polly budget (and apply the host's per-language TTS toggles) before sending
+	// so skipped TTS shows up in transcript metadata. Passthrough TTS is only voiced when
+	// someone other than the speaker listens in the speaker's language.
+	if _, ok := translations[sourceLang]; ok && !p.hasPassthroughAudience(sourceLang, result.SpeakerID) {
+		transcriptMsg.TTSSkipped = p.planTTS(result, sourceLang, translations, sourceLang)
+		if transcriptMsg.TTSSkipped == nil {
+			transcriptMsg.TTSSkipped = make(map[string]string)
+		}
+		transcriptMsg.TTSSkipped[sourceLang] = TTSSkipNoAudience
+	} else {
+		transcriptMsg.TTSSkipped = p.planTTS(result, sourceLang, translations)
+	}
+
 	// Send transcript with graceful degradation
 	if !p.sendTranscript(transcriptMsg) {
 		atomic.AddInt64(&p.droppedMessages, 1)
 	}
 
-	// Generate TTS for each target language (parallel, with caching and semaphore)
-	// FIX: Now includes passthrough TTS for same language (source == target)
+	// Generate TTS for each target language (parallel, with caching and semaphore).
+	// Passthrough TTS (source == target) goes to the low-priority lane instead.
 	log.Printf("[AWS Pipeline] 🔊 Generating TTS for %d translations (including passthrough)", len(translations))
 
 	var wg sync.WaitGroup
 	for lang, trans := range translations {
-		// FIX: Don't skip TTS for original language anymore - passthrough TTS ensures all listeners receive audio
-		// This is needed when source == target (e.g., English speaker, English listeners)
 		if trans == nil || trans.TranslatedText == "" {
 			continue
 		}
+		if _, skipped := transcriptMsg.TTSSkipped[lang]; skipped {
+			continue
+		}
+		if lang == sourceLang {
+			p.enqueuePassthroughTTS(transcriptMsg.ID, result.SpeakerID, lang, trans.TranslatedText)
+			continue
+		}
 
-		wg.Add(1)
-		go func(targetLang, text string) {
-			defer wg.Done()
-
-			var audioData []byte
-			var format string = "mp3"
-			var sampleRate int32 = 24000
-
-			// Check TTS cache first (before acquiring semaphore)
-			if cached, ok := p.cache.GetTTS(text, targetLang); ok {
-				audioData = cached
-			} else {
-				// Acquire TTS semaphore with timeout
-				select {
-				case p.ttsSem <- struct{}{}:
-					defer func() { <-p.ttsSem }()
-				case <-ctx.Done():
-					log.Printf("[AWS Pipeline] TTS timeout waiting for semaphore: %s", targetLang)
-					return
-				}
+		for _, voice := range p.voicesFor(lang) {
+			wg.Add(1)
+			go func(targetLang, text string, voice *VoicePreference) {
+				defer wg.Done()
+				p.synthesizeAndSend(ctx, transcriptMsg.ID, result.SpeakerID, sourceLang, targetLang, text, voice)
+			}(lang, trans.TranslatedText, voice)
+		}
+	}
+	wg.Wait()
+}
 
-				// Call Polly API with timeout
-				apiCtx, apiCancel := context.WithTimeout(ctx, APICallTimeout)
-				defer apiCancel()
+// synthesizeAndSend generates TTS for one target language and voice (cache + semaphore) and sends it
+func (p *Pipeline) synthesizeAndSend(ctx context.Context, transcriptID, speakerID, sourceLang, targetLang, text string, voice *VoicePreference) {
+	atomic.AddInt64(&p.ttsInFlight, 1)
+	defer atomic.AddInt64(&p.ttsInFlight, -1)
 
-				audio, err := p.polly.Synthesize(apiCtx, text, targetLang)
-				if err != nil {
-					log.Printf("[AWS Pipeline] ❌ TTS error for %s: %v", targetLang, err)
-					atomic.AddInt64(&p.totalErrors, 1)
-					return
-				}
+	var audioData []byte
+	format, sampleRate := voice.OutputProfile().Output()
 
-				if len(audio.AudioData) == 0 {
-					return
-				}
+	prosody := p.ttsProsody()
+	cacheKey := ttsCacheKey(voice, prosody)
 
-				// Store in cache
-				p.cache.SetTTS(text, targetLang, audio.AudioData)
+	// Track the clip so a newer final knows this transcript's audio is still in flight
+	var playback time.Duration
+	p.supersession.StartClip(transcriptID)
+	defer func() { p.supersession.FinishClip(transcriptID, playback) }()
 
-				audioData = audio.AudioData
-				format = audio.Format
-				sampleRate = audio.SampleRate
-			}
+	// Check TTS cache first (before acquiring semaphore)
+	if cached, ok := p.cache.GetTTS(text, targetLang, cacheKey); ok {
+		audioData = cached
+	} else {
+		// Polly outage: don't hold a TTS semaphore slot for a call that will be rejected
+		if !p.breakers.Polly.Available() {
+			return
+		}
+		// Superseded while waiting: don't pay for audio nobody should hear
+		if p.dropSuperseded(transcriptID) {
+			return
+		}
+		if p.checkQuota(QuotaResourcePolly) != nil {
+			return
+		}
 
-			audioMsg := &ai.AudioMessage{
-				TranscriptID:         transcriptMsg.ID,
-				TargetLanguage:       targetLang,
-				AudioData:            audioData,
-				Format:               format,
-				SampleRate:           uint32(sampleRate),
-				SpeakerParticipantID: result.SpeakerID,
-			}
+		// Acquire TTS semaphore with timeout
+		select {
+		case p.ttsSem <- struct{}{}:
+			defer func() { <-p.ttsSem }()
+		case <-ctx.Done():
+			log.Printf("[AWS Pipeline] TTS timeout waiting for semaphore: %s", targetLang)
+			return
+		}
+
+		// Call Polly API with timeout
+		apiCtx, apiCancel := context.WithTimeout(ctx, APICallTimeout)
+		defer apiCancel()
+
+		audio, err := p.synthesize(apiCtx, text, targetLang, voice, prosody)
+		if err != nil {
+			log.Printf("[AWS Pipeline] ❌ TTS error for %s: %v", targetLang, err)
+			atomic.AddInt64(&p.totalErrors, 1)
+			p.quality.RecordTTS(sourceLang, targetLang, false)
+			return
+		}
+
+		if len(audio.AudioData) == 0 {
+			return
+		}
+
+		// Store in cache
+		p.cache.SetTTS(text, targetLang, cacheKey, audio.AudioData)
+
+		audioData = audio.AudioData
+		format = audio.Format
+		sampleRate = audio.SampleRate
+	}
+
+	audioMsg := &ai.AudioMessage{
+		TranscriptID:         transcriptID,
+		TargetLanguage:       targetLang,
+		VoiceKey:             voice.Key(),
+		AudioData:            audioData,
+		Format:               format,
+		SampleRate:           uint32(sampleRate),
+		SpeakerParticipantID: speakerID,
+	}
 
-			if !p.sendAudio(audioMsg) {
-				atomic.AddInt64(&p.droppedMessages, 1)
+	if p.dropSuperseded(transcriptID) {
+		return
+	}
+	if !p.sendAudio(audioMsg) {
+		atomic.AddInt64(&p.droppedMessages, 1)
+		return
+	}
+	playback = EstimatePlaybackDuration(audioData, format, uint32(sampleRate))
+	p.quality.RecordTTS(sourceLang, targetLang, true)
+}
+
+// planTTS charges the room's Polly budget for each translation's TTS and returns the
+// languages whose audio is skipped (targetLang -> reason), including languages whose TTS
+// the host turned off. Languages in exclude get no TTS.
+func (p *Pipeline) planTTS(result *TranscriptResult, sourceLang string, translations map[string]*TranslationResult, exclude ...string) map[string]string {
+	var priority TTSPriority
+	if p.ttsBudget != nil {
+		priority = ClassifySentence(result.Text, sourceLang, p.speakerNicknames(result.SpeakerID))
+	}
+
+	var skipped map[string]string
+	for lang, trans := range translations {
+		if trans == nil || trans.TranslatedText == "" || slices.Contains(exclude, lang) {
+			continue
+		}
+
+		// Languages the host switched to captions only are not charged to the budget
+		reason := TTSSkipDisabled
+		if p.TTSEnabled(lang) {
+			chars := len([]rune(trans.TranslatedText)) * len(p.voicesFor(lang))
+			reason = p.ttsBudget.Reserve(chars, priority)
+		}
+		if reason != "" {
+			if skipped == nil {
+				skipped = make(map[string]string)
 			}
-		}(lang, trans.TranslatedText)
+			skipped[lang] = reason
+		}
 	}
-	wg.Wait()
+
+	if len(skipped) > 0 {
+		log.Printf("[AWS Pipeline] 💸 TTS skipped %v for '%s'", skipped, result.Text)
+	}
+	return skipped
+}
+
+// speakerNicknames returns the nicknames of other speakers (for direct-address detection)
+func (p *Pipeline) speakerNicknames(excludeSpeakerID string) []string {
+	p.speakerMetaMu.RLock()
+	defer p.speakerMetaMu.RUnlock()
+
+	names := make([]string, 0, len(p.speakerMeta))
+	for id, meta := range p.speakerMeta {
+		if id != excludeSpeakerID && meta != nil && meta.Nickname != "" {
+			names = append(names, meta.Nickname)
+		}
+	}
+	return names
+}
+
+// ttsProsody returns the prosody for the next TTS clip: the configured base prosody,
+// sped up when the audio queue is backing up (if auto prosody is enabled)
+func (p *Pipeline) ttsProsody() *Prosody {
+	if !p.autoProsody {
+		return p.prosody
+	}
+
+	switch {
+	case p.IsBackpressureActive():
+		return p.prosody.WithRate(BackpressureSpeakingRate)
+	case float64(len(p.AudioChan)) >= float64(cap(p.AudioChan))*ElevatedAudioQueueRatio:
+		return p.prosody.WithRate(ElevatedSpeakingRate)
+	}
+	return p.prosody
+}
+
+// ttsCacheKey returns the TTS cache key suffix of a voice and prosody
+func ttsCacheKey(voice *VoicePreference, prosody *Prosody) string {
+	key := voice.Key()
+	if prosodyKey := prosody.Key(); prosodyKey != "" {
+		key += "~" + prosodyKey
+	}
+	return key
 }
 
 // sendTranscript sends a transcript message with graceful degradation
@@ -1132,6 +1704,8 @@ func (p *Pipeline) sendTranscript(msg *ai.TranscriptMessage) bool {
 	// Try non-blocking send first
 	select {
 	case p.TranscriptChan <- msg:
+		p.queues.transcripts.Observe(len(p.TranscriptChan))
+		metrics.TranscriptsTotal.Inc("final")
 		return true
 	default:
 	}
@@ -1139,8 +1713,11 @@ func (p *Pipeline) sendTranscript(msg *ai.TranscriptMessage) bool {
 	// Channel full - try with short timeout for graceful degradation
 	select {
 	case p.TranscriptChan <- msg:
+		p.queues.transcripts.Observe(len(p.TranscriptChan))
+		metrics.TranscriptsTotal.Inc("final")
 		return true
 	case <-time.After(100 * time.Millisecond):
+		metrics.DroppedMessages.Inc(metrics.DropTranscriptChannel)
 		log.Printf("[AWS Pipeline] ⚠️ Transcript channel full, dropping message")
 		return false
 	}
@@ -1151,6 +1728,8 @@ func (p *Pipeline) sendAudio(msg *ai.AudioMessage) bool {
 	// Try non-blocking send first
 	select {
 	case p.AudioChan <- msg:
+		p.queues.audio.Observe(len(p.AudioChan))
+		p.recordPlayback(msg)
 		return true
 	default:
 	}
@@ -1158,35 +1737,51 @@ func (p *Pipeline) sendAudio(msg *ai.AudioMessage) bool {
 	// Channel full - try with short timeout for graceful degradation
 	select {
 	case p.AudioChan <- msg:
+		p.queues.audio.Observe(len(p.AudioChan))
+		p.recordPlayback(msg)
 		return true
 	case <-time.After(100 * time.Millisecond):
+		metrics.DroppedMessages.Inc(metrics.DropAudioChannel)
 		log.Printf("[AWS Pipeline] ⚠️ Audio channel full, dropping message for %s", msg.TargetLanguage)
 		return false
 	}
 }
 
-// processFinalTranscriptNoTTS handles translation for final transcripts, but skips TTS for specified language
+// recordPlayback extends the speaker's playback window for a delivered TTS clip
+func (p *Pipeline) recordPlayback(msg *ai.AudioMessage) {
+	if !p.IsPartialSuppressionEnabled() {
+		return
+	}
+	p.playback.Record(msg.SpeakerParticipantID, msg.AudioData, msg.Format, msg.SampleRate)
+}
+
+// processFinalTranscriptNoTTS handles translation for final transcripts, but skips TTS for the specified languages
 // Used when chunk TTS was already sent during partials (e.g., Korean→Japanese real-time TTS)
-func (p *Pipeline) processFinalTranscriptNoTTS(result *TranscriptResult, sourceLang, skipTTSLang string) {
+func (p *Pipeline) processFinalTranscriptNoTTS(result *TranscriptResult, sourceLang string, skipTTSLangs []string) {
 	ctx, cancel := context.WithTimeout(p.ctx, 15*time.Second)
 	defer cancel()
 
 	// Get target languages
-	p.targetLangsMu.RLock()
-	targetLangs := make([]string, len(p.targetLanguages))
-	copy(targetLangs, p.targetLanguages)
-	p.targetLangsMu.RUnlock()
+	targetLangs := p.liveTargetLanguages()
 
 	// Enhanced noise filtering
 	text := strings.TrimSpace(result.Text)
-	if isNoiseText(text, sourceLang, result.Confidence) {
+	if p.noiseFilter.IsNoise(text, sourceLang, result.Confidence, p.noiseGate.ConfidenceThreshold(result.SpeakerID)) {
 		if len([]rune(text)) >= 2 {
 			log.Printf("[AWS Pipeline] Filtering noise (NoTTS): '%s' (confidence: %.2f)", text, result.Confidence)
 		}
 		return
 	}
 
-	log.Printf("[AWS Pipeline] Processing final transcript (skip TTS for %s): '%s'", skipTTSLang, result.Text)
+	// Mask PII and flagged terms before translation/TTS so they never reach listeners
+	result = p.redactTranscript(result)
+	result, moderation := p.moderateTranscript(ctx, result, sourceLang)
+
+	log.Printf("[AWS Pipeline] Processing final transcript (skip TTS for %v): '%s'", skipTTSLangs, result.Text)
+
+	transcriptID := uuid.New().String()
+	p.queueArchive(transcriptID, result, sourceLang, targetLangs)
+	p.beginFinal(result.SpeakerID, transcriptID, result.TimestampMs)
 
 	// Translate to all target languages (with caching and semaphore)
 	translations := make(map[string]*TranslationResult)
@@ -1194,6 +1789,7 @@ func (p *Pipeline) processFinalTranscriptNoTTS(result *TranscriptResult, sourceL
 	var translateMu sync.Mutex
 
 	for _, targetLang := range targetLangs {
+		p.quality.RecordFinal(sourceLang, targetLang, float64(result.Confidence))
 		if targetLang == sourceLang {
 			continue
 		}
@@ -1204,12 +1800,22 @@ func (p *Pipeline) processFinalTranscriptNoTTS(result *TranscriptResult, sourceL
 
 			// Check cache first
 			if cached, ok := p.cache.GetTranslation(result.Text, sourceLang, tgtLang); ok {
+				p.quality.RecordTranslation(sourceLang, tgtLang, result.Text, cached.TranslatedText, true)
 				translateMu.Lock()
 				translations[tgtLang] = cached
 				translateMu.Unlock()
 				return
 			}
 
+			// Translate outage: don't wait on the semaphore, send the original text only
+			if !p.breakers.Translate.Available() {
+				p.quality.RecordTranslateFailure(sourceLang, tgtLang)
+				translateMu.Lock()
+				translations[tgtLang] = degradedTranslation(result.Text, sourceLang, tgtLang)
+				translateMu.Unlock()
+				return
+			}
+
 			// Acquire translate semaphore with timeout
 			select {
 			case p.translateSem <- struct{}{}:
@@ -1222,12 +1828,21 @@ func (p *Pipeline) processFinalTranscriptNoTTS(result *TranscriptResult, sourceL
 			apiCtx, apiCancel := context.WithTimeout(ctx, APICallTimeout)
 			defer apiCancel()
 
-			trans, err := p.translate.Translate(apiCtx, result.Text, sourceLang, tgtLang)
+			trans, err := p.translateText(apiCtx, result.Text, sourceLang, tgtLang)
+			if errors.Is(err, ErrCircuitOpen) {
+				p.quality.RecordTranslateFailure(sourceLang, tgtLang)
+				translateMu.Lock()
+				translations[tgtLang] = degradedTranslation(result.Text, sourceLang, tgtLang)
+				translateMu.Unlock()
+				return
+			}
 			if err != nil {
 				log.Printf("[AWS Pipeline] Translation error for %s: %v", tgtLang, err)
 				atomic.AddInt64(&p.totalErrors, 1)
+				p.quality.RecordTranslateFailure(sourceLang, tgtLang)
 				return
 			}
+			p.quality.RecordTranslation(sourceLang, tgtLang, result.Text, trans.TranslatedText, false)
 
 			// Store in cache
 			p.cache.SetTranslation(result.Text, sourceLang, tgtLang, trans)
@@ -1251,15 +1866,22 @@ func (p *Pipeline) processFinalTranscriptNoTTS(result *TranscriptResult, sourceL
 
 	// Build transcript message with translations
 	transcriptMsg := &ai.TranscriptMessage{
-		ID:               uuid.New().String(),
+		ID:               transcriptID,
 		OriginalText:     result.Text,
 		OriginalLanguage: sourceLang,
 		IsPartial:        false,
 		IsFinal:          true,
 		TimestampMs:      result.TimestampMs,
+		Sequence:         result.Sequence,
 		Confidence:       result.Confidence,
 		Translations:     make([]*pb.TranslationEntry, 0),
 		Speaker:          speakerInfo,
+		Crosstalk:        p.inCrosstalk(result),
+		Degraded:         hasDegradedTranslation(translations),
+	}
+	if moderation != nil && moderation.Flagged {
+		transcriptMsg.Moderated = true
+		transcriptMsg.ModerationLabels = moderation.Labels
 	}
 
 	for lang, trans := range translations {
@@ -1271,79 +1893,126 @@ func (p *Pipeline) processFinalTranscriptNoTTS(result *TranscriptResult, sourceL
 		}
 	}
 
+	// Charge the Polly budget before sending so skipped TTS shows up in transcript metadata
+	transcriptMsg.TTSSkipped = p.planTTS(result, sourceLang, translations, append(slices.Clone(skipTTSLangs), sourceLang)...)
+
 	// Send transcript with graceful degradation
 	if !p.sendTranscript(transcriptMsg) {
 		atomic.AddInt64(&p.droppedMessages, 1)
 	}
 
-	// Generate TTS for each target language EXCEPT skipTTSLang (with semaphore)
+	// Generate TTS for each target language EXCEPT skipTTSLangs (with semaphore)
 	var wg sync.WaitGroup
 	for lang, trans := range translations {
-		if lang == sourceLang || lang == skipTTSLang {
+		if lang == sourceLang || slices.Contains(skipTTSLangs, lang) {
 			continue
 		}
 		if trans == nil || trans.TranslatedText == "" {
 			continue
 		}
+		if _, skipped := transcriptMsg.TTSSkipped[lang]; skipped {
+			continue
+		}
 
-		wg.Add(1)
-		go func(targetLang, text string) {
-			defer wg.Done()
-
-			var audioData []byte
-			var format string = "mp3"
-			var sampleRate int32 = 24000
-
-			// Check TTS cache first
-			if cached, ok := p.cache.GetTTS(text, targetLang); ok {
-				audioData = cached
-			} else {
-				// Acquire TTS semaphore with timeout
-				select {
-				case p.ttsSem <- struct{}{}:
-					defer func() { <-p.ttsSem }()
-				case <-ctx.Done():
-					return
-				}
+		for _, voice := range p.voicesFor(lang) {
+			wg.Add(1)
+			go func(targetLang, text string, voice *VoicePreference) {
+				defer wg.Done()
+				p.synthesizeAndSend(ctx, transcriptMsg.ID, result.SpeakerID, sourceLang, targetLang, text, voice)
+			}(lang, trans.TranslatedText, voice)
+		}
+	}
+	wg.Wait()
+}
 
-				// Call Polly API with timeout
-				apiCtx, apiCancel := context.WithTimeout(ctx, APICallTimeout)
-				defer apiCancel()
+// inCrosstalk reports whether a final's utterance overlapped sustained crosstalk
+func (p *Pipeline) inCrosstalk(result *TranscriptResult) bool {
+	end := time.UnixMilli(int64(result.TimestampMs))
+	return p.crosstalk.Overlaps(end.Add(-CrosstalkTranscriptWindow), end)
+}
 
-				audio, err := p.polly.Synthesize(apiCtx, text, targetLang)
-				if err != nil {
-					log.Printf("[AWS Pipeline] ❌ TTS error for %s: %v", targetLang, err)
-					atomic.AddInt64(&p.totalErrors, 1)
-					return
-				}
+// sendCrosstalkEvent notifies the room that crosstalk started or ended (non-blocking)
+func (p *Pipeline) sendCrosstalkEvent(event *CrosstalkEvent) {
+	if atomic.LoadInt32(&p.closed) == 1 {
+		return
+	}
+	select {
+	case p.CrosstalkChan <- event:
+	default:
+		log.Printf("[AWS Pipeline] Crosstalk channel full, dropping event")
+	}
+}
 
-				if len(audio.AudioData) == 0 {
-					return
-				}
+// checkQuota returns ErrQuotaExceeded when the workspace's monthly quota for the resource is
+// used up, notifying listeners through QuotaChan the first time
+func (p *Pipeline) checkQuota(resource string) error {
+	event, notify := p.quota.Check(resource, p.usage.Pending())
+	if event == nil {
+		return nil
+	}
+	if notify && atomic.LoadInt32(&p.closed) == 0 {
+		log.Printf("[AWS Pipeline] ⛔ %s quota exceeded (%d/%d, %s)", resource, event.Used, event.Limit, event.Period)
+		select {
+		case p.QuotaChan <- event:
+		default:
+			log.Printf("[AWS Pipeline] Quota channel full, dropping event")
+		}
+	}
+	return ErrQuotaExceeded
+}
 
-				// Store in cache
-				p.cache.SetTTS(text, targetLang, audio.AudioData)
+// SetTTSInterruptPolicy changes what happens to older TTS when a newer final supersedes it
+func (p *Pipeline) SetTTSInterruptPolicy(policy string) {
+	parsed, ok := ParseTTSInterruptPolicy(policy)
+	if !ok {
+		log.Printf("[AWS Pipeline] Ignoring invalid TTS interrupt policy: %q", policy)
+		return
+	}
+	p.ttsInterruptMu.Lock()
+	p.ttsInterrupt = parsed
+	p.ttsInterruptMu.Unlock()
+	log.Printf("[AWS Pipeline] TTS interrupt policy: %s", parsed)
+}
 
-				audioData = audio.AudioData
-				format = audio.Format
-				sampleRate = audio.SampleRate
-			}
+// TTSInterruptPolicy returns the current TTS interrupt policy
+func (p *Pipeline) TTSInterruptPolicy() string {
+	p.ttsInterruptMu.RLock()
+	defer p.ttsInterruptMu.RUnlock()
+	return p.ttsInterrupt
+}
 
-			audioMsg := &ai.AudioMessage{
-				TranscriptID:         transcriptMsg.ID,
-				TargetLanguage:       targetLang,
-				AudioData:            audioData,
-				Format:               format,
-				SampleRate:           uint32(sampleRate),
-				SpeakerParticipantID: result.SpeakerID,
-			}
+// IsSuperseded reports whether a newer final of the same speaker replaced the transcript
+func (p *Pipeline) IsSuperseded(transcriptID string) bool {
+	return p.supersession.IsSuperseded(transcriptID)
+}
 
-			if !p.sendAudio(audioMsg) {
-				atomic.AddInt64(&p.droppedMessages, 1)
-			}
-		}(lang, trans.TranslatedText)
+// beginFinal registers a final transcript and signals the older final it supersedes
+func (p *Pipeline) beginFinal(speakerID, transcriptID string, timestampMs uint64) {
+	event := p.supersession.Begin(speakerID, transcriptID, timestampMs)
+	if event == nil || p.TTSInterruptPolicy() == TTSInterruptNone || atomic.LoadInt32(&p.closed) != 0 {
+		return
 	}
-	wg.Wait()
+
+	log.Printf("[AWS Pipeline] ⏭️ TTS of %s superseded by %s (speaker %s)", event.TranscriptID, event.SupersededBy, speakerID)
+	select {
+	case p.SupersededChan <- event:
+	default:
+		log.Printf("[AWS Pipeline] Superseded channel full, dropping event")
+	}
+}
+
+// dropSuperseded reports whether audio of the transcript should be dropped under the drop policy
+func (p *Pipeline) dropSuperseded(transcriptID string) bool {
+	if p.TTSInterruptPolicy() != TTSInterruptDrop || !p.supersession.IsSuperseded(transcriptID) {
+		return false
+	}
+	metrics.DroppedMessages.Inc(metrics.DropSuperseded)
+	return true
+}
+
+// UpdateQuota refreshes the quota limits and the workspace usage recorded so far
+func (p *Pipeline) UpdateQuota(limits QuotaLimits, recorded UsageSnapshot) {
+	p.quota.Update(limits, recorded)
 }
 
 // sendError sends an error to the error channel
@@ -1354,6 +2023,343 @@ func (p *Pipeline) sendError(err error) {
 	}
 }
 
+// UpdateVoicePreferences sets the distinct listener voices per target language.
+// TTS is generated once per (language, voice); a nil entry means the default voice.
+func (p *Pipeline) UpdateVoicePreferences(prefs map[string][]*VoicePreference) {
+	p.voicePrefsMu.Lock()
+	p.voicePrefs = prefs
+	p.voicePrefsMu.Unlock()
+}
+
+// voicesFor returns the voices to synthesize for a target language
+func (p *Pipeline) voicesFor(lang string) []*VoicePreference {
+	p.voicePrefsMu.RLock()
+	defer p.voicePrefsMu.RUnlock()
+
+	if voices := p.voicePrefs[lang]; len(voices) > 0 {
+		return voices
+	}
+	return []*VoicePreference{nil}
+}
+
+// SetVocabulary updates custom vocabulary/terminology for this room.
+// Terminology applies to the next translation; Transcribe vocabularies apply to newly started streams.
+func (p *Pipeline) SetVocabulary(v *Vocabulary) {
+	p.vocabularyMu.Lock()
+	p.vocabulary = v
+	p.vocabularyMu.Unlock()
+	p.cache.SetSharedScope(strings.Join(v.TerminologyNames(), ","))
+	log.Printf("[AWS Pipeline] Updated vocabulary: %+v", v)
+}
+
+// SetNoiseFilter replaces the custom noise rules and per-language confidence thresholds
+func (p *Pipeline) SetNoiseFilter(rules []NoiseRule, thresholds map[string]float32) {
+	p.noiseFilter.SetRules(rules)
+	p.noiseFilter.SetLanguageThresholds(thresholds)
+	log.Printf("[AWS Pipeline] Updated noise filter: %d rules, thresholds=%v", len(rules), thresholds)
+}
+
+// SetPIIRedaction enables or disables PII masking in transcripts
+func (p *Pipeline) SetPIIRedaction(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&p.redactPII, v)
+	log.Printf("[AWS Pipeline] PII redaction: %v", enabled)
+}
+
+// IsPIIRedactionEnabled returns whether PII is masked in transcripts
+func (p *Pipeline) IsPIIRedactionEnabled() bool {
+	return atomic.LoadInt32(&p.redactPII) == 1
+}
+
+// redactText masks PII when redaction is enabled
+func (p *Pipeline) redactText(text string) string {
+	if !p.IsPIIRedactionEnabled() {
+		return text
+	}
+	redacted, _ := RedactPII(text)
+	return redacted
+}
+
+// redactTranscript returns a copy of result with PII masked (result itself is shared with other goroutines)
+func (p *Pipeline) redactTranscript(result *TranscriptResult) *TranscriptResult {
+	if !p.IsPIIRedactionEnabled() {
+		return result
+	}
+	redacted, changed := RedactPII(result.Text)
+	if !changed {
+		return result
+	}
+	copied := *result
+	copied.Text = redacted
+	return &copied
+}
+
+// moderateTranscript runs the moderation stage on a final transcript.
+// Returns a copy of result with flagged terms masked (result itself is shared with other goroutines).
+func (p *Pipeline) moderateTranscript(ctx context.Context, result *TranscriptResult, sourceLang string) (*TranscriptResult, *ModerationResult) {
+	if p.moderator == nil {
+		return result, nil
+	}
+
+	moderation := p.moderator.Moderate(ctx, result.Text, sourceLang)
+	if moderation.Flagged {
+		log.Printf("[AWS Pipeline] 🚫 Moderation flagged transcript from %s (labels: %v)", result.SpeakerID, moderation.Labels)
+	}
+	if moderation.Text != result.Text {
+		masked := *result
+		masked.Text = moderation.Text
+		result = &masked
+	}
+	return result, moderation
+}
+
+// SetPartialMinLengths replaces the per-language minimum partial lengths
+func (p *Pipeline) SetPartialMinLengths(lengths map[string]int) {
+	p.noiseFilter.SetPartialMinLengths(lengths)
+	log.Printf("[AWS Pipeline] Updated partial min lengths: %v", lengths)
+}
+
+// SetMinTextLength replaces the minimum transcript length checked by the noise filter
+func (p *Pipeline) SetMinTextLength(n int) {
+	p.noiseFilter.SetMinTextLength(n)
+}
+
+// streamOptions returns Transcribe stream options for a source language
+func (p *Pipeline) streamOptions(sourceLang string) *StreamOptions {
+	opts := &StreamOptions{
+		TranscriptBuffer: p.buffers.StreamTranscripts,
+		AudioBuffer:      p.buffers.StreamAudioIn,
+	}
+
+	// Custom vocabularies are regional; languages routed elsewhere stream without one
+	if p.clientPool != nil && p.clientPool.TranscribeRegion(sourceLang) != p.clientPool.GetAWSConfig().Region {
+		return opts
+	}
+
+	p.vocabularyMu.RLock()
+	defer p.vocabularyMu.RUnlock()
+
+	opts.VocabularyName = p.vocabulary.VocabularyFor(sourceLang)
+	return opts
+}
+
+// CacheMetrics returns this room's translation/TTS cache metrics
+func (p *Pipeline) CacheMetrics() *CacheMetrics {
+	return p.cache.Metrics()
+}
+
+// CacheStats returns the size and eviction counters of this room's in-process cache
+func (p *Pipeline) CacheStats() CacheStats {
+	return p.cache.Stats()
+}
+
+// translateText calls Translate through the circuit breaker
+func (p *Pipeline) translateText(ctx context.Context, text, sourceLang, targetLang string) (*TranslationResult, error) {
+	var trans *TranslationResult
+	var callErr error
+	err := p.breakers.Translate.Execute(func() error {
+		start := time.Now()
+		trans, callErr = p.translate.TranslateWithTerminology(ctx, text, sourceLang, targetLang, p.terminologyNames())
+		metrics.TranslationLatency.Observe(time.Since(start).Seconds(), "realtime")
+		return breakerFailure(ctx, callErr)
+	})
+	if err == nil {
+		err = callErr
+	}
+	if err == nil {
+		p.usage.AddTranslateText(text)
+	}
+	return trans, err
+}
+
+// synthesize calls Polly through the circuit breaker
+func (p *Pipeline) synthesize(ctx context.Context, text, targetLang string, voice *VoicePreference, prosody *Prosody) (*AudioResult, error) {
+	if err := p.checkQuota(QuotaResourcePolly); err != nil {
+		return nil, err
+	}
+	var audio *AudioResult
+	var callErr error
+	err := p.breakers.Polly.Execute(func() error {
+		start := time.Now()
+		audio, callErr = p.polly.SynthesizeWithProsody(ctx, text, targetLang, voice, prosody)
+		metrics.TTSLatency.Observe(time.Since(start).Seconds())
+		return breakerFailure(ctx, callErr)
+	})
+	if err == nil {
+		err = callErr
+	}
+	if err == nil {
+		p.usage.AddPollyText(text)
+	}
+	return audio, err
+}
+
+// degradedTranslation is sent instead of a translation while Translate is unavailable:
+// listeners of targetLang get the original text only (and no TTS)
+func degradedTranslation(text, sourceLang, targetLang string) *TranslationResult {
+	return &TranslationResult{
+		SourceText:     text,
+		SourceLanguage: sourceLang,
+		TargetLanguage: targetLang,
+	}
+}
+
+// hasDegradedTranslation reports whether any target only got the original text
+func hasDegradedTranslation(translations map[string]*TranslationResult) bool {
+	for _, trans := range translations {
+		if trans != nil && trans.TranslatedText == "" && trans.TargetLanguage != trans.SourceLanguage {
+			return true
+		}
+	}
+	return false
+}
+
+// transcribeFor returns the Transcribe client for a source language (per-language region routing
+// is only available with a shared client pool)
+func (p *Pipeline) transcribeFor(sourceLang string) *TranscribeClient {
+	if p.clientPool != nil {
+		return p.clientPool.TranscribeFor(sourceLang)
+	}
+	return p.transcribe
+}
+
+// terminologyNames returns the Translate terminologies for this room
+func (p *Pipeline) terminologyNames() []string {
+	p.vocabularyMu.RLock()
+	defer p.vocabularyMu.RUnlock()
+	return p.vocabulary.TerminologyNames()
+}
+
+// configureArchive enables batch translation for archive-only languages
+func (p *Pipeline) configureArchive(pipelineCfg *PipelineConfig) {
+	if len(pipelineCfg.ArchiveLanguages) == 0 {
+		return
+	}
+
+	p.archive = NewArchiveBatcher(pipelineCfg.ArchiveLanguages)
+	p.archiveBatchInterval = pipelineCfg.ArchiveBatchInterval
+	if p.archiveBatchInterval <= 0 {
+		p.archiveBatchInterval = DefaultArchiveBatchInterval
+	}
+	log.Printf("[AWS Pipeline] Archive languages %v (batch every %v)", pipelineCfg.ArchiveLanguages, p.archiveBatchInterval)
+}
+
+// queueArchive queues a final for archive languages that are not translated live
+func (p *Pipeline) queueArchive(transcriptID string, result *TranscriptResult, sourceLang string, liveTargets []string) {
+	if p.archive == nil {
+		return
+	}
+
+	pending := p.archive.PendingLanguages(liveTargets, sourceLang)
+	if len(pending) == 0 {
+		return
+	}
+
+	entry := &ArchiveEntry{
+		TranscriptID: transcriptID,
+		SpeakerID:    result.SpeakerID,
+		SourceLang:   sourceLang,
+		Text:         result.Text,
+		TargetLangs:  pending,
+		CapturedAt:   time.Now(),
+	}
+	if meta := p.getSpeakerMeta(result.SpeakerID); meta != nil {
+		entry.SpeakerName = meta.Nickname
+	}
+	p.archive.Add(entry)
+}
+
+// archiveLoop periodically batch-translates accumulated finals
+func (p *Pipeline) archiveLoop() {
+	ticker := time.NewTicker(p.archiveBatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+			translations := p.FlushArchive(p.ctx)
+			if len(translations) == 0 {
+				continue
+			}
+			if atomic.LoadInt32(&p.closed) == 1 {
+				return
+			}
+			select {
+			case p.ArchiveChan <- translations:
+			case <-p.ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// FlushArchive translates all queued archive entries now and returns the results.
+// Called periodically and once more when the room shuts down so archives stay complete.
+func (p *Pipeline) FlushArchive(ctx context.Context) []*ArchiveTranslation {
+	entries := p.archive.Drain()
+	if len(entries) == 0 {
+		return nil
+	}
+
+	// Group by language pair so each pair is translated with batched calls
+	type langPair struct{ source, target string }
+	groups := make(map[langPair][]*ArchiveEntry)
+	for _, entry := range entries {
+		for _, target := range entry.TargetLangs {
+			pair := langPair{entry.SourceLang, target}
+			groups[pair] = append(groups[pair], entry)
+		}
+	}
+
+	translations := make([]*ArchiveTranslation, 0, len(entries))
+	for pair, group := range groups {
+		texts := make([]string, len(group))
+		for i, entry := range group {
+			texts[i] = entry.Text
+		}
+
+		apiCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		var results []string
+		var callErr error
+		err := p.breakers.Translate.Execute(func() error {
+			start := time.Now()
+			results, callErr = p.translate.TranslateBatch(apiCtx, texts, pair.source, pair.target, p.terminologyNames())
+			metrics.TranslationLatency.Observe(time.Since(start).Seconds(), "batch")
+			if callErr == nil {
+				for _, text := range texts {
+					p.usage.AddTranslateText(text)
+				}
+			}
+			return breakerFailure(apiCtx, callErr)
+		})
+		cancel()
+		if err == nil {
+			err = callErr
+		}
+		if err != nil {
+			log.Printf("[AWS Pipeline] ❌ Archive batch translation failed (%s→%s, %d finals): %v", pair.source, pair.target, len(group), err)
+			atomic.AddInt64(&p.totalErrors, 1)
+			continue
+		}
+
+		for i, entry := range group {
+			translations = append(translations, &ArchiveTranslation{
+				Entry:          entry,
+				TargetLang:     pair.target,
+				TranslatedText: results[i],
+			})
+		}
+	}
+
+	log.Printf("[AWS Pipeline] 📚 Archive batch translated %d finals into %d translations", len(entries), len(translations))
+	return translations
+}
+
 // UpdateTargetLanguages updates the list of target languages
 func (p *Pipeline) UpdateTargetLanguages(langs []string) {
 	p.targetLangsMu.Lock()
@@ -1364,6 +2370,11 @@ func (p *Pipeline) UpdateTargetLanguages(langs []string) {
 
 // RemoveSpeakerStream removes a speaker's transcription stream
 func (p *Pipeline) RemoveSpeakerStream(speakerID, sourceLang string) {
+	p.playback.Clear(speakerID)
+	p.supersession.Clear(speakerID)
+	p.noiseGate.Reset(speakerID)
+	p.crosstalk.Remove(speakerID)
+
 	// Use StreamManager if enabled
 	if p.useStreamManager && p.streamManager != nil {
 		p.streamManager.ReleaseSpeaker(speakerID, sourceLang)
@@ -1427,6 +2438,9 @@ func (p *Pipeline) Close() error {
 	close(p.TranscriptChan)
 	close(p.AudioChan)
 	close(p.ErrChan)
+	// ArchiveChan, ModeChan, CrosstalkChan, QuotaChan and SupersededChan are left open:
+	// their senders check closed and then send, which would race a close here.
+	// Readers stop on TranscriptChan/ctx instead.
 
 	log.Printf("[AWS Pipeline] Pipeline closed")
 	return nil
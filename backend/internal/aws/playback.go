@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"sync"
+	"time"
+)
+
+// Playback estimation constants
+const (
+	PollyMP3Bitrate          = 48000                   // Polly mp3 output bitrate at 24kHz (bits/sec)
+	LargeTTSClipThreshold    = 1500 * time.Millisecond // Clips shorter than this do not suppress partials
+	MaxPlaybackSuppression   = 15 * time.Second        // Upper bound for a single speaker's suppression window
+	PlaybackNetworkAllowance = 300 * time.Millisecond  // Extra time for delivery/decoding at listeners
+)
+
+// EstimatePlaybackDuration estimates how long an audio clip plays at listeners.
+// mp3/ogg is estimated from Polly's constant bitrate, pcm from sample rate (16-bit mono).
+func EstimatePlaybackDuration(audioData []byte, format string, sampleRate uint32) time.Duration {
+	if len(audioData) == 0 {
+		return 0
+	}
+
+	switch format {
+	case "pcm":
+		if sampleRate == 0 {
+			sampleRate = 16000
+		}
+		samples := len(audioData) / 2
+		return time.Duration(samples) * time.Second / time.Duration(sampleRate)
+	default:
+		bitrate := PollyMP3Bitrate
+		if sampleRate != 0 {
+			bitrate = EstimateAudioBitrate(format, int(sampleRate))
+		}
+		bits := int64(len(audioData)) * 8
+		return time.Duration(bits) * time.Second / time.Duration(bitrate)
+	}
+}
+
+// PlaybackTracker tracks the estimated end of TTS playback per speaker so that
+// new partial captions are not shown ahead of the audio listeners are still hearing.
+type PlaybackTracker struct {
+	playingUntil map[string]time.Time
+	mu           sync.Mutex
+}
+
+// NewPlaybackTracker creates a new playback tracker
+func NewPlaybackTracker() *PlaybackTracker {
+	return &PlaybackTracker{
+		playingUntil: make(map[string]time.Time),
+	}
+}
+
+// Record registers a TTS clip for a speaker. Clips are queued back to back at the
+// listener, so the window is extended from the current end rather than from now.
+// Returns the estimated clip duration.
+func (t *PlaybackTracker) Record(speakerID string, audioData []byte, format string, sampleRate uint32) time.Duration {
+	duration := EstimatePlaybackDuration(audioData, format, sampleRate)
+	if duration < LargeTTSClipThreshold {
+		return duration
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	start := now
+	if until, ok := t.playingUntil[speakerID]; ok && until.After(now) {
+		start = until
+	}
+
+	end := start.Add(duration + PlaybackNetworkAllowance)
+	if end.Sub(now) > MaxPlaybackSuppression {
+		end = now.Add(MaxPlaybackSuppression)
+	}
+	t.playingUntil[speakerID] = end
+
+	return duration
+}
+
+// IsPlaying reports whether a speaker's TTS is still estimated to be playing
+func (t *PlaybackTracker) IsPlaying(speakerID string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	until, ok := t.playingUntil[speakerID]
+	if !ok {
+		return false
+	}
+	if time.Now().After(until) {
+		delete(t.playingUntil, speakerID)
+		return false
+	}
+	return true
+}
+
+// Clear removes the playback window for a speaker
+func (t *PlaybackTracker) Clear(speakerID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.playingUntil, speakerID)
+}
@@ -4,12 +4,28 @@ import (
 	"context"
 	"io"
 	"log"
+	"strconv"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/polly"
 	"github.com/aws/aws-sdk-go-v2/service/polly/types"
+
+	"realtime-backend/internal/retry"
 )
 
+// pollyRetryPolicy retries throttled/transient SynthesizeSpeech errors while the audio is still useful
+var pollyRetryPolicy = retry.Policy{
+	MaxAttempts:    3,
+	InitialBackoff: 150 * time.Millisecond,
+	MaxBackoff:     time.Second,
+	Jitter:         0.1,
+	MaxElapsed:     3 * time.Second,
+	OnRetry: func(attempt int, err error, wait time.Duration) {
+		log.Printf("[Polly] ⚠️ Attempt %d failed, retrying in %v: %v", attempt, wait, err)
+	},
+}
+
 // PollyClient wraps Amazon Polly TTS
 type PollyClient struct {
 	client *polly.Client
@@ -25,24 +41,19 @@ type pollyVoiceConfig struct {
 // AudioResult contains synthesized audio
 type AudioResult struct {
 	AudioData  []byte
-	Format     string // "mp3"
-	SampleRate int32  // 24000
+	Format     string // "mp3" (default) | "ogg_vorbis" | "pcm"
+	SampleRate int32  // 24000 by default
 	Language   string
 }
 
-// 언어별 기본 Neural 음성 설정
-var defaultVoices = map[string]pollyVoiceConfig{
-	"ko": {VoiceID: types.VoiceIdSeoyeon, Engine: types.EngineNeural},
-	"en": {VoiceID: types.VoiceIdJoanna, Engine: types.EngineNeural},
-	"ja": {VoiceID: types.VoiceIdMizuki, Engine: types.EngineStandard}, // Mizuki는 Standard만 지원
-	"zh": {VoiceID: types.VoiceIdZhiyu, Engine: types.EngineNeural},
-}
-
 // NewPollyClient creates a new Polly TTS client
+// 언어별 기본 음성은 언어 레지스트리(languages.go)에서 가져옴
 func NewPollyClient(cfg aws.Config) *PollyClient {
 	voices := make(map[string]pollyVoiceConfig)
-	for k, v := range defaultVoices {
-		voices[k] = v
+	for _, lang := range SupportedLanguages() {
+		if lang.SupportsTTS() {
+			voices[lang.Code] = pollyVoiceConfig{VoiceID: lang.PollyVoice, Engine: lang.PollyEngine}
+		}
 	}
 
 	return &PollyClient{
@@ -51,8 +62,33 @@ func NewPollyClient(cfg aws.Config) *PollyClient {
 	}
 }
 
-// Synthesize generates speech from text
+// Synthesize generates speech from text with the language's default voice
 func (c *PollyClient) Synthesize(ctx context.Context, text, language string) (*AudioResult, error) {
+	return c.SynthesizeWithVoice(ctx, text, language, nil)
+}
+
+// SynthesizeWithVoice generates speech using a listener's voice preference.
+// If Polly rejects the preferred voice/engine, the language's default voice is used.
+func (c *PollyClient) SynthesizeWithVoice(ctx context.Context, text, language string, pref *VoicePreference) (*AudioResult, error) {
+	return c.SynthesizeWithProsody(ctx, text, language, pref, nil)
+}
+
+// SynthesizeWithProsody generates speech from plain text, wrapping it in SSML when
+// prosody (or the voice preference's speaking rate) changes rate, pitch or volume.
+func (c *PollyClient) SynthesizeWithProsody(ctx context.Context, text, language string, pref *VoicePreference, prosody *Prosody) (*AudioResult, error) {
+	if !pref.IsDefault() && pref.SpeakingRate != 0 {
+		prosody = prosody.WithRate(pref.SpeakingRate)
+	}
+	return c.synthesize(ctx, text, types.TextTypeText, language, pref, prosody)
+}
+
+// SynthesizeSSML generates speech from caller-provided SSML (must be wrapped in <speak>)
+func (c *PollyClient) SynthesizeSSML(ctx context.Context, ssml, language string, pref *VoicePreference) (*AudioResult, error) {
+	return c.synthesize(ctx, ssml, types.TextTypeSsml, language, pref, nil)
+}
+
+// synthesize calls Polly with the language's voice, applying the voice preference and prosody
+func (c *PollyClient) synthesize(ctx context.Context, text string, textType types.TextType, language string, pref *VoicePreference, prosody *Prosody) (*AudioResult, error) {
 	if text == "" {
 		return &AudioResult{
 			AudioData:  []byte{},
@@ -62,21 +98,52 @@ func (c *PollyClient) Synthesize(ctx context.Context, text, language string) (*A
 		}, nil
 	}
 
-	voiceCfg, ok := c.voices[language]
+	voiceCfg, ok := c.voices[NormalizeLanguage(language)]
 	if !ok {
-		voiceCfg = c.voices["en"] // 기본값: 영어
-		log.Printf("[Polly] Unknown language '%s', defaulting to English", language)
+		// 음성이 없는 언어는 자막만 제공 (다른 언어 음성으로 읽지 않음)
+		log.Printf("[Polly] No voice for language '%s', skipping TTS", language)
+		return &AudioResult{
+			AudioData:  []byte{},
+			Format:     "mp3",
+			SampleRate: 24000,
+			Language:   language,
+		}, nil
 	}
 
+	format, sampleRate := pref.OutputProfile().Output()
 	input := &polly.SynthesizeSpeechInput{
 		Text:         aws.String(text),
+		TextType:     textType,
 		VoiceId:      voiceCfg.VoiceID,
 		Engine:       voiceCfg.Engine,
-		OutputFormat: types.OutputFormatMp3,
-		SampleRate:   aws.String("24000"),
+		OutputFormat: types.OutputFormat(format),
+		SampleRate:   aws.String(strconv.Itoa(int(sampleRate))),
 	}
 
-	output, err := c.client.SynthesizeSpeech(ctx, input)
+	if !pref.IsDefault() {
+		if pref.VoiceID != "" {
+			input.VoiceId = types.VoiceId(pref.VoiceID)
+		}
+		if pref.Engine != "" {
+			input.Engine = types.Engine(pref.Engine)
+		}
+	}
+	if textType == types.TextTypeText && !prosody.IsDefault() {
+		input.Text = aws.String(BuildProsodySSML(text, prosody, input.Engine))
+		input.TextType = types.TextTypeSsml
+	}
+
+	output, err := c.synthesizeSpeech(ctx, input)
+	if err != nil && !pref.IsDefault() {
+		log.Printf("[Polly] Voice preference %s failed for language %s, using default voice: %v", pref.Key(), language, err)
+		fallback := *input
+		fallback.VoiceId = voiceCfg.VoiceID
+		fallback.Engine = voiceCfg.Engine
+		if textType == types.TextTypeText && !prosody.IsDefault() {
+			fallback.Text = aws.String(BuildProsodySSML(text, prosody, voiceCfg.Engine))
+		}
+		output, err = c.synthesizeSpeech(ctx, &fallback)
+	}
 	if err != nil {
 		log.Printf("[Polly] Error synthesizing speech for language %s: %v", language, err)
 		return nil, err
@@ -93,8 +160,15 @@ func (c *PollyClient) Synthesize(ctx context.Context, text, language string) (*A
 
 	return &AudioResult{
 		AudioData:  audioData,
-		Format:     "mp3",
-		SampleRate: 24000,
+		Format:     format,
+		SampleRate: sampleRate,
 		Language:   language,
 	}, nil
 }
+
+// synthesizeSpeech calls SynthesizeSpeech with pollyRetryPolicy
+func (c *PollyClient) synthesizeSpeech(ctx context.Context, input *polly.SynthesizeSpeechInput) (*polly.SynthesizeSpeechOutput, error) {
+	return retry.DoValue(ctx, pollyRetryPolicy, func(ctx context.Context) (*polly.SynthesizeSpeechOutput, error) {
+		return c.client.SynthesizeSpeech(ctx, input)
+	})
+}
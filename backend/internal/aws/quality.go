@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"sort"
+	"sync"
+	"unicode/utf8"
+)
+
+// PairQuality is the translation quality signal of one source→target language pair.
+// Sums are kept (not averages) so periods and rooms can be added together.
+type PairQuality struct {
+	SourceLang string `json:"sourceLang"`
+	TargetLang string `json:"targetLang"`
+
+	Finals          int64   `json:"finals"`          // Final transcripts routed to this pair
+	ConfidenceSum   float64 `json:"confidenceSum"`   // Sum of STT confidence of those finals
+	ConfidenceCount int64   `json:"confidenceCount"` // Finals that reported a confidence
+
+	SourceChars int64 `json:"sourceChars"` // Characters translated (for the length ratio)
+	TargetChars int64 `json:"targetChars"`
+
+	CacheHits         int64 `json:"cacheHits"`
+	CacheMisses       int64 `json:"cacheMisses"`
+	TranslateFailures int64 `json:"translateFailures"` // Errors and degraded (original-only) results
+
+	TTSRequests int64 `json:"ttsRequests"`
+	TTSFailures int64 `json:"ttsFailures"`
+}
+
+// IsZero reports whether nothing was recorded for the pair
+func (q PairQuality) IsZero() bool {
+	return q.Finals == 0 && q.CacheHits == 0 && q.CacheMisses == 0 && q.TranslateFailures == 0 && q.TTSRequests == 0
+}
+
+type languagePair struct {
+	source string
+	target string
+}
+
+// QualityMeter accumulates per-pair quality since the previous Drain. Same-language
+// (passthrough) pairs are ignored: there is no translation to judge.
+type QualityMeter struct {
+	mu    sync.Mutex
+	pairs map[languagePair]*PairQuality
+}
+
+// NewQualityMeter creates an empty meter
+func NewQualityMeter() *QualityMeter {
+	return &QualityMeter{pairs: make(map[languagePair]*PairQuality)}
+}
+
+// pair returns the pair's counters (m.mu held); nil for passthrough pairs
+func (m *QualityMeter) pair(sourceLang, targetLang string) *PairQuality {
+	if sourceLang == targetLang {
+		return nil
+	}
+	key := languagePair{source: sourceLang, target: targetLang}
+	q, ok := m.pairs[key]
+	if !ok {
+		q = &PairQuality{SourceLang: sourceLang, TargetLang: targetLang}
+		m.pairs[key] = q
+	}
+	return q
+}
+
+// RecordFinal records a final transcript routed to the pair with its STT confidence (0 = unknown)
+func (m *QualityMeter) RecordFinal(sourceLang, targetLang string, confidence float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if q := m.pair(sourceLang, targetLang); q != nil {
+		q.Finals++
+		if confidence > 0 {
+			q.ConfidenceSum += confidence
+			q.ConfidenceCount++
+		}
+	}
+}
+
+// RecordTranslation records a successful translation (from the cache or the API)
+func (m *QualityMeter) RecordTranslation(sourceLang, targetLang, sourceText, translatedText string, cacheHit bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if q := m.pair(sourceLang, targetLang); q != nil {
+		q.SourceChars += int64(utf8.RuneCountInString(sourceText))
+		q.TargetChars += int64(utf8.RuneCountInString(translatedText))
+		if cacheHit {
+			q.CacheHits++
+		} else {
+			q.CacheMisses++
+		}
+	}
+}
+
+// RecordTranslateFailure records a failed or degraded translation
+func (m *QualityMeter) RecordTranslateFailure(sourceLang, targetLang string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if q := m.pair(sourceLang, targetLang); q != nil {
+		q.TranslateFailures++
+	}
+}
+
+// RecordTTS records a TTS clip for the pair's translation and whether it was produced
+func (m *QualityMeter) RecordTTS(sourceLang, targetLang string, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if q := m.pair(sourceLang, targetLang); q != nil {
+		q.TTSRequests++
+		if !ok {
+			q.TTSFailures++
+		}
+	}
+}
+
+// Drain returns the pairs recorded since the previous Drain, sorted by pair
+func (m *QualityMeter) Drain() []PairQuality {
+	m.mu.Lock()
+	pairs := m.pairs
+	m.pairs = make(map[languagePair]*PairQuality)
+	m.mu.Unlock()
+
+	drained := make([]PairQuality, 0, len(pairs))
+	for _, q := range pairs {
+		if !q.IsZero() {
+			drained = append(drained, *q)
+		}
+	}
+	sort.Slice(drained, func(i, j int) bool {
+		if drained[i].SourceLang != drained[j].SourceLang {
+			return drained[i].SourceLang < drained[j].SourceLang
+		}
+		return drained[i].TargetLang < drained[j].TargetLang
+	})
+	return drained
+}
@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"sync/atomic"
+)
+
+// QueueWatermark tracks the highest depth a queue has reached since the last reset
+type QueueWatermark struct {
+	max int64
+}
+
+// Observe records a depth seen right after an enqueue
+func (w *QueueWatermark) Observe(depth int) {
+	d := int64(depth)
+	for {
+		cur := atomic.LoadInt64(&w.max)
+		if d <= cur || atomic.CompareAndSwapInt64(&w.max, cur, d) {
+			return
+		}
+	}
+}
+
+// Max returns the high watermark
+func (w *QueueWatermark) Max() int64 {
+	return atomic.LoadInt64(&w.max)
+}
+
+// Reset lowers the high watermark to the given current depth
+func (w *QueueWatermark) Reset(depth int) {
+	atomic.StoreInt64(&w.max, int64(depth))
+}
+
+// QueueDepth is a point-in-time view of one internal queue
+type QueueDepth struct {
+	Depth         int   `json:"depth"`
+	Capacity      int   `json:"capacity"`
+	HighWatermark int64 `json:"highWatermark"`
+}
+
+// Snapshot reads the queue depth; reset also restarts the high watermark from the current depth
+func (w *QueueWatermark) Snapshot(depth, capacity int, reset bool) QueueDepth {
+	q := QueueDepth{Depth: depth, Capacity: capacity, HighWatermark: w.Max()}
+	if q.HighWatermark < int64(depth) {
+		q.HighWatermark = int64(depth)
+	}
+	if reset {
+		w.Reset(depth)
+	}
+	return q
+}
+
+// PipelineQueues holds the depth of every queue inside a pipeline
+type PipelineQueues struct {
+	AudioIn     map[string]QueueDepth `json:"audioIn"` // Transcribe stream input, keyed by stream
+	Transcripts QueueDepth            `json:"transcripts"`
+	Audio       QueueDepth            `json:"audio"`
+	WorkerPools map[string]QueueDepth `json:"workerPools,omitempty"`
+}
+
+// pipelineWatermarks are the high watermarks of the pipeline output channels
+type pipelineWatermarks struct {
+	transcripts QueueWatermark
+	audio       QueueWatermark
+}
+
+// QueueDepth returns the stream's audio input queue depth
+func (ts *TranscribeStream) QueueDepth(reset bool) QueueDepth {
+	return ts.audioInHigh.Snapshot(len(ts.audioIn), cap(ts.audioIn), reset)
+}
+
+// QueueDepth returns the pool's task queue depth
+func (wp *WorkerPool) QueueDepth(reset bool) QueueDepth {
+	return wp.queueHigh.Snapshot(len(wp.taskQueue), cap(wp.taskQueue), reset)
+}
+
+// QueueDepths returns current depths and high watermarks of all pipeline queues.
+// reset restarts the high watermarks so the next call shows peaks since this one.
+func (p *Pipeline) QueueDepths(reset bool) PipelineQueues {
+	queues := PipelineQueues{
+		AudioIn:     make(map[string]QueueDepth),
+		Transcripts: p.queues.transcripts.Snapshot(len(p.TranscriptChan), cap(p.TranscriptChan), reset),
+		Audio:       p.queues.audio.Snapshot(len(p.AudioChan), cap(p.AudioChan), reset),
+	}
+
+	p.streamsMu.RLock()
+	for key, stream := range p.speakerStreams {
+		queues.AudioIn[key] = stream.QueueDepth(reset)
+	}
+	p.streamsMu.RUnlock()
+
+	if p.streamManager != nil {
+		for key, stream := range p.streamManager.Streams() {
+			queues.AudioIn[key] = stream.QueueDepth(reset)
+		}
+	}
+
+	if p.translatePool != nil || p.ttsPool != nil {
+		queues.WorkerPools = make(map[string]QueueDepth, 2)
+		for _, pool := range []*WorkerPool{p.translatePool, p.ttsPool} {
+			if pool != nil {
+				queues.WorkerPools[pool.name] = pool.QueueDepth(reset)
+			}
+		}
+	}
+	return queues
+}
+
+// PendingTTS returns the TTS clips still being synthesized or waiting in AudioChan
+func (p *Pipeline) PendingTTS() int {
+	return int(atomic.LoadInt64(&p.ttsInFlight)) + len(p.AudioChan)
+}
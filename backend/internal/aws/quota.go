@@ -0,0 +1,120 @@
+package aws
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// Quota resources
+const (
+	QuotaResourceTranscribe = "transcribe"
+	QuotaResourcePolly      = "polly"
+)
+
+// ErrQuotaExceeded is returned when a workspace has used up its monthly quota
+var ErrQuotaExceeded = errors.New("usage quota exceeded")
+
+// QuotaLimits are the monthly limits of a workspace (0 = unlimited)
+type QuotaLimits struct {
+	TranscribeMinutes int64 `json:"transcribeMinutes"`
+	PollyChars        int64 `json:"pollyChars"`
+}
+
+// IsUnlimited reports whether no limit is set
+func (l QuotaLimits) IsUnlimited() bool {
+	return l.TranscribeMinutes <= 0 && l.PollyChars <= 0
+}
+
+// QuotaExceededEvent is sent to listeners when the pipeline stops a service because of the quota
+type QuotaExceededEvent struct {
+	Resource string    `json:"resource"` // transcribe | polly
+	Limit    int64     `json:"limit"`    // Minutes for transcribe, characters for polly
+	Used     int64     `json:"used"`
+	Period   string    `json:"period"` // e.g. "2026-10"
+	At       time.Time `json:"at"`
+}
+
+// UsageQuota checks a pipeline's usage against its workspace's monthly limits.
+// The workspace usage recorded so far (all rooms) is refreshed periodically by the caller;
+// the pipeline adds its own not-yet-recorded usage on top.
+type UsageQuota struct {
+	limits   QuotaLimits
+	recorded UsageSnapshot // Month-to-date usage already persisted for the workspace
+	period   string
+	notified map[string]bool // Resources an exceeded event was already sent for
+	mu       sync.Mutex
+}
+
+// NewUsageQuota creates a quota for the current month
+func NewUsageQuota(limits QuotaLimits, recorded UsageSnapshot) *UsageQuota {
+	return &UsageQuota{
+		limits:   limits,
+		recorded: recorded,
+		period:   QuotaPeriod(time.Now()),
+		notified: make(map[string]bool),
+	}
+}
+
+// QuotaPeriod returns the monthly quota period of t ("2006-01")
+func QuotaPeriod(t time.Time) string {
+	return t.Format("2006-01")
+}
+
+// QuotaPeriodStart returns the start of the monthly period containing t
+func QuotaPeriodStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// Update replaces the limits and the recorded workspace usage (resets notifications on a new month)
+func (q *UsageQuota) Update(limits QuotaLimits, recorded UsageSnapshot) {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if period := QuotaPeriod(time.Now()); period != q.period {
+		q.period = period
+		q.notified = make(map[string]bool)
+	}
+	if limits != q.limits {
+		q.notified = make(map[string]bool)
+	}
+	q.limits = limits
+	q.recorded = recorded
+}
+
+// Check returns an event if the resource's limit is reached with the pending (unrecorded) usage
+// added. notify is true the first time the limit is hit so the caller can tell listeners once.
+func (q *UsageQuota) Check(resource string, pending UsageSnapshot) (event *QuotaExceededEvent, notify bool) {
+	if q == nil {
+		return nil, false
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var limit, used int64
+	switch resource {
+	case QuotaResourceTranscribe:
+		limit = q.limits.TranscribeMinutes
+		used = int64((q.recorded.TranscribeSeconds + pending.TranscribeSeconds) / 60)
+	case QuotaResourcePolly:
+		limit = q.limits.PollyChars
+		used = q.recorded.PollyChars + pending.PollyChars
+	}
+	if limit <= 0 || used < limit {
+		return nil, false
+	}
+
+	event = &QuotaExceededEvent{
+		Resource: resource,
+		Limit:    limit,
+		Used:     used,
+		Period:   q.period,
+		At:       time.Now(),
+	}
+	notify = !q.notified[resource]
+	q.notified[resource] = true
+	return event, notify
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"log"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultSlowModePartialInterval is the minimum gap between partial emissions per speaker in slow mode
+const DefaultSlowModePartialInterval = 1500 * time.Millisecond
+
+// slowMode coalesces partial updates for audiences on constrained (mobile) clients.
+// While enabled, each speaker emits at most one partial per interval and incremental
+// deltas are captioned without TTS, so every utterance is voiced by a single final clip.
+type slowMode struct {
+	enabled  int32 // atomic flag
+	interval int64 // atomic, nanoseconds
+}
+
+func (s *slowMode) set(enabled bool, interval time.Duration) time.Duration {
+	if interval <= 0 {
+		interval = DefaultSlowModePartialInterval
+	}
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt64(&s.interval, int64(interval))
+	atomic.StoreInt32(&s.enabled, v)
+	return interval
+}
+
+func (s *slowMode) on() bool {
+	return atomic.LoadInt32(&s.enabled) == 1
+}
+
+// throttled reports whether a partial arriving now should be coalesced into a later one
+func (s *slowMode) throttled(lastEmit time.Time) bool {
+	if !s.on() || lastEmit.IsZero() {
+		return false
+	}
+	return time.Since(lastEmit) < time.Duration(atomic.LoadInt64(&s.interval))
+}
+
+// SetSlowMode enables or disables slow mode (interval <= 0 uses DefaultSlowModePartialInterval)
+func (p *Pipeline) SetSlowMode(enabled bool, interval time.Duration) {
+	interval = p.slowMode.set(enabled, interval)
+	log.Printf("[AWS Pipeline] 🐢 Slow mode: %v (partial interval: %v)", enabled, interval)
+}
+
+// SlowModeEnabled reports whether partials are coalesced and partial TTS is held back
+func (p *Pipeline) SlowModeEnabled() bool {
+	return p.slowMode.on()
+}
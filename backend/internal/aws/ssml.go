@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/polly/types"
+)
+
+// Prosody limits (Polly SSML)
+const (
+	MinPitchPercent = -50
+	MaxPitchPercent = 50
+	MinVolumeDB     = -6
+	MaxVolumeDB     = 6
+
+	ElevatedSpeakingRate     = 115 // Audio queue over half full
+	BackpressureSpeakingRate = 130 // Backpressure active: shorten clips so listeners catch up
+	ElevatedAudioQueueRatio  = 0.5
+)
+
+// Prosody adjusts how Polly speaks a sentence (applied via SSML <prosody>)
+type Prosody struct {
+	Rate   int `json:"rate,omitempty"`   // Percent of normal speed (0/100 = normal)
+	Pitch  int `json:"pitch,omitempty"`  // Percent change (standard engine only)
+	Volume int `json:"volume,omitempty"` // dB change
+}
+
+// IsDefault reports whether the prosody leaves speech unchanged
+func (p *Prosody) IsDefault() bool {
+	return p == nil || ((p.Rate == 0 || p.Rate == DefaultSpeakingRate) && p.Pitch == 0 && p.Volume == 0)
+}
+
+// Key identifies the prosody for caching ("" when default)
+func (p *Prosody) Key() string {
+	if p.IsDefault() {
+		return ""
+	}
+	return fmt.Sprintf("r%d|p%d|v%d", p.rate(), p.Pitch, p.Volume)
+}
+
+// rate returns the effective rate percent
+func (p *Prosody) rate() int {
+	if p == nil || p.Rate == 0 {
+		return DefaultSpeakingRate
+	}
+	return p.Rate
+}
+
+// WithRate returns a copy whose rate is scaled by ratePercent (e.g. 130 = 30% faster),
+// clamped to the speaking rate limits
+func (p *Prosody) WithRate(ratePercent int) *Prosody {
+	out := Prosody{}
+	if p != nil {
+		out = *p
+	}
+	if ratePercent == 0 || ratePercent == DefaultSpeakingRate {
+		return &out
+	}
+	out.Rate = clampInt(out.rate()*ratePercent/DefaultSpeakingRate, MinSpeakingRate, MaxSpeakingRate)
+	return &out
+}
+
+// Normalize clamps rate, pitch and volume to the ranges Polly accepts
+func (p *Prosody) Normalize() *Prosody {
+	if p == nil {
+		return nil
+	}
+	out := *p
+	if out.Rate != 0 {
+		out.Rate = clampInt(out.Rate, MinSpeakingRate, MaxSpeakingRate)
+	}
+	out.Pitch = clampInt(out.Pitch, MinPitchPercent, MaxPitchPercent)
+	out.Volume = clampInt(out.Volume, MinVolumeDB, MaxVolumeDB)
+	return &out
+}
+
+// BuildProsodySSML wraps plain text in SSML with the given prosody.
+// Pitch is dropped for engines other than standard, which reject it.
+func BuildProsodySSML(text string, p *Prosody, engine types.Engine) string {
+	var escaped strings.Builder
+	xml.EscapeText(&escaped, []byte(text))
+	if p.IsDefault() {
+		return "<speak>" + escaped.String() + "</speak>"
+	}
+
+	attrs := []string{`rate="` + strconv.Itoa(p.rate()) + `%"`}
+	if p.Pitch != 0 && engine == types.EngineStandard {
+		attrs = append(attrs, fmt.Sprintf(`pitch="%+d%%"`, p.Pitch))
+	}
+	if p.Volume != 0 {
+		attrs = append(attrs, fmt.Sprintf(`volume="%+ddB"`, p.Volume))
+	}
+	return "<speak><prosody " + strings.Join(attrs, " ") + ">" + escaped.String() + "</prosody></speak>"
+}
+
+func clampInt(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
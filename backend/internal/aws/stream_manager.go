@@ -87,6 +87,12 @@ func (sm *StreamManager) SetOnStreamDead(callback func(sourceLang string)) {
 // Each speaker now gets their own stream to preserve speaker identity.
 // This fixes the "lang-ko" speaker ID issue and enables proper bidirectional translation.
 func (sm *StreamManager) GetOrCreateStream(speakerID, sourceLang string) (*TranscribeStream, error) {
+	return sm.GetOrCreateStreamWithOptions(speakerID, sourceLang, nil)
+}
+
+// GetOrCreateStreamWithOptions is GetOrCreateStream with stream options (e.g. custom vocabulary)
+// applied when a new stream has to be created.
+func (sm *StreamManager) GetOrCreateStreamWithOptions(speakerID, sourceLang string, opts *StreamOptions) (*TranscribeStream, error) {
 	// Use speakerID as the stream key (not sourceLang) to preserve speaker identity
 	streamKey := speakerID
 
@@ -126,7 +132,16 @@ func (sm *StreamManager) GetOrCreateStream(speakerID, sourceLang string) (*Trans
 
 	// Create new stream using shared TranscribeClient
 	// FIX: Use actual speakerID instead of "lang-"+sourceLang
-	stream, err := sm.clientPool.Transcribe.StartStream(sm.ctx, speakerID, sourceLang)
+	// Languages routed to another region use that region's Transcribe client
+	var stream *TranscribeStream
+	var startErr error
+	err := sm.clientPool.Breakers.Transcribe.Execute(func() error {
+		stream, startErr = sm.clientPool.TranscribeFor(sourceLang).StartStreamWithOptions(sm.ctx, speakerID, sourceLang, opts)
+		return breakerFailure(sm.ctx, startErr)
+	})
+	if err == nil {
+		err = startErr
+	}
 	if err != nil {
 		log.Printf("[StreamManager] Failed to create stream for speaker=%s (lang=%s): %v", speakerID, sourceLang, err)
 		return nil, err
@@ -235,6 +250,32 @@ func (sm *StreamManager) GetStreamForLang(sourceLang string) *TranscribeStream {
 	return nil
 }
 
+// SpeakerLanguages returns the source language of each speaker's stream
+func (sm *StreamManager) SpeakerLanguages() map[string]string {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	langs := make(map[string]string, len(sm.streams))
+	for speakerID, ref := range sm.streams {
+		langs[speakerID] = ref.SourceLang
+	}
+	return langs
+}
+
+// Streams returns the open Transcribe streams keyed like SpeakerLanguages
+func (sm *StreamManager) Streams() map[string]*TranscribeStream {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	streams := make(map[string]*TranscribeStream, len(sm.streams))
+	for key, ref := range sm.streams {
+		if ref.Stream != nil {
+			streams[key] = ref.Stream
+		}
+	}
+	return streams
+}
+
 // GetActiveStreams returns count of active streams
 func (sm *StreamManager) GetActiveStreams() int {
 	sm.mu.RLock()
@@ -242,6 +283,23 @@ func (sm *StreamManager) GetActiveStreams() int {
 	return len(sm.streams)
 }
 
+// StreamHealths returns the health of each managed stream, keyed by source language
+func (sm *StreamManager) StreamHealths() map[string]*StreamHealth {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	healths := make(map[string]*StreamHealth, len(sm.streams))
+	for lang, ref := range sm.streams {
+		if ref.Stream == nil {
+			continue
+		}
+		if health := ref.Stream.GetHealth(); health != nil {
+			healths[lang] = health
+		}
+	}
+	return healths
+}
+
 // GetStats returns statistics about managed streams
 func (sm *StreamManager) GetStats() map[string]interface{} {
 	sm.mu.RLock()
@@ -348,15 +406,16 @@ func (sm *StreamManager) Close() error {
 
 // WorkerPool manages a fixed pool of workers for processing tasks
 type WorkerPool struct {
-	name       string
-	workers    int
-	taskQueue  chan func()
-	wg         sync.WaitGroup
-	ctx        context.Context
-	cancel     context.CancelFunc
-	closed     int32
-	processed  int64
-	dropped    int64
+	name      string
+	workers   int
+	taskQueue chan func()
+	wg        sync.WaitGroup
+	ctx       context.Context
+	cancel    context.CancelFunc
+	closed    int32
+	processed int64
+	dropped   int64
+	queueHigh QueueWatermark
 }
 
 // NewWorkerPool creates a new worker pool with the specified number of workers
@@ -416,6 +475,7 @@ func (wp *WorkerPool) Submit(task func()) bool {
 
 	select {
 	case wp.taskQueue <- task:
+		wp.queueHigh.Observe(len(wp.taskQueue))
 		return true
 	default:
 		atomic.AddInt64(&wp.dropped, 1)
@@ -431,6 +491,7 @@ func (wp *WorkerPool) SubmitWait(task func(), timeout time.Duration) bool {
 
 	select {
 	case wp.taskQueue <- task:
+		wp.queueHigh.Observe(len(wp.taskQueue))
 		return true
 	case <-time.After(timeout):
 		atomic.AddInt64(&wp.dropped, 1)
@@ -453,6 +514,12 @@ func (wp *WorkerPool) Stats() map[string]interface{} {
 	}
 }
 
+// Stale reports whether the pool's context was cancelled without the pool being closed
+// (workers have exited but the queue still accepts tasks)
+func (wp *WorkerPool) Stale() bool {
+	return atomic.LoadInt32(&wp.closed) == 0 && wp.ctx.Err() != nil
+}
+
 // Close shuts down the worker pool
 func (wp *WorkerPool) Close() {
 	if !atomic.CompareAndSwapInt32(&wp.closed, 0, 1) {
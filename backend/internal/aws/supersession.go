@@ -0,0 +1,166 @@
+package aws
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// TTS interrupt policies: what happens to an older final's audio when the same speaker
+// produces a newer final while listeners are still receiving the older one's TTS
+const (
+	TTSInterruptNone   = "none"   // Keep playing everything, no signaling
+	TTSInterruptSignal = "signal" // Send a cancel event; clients decide whether to stop playback
+	TTSInterruptDrop   = "drop"   // Send a cancel event and drop not-yet-sent audio in the pipeline
+)
+
+// Supersession tracking constants
+const (
+	SupersessionRetention = 2 * time.Minute // Finals older than this are forgotten
+)
+
+// ParseTTSInterruptPolicy validates a TTS interrupt policy (empty = signal)
+func ParseTTSInterruptPolicy(policy string) (string, bool) {
+	switch policy = strings.ToLower(strings.TrimSpace(policy)); policy {
+	case "":
+		return TTSInterruptSignal, true
+	case TTSInterruptNone, TTSInterruptSignal, TTSInterruptDrop:
+		return policy, true
+	}
+	return "", false
+}
+
+// TTSSupersededEvent tells listeners that audio of an older transcript is outdated
+type TTSSupersededEvent struct {
+	SpeakerID    string    `json:"speakerId"`
+	TranscriptID string    `json:"transcriptId"` // Transcript whose audio should be cancelled
+	SupersededBy string    `json:"supersededBy"` // Newer final of the same speaker
+	At           time.Time `json:"at"`
+}
+
+// ttsTurn is the TTS state of one final transcript
+type ttsTurn struct {
+	speakerID    string
+	timestampMs  uint64
+	createdAt    time.Time
+	inFlight     int       // Clips being synthesized or sent
+	playingUntil time.Time // Estimated end of playback of the clips already sent
+	supersededBy string
+}
+
+// SupersessionTracker tracks the latest final per speaker and which older finals still
+// have audio in flight or playing, so a newer final can supersede them.
+type SupersessionTracker struct {
+	turns  map[string]*ttsTurn // transcriptID -> turn
+	latest map[string]string   // speakerID -> latest transcriptID
+	mu     sync.Mutex
+}
+
+// NewSupersessionTracker creates a new supersession tracker
+func NewSupersessionTracker() *SupersessionTracker {
+	return &SupersessionTracker{
+		turns:  make(map[string]*ttsTurn),
+		latest: make(map[string]string),
+	}
+}
+
+// Begin registers a new final transcript of a speaker. It returns the event for the final
+// that is superseded (nil if none): the speaker's previous final while its audio is still
+// in flight or playing, or the new final itself if it arrives after a newer one (out of order).
+func (t *SupersessionTracker) Begin(speakerID, transcriptID string, timestampMs uint64) *TTSSupersededEvent {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	t.prune(now)
+
+	turn := &ttsTurn{speakerID: speakerID, timestampMs: timestampMs, createdAt: now}
+	t.turns[transcriptID] = turn
+
+	prevID, ok := t.latest[speakerID]
+	prev := t.turns[prevID]
+	if !ok || prev == nil {
+		t.latest[speakerID] = transcriptID
+		return nil
+	}
+
+	// Finals are processed concurrently; an older utterance finishing late never supersedes a newer one
+	if timestampMs < prev.timestampMs {
+		turn.supersededBy = prevID
+		return &TTSSupersededEvent{SpeakerID: speakerID, TranscriptID: transcriptID, SupersededBy: prevID, At: now}
+	}
+
+	t.latest[speakerID] = transcriptID
+	if prev.supersededBy != "" || (prev.inFlight == 0 && !now.Before(prev.playingUntil)) {
+		return nil
+	}
+	prev.supersededBy = transcriptID
+	return &TTSSupersededEvent{SpeakerID: speakerID, TranscriptID: prevID, SupersededBy: transcriptID, At: now}
+}
+
+// StartClip marks a TTS clip of a transcript as in flight
+func (t *SupersessionTracker) StartClip(transcriptID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if turn, ok := t.turns[transcriptID]; ok {
+		turn.inFlight++
+	}
+}
+
+// FinishClip marks a clip as done; duration is its estimated playback (0 if not sent).
+// Clips play back to back at the listener, so playback extends from the current end.
+func (t *SupersessionTracker) FinishClip(transcriptID string, duration time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	turn, ok := t.turns[transcriptID]
+	if !ok {
+		return
+	}
+	if turn.inFlight > 0 {
+		turn.inFlight--
+	}
+	if duration > 0 {
+		start := time.Now()
+		if turn.playingUntil.After(start) {
+			start = turn.playingUntil
+		}
+		turn.playingUntil = start.Add(duration + PlaybackNetworkAllowance)
+	}
+}
+
+// IsSuperseded reports whether a newer final replaced the transcript
+func (t *SupersessionTracker) IsSuperseded(transcriptID string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	turn, ok := t.turns[transcriptID]
+	return ok && turn.supersededBy != ""
+}
+
+// Clear forgets a speaker's finals (speaker left)
+func (t *SupersessionTracker) Clear(speakerID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.latest, speakerID)
+	for id, turn := range t.turns {
+		if turn.speakerID == speakerID {
+			delete(t.turns, id)
+		}
+	}
+}
+
+// prune drops finals past the retention window (caller holds the lock)
+func (t *SupersessionTracker) prune(now time.Time) {
+	for id, turn := range t.turns {
+		if now.Sub(turn.createdAt) < SupersessionRetention || turn.inFlight > 0 {
+			continue
+		}
+		delete(t.turns, id)
+		if t.latest[turn.speakerID] == id {
+			delete(t.latest, turn.speakerID)
+		}
+	}
+}
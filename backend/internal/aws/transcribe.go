@@ -3,7 +3,6 @@ package aws
 import (
 	"context"
 	"log"
-	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -11,6 +10,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/transcribestreaming"
 	"github.com/aws/aws-sdk-go-v2/service/transcribestreaming/types"
+
+	"realtime-backend/internal/retry"
 )
 
 // Stream configuration constants
@@ -24,6 +25,13 @@ const (
 	HealthCheckInterval  = 30 * time.Second
 )
 
+// reconnectPolicy is the stream reconnection backoff (10-20% jitter; attempts are capped by shouldReconnect)
+var reconnectPolicy = retry.Policy{
+	InitialBackoff: InitialBackoff,
+	MaxBackoff:     MaxBackoff,
+	Jitter:         0.1,
+}
+
 // TranscribeClient wraps Amazon Transcribe Streaming with resilience features
 type TranscribeClient struct {
 	client     *transcribestreaming.Client
@@ -42,9 +50,10 @@ const (
 
 // TranscribeStream represents an active transcription stream for a speaker
 type TranscribeStream struct {
-	speakerID  string
-	sourceLang string
-	client     *TranscribeClient
+	speakerID      string
+	sourceLang     string
+	vocabularyName string // Custom vocabulary (kept across reconnects)
+	client         *TranscribeClient
 
 	eventStream *transcribestreaming.StartStreamTranscriptionEventStream
 	ctx         context.Context
@@ -59,12 +68,17 @@ type TranscribeStream struct {
 	// Audio input channel (buffered for resilience)
 	audioIn       chan []byte
 	audioInClosed int32 // atomic flag to prevent sends after close
+	audioInHigh   QueueWatermark
 	audioPending  [][]byte // Pending audio during reconnection
 	pendingMu     sync.Mutex
 
-	// Keep-alive
-	lastAudioTime time.Time
-	keepAliveMu   sync.Mutex
+	// Keep-alive (see keepalive.go)
+	lastAudioTime      time.Time // Last speaker audio
+	lastSendTime       time.Time // Last audio or silence queued; Transcribe's no-audio timeout counts from here
+	keepAliveMu        sync.Mutex
+	keepAliveSuspended int32         // atomic flag, set while the pipeline is hibernated
+	keepAliveWake      chan struct{} // Wakes a suspended keep-alive loop (resume or close)
+	keepAlivesSent     int64         // atomic
 
 	// Stream lifecycle
 	streamStartTime time.Time
@@ -96,27 +110,31 @@ type TranscriptResult struct {
 	IsFinal     bool
 	Confidence  float32
 	TimestampMs uint64
+	Sequence    uint64 // Final results only: assigned in arrival order before async processing
 }
 
 // StreamHealth contains health information for a stream
 type StreamHealth struct {
-	SpeakerID       string        `json:"speakerId"`
-	SourceLang      string        `json:"sourceLang"`
-	Status          StreamStatus  `json:"status"`
-	Uptime          time.Duration `json:"uptime"`
-	LastActivity    time.Time     `json:"lastActivity"`
-	ErrorCount      int32         `json:"errorCount"`
-	SuccessCount    int64         `json:"successCount"`
-	ReconnectCount  int32         `json:"reconnectCount"`
-	IsReconnecting  bool          `json:"isReconnecting"`
+	SpeakerID      string        `json:"speakerId"`
+	SourceLang     string        `json:"sourceLang"`
+	Status         StreamStatus  `json:"status"`
+	Uptime         time.Duration `json:"uptime"`
+	LastActivity   time.Time     `json:"lastActivity"`
+	ErrorCount     int32         `json:"errorCount"`
+	SuccessCount   int64         `json:"successCount"`
+	ReconnectCount int32         `json:"reconnectCount"`
+	IsReconnecting bool          `json:"isReconnecting"`
+	Hibernated     bool          `json:"hibernated"` // Keep-alive suspended
+	KeepAlivesSent int64         `json:"keepAlivesSent"`
 }
 
-// Transcribe language code mapping
-var transcribeLanguageCodes = map[string]types.LanguageCode{
-	"ko": types.LanguageCodeKoKr,
-	"en": types.LanguageCodeEnUs,
-	"ja": types.LanguageCodeJaJp,
-	"zh": types.LanguageCodeZhCn,
+// transcribeLanguageCode returns the Transcribe Streaming locale for a language (see languages.go)
+func transcribeLanguageCode(lang string) (types.LanguageCode, bool) {
+	info, ok := LookupLanguage(lang)
+	if !ok || !info.SupportsTranscribe() {
+		return "", false
+	}
+	return info.TranscribeCode, true
 }
 
 // NewTranscribeClient creates a new Transcribe Streaming client with resilience
@@ -128,26 +146,47 @@ func NewTranscribeClient(cfg aws.Config, sampleRate int32) *TranscribeClient {
 	}
 }
 
+// newStartStreamInput builds the StartStreamTranscription request used for start and reconnect
+func (c *TranscribeClient) newStartStreamInput(langCode types.LanguageCode, vocabularyName string) *transcribestreaming.StartStreamTranscriptionInput {
+	input := &transcribestreaming.StartStreamTranscriptionInput{
+		LanguageCode:                      langCode,
+		MediaEncoding:                     types.MediaEncodingPcm,
+		MediaSampleRateHertz:              aws.Int32(c.sampleRate),
+		EnablePartialResultsStabilization: true,                                // Enable partial stabilization to reduce choppy updates
+		PartialResultsStability:           types.PartialResultsStabilityMedium, // Medium stability: balance between real-time and accuracy
+	}
+	if vocabularyName != "" {
+		input.VocabularyName = aws.String(vocabularyName)
+	}
+	return input
+}
+
 // StartStream initiates a new transcription stream for a speaker
 func (c *TranscribeClient) StartStream(ctx context.Context, speakerID, sourceLang string) (*TranscribeStream, error) {
-	langCode, ok := transcribeLanguageCodes[sourceLang]
+	return c.StartStreamWithOptions(ctx, speakerID, sourceLang, nil)
+}
+
+// StartStreamWithOptions initiates a new transcription stream with optional custom vocabulary
+func (c *TranscribeClient) StartStreamWithOptions(ctx context.Context, speakerID, sourceLang string, opts *StreamOptions) (*TranscribeStream, error) {
+	langCode, ok := transcribeLanguageCode(sourceLang)
 	if !ok {
 		langCode = types.LanguageCodeEnUs
-		log.Printf("[Transcribe] Unknown language '%s', defaulting to en-US", sourceLang)
+		log.Printf("[Transcribe] Unsupported language '%s', defaulting to en-US", sourceLang)
 	}
 
-	log.Printf("[Transcribe] Starting stream for speaker %s (lang=%s)", speakerID, sourceLang)
+	vocabularyName := ""
+	buffers := (*BufferSizes)(nil).withDefaults()
+	if opts != nil {
+		vocabularyName = opts.VocabularyName
+		buffers = (&BufferSizes{StreamTranscripts: opts.TranscriptBuffer, StreamAudioIn: opts.AudioBuffer}).withDefaults()
+	}
+
+	log.Printf("[Transcribe] Starting stream for speaker %s (lang=%s, vocabulary=%q)", speakerID, sourceLang, vocabularyName)
 
 	streamCtx, cancel := context.WithCancel(ctx)
 
 	// Start the transcription stream directly (no circuit breaker - AWS SDK handles retries)
-	resp, err := c.client.StartStreamTranscription(streamCtx, &transcribestreaming.StartStreamTranscriptionInput{
-		LanguageCode:                      langCode,
-		MediaEncoding:                     types.MediaEncodingPcm,
-		MediaSampleRateHertz:              aws.Int32(c.sampleRate),
-		EnablePartialResultsStabilization: true,                                 // Enable partial stabilization to reduce choppy updates
-		PartialResultsStability:           types.PartialResultsStabilityMedium, // Medium stability: balance between real-time and accuracy
-	})
+	resp, err := c.client.StartStreamTranscription(streamCtx, c.newStartStreamInput(langCode, vocabularyName))
 	if err != nil {
 		log.Printf("[Transcribe] ERROR StartStreamTranscription failed: %v", err)
 		cancel()
@@ -157,15 +196,18 @@ func (c *TranscribeClient) StartStream(ctx context.Context, speakerID, sourceLan
 	ts := &TranscribeStream{
 		speakerID:       speakerID,
 		sourceLang:      sourceLang,
+		vocabularyName:  vocabularyName,
 		client:          c,
 		eventStream:     resp.GetStream(),
 		ctx:             streamCtx,
 		cancel:          cancel,
 		parentCtx:       ctx,
-		TranscriptChan:  make(chan *TranscriptResult, 100), // Increased buffer
-		audioIn:         make(chan []byte, 200),           // Increased buffer
+		TranscriptChan:  make(chan *TranscriptResult, buffers.StreamTranscripts),
+		audioIn:         make(chan []byte, buffers.StreamAudioIn),
 		audioPending:    make([][]byte, 0),
 		lastAudioTime:   time.Now(),
+		lastSendTime:    time.Now(),
+		keepAliveWake:   make(chan struct{}, 1),
 		streamStartTime: time.Now(),
 		lastSuccessTime: time.Now(),
 		status:          StreamStatusHealthy,
@@ -201,9 +243,12 @@ func (ts *TranscribeStream) SendAudio(audioData []byte) error {
 	}
 
 	// Update last audio time for keep-alive
+	now := time.Now()
 	ts.keepAliveMu.Lock()
-	ts.lastAudioTime = time.Now()
+	ts.lastAudioTime = now
+	ts.lastSendTime = now
 	ts.keepAliveMu.Unlock()
+	ts.ResumeKeepAlive()
 
 	// If reconnecting, buffer the audio
 	if atomic.LoadInt32(&ts.isReconnecting) == 1 {
@@ -238,6 +283,7 @@ func (ts *TranscribeStream) SendAudio(audioData []byte) error {
 
 		select {
 		case ts.audioIn <- chunk:
+			ts.audioInHigh.Observe(len(ts.audioIn))
 		case <-ctx.Done():
 			return ctx.Err()
 		default:
@@ -249,49 +295,6 @@ func (ts *TranscribeStream) SendAudio(audioData []byte) error {
 	return nil
 }
 
-// keepAliveLoop sends silence audio to keep the stream alive
-func (ts *TranscribeStream) keepAliveLoop() {
-	ticker := time.NewTicker(KeepAliveInterval)
-	defer ticker.Stop()
-
-	// Pre-allocate silence buffer (all zeros = silence in PCM)
-	silenceChunk := make([]byte, SilenceChunkSize)
-
-	for {
-		// Get context safely
-		ts.ctxMu.RLock()
-		ctx := ts.ctx
-		ts.ctxMu.RUnlock()
-
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			ts.keepAliveMu.Lock()
-			timeSinceLastAudio := time.Since(ts.lastAudioTime)
-			ts.keepAliveMu.Unlock()
-
-			// Only send silence if no audio received recently and not reconnecting
-			if timeSinceLastAudio >= KeepAliveInterval-time.Second {
-				if atomic.LoadInt32(&ts.isReconnecting) == 0 && atomic.LoadInt32(&ts.audioInClosed) == 0 {
-					ts.mu.Lock()
-					closed := ts.isClosed
-					ts.mu.Unlock()
-
-					if !closed {
-						select {
-						case ts.audioIn <- silenceChunk:
-							// Silence sent successfully
-						default:
-							// Buffer full, skip
-						}
-					}
-				}
-			}
-		}
-	}
-}
-
 // healthCheckLoop monitors stream health
 func (ts *TranscribeStream) healthCheckLoop() {
 	ticker := time.NewTicker(HealthCheckInterval)
@@ -450,6 +453,14 @@ func (ts *TranscribeStream) receiveLoopWithReconnect() {
 
 		// Stream ended - check for errors
 		if err := ts.eventStream.Err(); err != nil {
+			// Hibernated: Transcribe timed out the silent stream on purpose. Close it
+			// instead of reconnecting; the next audio opens a fresh stream.
+			if ts.KeepAliveSuspended() {
+				log.Printf("[Transcribe] 💤 Hibernated stream for %s timed out, closing", ts.speakerID)
+				ts.Close()
+				return
+			}
+
 			atomic.AddInt32(&ts.errorCount, 1)
 			log.Printf("[Transcribe] Stream error for %s: %v", ts.speakerID, err)
 
@@ -524,17 +535,11 @@ func (ts *TranscribeStream) attemptReconnect() error {
 		ts.onReconnect(ts.speakerID, ts.sourceLang, int(attempt))
 	}
 
-	// Calculate backoff with jitter
-	backoff := time.Duration(math.Pow(2, float64(attempt-1))) * InitialBackoff
-	if backoff > MaxBackoff {
-		backoff = MaxBackoff
-	}
-	// Add jitter (10-20% of backoff)
-	jitter := time.Duration(float64(backoff) * (0.1 + 0.1*float64(time.Now().UnixNano()%100)/100))
-	backoff += jitter
-
+	backoff := reconnectPolicy.Backoff(int(attempt))
 	log.Printf("[Transcribe] Waiting %v before reconnection for %s", backoff, ts.speakerID)
-	time.Sleep(backoff)
+	if err := retry.Sleep(ts.parentCtx, backoff); err != nil {
+		return err
+	}
 
 	// Close old event stream
 	if ts.eventStream != nil {
@@ -550,19 +555,13 @@ func (ts *TranscribeStream) attemptReconnect() error {
 	ts.ctxMu.Unlock()
 
 	// Get language code
-	langCode, ok := transcribeLanguageCodes[ts.sourceLang]
+	langCode, ok := transcribeLanguageCode(ts.sourceLang)
 	if !ok {
 		langCode = types.LanguageCodeEnUs
 	}
 
 	// Start new stream directly (no circuit breaker - AWS SDK handles retries)
-	resp, err := ts.client.client.StartStreamTranscription(newCtx, &transcribestreaming.StartStreamTranscriptionInput{
-		LanguageCode:                      langCode,
-		MediaEncoding:                     types.MediaEncodingPcm,
-		MediaSampleRateHertz:              aws.Int32(ts.client.sampleRate),
-		EnablePartialResultsStabilization: true,                                 // Enable partial stabilization to reduce choppy updates
-		PartialResultsStability:           types.PartialResultsStabilityMedium, // Medium stability: balance between real-time and accuracy
-	})
+	resp, err := ts.client.client.StartStreamTranscription(newCtx, ts.client.newStartStreamInput(langCode, ts.vocabularyName))
 	if err != nil {
 		log.Printf("[Transcribe] Failed to start new stream for %s: %v", ts.speakerID, err)
 		return err
@@ -669,15 +668,17 @@ func (ts *TranscribeStream) GetHealth() *StreamHealth {
 	defer ts.mu.Unlock()
 
 	return &StreamHealth{
-		SpeakerID:       ts.speakerID,
-		SourceLang:      ts.sourceLang,
-		Status:          ts.status,
-		Uptime:          time.Since(ts.streamStartTime),
-		LastActivity:    ts.lastAudioTime,
-		ErrorCount:      atomic.LoadInt32(&ts.errorCount),
-		SuccessCount:    atomic.LoadInt64(&ts.successCount),
-		ReconnectCount:  atomic.LoadInt32(&ts.reconnectAttempts),
-		IsReconnecting:  atomic.LoadInt32(&ts.isReconnecting) == 1,
+		SpeakerID:      ts.speakerID,
+		SourceLang:     ts.sourceLang,
+		Status:         ts.status,
+		Uptime:         time.Since(ts.streamStartTime),
+		LastActivity:   ts.lastAudioTime,
+		ErrorCount:     atomic.LoadInt32(&ts.errorCount),
+		SuccessCount:   atomic.LoadInt64(&ts.successCount),
+		ReconnectCount: atomic.LoadInt32(&ts.reconnectAttempts),
+		IsReconnecting: atomic.LoadInt32(&ts.isReconnecting) == 1,
+		Hibernated:     ts.KeepAliveSuspended(),
+		KeepAlivesSent: atomic.LoadInt64(&ts.keepAlivesSent),
 	}
 }
 
@@ -742,6 +743,9 @@ func (ts *TranscribeStream) Close() error {
 		ts.eventStream.Close()
 	}
 
+	// Let a suspended keep-alive loop see the close and exit
+	ts.wakeKeepAlive()
+
 	log.Printf("[Transcribe] Closed stream for speaker %s", ts.speakerID)
 	return nil
 }
@@ -2,12 +2,18 @@ package aws
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/translate"
+	"github.com/aws/aws-sdk-go-v2/service/translate/types"
+
+	"realtime-backend/internal/retry"
 )
 
 // TranslateClient wraps Amazon Translate
@@ -23,48 +29,28 @@ type TranslationResult struct {
 	TranslatedText string
 }
 
-// Translate 언어 코드 매핑 (Amazon Translate는 ISO 639-1 사용)
-// AWS Translate supports these primary codes
-var translateLanguageCodes = map[string]string{
-	"ko": "ko",
-	"en": "en",
-	"ja": "ja",
-	"zh": "zh",
-	// Aliases for common variations
-	"ko-KR": "ko",
-	"en-US": "en",
-	"en-GB": "en",
-	"ja-JP": "ja",
-	"zh-CN": "zh",
-	"zh-TW": "zh",
+// translateRetryPolicy retries throttled/transient TranslateText errors within the real-time budget
+var translateRetryPolicy = retry.Policy{
+	MaxAttempts:    3,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     time.Second,
+	Jitter:         0.1,
+	MaxElapsed:     2 * time.Second,
+	OnRetry: func(attempt int, err error, wait time.Duration) {
+		log.Printf("[Translate] ⚠️ Attempt %d failed, retrying in %v: %v", attempt, wait, err)
+	},
 }
 
-// supportedTargetLanguages is a set of valid target languages
-var supportedTargetLanguages = map[string]bool{
-	"ko": true,
-	"en": true,
-	"ja": true,
-	"zh": true,
-}
+// ErrUnsupportedLanguage is returned when Amazon Translate does not support a target language
+var ErrUnsupportedLanguage = errors.New("unsupported language")
 
-// normalizeLanguageCode normalizes a language code to a supported format
+// normalizeLanguageCode normalizes a language code to an Amazon Translate code (see languages.go)
 func normalizeLanguageCode(lang string) string {
-	// First check if it's already in the map
-	if code, ok := translateLanguageCodes[lang]; ok {
-		return code
-	}
-	// Try lowercase
-	if code, ok := translateLanguageCodes[strings.ToLower(lang)]; ok {
-		return code
-	}
-	// Try just the first 2 characters
-	if len(lang) >= 2 {
-		short := strings.ToLower(lang[:2])
-		if code, ok := translateLanguageCodes[short]; ok {
-			return code
-		}
+	info, ok := LookupLanguage(lang)
+	if !ok || !info.SupportsTranslate() {
+		return ""
 	}
-	return ""
+	return info.TranslateCode
 }
 
 // NewTranslateClient creates a new Translate client
@@ -76,24 +62,24 @@ func NewTranslateClient(cfg aws.Config) *TranslateClient {
 
 // Translate translates text from source to target language
 func (c *TranslateClient) Translate(ctx context.Context, text, sourceLang, targetLang string) (*TranslationResult, error) {
+	return c.TranslateWithTerminology(ctx, text, sourceLang, targetLang, nil)
+}
+
+// TranslateWithTerminology translates text applying custom terminologies (company/product names)
+func (c *TranslateClient) TranslateWithTerminology(ctx context.Context, text, sourceLang, targetLang string, terminologyNames []string) (*TranslationResult, error) {
 	// Normalize language codes
 	srcCode := normalizeLanguageCode(sourceLang)
 	tgtCode := normalizeLanguageCode(targetLang)
 
-	// Validate and fix invalid language codes
+	// Unknown source: let Amazon Translate detect it
 	if srcCode == "" {
-		log.Printf("[Translate] ⚠️ Unknown source language '%s', defaulting to 'ko'", sourceLang)
-		srcCode = "ko"
+		log.Printf("[Translate] ⚠️ Unknown source language '%s', using auto detection", sourceLang)
+		srcCode = "auto"
 	}
+	// Unsupported target: never substitute another language for the listener
 	if tgtCode == "" {
-		log.Printf("[Translate] ⚠️ Unknown target language '%s', defaulting to 'en'", targetLang)
-		tgtCode = "en"
-	}
-
-	// Validate target is a supported language (prevent German, Spanish, etc.)
-	if !supportedTargetLanguages[tgtCode] {
-		log.Printf("[Translate] ⚠️ Unsupported target language '%s' (normalized from '%s'), defaulting to 'en'", tgtCode, targetLang)
-		tgtCode = "en"
+		log.Printf("[Translate] ⚠️ Unsupported target language '%s'", targetLang)
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedLanguage, targetLang)
 	}
 
 	// Skip if same language
@@ -121,10 +107,15 @@ func (c *TranslateClient) Translate(ctx context.Context, text, sourceLang, targe
 		SourceLanguageCode: aws.String(srcCode),
 		TargetLanguageCode: aws.String(tgtCode),
 	}
+	if len(terminologyNames) > 0 {
+		input.TerminologyNames = terminologyNames
+	}
 
 	log.Printf("[Translate] Translating: '%s' from %s to %s", text, srcCode, tgtCode)
 
-	output, err := c.client.TranslateText(ctx, input)
+	output, err := retry.DoValue(ctx, translateRetryPolicy, func(ctx context.Context) (*translate.TranslateTextOutput, error) {
+		return c.client.TranslateText(ctx, input)
+	})
 	if err != nil {
 		log.Printf("[Translate] ❌ Error translating from %s to %s: %v", srcCode, tgtCode, err)
 		return nil, err
@@ -141,6 +132,97 @@ func (c *TranslateClient) Translate(ctx context.Context, text, sourceLang, targe
 	}, nil
 }
 
+// MaxTranslateBatchBytes keeps a joined batch under the TranslateText 10,000 byte limit
+const MaxTranslateBatchBytes = 9000
+
+// TranslateBatch translates several texts with as few TranslateText calls as possible.
+// Texts are joined with newlines into chunks of up to MaxTranslateBatchBytes; if a chunk's
+// result does not split back into the same number of lines, it falls back to one call per text.
+func (c *TranslateClient) TranslateBatch(ctx context.Context, texts []string, sourceLang, targetLang string, terminologyNames []string) ([]string, error) {
+	results := make([]string, len(texts))
+
+	translateChunk := func(start, end int) error {
+		lines := make([]string, 0, end-start)
+		for _, text := range texts[start:end] {
+			lines = append(lines, strings.ReplaceAll(text, "\n", " "))
+		}
+
+		trans, err := c.TranslateWithTerminology(ctx, strings.Join(lines, "\n"), sourceLang, targetLang, terminologyNames)
+		if err != nil {
+			return err
+		}
+
+		translated := strings.Split(trans.TranslatedText, "\n")
+		if len(translated) == len(lines) {
+			for i, line := range translated {
+				results[start+i] = strings.TrimSpace(line)
+			}
+			return nil
+		}
+
+		log.Printf("[Translate] ⚠️ Batch split mismatch (%d → %d lines), translating individually", len(lines), len(translated))
+		for i, line := range lines {
+			single, err := c.TranslateWithTerminology(ctx, line, sourceLang, targetLang, terminologyNames)
+			if err != nil {
+				return err
+			}
+			results[start+i] = single.TranslatedText
+		}
+		return nil
+	}
+
+	start, size := 0, 0
+	for i, text := range texts {
+		if i > start && size+len(text)+1 > MaxTranslateBatchBytes {
+			if err := translateChunk(start, i); err != nil {
+				return nil, err
+			}
+			start, size = i, 0
+		}
+		size += len(text) + 1
+	}
+	if start < len(texts) {
+		if err := translateChunk(start, len(texts)); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// ImportTerminology creates or overwrites a custom terminology that keeps phrases verbatim across languages
+func (c *TranslateClient) ImportTerminology(ctx context.Context, name string, phrases []string) error {
+	file, err := BuildTerminologyCSV(phrases, TranslateLanguages())
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.ImportTerminology(ctx, &translate.ImportTerminologyInput{
+		Name:          aws.String(name),
+		MergeStrategy: types.MergeStrategyOverwrite,
+		TerminologyData: &types.TerminologyData{
+			File:           file,
+			Format:         types.TerminologyDataFormatCsv,
+			Directionality: types.DirectionalityMulti,
+		},
+	})
+	if err != nil {
+		log.Printf("[Translate] ❌ Failed to import terminology %s: %v", name, err)
+		return err
+	}
+
+	log.Printf("[Translate] ✅ Imported terminology %s (%d phrases)", name, len(phrases))
+	return nil
+}
+
+// DeleteTerminology deletes a custom terminology
+func (c *TranslateClient) DeleteTerminology(ctx context.Context, name string) error {
+	_, err := c.client.DeleteTerminology(ctx, &translate.DeleteTerminologyInput{
+		Name: aws.String(name),
+	})
+	return err
+}
+
 // TranslateToMultiple translates text to multiple target languages concurrently
 func (c *TranslateClient) TranslateToMultiple(ctx context.Context, text, sourceLang string, targetLangs []string) (map[string]*TranslationResult, error) {
 	results := make(map[string]*TranslationResult)
@@ -0,0 +1,174 @@
+package aws
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// TTS budget constants
+const (
+	TTSBudgetSoftLimitRatio = 0.8 // Above this share of the budget only high-priority sentences are synthesized
+	FillerMaxWords          = 3   // Sentences this short made only of filler words are skipped near the limit
+)
+
+// TTS skip reasons exposed in transcript metadata
+const (
+	TTSSkipBudgetExhausted = "tts_budget_exhausted"
+	TTSSkipFiller          = "tts_budget_filler"
+	TTSSkipLowPriority     = "tts_budget_low_priority"
+)
+
+// TTSPriority ranks how important it is that a sentence is heard as audio
+type TTSPriority int
+
+const (
+	TTSPriorityFiller TTSPriority = iota // Back-channel/filler ("okay", "네네")
+	TTSPriorityNormal
+	TTSPriorityHigh // Questions and direct addresses
+)
+
+// Question markers per language (sentence endings / leading words)
+var (
+	questionSuffixes = map[string][]string{
+		"ko": {"까", "나요", "가요", "죠", "니", "래요", "을래", "할래"},
+		"ja": {"か", "かな", "の", "ですか"},
+		"zh": {"吗", "呢", "么"},
+	}
+	questionPrefixes = map[string][]string{
+		"en": {"what", "why", "how", "when", "where", "who", "which", "can", "could", "would", "should", "do", "does", "did", "is", "are", "will"},
+	}
+	directAddressMarkers = map[string][]string{
+		"en": {"you ", "your ", "you?", "you,"},
+		"ko": {"님", "씨"},
+		"ja": {"さん", "様"},
+	}
+	fillerWords = map[string]bool{
+		"okay": true, "ok": true, "yeah": true, "yes": true, "right": true, "sure": true, "thanks": true, "thank": true,
+		"um": true, "uh": true, "hmm": true, "well": true, "so": true, "alright": true, "got": true, "it": true,
+		"네": true, "네네": true, "예": true, "응": true, "음": true, "그렇죠": true, "맞아요": true, "감사합니다": true,
+		"はい": true, "ええ": true, "そうですね": true,
+		"好": true, "好的": true, "对": true, "嗯": true,
+	}
+)
+
+// ClassifySentence heuristically ranks a sentence for TTS under budget pressure.
+// names are participant nicknames: mentioning one counts as a direct address.
+func ClassifySentence(text, lang string, names []string) TTSPriority {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return TTSPriorityFiller
+	}
+	lower := strings.ToLower(text)
+	base := strings.TrimRightFunc(lower, func(r rune) bool { return unicode.IsPunct(r) || unicode.IsSpace(r) })
+
+	// Questions
+	if strings.ContainsAny(text, "?？") {
+		return TTSPriorityHigh
+	}
+	for _, suffix := range questionSuffixes[lang] {
+		if strings.HasSuffix(base, suffix) {
+			return TTSPriorityHigh
+		}
+	}
+	for _, prefix := range questionPrefixes[lang] {
+		if strings.HasPrefix(lower, prefix+" ") {
+			return TTSPriorityHigh
+		}
+	}
+
+	// Direct addresses
+	if strings.Contains(lower, "@") {
+		return TTSPriorityHigh
+	}
+	for _, marker := range directAddressMarkers[lang] {
+		if strings.Contains(lower+" ", marker) {
+			return TTSPriorityHigh
+		}
+	}
+	for _, name := range names {
+		if name != "" && strings.Contains(lower, strings.ToLower(name)) {
+			return TTSPriorityHigh
+		}
+	}
+
+	// Filler: a few words that are all back-channel words
+	words := strings.FieldsFunc(lower, func(r rune) bool { return unicode.IsSpace(r) || unicode.IsPunct(r) })
+	if len(words) <= FillerMaxWords {
+		allFiller := true
+		for _, w := range words {
+			if !fillerWords[w] {
+				allFiller = false
+				break
+			}
+		}
+		if allFiller {
+			return TTSPriorityFiller
+		}
+	}
+
+	return TTSPriorityNormal
+}
+
+// TTSBudget tracks Polly characters used by a room against a character budget.
+// A nil budget is unlimited.
+type TTSBudget struct {
+	limit   int64
+	used    int64
+	skipped int64
+	mu      sync.Mutex
+}
+
+// TTSBudgetStats is a snapshot of budget usage
+type TTSBudgetStats struct {
+	Limit   int64 `json:"limit"`
+	Used    int64 `json:"used"`
+	Skipped int64 `json:"skipped"`
+}
+
+// NewTTSBudget creates a budget of limit Polly characters (limit <= 0 = unlimited, returns nil)
+func NewTTSBudget(limit int64) *TTSBudget {
+	if limit <= 0 {
+		return nil
+	}
+	return &TTSBudget{limit: limit}
+}
+
+// Reserve charges chars against the budget if a sentence of the given priority
+// may be synthesized. Returns "" when allowed, otherwise the skip reason.
+func (b *TTSBudget) Reserve(chars int, priority TTSPriority) string {
+	if b == nil {
+		return ""
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	reason := ""
+	switch {
+	case b.used+int64(chars) > b.limit:
+		reason = TTSSkipBudgetExhausted
+	case float64(b.used) >= float64(b.limit)*TTSBudgetSoftLimitRatio && priority == TTSPriorityFiller:
+		reason = TTSSkipFiller
+	case float64(b.used) >= float64(b.limit)*TTSBudgetSoftLimitRatio && priority < TTSPriorityHigh:
+		reason = TTSSkipLowPriority
+	}
+
+	if reason != "" {
+		b.skipped++
+		return reason
+	}
+	b.used += int64(chars)
+	return ""
+}
+
+// Stats returns current budget usage (nil for an unlimited budget)
+func (b *TTSBudget) Stats() *TTSBudgetStats {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return &TTSBudgetStats{Limit: b.limit, Used: b.used, Skipped: b.skipped}
+}
@@ -0,0 +1,89 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"realtime-backend/internal/metrics"
+)
+
+// TTSSkipNoAudience is the TTS skip reason for passthrough (source == target) TTS when no
+// listener other than the speaker hears the speaker's own language
+const TTSSkipNoAudience = "no_passthrough_audience"
+
+// Passthrough TTS lane settings
+const (
+	// PassthroughTTSFreshness is how long a passthrough clip may wait in the lane (and synthesize)
+	// before it is dropped; a late echo of the speaker's own words is worse than none
+	PassthroughTTSFreshness = 3 * time.Second
+	passthroughQueueSize    = 32
+)
+
+// passthroughJob is one queued passthrough TTS clip
+type passthroughJob struct {
+	transcriptID string
+	speakerID    string
+	lang         string
+	text         string
+	voice        *VoicePreference
+	enqueuedAt   time.Time
+}
+
+// hasPassthroughAudience reports whether anyone besides the speaker listens in the speaker's
+// own language (always true when the room did not install an audience check)
+func (p *Pipeline) hasPassthroughAudience(lang, speakerID string) bool {
+	if p.passthroughAudience == nil {
+		return true
+	}
+	return p.passthroughAudience(lang, speakerID)
+}
+
+// enqueuePassthroughTTS hands passthrough clips to the low-priority lane so they never hold up
+// translated TTS of the same final (non-blocking, drops when the lane is full)
+func (p *Pipeline) enqueuePassthroughTTS(transcriptID, speakerID, lang, text string) {
+	if atomic.LoadInt32(&p.closed) == 1 {
+		return
+	}
+	now := time.Now()
+	for _, voice := range p.voicesFor(lang) {
+		select {
+		case p.passthroughQueue <- &passthroughJob{
+			transcriptID: transcriptID,
+			speakerID:    speakerID,
+			lang:         lang,
+			text:         text,
+			voice:        voice,
+			enqueuedAt:   now,
+		}:
+		default:
+			metrics.DroppedMessages.Inc(metrics.DropPassthroughTTS)
+			log.Printf("[AWS Pipeline] Passthrough TTS lane full, dropping %s clip of %s", lang, transcriptID)
+		}
+	}
+}
+
+// passthroughLoop synthesizes queued passthrough clips one at a time, skipping clips that went
+// stale or were superseded by a newer final while they waited
+func (p *Pipeline) passthroughLoop() {
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+		case job := <-p.passthroughQueue:
+			deadline := job.enqueuedAt.Add(PassthroughTTSFreshness)
+			if time.Now().After(deadline) || p.supersession.IsSuperseded(job.transcriptID) {
+				metrics.DroppedMessages.Inc(metrics.DropPassthroughTTS)
+				continue
+			}
+			if !p.hasPassthroughAudience(job.lang, job.speakerID) {
+				continue
+			}
+
+			ctx, cancel := context.WithDeadline(p.ctx, deadline)
+			p.synthesizeAndSend(ctx, job.transcriptID, job.speakerID, job.lang, job.lang, job.text, job.voice)
+			cancel()
+		}
+	}
+}
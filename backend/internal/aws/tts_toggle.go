@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"log"
+	"sort"
+	"sync"
+)
+
+// TTSSkipDisabled is the TTS skip reason for target languages whose TTS the host turned off
+const TTSSkipDisabled = "tts_disabled"
+
+// ttsToggle tracks target languages whose TTS is turned off at runtime.
+// Captions for those languages are still translated and sent.
+type ttsToggle struct {
+	disabled map[string]bool
+	mu       sync.RWMutex
+}
+
+// set replaces the disabled languages and returns them normalized and sorted
+func (t *ttsToggle) set(langs []string) []string {
+	disabled := make(map[string]bool, len(langs))
+	for _, lang := range langs {
+		if code := NormalizeLanguage(lang); code != "" {
+			disabled[code] = true
+		}
+	}
+
+	t.mu.Lock()
+	t.disabled = disabled
+	t.mu.Unlock()
+	return t.list()
+}
+
+func (t *ttsToggle) enabled(lang string) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return !t.disabled[lang]
+}
+
+func (t *ttsToggle) list() []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	langs := make([]string, 0, len(t.disabled))
+	for lang := range t.disabled {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// SetTTSDisabledLanguages turns TTS off for the given target languages and back on for all others
+func (p *Pipeline) SetTTSDisabledLanguages(langs []string) {
+	disabled := p.ttsToggle.set(langs)
+	log.Printf("[AWS Pipeline] 🔇 TTS disabled languages: %v", disabled)
+}
+
+// TTSDisabledLanguages returns the target languages whose TTS is turned off
+func (p *Pipeline) TTSDisabledLanguages() []string {
+	return p.ttsToggle.list()
+}
+
+// TTSEnabled reports whether TTS is generated for a target language
+func (p *Pipeline) TTSEnabled(targetLang string) bool {
+	return p.ttsToggle.enabled(targetLang)
+}
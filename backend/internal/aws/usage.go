@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"sync/atomic"
+	"unicode/utf8"
+)
+
+// Estimated on-demand Transcribe streaming price (USD per second of audio)
+const TranscribeCostPerSecond = 0.024 / 60
+
+// UsageSnapshot is the billable AWS usage of a pipeline
+type UsageSnapshot struct {
+	TranscribeSeconds float64 `json:"transcribeSeconds"`
+	TranslateChars    int64   `json:"translateChars"`
+	PollyChars        int64   `json:"pollyChars"`
+	EstimatedCostUSD  float64 `json:"estimatedCostUsd"`
+}
+
+// IsZero reports whether nothing billable happened
+func (u UsageSnapshot) IsZero() bool {
+	return u.TranscribeSeconds == 0 && u.TranslateChars == 0 && u.PollyChars == 0
+}
+
+// EstimateCostUSD estimates the AWS cost of the given usage
+func EstimateCostUSD(transcribeSeconds float64, translateChars, pollyChars int64) float64 {
+	return transcribeSeconds*TranscribeCostPerSecond +
+		float64(translateChars)*TranslateCostPerChar +
+		float64(pollyChars)*PollyCostPerChar
+}
+
+// UsageMeter counts billable work done by live AWS calls (cache hits are not counted).
+// Totals are cumulative; Drain returns what accumulated since the previous drain so it
+// can be persisted incrementally.
+type UsageMeter struct {
+	bytesPerSecond int64 // 16-bit mono PCM at the Transcribe sample rate
+
+	transcribeBytes int64
+	translateChars  int64
+	pollyChars      int64
+
+	drainedTranscribeBytes int64
+	drainedTranslateChars  int64
+	drainedPollyChars      int64
+}
+
+// NewUsageMeter creates a meter for PCM audio at the given sample rate
+func NewUsageMeter(sampleRate int32) *UsageMeter {
+	if sampleRate <= 0 {
+		sampleRate = 16000
+	}
+	return &UsageMeter{bytesPerSecond: int64(sampleRate) * 2}
+}
+
+// AddTranscribeAudio records PCM bytes streamed to Transcribe
+func (m *UsageMeter) AddTranscribeAudio(bytes int) {
+	atomic.AddInt64(&m.transcribeBytes, int64(bytes))
+}
+
+// AddTranslateText records source characters sent to Translate
+func (m *UsageMeter) AddTranslateText(text string) {
+	atomic.AddInt64(&m.translateChars, int64(utf8.RuneCountInString(text)))
+}
+
+// AddPollyText records characters synthesized by Polly
+func (m *UsageMeter) AddPollyText(text string) {
+	atomic.AddInt64(&m.pollyChars, int64(utf8.RuneCountInString(text)))
+}
+
+// Snapshot returns the cumulative usage
+func (m *UsageMeter) Snapshot() UsageSnapshot {
+	return m.snapshot(
+		atomic.LoadInt64(&m.transcribeBytes),
+		atomic.LoadInt64(&m.translateChars),
+		atomic.LoadInt64(&m.pollyChars),
+	)
+}
+
+// Pending returns the usage accumulated since the previous Drain without draining it
+func (m *UsageMeter) Pending() UsageSnapshot {
+	return m.snapshot(
+		atomic.LoadInt64(&m.transcribeBytes)-atomic.LoadInt64(&m.drainedTranscribeBytes),
+		atomic.LoadInt64(&m.translateChars)-atomic.LoadInt64(&m.drainedTranslateChars),
+		atomic.LoadInt64(&m.pollyChars)-atomic.LoadInt64(&m.drainedPollyChars),
+	)
+}
+
+// Drain returns the usage accumulated since the previous Drain
+func (m *UsageMeter) Drain() UsageSnapshot {
+	transcribe := atomic.LoadInt64(&m.transcribeBytes)
+	translate := atomic.LoadInt64(&m.translateChars)
+	polly := atomic.LoadInt64(&m.pollyChars)
+
+	return m.snapshot(
+		transcribe-atomic.SwapInt64(&m.drainedTranscribeBytes, transcribe),
+		translate-atomic.SwapInt64(&m.drainedTranslateChars, translate),
+		polly-atomic.SwapInt64(&m.drainedPollyChars, polly),
+	)
+}
+
+func (m *UsageMeter) snapshot(transcribeBytes, translateChars, pollyChars int64) UsageSnapshot {
+	seconds := float64(transcribeBytes) / float64(m.bytesPerSecond)
+	return UsageSnapshot{
+		TranscribeSeconds: seconds,
+		TranslateChars:    translateChars,
+		PollyChars:        pollyChars,
+		EstimatedCostUSD:  EstimateCostUSD(seconds, translateChars, pollyChars),
+	}
+}
@@ -0,0 +1,72 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/csv"
+)
+
+// Vocabulary holds custom vocabulary and terminology settings for a room.
+// Transcribe vocabularies are selected per source language, while a single
+// Translate terminology applies to every language pair (TranslateText accepts one).
+type Vocabulary struct {
+	TranscribeVocabularies map[string]string // sourceLang -> Transcribe custom vocabulary name
+	TerminologyName        string            // Translate custom terminology name
+}
+
+// VocabularyFor returns the Transcribe vocabulary name for a source language
+func (v *Vocabulary) VocabularyFor(sourceLang string) string {
+	if v == nil || v.TranscribeVocabularies == nil {
+		return ""
+	}
+	return v.TranscribeVocabularies[sourceLang]
+}
+
+// TerminologyNames returns the terminology names to pass to TranslateText
+func (v *Vocabulary) TerminologyNames() []string {
+	if v == nil || v.TerminologyName == "" {
+		return nil
+	}
+	return []string{v.TerminologyName}
+}
+
+// StreamOptions optional settings applied when starting a Transcribe stream
+type StreamOptions struct {
+	VocabularyName string // Transcribe custom vocabulary (must exist for the stream language)
+
+	// Channel capacities of the stream (0 = defaults)
+	TranscriptBuffer int
+	AudioBuffer      int
+}
+
+// BuildTerminologyCSV builds a multi-directional terminology file that keeps each
+// phrase unchanged in every language, so company/product names survive translation.
+func BuildTerminologyCSV(phrases []string, languages []string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(languages); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	for _, phrase := range phrases {
+		if phrase == "" || seen[phrase] {
+			continue
+		}
+		seen[phrase] = true
+
+		row := make([]string, len(languages))
+		for i := range languages {
+			row[i] = phrase
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/polly/types"
+)
+
+// Speaking rate limits (percent of normal speed, applied via SSML prosody)
+const (
+	DefaultSpeakingRate = 100
+	MinSpeakingRate     = 20
+	MaxSpeakingRate     = 200
+)
+
+// VoicePreference is a listener's Polly voice choice.
+// Empty fields fall back to the language's default voice from the registry.
+type VoicePreference struct {
+	VoiceID      string `json:"voiceId,omitempty"`
+	Engine       string `json:"engine,omitempty"`       // neural | standard | long-form
+	SpeakingRate int    `json:"speakingRate,omitempty"` // Percent, 100 = normal
+
+	// Output format negotiated at join (nil = mp3 24kHz); not settable through voice controls
+	Output *AudioProfile `json:"-"`
+}
+
+// IsDefault reports whether the preference is equivalent to the default voice
+func (v *VoicePreference) IsDefault() bool {
+	return v == nil || (v.VoiceID == "" && v.Engine == "" && (v.SpeakingRate == 0 || v.SpeakingRate == DefaultSpeakingRate) && v.Output.IsDefault())
+}
+
+// Key identifies the preference for caching and audio routing ("" for the default voice)
+func (v *VoicePreference) Key() string {
+	if v.IsDefault() {
+		return ""
+	}
+	rate := v.SpeakingRate
+	if rate == 0 {
+		rate = DefaultSpeakingRate
+	}
+	key := v.VoiceID + "|" + v.Engine + "|" + strconv.Itoa(rate)
+	if !v.Output.IsDefault() {
+		key += "#" + v.Output.Key()
+	}
+	return key
+}
+
+// OutputProfile returns the output format of the preference (nil = default)
+func (v *VoicePreference) OutputProfile() *AudioProfile {
+	if v == nil {
+		return nil
+	}
+	return v.Output
+}
+
+// WithOutput returns a copy of the preference with the given output format (nil if all default)
+func (v *VoicePreference) WithOutput(profile *AudioProfile) *VoicePreference {
+	var pref VoicePreference
+	if v != nil {
+		pref = *v
+	}
+	pref.Output = profile
+	if pref.IsDefault() {
+		return nil
+	}
+	return &pref
+}
+
+// ValidateVoicePreference checks voice ID, engine and speaking rate
+func ValidateVoicePreference(v *VoicePreference) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.VoiceID != "" {
+		valid := false
+		for _, id := range types.VoiceId("").Values() {
+			if strings.EqualFold(string(id), v.VoiceID) {
+				v.VoiceID = string(id)
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("unknown voice: %s", v.VoiceID)
+		}
+	}
+
+	switch types.Engine(v.Engine) {
+	case "", types.EngineNeural, types.EngineStandard, types.EngineLongForm:
+	default:
+		return fmt.Errorf("unsupported engine: %s", v.Engine)
+	}
+
+	if v.SpeakingRate != 0 && (v.SpeakingRate < MinSpeakingRate || v.SpeakingRate > MaxSpeakingRate) {
+		return fmt.Errorf("speaking rate must be between %d and %d", MinSpeakingRate, MaxSpeakingRate)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package aws
+
+import (
+	"context"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Warm-up constants
+const (
+	MaxWarmupPhrases   = 50               // Phrases per source language
+	WarmupProbeTimeout = 10 * time.Second // Opening a probe Transcribe stream
+)
+
+// DefaultWarmupPhrases are phrases said at the start of almost every meeting (sourceLang -> phrases).
+// Priming them means the first minute of a meeting is served from the cache.
+var DefaultWarmupPhrases = map[string][]string{
+	"ko": {"안녕하세요", "다들 잘 들리시나요?", "네, 잘 들립니다", "화면 공유하겠습니다", "그럼 시작하겠습니다", "감사합니다"},
+	"en": {"Hello everyone", "Can everyone hear me?", "Yes, I can hear you", "Let me share my screen", "Let's get started", "Thank you"},
+	"ja": {"こんにちは", "皆さん聞こえますか?", "はい、聞こえます", "画面を共有します", "では始めましょう", "ありがとうございます"},
+	"zh": {"大家好", "大家能听到吗?", "能听到", "我来共享一下屏幕", "我们开始吧", "谢谢"},
+}
+
+// WarmupRequest describes what to prepare before a meeting starts
+type WarmupRequest struct {
+	SourceLanguages []string            // Languages speakers are expected to use
+	TargetLanguages []string            // Languages listeners are expected to use
+	Phrases         map[string][]string // sourceLang -> phrases to prime (nil = DefaultWarmupPhrases)
+	SynthesizeTTS   bool                // Also prime TTS audio of the translated phrases
+}
+
+// WarmupResult reports what a warm-up prepared
+type WarmupResult struct {
+	Streams      map[string]string `json:"streams"`      // sourceLang -> "ok" or the error
+	Translations int               `json:"translations"` // Phrase translations now cached
+	Clips        int               `json:"clips"`        // TTS clips now cached
+	Errors       int               `json:"errors"`
+	DurationMs   int64             `json:"durationMs"`
+}
+
+// Warm prepares the pipeline for a meeting that is about to start: it opens and closes a probe
+// Transcribe stream per source language (establishing the HTTP/2 connection and credentials and
+// validating the custom vocabulary), then translates the template phrases into every target
+// language and optionally synthesizes them, so the first utterances hit the cache.
+func (p *Pipeline) Warm(ctx context.Context, req WarmupRequest) *WarmupResult {
+	start := time.Now()
+	result := &WarmupResult{Streams: make(map[string]string)}
+	var resultMu sync.Mutex
+
+	var wg sync.WaitGroup
+	for _, lang := range req.SourceLanguages {
+		wg.Add(1)
+		go func(sourceLang string) {
+			defer wg.Done()
+			status := "ok"
+			if err := p.probeStream(ctx, sourceLang); err != nil {
+				status = err.Error()
+			}
+			resultMu.Lock()
+			result.Streams[sourceLang] = status
+			resultMu.Unlock()
+		}(lang)
+	}
+
+	phrases := req.Phrases
+	if phrases == nil {
+		phrases = DefaultWarmupPhrases
+	}
+
+	var translations, clips, errs int64
+	for _, sourceLang := range req.SourceLanguages {
+		texts := phrases[sourceLang]
+		if len(texts) > MaxWarmupPhrases {
+			texts = texts[:MaxWarmupPhrases]
+		}
+		for _, text := range texts {
+			for _, targetLang := range req.TargetLanguages {
+				if targetLang == sourceLang || text == "" {
+					continue
+				}
+				wg.Add(1)
+				go func(text, sourceLang, targetLang string) {
+					defer wg.Done()
+					translated, err := p.warmTranslation(ctx, text, sourceLang, targetLang)
+					if err != nil {
+						atomic.AddInt64(&errs, 1)
+						return
+					}
+					atomic.AddInt64(&translations, 1)
+					if !req.SynthesizeTTS || translated == "" || !SupportsTTS(targetLang) {
+						return
+					}
+					if err := p.warmTTS(ctx, translated, targetLang); err != nil {
+						atomic.AddInt64(&errs, 1)
+						return
+					}
+					atomic.AddInt64(&clips, 1)
+				}(text, sourceLang, targetLang)
+			}
+		}
+	}
+	wg.Wait()
+
+	result.Translations = int(translations)
+	result.Clips = int(clips)
+	result.Errors = int(errs)
+	result.DurationMs = time.Since(start).Milliseconds()
+
+	log.Printf("[AWS Pipeline] 🔥 Warm-up done in %dms: streams=%v, translations=%d, clips=%d, errors=%d",
+		result.DurationMs, result.Streams, result.Translations, result.Clips, result.Errors)
+	return result
+}
+
+// probeStream opens a Transcribe stream for a language and closes it again
+func (p *Pipeline) probeStream(ctx context.Context, sourceLang string) error {
+	probeCtx, cancel := context.WithTimeout(ctx, WarmupProbeTimeout)
+	defer cancel()
+
+	var stream *TranscribeStream
+	var startErr error
+	err := p.breakers.Transcribe.Execute(func() error {
+		stream, startErr = p.transcribeFor(sourceLang).StartStreamWithOptions(probeCtx, "warmup-"+sourceLang, sourceLang, p.streamOptions(sourceLang))
+		return breakerFailure(probeCtx, startErr)
+	})
+	if err == nil {
+		err = startErr
+	}
+	if err != nil {
+		log.Printf("[AWS Pipeline] Warm-up stream for %s failed: %v", sourceLang, err)
+		return err
+	}
+	return stream.Close()
+}
+
+// warmTranslation translates a phrase into the cache (cached phrases are not translated again)
+func (p *Pipeline) warmTranslation(ctx context.Context, text, sourceLang, targetLang string) (string, error) {
+	if cached, ok := p.cache.GetTranslation(text, sourceLang, targetLang); ok {
+		return cached.TranslatedText, nil
+	}
+
+	select {
+	case p.translateSem <- struct{}{}:
+		defer func() { <-p.translateSem }()
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+
+	apiCtx, apiCancel := context.WithTimeout(ctx, APICallTimeout)
+	defer apiCancel()
+
+	trans, err := p.translateText(apiCtx, text, sourceLang, targetLang)
+	if err != nil {
+		log.Printf("[AWS Pipeline] Warm-up translation %s->%s failed: %v", sourceLang, targetLang, err)
+		return "", err
+	}
+	p.cache.SetTranslation(text, sourceLang, targetLang, trans)
+	return trans.TranslatedText, nil
+}
+
+// warmTTS synthesizes a translated phrase with the default voice into the cache
+func (p *Pipeline) warmTTS(ctx context.Context, text, targetLang string) error {
+	prosody := p.ttsProsody()
+	cacheKey := ttsCacheKey(nil, prosody)
+	if _, ok := p.cache.GetTTS(text, targetLang, cacheKey); ok {
+		return nil
+	}
+
+	select {
+	case p.ttsSem <- struct{}{}:
+		defer func() { <-p.ttsSem }()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	apiCtx, apiCancel := context.WithTimeout(ctx, APICallTimeout)
+	defer apiCancel()
+
+	audio, err := p.synthesize(apiCtx, text, targetLang, nil, prosody)
+	if err != nil {
+		log.Printf("[AWS Pipeline] Warm-up TTS for %s failed: %v", targetLang, err)
+		return err
+	}
+	if len(audio.AudioData) > 0 {
+		p.cache.SetTTS(text, targetLang, cacheKey, audio.AudioData)
+	}
+	return nil
+}
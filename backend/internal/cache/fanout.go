@@ -0,0 +1,42 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Fan-out event kinds
+const (
+	FanoutKindMessage = "message" // Transcript/TTS produced by the origin instance's speakers
+	FanoutKindLangs   = "langs"   // Target languages of the origin instance's listeners (heartbeat)
+	FanoutKindLeave   = "leave"   // Origin instance has no more listeners in the room
+)
+
+// FanoutEvent is published on a room's fan-out channel so every backend instance
+// serving the room can deliver it to its own listeners
+type FanoutEvent struct {
+	Origin  string          `json:"origin"` // Instance ID of the publisher
+	Kind    string          `json:"kind"`
+	Message json.RawMessage `json:"message,omitempty"`
+	Langs   []string        `json:"langs,omitempty"`
+}
+
+func fanoutChannel(roomID string) string {
+	return "room:" + roomID + ":fanout"
+}
+
+// PublishFanout publishes an event to every instance subscribed to the room
+func (r *RedisClient) PublishFanout(ctx context.Context, roomID string, event *FanoutEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return r.client.Publish(ctx, fanoutChannel(roomID), data).Err()
+}
+
+// SubscribeFanout subscribes to the room's fan-out channel (caller closes the PubSub)
+func (r *RedisClient) SubscribeFanout(ctx context.Context, roomID string) *redis.PubSub {
+	return r.client.Subscribe(ctx, fanoutChannel(roomID))
+}
@@ -0,0 +1,95 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// renewLeaseScript extends the lease if it is still ours; returns the current owner ("" if free)
+var renewLeaseScript = redis.NewScript(`
+local owner = redis.call("GET", KEYS[1])
+if owner == ARGV[1] then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return owner or ""
+`)
+
+// releaseLeaseScript deletes the lease only if it is still ours
+var releaseLeaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// RoomAudioFrame is speaker audio forwarded from an instance that does not own the
+// room's pipeline to the instance that does
+type RoomAudioFrame struct {
+	Origin      string `json:"origin"`
+	SpeakerID   string `json:"speakerId"`
+	SourceLang  string `json:"sourceLang"`
+	SpeakerName string `json:"speakerName,omitempty"`
+	ProfileImg  string `json:"profileImg,omitempty"`
+	Audio       []byte `json:"audio"`
+}
+
+func roomLeaseKey(roomID string) string {
+	return "room:" + roomID + ":owner"
+}
+
+func roomAudioChannel(roomID string) string {
+	return "room:" + roomID + ":audio"
+}
+
+// AcquireRoomLease takes or renews the room's ownership lease for instanceID and
+// returns the instance that owns it afterwards
+func (r *RedisClient) AcquireRoomLease(ctx context.Context, roomID, instanceID string, ttl time.Duration) (string, error) {
+	key := roomLeaseKey(roomID)
+	acquired, err := r.client.SetNX(ctx, key, instanceID, ttl).Result()
+	if err != nil {
+		return "", err
+	}
+	if acquired {
+		return instanceID, nil
+	}
+	owner, err := renewLeaseScript.Run(ctx, r.client, []string{key}, instanceID, ttl.Milliseconds()).Text()
+	if err != nil {
+		return "", err
+	}
+	if owner == "" {
+		// Expired between SETNX and the renewal; the next attempt takes it
+		return "", nil
+	}
+	return owner, nil
+}
+
+// ReleaseRoomLease gives up the lease if instanceID still owns it
+func (r *RedisClient) ReleaseRoomLease(ctx context.Context, roomID, instanceID string) error {
+	return releaseLeaseScript.Run(ctx, r.client, []string{roomLeaseKey(roomID)}, instanceID).Err()
+}
+
+// RoomLeaseHeld reports whether any instance currently holds the room's ownership lease
+func (r *RedisClient) RoomLeaseHeld(ctx context.Context, roomID string) (bool, error) {
+	n, err := r.client.Exists(ctx, roomLeaseKey(roomID)).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// PublishRoomAudio forwards a speaker audio frame to the room's owner
+func (r *RedisClient) PublishRoomAudio(ctx context.Context, roomID string, frame *RoomAudioFrame) error {
+	data, err := json.Marshal(frame)
+	if err != nil {
+		return err
+	}
+	return r.client.Publish(ctx, roomAudioChannel(roomID), data).Err()
+}
+
+// SubscribeRoomAudio subscribes to audio forwarded to the room (caller closes the PubSub)
+func (r *RedisClient) SubscribeRoomAudio(ctx context.Context, roomID string) *redis.PubSub {
+	return r.client.Subscribe(ctx, roomAudioChannel(roomID))
+}
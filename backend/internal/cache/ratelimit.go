@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// takeTokenScript refills the token bucket at KEYS[1] by elapsed server time and takes one token.
+// ARGV: rate (tokens/sec), burst, ttl (ms). Returns 1 when a token was taken, 0 when empty.
+// Uses the server clock so instances with skewed clocks share one consistent bucket.
+var takeTokenScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call("TIME")
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", now)
+redis.call("PEXPIRE", KEYS[1], ARGV[3])
+return allowed
+`)
+
+func rateLimitKey(key string) string {
+	return "ratelimit:" + key
+}
+
+// TakeRateToken takes one token from the shared bucket for key, refilled at rate tokens/sec
+// up to burst. Not retried: a lost reply would take a second token.
+func (r *RedisClient) TakeRateToken(ctx context.Context, key string, rate float64, burst int) (bool, error) {
+	// Keep the bucket until it would be full again anyway
+	ttl := time.Duration(float64(burst)/rate*float64(time.Second)) + time.Second
+	allowed, err := takeTokenScript.Run(ctx, r.client, []string{rateLimitKey(key)}, rate, burst, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return allowed == 1, nil
+}
@@ -3,23 +3,79 @@ package cache
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
+
+	"realtime-backend/internal/retry"
 )
 
+// redisRetryPolicy retries idempotent commands on connection errors and loading/busy replies
+// (appends like AddTranscript are not retried: a lost reply would duplicate the entry)
+var redisRetryPolicy = retry.Policy{
+	MaxAttempts:    3,
+	InitialBackoff: 50 * time.Millisecond,
+	MaxBackoff:     500 * time.Millisecond,
+	Jitter:         0.1,
+	Classify:       classifyRedisError,
+}
+
+// redisTransientReplies are server replies worth retrying
+var redisTransientReplies = []string{"LOADING", "BUSY", "TRYAGAIN", "CLUSTERDOWN", "MASTERDOWN"}
+
+// classifyRedisError never retries a missing key or a command error reply
+func classifyRedisError(err error) retry.Class {
+	if errors.Is(err, redis.Nil) {
+		return retry.Permanent
+	}
+	var replyErr redis.Error
+	if errors.As(err, &replyErr) {
+		for _, prefix := range redisTransientReplies {
+			if strings.HasPrefix(replyErr.Error(), prefix) {
+				return retry.Transient
+			}
+		}
+		return retry.Permanent
+	}
+	return retry.DefaultClassify(err)
+}
+
 // RoomTranscript represents a transcript entry for a room
 type RoomTranscript struct {
-	RoomID      string    `json:"roomId"`
-	SpeakerID   string    `json:"speakerId"`
-	SpeakerName string    `json:"speakerName"`
-	Original    string    `json:"original"`
-	Translated  string    `json:"translated,omitempty"`
-	SourceLang  string    `json:"sourceLang"`
-	TargetLang  string    `json:"targetLang,omitempty"`
-	IsFinal     bool      `json:"isFinal"`
-	Timestamp   time.Time `json:"timestamp"`
+	RoomID       string    `json:"roomId"`
+	TranscriptID string    `json:"transcriptId,omitempty"`
+	SpeakerID    string    `json:"speakerId"`
+	SpeakerName  string    `json:"speakerName"`
+	Original     string    `json:"original"`
+	Translated   string    `json:"translated,omitempty"`
+	SourceLang   string    `json:"sourceLang"`
+	TargetLang   string    `json:"targetLang,omitempty"`
+	IsFinal      bool      `json:"isFinal"`
+	Crosstalk    bool      `json:"crosstalk,omitempty"`
+	Moderated    bool      `json:"moderated,omitempty"` // Flagged by moderation (terms already masked)
+	Sequence     uint64    `json:"seq,omitempty"`       // Per-room utterance order (ai.Sequencer; 0 on older entries)
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+// OrderKey is the utterance order of a transcript: its sequence number, or for entries written
+// before sequences existed, its timestamp on the same microsecond scale
+func (t *RoomTranscript) OrderKey() uint64 {
+	if t.Sequence > 0 {
+		return t.Sequence
+	}
+	return uint64(t.Timestamp.UnixMicro())
+}
+
+// SortTranscripts orders transcripts by utterance (appends from concurrent goroutines can land
+// out of order); entries of the same utterance keep their list order
+func SortTranscripts(transcripts []RoomTranscript) {
+	sort.SliceStable(transcripts, func(i, j int) bool {
+		return transcripts[i].OrderKey() < transcripts[j].OrderKey()
+	})
 }
 
 // RedisClient wraps the Redis client for transcript caching
@@ -53,7 +109,9 @@ func NewRedisClient(addr, password string) (*RedisClient, error) {
 // AddTranscript adds a transcript to the room's list
 func (r *RedisClient) AddTranscript(ctx context.Context, roomID string, t *RoomTranscript) error {
 	key := "room:" + roomID + ":transcripts"
-	t.Timestamp = time.Now()
+	if t.Timestamp.IsZero() {
+		t.Timestamp = time.Now()
+	}
 
 	data, err := json.Marshal(t)
 	if err != nil {
@@ -76,7 +134,9 @@ func (r *RedisClient) AddTranscript(ctx context.Context, roomID string, t *RoomT
 func (r *RedisClient) GetTranscripts(ctx context.Context, roomID string) ([]RoomTranscript, error) {
 	key := "room:" + roomID + ":transcripts"
 
-	results, err := r.client.LRange(ctx, key, 0, -1).Result()
+	results, err := retry.DoValue(ctx, redisRetryPolicy, func(ctx context.Context) ([]string, error) {
+		return r.client.LRange(ctx, key, 0, -1).Result()
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -89,6 +149,7 @@ func (r *RedisClient) GetTranscripts(ctx context.Context, roomID string) ([]Room
 		}
 		transcripts = append(transcripts, t)
 	}
+	SortTranscripts(transcripts)
 
 	return transcripts, nil
 }
@@ -98,7 +159,9 @@ func (r *RedisClient) GetRecentTranscripts(ctx context.Context, roomID string, c
 	key := "room:" + roomID + ":transcripts"
 
 	// Get last N items
-	results, err := r.client.LRange(ctx, key, -count, -1).Result()
+	results, err := retry.DoValue(ctx, redisRetryPolicy, func(ctx context.Context) ([]string, error) {
+		return r.client.LRange(ctx, key, -count, -1).Result()
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +174,7 @@ func (r *RedisClient) GetRecentTranscripts(ctx context.Context, roomID string, c
 		}
 		transcripts = append(transcripts, t)
 	}
+	SortTranscripts(transcripts)
 
 	return transcripts, nil
 }
@@ -143,6 +207,24 @@ func (r *RedisClient) FlushRoom(ctx context.Context, roomID string) ([]RoomTrans
 	return transcripts, nil
 }
 
+// TrimTranscripts removes the first count transcripts of a room
+// (transcripts appended after they were read are kept)
+func (r *RedisClient) TrimTranscripts(ctx context.Context, roomID string, count int64) error {
+	key := "room:" + roomID + ":transcripts"
+	return r.client.LTrim(ctx, key, count, -1).Err()
+}
+
+// TranscriptRoomIDs returns the IDs of all rooms that have transcripts in Redis
+func (r *RedisClient) TranscriptRoomIDs(ctx context.Context) ([]string, error) {
+	var roomIDs []string
+	iter := r.client.Scan(ctx, 0, "room:*:transcripts", 100).Iterator()
+	for iter.Next(ctx) {
+		key := iter.Val()
+		roomIDs = append(roomIDs, strings.TrimSuffix(strings.TrimPrefix(key, "room:"), ":transcripts"))
+	}
+	return roomIDs, iter.Err()
+}
+
 // DeleteRoom removes all transcripts for a room
 func (r *RedisClient) DeleteRoom(ctx context.Context, roomID string) error {
 	key := "room:" + roomID + ":transcripts"
@@ -163,12 +245,55 @@ func (r *RedisClient) Health(ctx context.Context) error {
 
 // Set sets a key-value pair with expiration
 func (r *RedisClient) Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error {
-	return r.client.Set(ctx, key, value, expiration).Err()
+	return retry.Do(ctx, redisRetryPolicy, func(ctx context.Context) error {
+		return r.client.Set(ctx, key, value, expiration).Err()
+	})
 }
 
 // Get gets a value by key
 func (r *RedisClient) Get(ctx context.Context, key string) (string, error) {
-	return r.client.Get(ctx, key).Result()
+	return retry.DoValue(ctx, redisRetryPolicy, func(ctx context.Context) (string, error) {
+		return r.client.Get(ctx, key).Result()
+	})
+}
+
+// GetBytes gets a binary value by key (nil without error if the key does not exist)
+func (r *RedisClient) GetBytes(ctx context.Context, key string) ([]byte, error) {
+	data, err := retry.DoValue(ctx, redisRetryPolicy, func(ctx context.Context) ([]byte, error) {
+		return r.client.Get(ctx, key).Bytes()
+	})
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// SetBytes sets a binary value with expiration
+func (r *RedisClient) SetBytes(ctx context.Context, key string, value []byte, expiration time.Duration) error {
+	return retry.Do(ctx, redisRetryPolicy, func(ctx context.Context) error {
+		return r.client.Set(ctx, key, value, expiration).Err()
+	})
+}
+
+// Del deletes one or more keys
+func (r *RedisClient) Del(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return r.client.Del(ctx, keys...).Err()
+}
+
+// DeleteByPattern deletes all keys matching a glob pattern (SCAN, so it does not block the server)
+func (r *RedisClient) DeleteByPattern(ctx context.Context, pattern string) (int, error) {
+	var keys []string
+	iter := r.client.Scan(ctx, 0, pattern, 100).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return 0, err
+	}
+	return len(keys), r.Del(ctx, keys...)
 }
 
 // HGetAll gets all fields and values from a hash
@@ -195,3 +320,29 @@ func (r *RedisClient) SIsMember(ctx context.Context, key string, member interfac
 func (r *RedisClient) SRem(ctx context.Context, key string, members ...interface{}) error {
 	return r.client.SRem(ctx, key, members...).Err()
 }
+
+// roomSeqTTL keeps a room's sequence counter after the last final (a later counter restarts at the clock)
+const roomSeqTTL = 24 * time.Hour
+
+// nextRoomSeqScript increments the room counter and lifts it to the caller's floor (microsecond clock)
+// so sequences stay on the same scale as entries ordered by timestamp
+var nextRoomSeqScript = redis.NewScript(`
+local v = redis.call('INCR', KEYS[1])
+if v < tonumber(ARGV[1]) then
+	redis.call('SET', KEYS[1], ARGV[1])
+	v = tonumber(ARGV[1])
+end
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+return v
+`)
+
+// NextRoomSeq returns the next per-room utterance sequence shared by every instance serving the room
+// (at least floor and greater than any sequence issued before)
+func (r *RedisClient) NextRoomSeq(ctx context.Context, roomID string, floor uint64) (uint64, error) {
+	key := "room:" + roomID + ":seq"
+	seq, err := nextRoomSeqScript.Run(ctx, r.client, []string{key}, floor, roomSeqTTL.Milliseconds()).Int64()
+	if err != nil {
+		return 0, err
+	}
+	return uint64(seq), nil
+}
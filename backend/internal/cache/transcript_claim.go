@@ -0,0 +1,99 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Claimed transcripts are moved out of the room list into a per-claim list until the
+// database commit is confirmed, so a crash between the insert and the delete leaves the
+// claim behind to be recovered instead of losing or duplicating transcripts.
+const transcriptClaimTTL = 7 * 24 * time.Hour
+
+// claimTranscriptsScript atomically moves up to ARGV[1] transcripts from the head of the
+// room list to the claim list and registers the claim token
+var claimTranscriptsScript = redis.NewScript(`
+local items = redis.call("LRANGE", KEYS[1], 0, tonumber(ARGV[1]) - 1)
+if #items == 0 then
+	return items
+end
+redis.call("RPUSH", KEYS[2], unpack(items))
+redis.call("LTRIM", KEYS[1], #items, -1)
+redis.call("SADD", KEYS[3], ARGV[2])
+redis.call("EXPIRE", KEYS[2], ARGV[3])
+redis.call("EXPIRE", KEYS[3], ARGV[3])
+return items
+`)
+
+func transcriptsKey(roomID string) string {
+	return "room:" + roomID + ":transcripts"
+}
+
+func transcriptClaimKey(roomID, token string) string {
+	return "room:" + roomID + ":claim:" + token
+}
+
+func transcriptClaimsKey(roomID string) string {
+	return "room:" + roomID + ":claims"
+}
+
+func decodeTranscripts(results []string) []RoomTranscript {
+	transcripts := make([]RoomTranscript, 0, len(results))
+	for _, data := range results {
+		var t RoomTranscript
+		if err := json.Unmarshal([]byte(data), &t); err != nil {
+			continue
+		}
+		transcripts = append(transcripts, t)
+	}
+	return transcripts
+}
+
+// ClaimTranscripts moves up to count of the oldest transcripts of a room into a claim
+// identified by token and returns them (empty when the room has none left)
+func (r *RedisClient) ClaimTranscripts(ctx context.Context, roomID, token string, count int64) ([]RoomTranscript, error) {
+	keys := []string{transcriptsKey(roomID), transcriptClaimKey(roomID, token), transcriptClaimsKey(roomID)}
+	results, err := claimTranscriptsScript.Run(ctx, r.client, keys, count, token, int64(transcriptClaimTTL.Seconds())).StringSlice()
+	if err != nil {
+		return nil, err
+	}
+	return decodeTranscripts(results), nil
+}
+
+// TranscriptClaims returns the tokens of claims not released yet (left by a crash or a failed commit)
+func (r *RedisClient) TranscriptClaims(ctx context.Context, roomID string) ([]string, error) {
+	return r.client.SMembers(ctx, transcriptClaimsKey(roomID)).Result()
+}
+
+// ClaimedTranscripts returns the transcripts held by a claim
+func (r *RedisClient) ClaimedTranscripts(ctx context.Context, roomID, token string) ([]RoomTranscript, error) {
+	results, err := r.client.LRange(ctx, transcriptClaimKey(roomID, token), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	return decodeTranscripts(results), nil
+}
+
+// ReleaseTranscriptClaim deletes a claim once its transcripts are committed to the database
+func (r *RedisClient) ReleaseTranscriptClaim(ctx context.Context, roomID, token string) error {
+	pipe := r.client.TxPipeline()
+	pipe.Del(ctx, transcriptClaimKey(roomID, token))
+	pipe.SRem(ctx, transcriptClaimsKey(roomID), token)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// TranscriptClaimRoomIDs returns the IDs of all rooms that have unreleased claims
+func (r *RedisClient) TranscriptClaimRoomIDs(ctx context.Context) ([]string, error) {
+	var roomIDs []string
+	iter := r.client.Scan(ctx, 0, "room:*:claims", 100).Iterator()
+	for iter.Next(ctx) {
+		key := iter.Val()
+		roomIDs = append(roomIDs, strings.TrimSuffix(strings.TrimPrefix(key, "room:"), ":claims"))
+	}
+	return roomIDs, iter.Err()
+}
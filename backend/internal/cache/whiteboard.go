@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// whiteboardBufferTTL keeps an abandoned buffer around long enough for any instance to compact it
+const whiteboardBufferTTL = 24 * time.Hour
+
+// BufferedStroke is a live whiteboard stroke that has not been compacted into a snapshot yet
+type BufferedStroke struct {
+	Seq    int64           `json:"seq"` // Per-meeting sequence (becomes StartID/EndID of the snapshot)
+	UserID int64           `json:"userId"`
+	Data   json.RawMessage `json:"data"`
+	At     time.Time       `json:"at"`
+}
+
+func whiteboardKey(meetingID int64, suffix string) string {
+	return "whiteboard:" + strconv.FormatInt(meetingID, 10) + ":" + suffix
+}
+
+// SeedWhiteboardSeq makes sure the meeting's stroke sequence starts after floor
+// (the last compacted EndID), so a lost Redis key never reuses snapshot ranges
+func (r *RedisClient) SeedWhiteboardSeq(ctx context.Context, meetingID, floor int64) error {
+	return r.client.SetNX(ctx, whiteboardKey(meetingID, "seq"), floor, whiteboardBufferTTL).Err()
+}
+
+// AppendWhiteboardStroke assigns the next sequence number and appends the stroke to the buffer
+func (r *RedisClient) AppendWhiteboardStroke(ctx context.Context, meetingID, userID int64, data json.RawMessage) (*BufferedStroke, error) {
+	seqKey := whiteboardKey(meetingID, "seq")
+	seq, err := r.client.Incr(ctx, seqKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	stroke := &BufferedStroke{Seq: seq, UserID: userID, Data: data, At: time.Now()}
+	encoded, err := json.Marshal(stroke)
+	if err != nil {
+		return nil, err
+	}
+
+	bufferKey := whiteboardKey(meetingID, "buffer")
+	pipe := r.client.TxPipeline()
+	pipe.RPush(ctx, bufferKey, encoded)
+	pipe.Expire(ctx, bufferKey, whiteboardBufferTTL)
+	pipe.Expire(ctx, seqKey, whiteboardBufferTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+	return stroke, nil
+}
+
+// WhiteboardBuffer returns every buffered stroke in append order
+func (r *RedisClient) WhiteboardBuffer(ctx context.Context, meetingID int64) ([]BufferedStroke, error) {
+	values, err := r.client.LRange(ctx, whiteboardKey(meetingID, "buffer"), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	strokes := make([]BufferedStroke, 0, len(values))
+	for _, v := range values {
+		var s BufferedStroke
+		if err := json.Unmarshal([]byte(v), &s); err != nil {
+			continue
+		}
+		strokes = append(strokes, s)
+	}
+	return strokes, nil
+}
+
+// WhiteboardBufferLen returns the number of buffered strokes
+func (r *RedisClient) WhiteboardBufferLen(ctx context.Context, meetingID int64) (int64, error) {
+	return r.client.LLen(ctx, whiteboardKey(meetingID, "buffer")).Result()
+}
+
+// TrimWhiteboardBuffer drops the first count strokes (already compacted)
+func (r *RedisClient) TrimWhiteboardBuffer(ctx context.Context, meetingID, count int64) error {
+	return r.client.LTrim(ctx, whiteboardKey(meetingID, "buffer"), count, -1).Err()
+}
+
+// ClearWhiteboardBuffer drops every buffered stroke (the sequence keeps counting)
+func (r *RedisClient) ClearWhiteboardBuffer(ctx context.Context, meetingID int64) error {
+	return r.client.Del(ctx, whiteboardKey(meetingID, "buffer")).Err()
+}
+
+// LockWhiteboard takes the meeting's compaction lock so only one instance rewrites the buffer at a time
+func (r *RedisClient) LockWhiteboard(ctx context.Context, meetingID int64, owner string, ttl time.Duration) (bool, error) {
+	return r.client.SetNX(ctx, whiteboardKey(meetingID, "lock"), owner, ttl).Result()
+}
+
+// UnlockWhiteboard releases the compaction lock if owner still holds it
+func (r *RedisClient) UnlockWhiteboard(ctx context.Context, meetingID int64, owner string) error {
+	return releaseLeaseScript.Run(ctx, r.client, []string{whiteboardKey(meetingID, "lock")}, owner).Err()
+}
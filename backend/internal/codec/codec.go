@@ -0,0 +1,93 @@
+// Package codec 화자 업링크 오디오 디코딩 (압축 프레임 → Transcribe용 16-bit PCM)
+package codec
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// 업링크 오디오 코덱 이름
+const (
+	PCM  = "pcm"  // 16-bit signed little-endian mono (기본, 디코딩 없음)
+	Opus = "opus" // Opus 패킷 (프레임당 패킷 1개, libopus로 디코딩)
+)
+
+// ErrUnsupported 이 서버 빌드에서 지원하지 않는 코덱
+// (Opus는 libopus와 함께 -tags opus로 빌드해야 등록됨)
+var ErrUnsupported = errors.New("audio codec not supported by this server")
+
+// Decoder 한 오디오 스트림의 인코딩된 프레임을 16-bit little-endian mono PCM으로 변환
+// 스트림별 상태를 가지므로 동시에 사용하면 안 됨
+type Decoder interface {
+	Decode(frame []byte) ([]byte, error)
+	Close()
+}
+
+// Factory 출력 샘플레이트에 맞는 디코더 생성
+type Factory func(sampleRate int) (Decoder, error)
+
+var (
+	factories   = make(map[string]Factory)
+	factoriesMu sync.RWMutex
+)
+
+// Register 코덱 디코더 등록 (빌드 태그가 붙은 구현 파일의 init에서 호출)
+func Register(name string, factory Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	factories[Normalize(name)] = factory
+}
+
+// Normalize 클라이언트가 보내는 코덱 이름 정리 (빈 값이면 PCM)
+func Normalize(name string) string {
+	switch name = strings.ToLower(strings.TrimSpace(name)); name {
+	case "", "pcm", "l16", "s16le", "audio/l16":
+		return PCM
+	case "opus", "audio/opus":
+		return Opus
+	}
+	return name
+}
+
+// Supported 코덱 디코딩 가능 여부 (PCM은 항상 가능)
+func Supported(name string) bool {
+	name = Normalize(name)
+	if name == PCM {
+		return true
+	}
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+	_, ok := factories[name]
+	return ok
+}
+
+// SupportedList 지원하는 업링크 코덱 목록 (ready 응답용)
+func SupportedList() []string {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+
+	names := []string{PCM}
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names[1:])
+	return names
+}
+
+// NewDecoder 코덱 디코더 생성 (PCM이면 nil, 디코딩 불필요)
+func NewDecoder(name string, sampleRate int) (Decoder, error) {
+	name = Normalize(name)
+	if name == PCM {
+		return nil, nil
+	}
+
+	factoriesMu.RLock()
+	factory, ok := factories[name]
+	factoriesMu.RUnlock()
+	if !ok {
+		return nil, ErrUnsupported
+	}
+	return factory(sampleRate)
+}
@@ -0,0 +1,73 @@
+//go:build opus && cgo
+
+package codec
+
+/*
+#cgo pkg-config: opus
+#include <opus.h>
+*/
+import "C"
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"unsafe"
+)
+
+// opusMaxFrameMs Opus 패킷 하나의 최대 길이 (120ms)
+const opusMaxFrameMs = 120
+
+func init() {
+	Register(Opus, newOpusDecoder)
+}
+
+// opusDecoder libopus 디코더 (mono, 요청한 샘플레이트로 바로 디코딩)
+type opusDecoder struct {
+	dec *C.OpusDecoder
+	pcm []C.opus_int16
+}
+
+// newOpusDecoder libopus는 8/12/16/24/48kHz 출력만 지원
+func newOpusDecoder(sampleRate int) (Decoder, error) {
+	var code C.int
+	dec := C.opus_decoder_create(C.opus_int32(sampleRate), 1, &code)
+	if code != C.OPUS_OK || dec == nil {
+		return nil, fmt.Errorf("opus: create decoder (%d Hz): %s", sampleRate, C.GoString(C.opus_strerror(code)))
+	}
+	return &opusDecoder{
+		dec: dec,
+		pcm: make([]C.opus_int16, sampleRate*opusMaxFrameMs/1000),
+	}, nil
+}
+
+// Decode Opus 패킷 하나를 PCM으로 디코딩
+func (d *opusDecoder) Decode(frame []byte) ([]byte, error) {
+	if d.dec == nil {
+		return nil, errors.New("opus: decoder closed")
+	}
+	if len(frame) == 0 {
+		return nil, nil
+	}
+
+	n := C.opus_decode(d.dec,
+		(*C.uchar)(unsafe.Pointer(&frame[0])), C.opus_int32(len(frame)),
+		&d.pcm[0], C.int(len(d.pcm)), 0)
+	if n < 0 {
+		return nil, fmt.Errorf("opus: decode: %s", C.GoString(C.opus_strerror(n)))
+	}
+
+	out := make([]byte, int(n)*2)
+	for i := 0; i < int(n); i++ {
+		binary.LittleEndian.PutUint16(out[i*2:], uint16(d.pcm[i]))
+	}
+	return out, nil
+}
+
+// Close 디코더 해제 (여러 번 호출해도 안전)
+func (d *opusDecoder) Close() {
+	if d.dec != nil {
+		C.opus_decoder_destroy(d.dec)
+		d.dec = nil
+	}
+}
@@ -0,0 +1,113 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 버퍼 크기 프로필 (BUFFER_PROFILE)
+const (
+	BufferProfileSmall  = "small"
+	BufferProfileMedium = "medium"
+	BufferProfileLarge  = "large"
+)
+
+// 버퍼 크기 한도 (채널 하나에 잡히는 메모리가 지나치게 커지지 않도록)
+const (
+	minBufferSize = 1
+	maxBufferSize = 10000
+)
+
+// BufferConfig 파이프라인/Transcribe 스트림/Room 채널 크기
+// 프로필로 기본값을 고르고 항목별 환경 변수로 덮어씀 (가득 차면 메시지를 버리므로 트래픽에 맞게 조정)
+type BufferConfig struct {
+	Profile string
+
+	// 파이프라인 → Room (자막, TTS 오디오)
+	PipelineTranscripts int
+	PipelineAudio       int
+
+	// Transcribe 스트림 (인식 결과 대기열, 전송 전 화자 오디오)
+	StreamTranscripts int
+	StreamAudioIn     int
+
+	// Room (리스너 방송 대기열, 파이프라인 전달 전 화자 오디오)
+	RoomBroadcast int
+	RoomAudioIn   int
+}
+
+// bufferProfiles 프로필별 기본 크기 (medium = 기존 고정값)
+var bufferProfiles = map[string]BufferConfig{
+	BufferProfileSmall: {
+		PipelineTranscripts: 50,
+		PipelineAudio:       100,
+		StreamTranscripts:   50,
+		StreamAudioIn:       100,
+		RoomBroadcast:       50,
+		RoomAudioIn:         50,
+	},
+	BufferProfileMedium: {
+		PipelineTranscripts: 100,
+		PipelineAudio:       200,
+		StreamTranscripts:   100,
+		StreamAudioIn:       200,
+		RoomBroadcast:       100,
+		RoomAudioIn:         100,
+	},
+	BufferProfileLarge: {
+		PipelineTranscripts: 400,
+		PipelineAudio:       800,
+		StreamTranscripts:   400,
+		StreamAudioIn:       800,
+		RoomBroadcast:       400,
+		RoomAudioIn:         400,
+	},
+}
+
+// BufferProfile 프로필 이름의 기본 버퍼 크기 (없는 프로필이면 false)
+func BufferProfile(name string) (BufferConfig, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	profile, ok := bufferProfiles[name]
+	profile.Profile = name
+	return profile, ok
+}
+
+// Validate 모든 버퍼 크기가 허용 범위 안인지 확인
+func (b BufferConfig) Validate() error {
+	sizes := []struct {
+		name string
+		size int
+	}{
+		{"BUFFER_PIPELINE_TRANSCRIPTS", b.PipelineTranscripts},
+		{"BUFFER_PIPELINE_AUDIO", b.PipelineAudio},
+		{"BUFFER_STREAM_TRANSCRIPTS", b.StreamTranscripts},
+		{"BUFFER_STREAM_AUDIO_IN", b.StreamAudioIn},
+		{"BUFFER_ROOM_BROADCAST", b.RoomBroadcast},
+		{"BUFFER_ROOM_AUDIO_IN", b.RoomAudioIn},
+	}
+	for _, s := range sizes {
+		if s.size < minBufferSize || s.size > maxBufferSize {
+			return fmt.Errorf("%s must be between %d and %d, got %d", s.name, minBufferSize, maxBufferSize, s.size)
+		}
+	}
+	return nil
+}
+
+// loadBufferConfig BUFFER_PROFILE 기본값에 항목별 환경 변수 적용 (크기 범위는 Config.Validate에서 확인)
+func loadBufferConfig() BufferConfig {
+	name := getEnv("BUFFER_PROFILE", BufferProfileMedium)
+	b, ok := BufferProfile(name)
+	if !ok {
+		invalidEnv("BUFFER_PROFILE", name, fmt.Sprintf("must be %s, %s or %s",
+			BufferProfileSmall, BufferProfileMedium, BufferProfileLarge))
+		b, _ = BufferProfile(BufferProfileMedium)
+	}
+
+	b.PipelineTranscripts = getInt("BUFFER_PIPELINE_TRANSCRIPTS", b.PipelineTranscripts)
+	b.PipelineAudio = getInt("BUFFER_PIPELINE_AUDIO", b.PipelineAudio)
+	b.StreamTranscripts = getInt("BUFFER_STREAM_TRANSCRIPTS", b.StreamTranscripts)
+	b.StreamAudioIn = getInt("BUFFER_STREAM_AUDIO_IN", b.StreamAudioIn)
+	b.RoomBroadcast = getInt("BUFFER_ROOM_BROADCAST", b.RoomBroadcast)
+	b.RoomAudioIn = getInt("BUFFER_ROOM_AUDIO_IN", b.RoomAudioIn)
+	return b
+}
@@ -1,13 +1,14 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"log"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
-
-	"github.com/joho/godotenv"
 )
 
 // Config 애플리케이션 전체 설정
@@ -21,6 +22,12 @@ type Config struct {
 	S3        S3Config
 	LiveKit   LiveKitConfig
 	Redis     RedisConfig
+	Relay     RelayConfig
+	Presence  PresenceConfig
+	RateLimit RateLimitConfig
+	Buffers   BufferConfig
+	Email     EmailConfig
+	Scheduler SchedulerConfig
 }
 
 // RedisConfig ElastiCache/Valkey 설정
@@ -29,6 +36,82 @@ type RedisConfig struct {
 	Password string
 	Enabled  bool
 	DB       int
+
+	// 여러 백엔드 인스턴스가 같은 Room을 서비스 (자막/TTS를 Redis pub/sub으로 공유)
+	Fanout bool
+
+	// Room 소유권 임대 시간: 임대를 가진 인스턴스만 AWS 파이프라인 실행, 나머지는 오디오 전달
+	// (Fanout 사용 시, 음수 = 사용 안 함)
+	RoomLeaseTTL time.Duration
+}
+
+// RelayConfig 엣지 릴레이 설정
+type RelayConfig struct {
+	// 릴레이 노드가 Room 방송을 구독하는 gRPC 주소 (비어 있으면 사용 안 함, 예: ":9090")
+	GRPCAddr string
+	// 릴레이 인증용 공유 비밀 (gRPC metadata x-relay-secret, 비어 있으면 검사 안 함)
+	Secret string
+	// 클라이언트에 안내할 릴레이 목록 ("리전=wss://주소" 쉼표 구분)
+	Nodes []string
+}
+
+// PresenceConfig 외부 접속 상태 시스템 연동 (리스너/화자 입장·퇴장 훅)
+type PresenceConfig struct {
+	// 입장/퇴장 이벤트를 POST할 웹훅 주소 (비어 있으면 웹훅 훅 없음)
+	WebhookURL string
+	// 웹훅 서명 키 (X-Eum-Signature: HMAC-SHA256, 비어 있으면 서명 안 함)
+	WebhookSecret string
+	// 훅 호출 대기 큐 크기 (가득 차면 이벤트 버림)
+	QueueSize int
+}
+
+// RateLimitConfig 남용/오작동 클라이언트 제한 (토큰 버킷, Redis 사용 시 인스턴스 간 공유, 0 = 제한 없음)
+type RateLimitConfig struct {
+	Enabled bool
+
+	// /ws/* 업그레이드 (IP당 분당 횟수, 버스트)
+	WSUpgradesPerMinute float64
+	WSUpgradeBurst      int
+
+	// 채팅 메시지 (사용자당 초당 개수, 버스트)
+	ChatMessagesPerSecond float64
+	ChatMessageBurst      int
+
+	// 오디오 프레임 (Room의 화자당 초당 프레임, 버스트; 인스턴스 내 버킷만 사용)
+	AudioFramesPerSecond float64
+	AudioFrameBurst      int
+}
+
+// EmailConfig 이메일 알림 설정 (SMTP, Host가 비어 있으면 발송하지 않고 로그만 남김)
+type EmailConfig struct {
+	Enabled bool
+	From    string // 보내는 사람 (예: "EUM <no-reply@eum.example.com>")
+
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+
+	// 회의 시작 전 알림 시점, 발송 대기열 확인 주기, 실패 시 최대 시도 횟수
+	ReminderLead     time.Duration
+	DispatchInterval time.Duration
+	MaxAttempts      int
+
+	// 메일 본문에 표시하는 시각대 (IANA 이름)
+	TimeZone string
+}
+
+// SchedulerConfig 예정 회의 스케줄러 (시작 전 알림, 자동 시작, 최대 시간 초과 시 자동 종료)
+type SchedulerConfig struct {
+	Enabled  bool
+	Interval time.Duration
+
+	// 시작 전 알림 시점 (예: 15m,5m)
+	ReminderOffsets []time.Duration
+	// 예정 시각이 되면 IN_PROGRESS로 변경
+	AutoStart bool
+	// 시작 후 이 시간이 지나면 자동 종료 (0 = 제한 없음)
+	MaxDuration time.Duration
 }
 
 // S3Config AWS S3 설정
@@ -38,6 +121,32 @@ type S3Config struct {
 	AccessKeyID     string
 	SecretAccessKey string
 	PresignExpiry   time.Duration
+
+	// 휴지통 보관 기간 (지나면 DB 행과 S3 객체 영구 삭제)
+	TrashRetention time.Duration
+	// 시작 시 버킷 수명 주기 규칙(휴지통 태그 객체 만료, 미완료 멀티파트 정리) 설정
+	ManageLifecycle bool
+
+	// 업로드 파일 최대 크기 기본값 (워크스페이스 파일 정책으로 변경 가능)
+	MaxUploadSize int64
+	// 워크스페이스 저장 용량 한도 기본값 (0 = 무제한, 워크스페이스별로 변경 가능)
+	StorageQuota int64
+
+	// 서버 측 암호화: "" (버킷 기본), "AES256" (SSE-S3), "aws:kms" (SSE-KMS)
+	// 워크스페이스 컴플라이언스 설정에 KMS 키가 있으면 그 키로 SSE-KMS
+	Encryption string
+	KMSKeyID   string // SSE-KMS 기본 키 ARN ("" = AWS 관리형 키 aws/s3)
+
+	// CloudFront 서명 URL/쿠키 (도메인이 설정되면 다운로드를 S3 대신 CloudFront로 제공)
+	CloudFrontDomain         string
+	CloudFrontKeyPairID      string // 신뢰할 수 있는 키 그룹에 등록한 공개 키 ID
+	CloudFrontPrivateKey     string // PEM (줄바꿈은 \n 허용)
+	CloudFrontPrivateKeyPath string // PEM 파일 경로 (설정되면 CloudFrontPrivateKey보다 우선)
+	CloudFrontCookieDomain   string // 서명 쿠키 도메인 (API와 배포가 공유하는 상위 도메인)
+
+	// 업로드 파일 바이러스 검사 (clamd 주소, 비어 있으면 검사하지 않음, 예: "clamav:3310")
+	ClamAVAddr  string
+	ScanTimeout time.Duration // 파일 하나 검사 한도
 }
 
 // LiveKitConfig LiveKit 설정
@@ -54,6 +163,9 @@ type AuthConfig struct {
 	RefreshTokenExpiry time.Duration
 	GoogleClientID     string
 	SecureCookie       bool
+
+	// 운영자 API(/api/admin) 사용 가능한 계정 이메일 (비어 있으면 운영자 API 차단)
+	AdminEmails []string
 }
 
 // AIConfig AI 서버 설정
@@ -61,6 +173,97 @@ type AIConfig struct {
 	ServerAddr string
 	Enabled    bool
 	UseAWS     bool // true: AWS 직접 사용, false: Python gRPC 서버 사용
+
+	// TTS 재생 중 해당 화자의 partial 자막 억제 (Room 기본값, Room별로 변경 가능)
+	SuppressPartialsDuringTTS bool
+
+	// 아카이브 전용 언어 (실시간 청취자가 없으면 실시간 번역 대신 주기적으로 일괄 번역)
+	ArchiveLanguages     []string
+	ArchiveBatchInterval time.Duration
+
+	// Room별 Polly 문자 예산 (0 = 무제한, 한도 근접 시 질문/호명 문장만 TTS)
+	PollyCharBudget int64
+
+	// TTS 운율 (속도 %, 피치 %, 볼륨 dB), TTSAutoRate: 백프레셔 시 자동으로 속도 증가
+	TTSRate     int
+	TTSPitch    int
+	TTSVolumeDB int
+	TTSAutoRate bool
+
+	// 같은 화자의 새 final이 이전 final을 대체할 때 이전 TTS 처리 (none | signal | drop)
+	TTSInterrupt string
+
+	// 회의 시작 전 워밍업된 Room을 참가자 없이 유지하는 시간
+	WarmupTTL time.Duration
+
+	// 회의 중간에 들어온 리스너에게 보내는 최근 final 자막 수 (0 = 보내지 않음, join 시 ?history=로 변경)
+	HistoryTranscripts int
+
+	// 회의 녹음 조각 길이와 보관 기간 (0 = 계속 보관)
+	RecordingChunkDuration time.Duration
+	RecordingRetention     time.Duration
+
+	// 종료된 회의를 호스트가 같은 회의록으로 재개할 수 있는 시간 (0 = 재개 불가)
+	MeetingResumeWindow time.Duration
+
+	// Room별 프로세스 내 번역/TTS 캐시 한도 (LRU로 오래 안 쓴 항목부터 제거, 0 = 기본값)
+	CacheMaxTranslations int
+	CacheMaxTTSBytes     int64
+
+	// 번역/TTS 공유 캐시 (Redis 2차 캐시, Room/인스턴스 간 공유)
+	// SharedCacheMaxBytes보다 큰 항목(긴 TTS 오디오)은 프로세스 내 캐시에만 저장
+	SharedCacheEnabled  bool
+	SharedCacheTTL      time.Duration
+	SharedCacheMaxBytes int
+
+	// partial 문장 단위 실시간 번역+TTS 언어쌍 ("ko:ja", "en:*", "*" = 전체)
+	IncrementalPairs []string
+
+	// 슬로 모드 (호스트가 Room별로 켬): 화자별 partial 최소 전송 간격, 발화당 TTS 1회
+	SlowModePartialInterval time.Duration
+
+	// 화자 음성이 이 시간 동안 없으면 Room 파이프라인을 휴면시켜 keep-alive 중단 (음수 = 휴면하지 않음)
+	HibernateAfter time.Duration
+
+	// 파이프라인이 이 시간 이상 unhealthy면 부가 기능 비활성화, healthy가 이 시간 유지되면 복구
+	DowngradeAfter time.Duration
+	RecoverAfter   time.Duration
+
+	// 노이즈 필터: 언어별 최소 confidence ("ko:0.6,en:0.45"), 최소 글자 수
+	// (워크스페이스별 패턴/임계값은 noise-filter API로 관리)
+	NoiseThresholds    []string
+	NoiseMinTextLength int
+
+	// partial 자막 언어별 최소 글자 수 ("ja:4,en:3,zh:3", 워크스페이스/Room 단위로 재정의 가능)
+	PartialMinLengths []string
+
+	// 자막 모더레이션: 금칙어 마스킹 ("en:word,*:word") + Comprehend 유해성 감지 (영어만 지원)
+	ModerationEnabled           bool
+	ModerationWords             []string
+	ModerationToxicity          bool
+	ModerationToxicityThreshold float64
+
+	// 언어별 Transcribe 리전 ("ja:ap-northeast-1,zh:ap-east-1", 지정하지 않은 언어는 AWS_REGION)
+	// 다른 리전으로 보내는 언어는 커스텀 용어집(리전 리소스)을 사용하지 않음
+	TranscribeRegions []string
+
+	// 워크스페이스 월간 사용 한도 기본값 (0 = 무제한, 워크스페이스별로 quota API에서 변경)
+	QuotaTranscribeMinutes int64
+	QuotaPollyChars        int64
+
+	// Room당 동시에 번역/TTS할 수 있는 대상 언어 수 기본값 (0 = 무제한, 워크스페이스별로 quota API에서 변경)
+	// 초과 언어를 요청한 리스너는 이미 사용 중인 언어로 대체되고 language_unavailable 안내를 받음
+	MaxTargetLanguages int
+
+	// 발언 대기열(손들기) 활성화 시 동시에 발언권을 가질 수 있는 참가자 수 (Transcribe 슬롯)
+	SpeakerSlots int
+
+	// 회의 종료 시 Bedrock으로 회의 요약 생성 (모델 ID, 리전 - 비어 있으면 AWS_REGION)
+	SummaryEnabled bool
+	SummaryModelID string
+	SummaryRegion  string
+	// 생성된 요약 응답의 Redis 캐시 유지 시간 (회의록 버전이 바뀌면 무효화)
+	SummaryCacheTTL time.Duration
 }
 
 // ServerConfig HTTP 서버 설정
@@ -69,6 +272,23 @@ type ServerConfig struct {
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
 	IdleTimeout  time.Duration
+
+	// 외부 시스템에 돌려주는 참가 링크 (비어 있으면 경로만 반환)
+	AppURL      string // 프론트엔드 주소 (예: https://eum.example.com)
+	PublicWSURL string // 이 서버의 외부 WebSocket 주소 (예: wss://api.eum.example.com)
+
+	// 종료 시 진행 중인 TTS 전송과 자막 저장을 기다리는 최대 시간, 클라이언트에 안내하는 재접속 대기 시간
+	DrainTimeout   time.Duration
+	ReconnectDelay time.Duration
+
+	// block/mutex 프로파일 샘플링 (0 = 끔, /api/admin/debug/pprof/block·mutex가 비어 있음)
+	// 운영 중에는 PUT /api/admin/runtime/profile-rates로 잠깐 켜는 편이 안전
+	BlockProfileRate     int
+	MutexProfileFraction int
+
+	// 설정 파일 (.env 형식, 프로세스 환경 변수가 우선) 과 변경 감지 주기 (0 = SIGHUP으로만 다시 읽음)
+	ConfigFile           string
+	ConfigReloadInterval time.Duration
 }
 
 // WebSocketConfig WebSocket 관련 설정
@@ -77,6 +297,25 @@ type WebSocketConfig struct {
 	WriteBufferSize  int
 	HandshakeTimeout time.Duration
 	WriteTimeout     time.Duration
+
+	// Room 리스너별 송신 큐 (자막/제어 메시지, TTS/원음 오디오는 가득 차면 오래된 것부터 버림)
+	ListenerQueueSize      int
+	ListenerAudioQueueSize int
+
+	// 송신 큐가 이 시간 이상 계속 가득 차 있으면 느린 클라이언트로 보고 연결 종료
+	SlowListenerEvictAfter time.Duration
+
+	// 웨비나 Room 브로드캐스트를 리스너 그룹으로 나눠 동시에 큐에 넣는 워커 수
+	WebinarFanoutWorkers int
+
+	// /ws/room, /ws/audio 인증 필수 여부 (false면 토큰 없는 연결도 허용, 로컬 개발용)
+	RequireAuth bool
+
+	// 회의 입장 시 발급하는 Room WebSocket 토큰 유효 시간
+	RoomTokenTTL time.Duration
+
+	// POST /api/meetings/:id/join-token 으로 발급하는 입장 토큰 유효 시간 (WebSocket 연결 직전에 발급)
+	JoinTokenTTL time.Duration
 }
 
 // AudioConfig 오디오 처리 설정
@@ -89,35 +328,80 @@ type AudioConfig struct {
 
 // CORSConfig CORS 설정
 type CORSConfig struct {
-	AllowOrigins string
+	AllowOrigins string // 쉼표 구분 origin 목록 ("https://*.example.com" 허용, "*" 불가), 재시작 없이 변경 가능
 	AllowHeaders string
 }
 
-// Load 환경 변수에서 설정 로드
+// parse 중 발견한 형식 오류 (Parse가 모아서 반환)
+var (
+	parseMu   sync.Mutex
+	parseErrs []error
+)
+
+// Load 설정 파일과 환경 변수에서 설정 로드 (잘못된 값이 있으면 모두 출력하고 종료)
 func Load() *Config {
-	// .env 파일 로드 (없어도 에러 무시)
-	if err := godotenv.Load(); err != nil {
+	// 설정 파일 로드 (없어도 에러 무시)
+	if err := loadEnvFile(getEnv("CONFIG_FILE", ".env")); err != nil {
 		log.Println("ℹ️ No .env file found, using environment variables")
 	}
 
-	// 필수 환경 변수 검증
-	jwtSecret := getRequiredEnv("JWT_SECRET")
-	if jwtSecret == "change-this-secret-in-production" {
-		log.Fatal("🚨 CRITICAL: JWT_SECRET must be changed from default value in production!")
+	cfg, err := Parse()
+	if err != nil {
+		log.Fatalf("🚨 CRITICAL: invalid configuration:\n%v", err)
+	}
+	return cfg
+}
+
+// Parse 현재 환경 변수로 설정을 만들고 검증 (형식 오류와 Validate 결과를 모두 모아 반환)
+func Parse() (*Config, error) {
+	parseMu.Lock()
+	defer parseMu.Unlock()
+
+	parseErrs = nil
+	cfg := build()
+	errs := append(parseErrs, cfg.Validate())
+	parseErrs = nil
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
 	}
+	return cfg, nil
+}
 
+// build 환경 변수에서 설정 구성 (parseMu를 잡고 호출)
+func build() *Config {
 	return &Config{
 		Server: ServerConfig{
 			Port:         getEnv("PORT", ":8080"),
 			ReadTimeout:  getDuration("READ_TIMEOUT", 10*time.Second),
 			WriteTimeout: getDuration("WRITE_TIMEOUT", 10*time.Second),
 			IdleTimeout:  getDuration("IDLE_TIMEOUT", 120*time.Second),
+			AppURL:       strings.TrimRight(getEnv("APP_URL", ""), "/"),
+			PublicWSURL:  strings.TrimRight(getEnv("PUBLIC_WS_URL", ""), "/"),
+
+			DrainTimeout:   getDuration("SERVER_DRAIN_TIMEOUT", 20*time.Second),
+			ReconnectDelay: getDuration("SERVER_RECONNECT_DELAY", 3*time.Second),
+
+			BlockProfileRate:     getInt("PPROF_BLOCK_RATE", 0),
+			MutexProfileFraction: getInt("PPROF_MUTEX_FRACTION", 0),
+
+			ConfigFile:           getEnv("CONFIG_FILE", ".env"),
+			ConfigReloadInterval: getDuration("CONFIG_RELOAD_INTERVAL", 10*time.Second),
 		},
 		WebSocket: WebSocketConfig{
 			ReadBufferSize:   getInt("WS_READ_BUFFER_SIZE", 16*1024),
 			WriteBufferSize:  getInt("WS_WRITE_BUFFER_SIZE", 16*1024),
 			HandshakeTimeout: getDuration("WS_HANDSHAKE_TIMEOUT", 10*time.Second),
 			WriteTimeout:     getDuration("WS_WRITE_TIMEOUT", 5*time.Second),
+
+			ListenerQueueSize:      getInt("WS_LISTENER_QUEUE_SIZE", 64),
+			ListenerAudioQueueSize: getInt("WS_LISTENER_AUDIO_QUEUE_SIZE", 16),
+			SlowListenerEvictAfter: getDuration("WS_SLOW_LISTENER_EVICT_AFTER", 10*time.Second),
+			WebinarFanoutWorkers:   getInt("WS_WEBINAR_FANOUT_WORKERS", 8),
+
+			RequireAuth:  getBool("WS_REQUIRE_AUTH", true),
+			RoomTokenTTL: getDuration("WS_ROOM_TOKEN_TTL", 24*time.Hour),
+			JoinTokenTTL: getDuration("WS_JOIN_TOKEN_TTL", 5*time.Minute),
 		},
 		Audio: AudioConfig{
 			ChannelBufferSize: getInt("AUDIO_CHANNEL_BUFFER_SIZE", 100),
@@ -126,20 +410,79 @@ func Load() *Config {
 			ValidBitDepths:    []uint16{16, 32},
 		},
 		CORS: CORSConfig{
-			AllowOrigins: getEnv("CORS_ALLOW_ORIGINS", "*"),
+			AllowOrigins: getEnv("CORS_ALLOW_ORIGINS", "http://localhost:3000"),
 			AllowHeaders: getEnv("CORS_ALLOW_HEADERS", "Origin, Content-Type, Accept"),
 		},
 		AI: AIConfig{
 			ServerAddr: getEnv("AI_SERVER_ADDR", "localhost:50051"),
 			Enabled:    getBool("AI_ENABLED", false),
 			UseAWS:     getBool("AI_USE_AWS", false),
+
+			SuppressPartialsDuringTTS: getBool("AI_SUPPRESS_PARTIALS_DURING_TTS", false),
+
+			ArchiveLanguages:     getList("AI_ARCHIVE_LANGUAGES", nil),
+			ArchiveBatchInterval: getDuration("AI_ARCHIVE_BATCH_INTERVAL", 3*time.Minute),
+
+			PollyCharBudget: int64(getInt("AI_POLLY_CHAR_BUDGET", 0)),
+
+			TTSRate:     getInt("AI_TTS_RATE", 100),
+			TTSPitch:    getInt("AI_TTS_PITCH", 0),
+			TTSVolumeDB: getInt("AI_TTS_VOLUME_DB", 0),
+			TTSAutoRate: getBool("AI_TTS_AUTO_RATE", true),
+
+			TTSInterrupt: getEnv("AI_TTS_INTERRUPT", "signal"),
+
+			CacheMaxTranslations: getInt("AI_CACHE_MAX_TRANSLATIONS", 5000),
+			CacheMaxTTSBytes:     int64(getInt("AI_CACHE_MAX_TTS_BYTES", 16*1024*1024)),
+
+			SharedCacheEnabled:  getBool("AI_SHARED_CACHE_ENABLED", true),
+			SharedCacheTTL:      getDuration("AI_SHARED_CACHE_TTL", 24*time.Hour),
+			SharedCacheMaxBytes: getInt("AI_SHARED_CACHE_MAX_BYTES", 256*1024),
+
+			IncrementalPairs: getList("AI_INCREMENTAL_PAIRS", []string{"ko:ja"}),
+
+			DowngradeAfter: getDuration("AI_DOWNGRADE_AFTER", time.Minute),
+			RecoverAfter:   getDuration("AI_RECOVER_AFTER", time.Minute),
+
+			NoiseThresholds:    getList("AI_NOISE_THRESHOLDS", nil),
+			NoiseMinTextLength: getInt("AI_NOISE_MIN_TEXT_LENGTH", 2),
+			PartialMinLengths:  getList("AI_PARTIAL_MIN_LENGTHS", []string{"ja:4", "en:3", "zh:3"}),
+
+			ModerationEnabled:           getBool("AI_MODERATION_ENABLED", false),
+			ModerationWords:             getList("AI_MODERATION_WORDS", nil),
+			ModerationToxicity:          getBool("AI_MODERATION_TOXICITY", false),
+			ModerationToxicityThreshold: getFloat("AI_MODERATION_TOXICITY_THRESHOLD", 0.7),
+
+			TranscribeRegions: getList("AI_TRANSCRIBE_REGIONS", nil),
+			SpeakerSlots:      getInt("AI_SPEAKER_SLOTS", 3),
+
+			QuotaTranscribeMinutes: int64(getInt("AI_QUOTA_TRANSCRIBE_MINUTES", 0)),
+			QuotaPollyChars:        int64(getInt("AI_QUOTA_POLLY_CHARS", 0)),
+
+			MaxTargetLanguages: getInt("AI_MAX_TARGET_LANGUAGES", 0),
+
+			WarmupTTL:          getDuration("AI_WARMUP_TTL", 15*time.Minute),
+			HistoryTranscripts: getInt("AI_HISTORY_TRANSCRIPTS", 20),
+
+			SlowModePartialInterval: getDuration("AI_SLOW_MODE_PARTIAL_INTERVAL", 1500*time.Millisecond),
+			HibernateAfter:          getDuration("AI_HIBERNATE_AFTER", 10*time.Minute),
+
+			RecordingChunkDuration: getDuration("AI_RECORDING_CHUNK_DURATION", time.Minute),
+			RecordingRetention:     getDuration("AI_RECORDING_RETENTION", 0),
+			MeetingResumeWindow:    getDuration("AI_MEETING_RESUME_WINDOW", 30*time.Minute),
+
+			SummaryEnabled:  getBool("AI_SUMMARY_ENABLED", false),
+			SummaryModelID:  getEnv("AI_SUMMARY_MODEL_ID", "amazon.nova-lite-v1:0"),
+			SummaryRegion:   getEnv("AI_SUMMARY_REGION", ""),
+			SummaryCacheTTL: getDuration("AI_SUMMARY_CACHE_TTL", 24*time.Hour),
 		},
 		Auth: AuthConfig{
-			JWTSecret:          jwtSecret,
+			JWTSecret:          getRequiredEnv("JWT_SECRET"),
 			AccessTokenExpiry:  getDuration("ACCESS_TOKEN_EXPIRY", 1*time.Hour),
 			RefreshTokenExpiry: getDuration("REFRESH_TOKEN_EXPIRY", 7*24*time.Hour),
 			GoogleClientID:     getEnv("GOOGLE_CLIENT_ID", ""),
 			SecureCookie:       getBool("SECURE_COOKIE", false),
+			AdminEmails:        getList("ADMIN_EMAILS", nil),
 		},
 		S3: S3Config{
 			Region:          getEnv("AWS_REGION", "ap-northeast-2"),
@@ -147,6 +490,21 @@ func Load() *Config {
 			AccessKeyID:     getEnv("AWS_ACCESS_KEY_ID", ""),
 			SecretAccessKey: getEnv("AWS_SECRET_ACCESS_KEY", ""),
 			PresignExpiry:   getDuration("S3_PRESIGN_EXPIRY", 15*time.Minute),
+			TrashRetention:  getDuration("S3_TRASH_RETENTION", 30*24*time.Hour),
+			ManageLifecycle: getBool("S3_MANAGE_LIFECYCLE", false),
+			MaxUploadSize:   int64(getInt("S3_MAX_UPLOAD_SIZE_MB", 5*1024)) * 1024 * 1024,
+			StorageQuota:    int64(getInt("S3_WORKSPACE_QUOTA_MB", 0)) * 1024 * 1024,
+			Encryption:      getEnv("S3_SSE", ""),
+			KMSKeyID:        getEnv("S3_KMS_KEY_ID", ""),
+
+			CloudFrontDomain:         getEnv("CLOUDFRONT_DOMAIN", ""),
+			CloudFrontKeyPairID:      getEnv("CLOUDFRONT_KEY_PAIR_ID", ""),
+			CloudFrontPrivateKey:     getEnv("CLOUDFRONT_PRIVATE_KEY", ""),
+			CloudFrontPrivateKeyPath: getEnv("CLOUDFRONT_PRIVATE_KEY_PATH", ""),
+			CloudFrontCookieDomain:   getEnv("CLOUDFRONT_COOKIE_DOMAIN", ""),
+
+			ClamAVAddr:  getEnv("CLAMAV_ADDR", ""),
+			ScanTimeout: getDuration("CLAMAV_SCAN_TIMEOUT", 5*time.Minute),
 		},
 		LiveKit: LiveKitConfig{
 			Host:      getEnv("LIVEKIT_HOST", "ws://localhost:7880"),
@@ -158,15 +516,62 @@ func Load() *Config {
 			Password: getEnv("REDIS_PASSWORD", ""),
 			Enabled:  getBool("REDIS_ENABLED", false),
 			DB:       getInt("REDIS_DB", 0),
+			Fanout:   getBool("REDIS_FANOUT", false),
+
+			RoomLeaseTTL: getDuration("REDIS_ROOM_LEASE_TTL", 15*time.Second),
+		},
+		Relay: RelayConfig{
+			GRPCAddr: getEnv("RELAY_GRPC_ADDR", ""),
+			Secret:   getEnv("RELAY_SECRET", ""),
+			Nodes:    getList("RELAY_NODES", nil),
+		},
+		Presence: PresenceConfig{
+			WebhookURL:    getEnv("PRESENCE_WEBHOOK_URL", ""),
+			WebhookSecret: getEnv("PRESENCE_WEBHOOK_SECRET", ""),
+			QueueSize:     getInt("PRESENCE_QUEUE_SIZE", 256),
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:               getBool("RATE_LIMIT_ENABLED", true),
+			WSUpgradesPerMinute:   getFloat("RATE_LIMIT_WS_UPGRADES_PER_MINUTE", 60),
+			WSUpgradeBurst:        getInt("RATE_LIMIT_WS_UPGRADE_BURST", 20),
+			ChatMessagesPerSecond: getFloat("RATE_LIMIT_CHAT_MESSAGES_PER_SECOND", 2),
+			ChatMessageBurst:      getInt("RATE_LIMIT_CHAT_MESSAGE_BURST", 10),
+			AudioFramesPerSecond:  getFloat("RATE_LIMIT_AUDIO_FRAMES_PER_SECOND", 100),
+			AudioFrameBurst:       getInt("RATE_LIMIT_AUDIO_FRAME_BURST", 200),
+		},
+		Buffers: loadBufferConfig(),
+		Email: EmailConfig{
+			Enabled:          getBool("EMAIL_ENABLED", false),
+			From:             getEnv("EMAIL_FROM", "EUM <no-reply@localhost>"),
+			SMTPHost:         getEnv("SMTP_HOST", ""),
+			SMTPPort:         getInt("SMTP_PORT", 587),
+			SMTPUsername:     getEnv("SMTP_USERNAME", ""),
+			SMTPPassword:     getEnv("SMTP_PASSWORD", ""),
+			ReminderLead:     getDuration("EMAIL_REMINDER_LEAD", 15*time.Minute),
+			DispatchInterval: getDuration("EMAIL_DISPATCH_INTERVAL", time.Minute),
+			MaxAttempts:      getInt("EMAIL_MAX_ATTEMPTS", 5),
+			TimeZone:         getEnv("EMAIL_TIMEZONE", "Asia/Seoul"),
+		},
+		Scheduler: SchedulerConfig{
+			Enabled:         getBool("MEETING_SCHEDULER_ENABLED", true),
+			Interval:        getDuration("MEETING_SCHEDULER_INTERVAL", 30*time.Second),
+			ReminderOffsets: getDurationList("MEETING_REMINDER_OFFSETS", []time.Duration{15 * time.Minute, 5 * time.Minute}),
+			AutoStart:       getBool("MEETING_AUTO_START", true),
+			MaxDuration:     getDuration("MEETING_MAX_DURATION", 4*time.Hour),
 		},
 	}
 }
 
-// getRequiredEnv 필수 환경 변수 조회 (없으면 Fatal)
+// invalidEnv 형식이 잘못된 환경 변수 기록 (값은 기본값으로 대체되지만 Parse가 오류로 반환)
+func invalidEnv(key, value, want string) {
+	parseErrs = append(parseErrs, fmt.Errorf("%s=%q: %s", key, value, want))
+}
+
+// getRequiredEnv 필수 환경 변수 조회 (없으면 오류로 기록)
 func getRequiredEnv(key string) string {
 	value := os.Getenv(key)
 	if value == "" {
-		log.Fatalf("🚨 CRITICAL: Required environment variable %s is not set!", key)
+		parseErrs = append(parseErrs, fmt.Errorf("%s is required", key))
 	}
 	return value
 }
@@ -182,21 +587,76 @@ func getEnv(key, defaultValue string) string {
 // getInt 정수형 환경 변수 조회
 func getInt(key string, defaultValue int) int {
 	if value := os.Getenv(key); value != "" {
-		if intVal, err := strconv.Atoi(value); err == nil {
+		intVal, err := strconv.Atoi(value)
+		if err == nil {
 			return intVal
 		}
+		invalidEnv(key, value, "must be an integer")
 	}
 	return defaultValue
 }
 
-// getBool 불리언 환경 변수 조회
-func getBool(key string, defaultValue bool) bool {
+// getFloat 실수 환경 변수 조회
+func getFloat(key string, defaultValue float64) float64 {
 	if value := os.Getenv(key); value != "" {
-		return value == "true" || value == "1" || value == "yes"
+		floatVal, err := strconv.ParseFloat(value, 64)
+		if err == nil {
+			return floatVal
+		}
+		invalidEnv(key, value, "must be a number")
 	}
 	return defaultValue
 }
 
+// getBool 불리언 환경 변수 조회
+func getBool(key string, defaultValue bool) bool {
+	switch value := os.Getenv(key); value {
+	case "":
+		return defaultValue
+	case "true", "1", "yes":
+		return true
+	case "false", "0", "no":
+		return false
+	default:
+		invalidEnv(key, value, "must be true or false")
+		return defaultValue
+	}
+}
+
+// getList 쉼표로 구분된 목록 환경 변수 조회
+func getList(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// getDurationList 쉼표로 구분한 시간 목록
+func getDurationList(key string, defaultValue []time.Duration) []time.Duration {
+	values := getList(key, nil)
+	if values == nil {
+		return defaultValue
+	}
+	durations := make([]time.Duration, 0, len(values))
+	for _, v := range values {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			invalidEnv(key, v, "must be a positive duration like 15m")
+			continue
+		}
+		durations = append(durations, d)
+	}
+	return durations
+}
+
 // getDuration 시간 환경 변수 조회
 func getDuration(key string, defaultValue time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
@@ -209,6 +669,7 @@ func getDuration(key string, defaultValue time.Duration) time.Duration {
 		if duration, err := time.ParseDuration(value); err == nil {
 			return duration
 		}
+		invalidEnv(key, value, "must be a duration like 30s or 5m")
 	}
 	return defaultValue
 }
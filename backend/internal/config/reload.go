@@ -0,0 +1,220 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"reflect"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+// 설정 파일 상태 (프로세스 시작 시 이미 있던 환경 변수는 설정 파일보다 우선하고 다시 읽어도 바뀌지 않음)
+var envFile struct {
+	sync.Mutex
+	processEnv map[string]bool   // 설정 파일을 읽기 전부터 있던 키
+	values     map[string]string // 마지막으로 적용한 설정 파일 값
+}
+
+// loadEnvFile 설정 파일 값을 환경 변수에 반영 (파일에서 사라진 키는 제거)
+func loadEnvFile(path string) error {
+	envFile.Lock()
+	defer envFile.Unlock()
+
+	if envFile.processEnv == nil {
+		envFile.processEnv = make(map[string]bool)
+		for _, kv := range os.Environ() {
+			key, _, _ := strings.Cut(kv, "=")
+			envFile.processEnv[key] = true
+		}
+	}
+
+	values, err := godotenv.Read(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	for key := range envFile.values {
+		if _, ok := values[key]; !ok && !envFile.processEnv[key] {
+			os.Unsetenv(key)
+		}
+	}
+	for key, value := range values {
+		if !envFile.processEnv[key] {
+			os.Setenv(key, value)
+		}
+	}
+	envFile.values = values
+	return err
+}
+
+// Tunables 재시작 없이 바꿀 수 있는 설정 (진행 중인 Room은 유지한 채 적용)
+type Tunables struct {
+	CORSAllowOrigins string
+
+	// 노이즈 필터 기본값 (워크스페이스 설정이 있으면 그 값이 우선)
+	NoiseThresholds    []string
+	NoiseMinTextLength int
+	PartialMinLengths  []string
+
+	// 이후 웨비나로 전환되는 Room부터 적용 (실행 중인 브로드캐스트 워커는 그대로)
+	WebinarFanoutWorkers int
+}
+
+// Tunables 현재 설정의 Tunables
+func (c *Config) Tunables() Tunables {
+	return Tunables{
+		CORSAllowOrigins:     c.CORS.AllowOrigins,
+		NoiseThresholds:      c.AI.NoiseThresholds,
+		NoiseMinTextLength:   c.AI.NoiseMinTextLength,
+		PartialMinLengths:    c.AI.PartialMinLengths,
+		WebinarFanoutWorkers: c.WebSocket.WebinarFanoutWorkers,
+	}
+}
+
+func (c *Config) setTunables(t Tunables) {
+	c.CORS.AllowOrigins = t.CORSAllowOrigins
+	c.AI.NoiseThresholds = t.NoiseThresholds
+	c.AI.NoiseMinTextLength = t.NoiseMinTextLength
+	c.AI.PartialMinLengths = t.PartialMinLengths
+	c.WebSocket.WebinarFanoutWorkers = t.WebinarFanoutWorkers
+}
+
+// Reloader SIGHUP을 받거나 설정 파일이 바뀌면 설정을 다시 읽어 Tunables만 적용
+// 검증에 실패하면 아무것도 바꾸지 않고, 그 밖의 항목(포트, 자격 증명, 버퍼 크기 등)이 바뀌면 재시작이 필요하다고 경고만 남김
+type Reloader struct {
+	mu       sync.Mutex
+	current  *Config // 실행 중인 설정 (시작 시 설정 + 적용한 Tunables, 공유 포인터는 수정하지 않음)
+	handlers []func(Tunables)
+	modTime  time.Time
+
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewReloader Reloader 생성
+func NewReloader(cfg *Config) *Reloader {
+	return &Reloader{
+		current: cfg,
+		modTime: fileModTime(cfg.Server.ConfigFile),
+		stop:    make(chan struct{}),
+	}
+}
+
+// OnReload Tunables가 바뀌었을 때 호출할 함수 등록 (Start 전에 호출)
+func (r *Reloader) OnReload(fn func(Tunables)) {
+	r.handlers = append(r.handlers, fn)
+}
+
+// Start SIGHUP과 설정 파일 변경 감지 시작
+func (r *Reloader) Start() {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	interval := r.current.Server.ConfigReloadInterval
+
+	go func() {
+		defer signal.Stop(hup)
+		var tick <-chan time.Time
+		if interval > 0 {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			tick = ticker.C
+		}
+		for {
+			select {
+			case <-hup:
+				r.reload("SIGHUP")
+			case <-tick:
+				if r.fileChanged() {
+					r.reload("config file changed")
+				}
+			case <-r.stop:
+				return
+			}
+		}
+	}()
+	log.Printf("🔄 [Config] Watching %s (send SIGHUP to reload)", r.current.Server.ConfigFile)
+}
+
+// Stop 감지 중지
+func (r *Reloader) Stop() {
+	r.closeOnce.Do(func() {
+		close(r.stop)
+	})
+}
+
+func (r *Reloader) reload(reason string) {
+	if err := r.Reload(); err != nil {
+		log.Printf("❌ [Config] Reload (%s) failed, keeping current settings:\n%v", reason, err)
+	}
+}
+
+// Reload 설정 파일과 환경 변수를 다시 읽어 검증한 뒤 바뀐 Tunables 적용
+func (r *Reloader) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	path := r.current.Server.ConfigFile
+	r.modTime = fileModTime(path)
+	if err := loadEnvFile(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("read %s: %w", path, err)
+	}
+	next, err := Parse()
+	if err != nil {
+		return err
+	}
+
+	if changed := restartRequired(r.current, next); len(changed) > 0 {
+		log.Printf("⚠️ [Config] %s settings changed, restart to apply them", strings.Join(changed, ", "))
+	}
+
+	tunables := next.Tunables()
+	if reflect.DeepEqual(r.current.Tunables(), tunables) {
+		log.Printf("🔄 [Config] Reloaded, no tunable changes")
+		return nil
+	}
+
+	applied := *r.current
+	applied.setTunables(tunables)
+	r.current = &applied
+	for _, fn := range r.handlers {
+		fn(tunables)
+	}
+	log.Printf("🔄 [Config] Applied tunables: %+v", tunables)
+	return nil
+}
+
+// restartRequired Tunables 외에 값이 바뀐 설정 섹션 이름
+func restartRequired(current, next *Config) []string {
+	compare := *next
+	compare.setTunables(current.Tunables())
+
+	a, b := reflect.ValueOf(*current), reflect.ValueOf(compare)
+	var changed []string
+	for i := 0; i < a.NumField(); i++ {
+		if !reflect.DeepEqual(a.Field(i).Interface(), b.Field(i).Interface()) {
+			changed = append(changed, a.Type().Field(i).Name)
+		}
+	}
+	return changed
+}
+
+// fileChanged 마지막으로 읽은 뒤 설정 파일이 바뀌었는지 (ConfigMap 심볼릭 링크 교체도 감지)
+func (r *Reloader) fileChanged() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return !fileModTime(r.current.Server.ConfigFile).Equal(r.modTime)
+}
+
+func fileModTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
@@ -0,0 +1,225 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 검증 한도
+const (
+	minWSBufferSize       = 1024
+	maxWSBufferSize       = 1024 * 1024
+	maxFanoutWorkers      = 256
+	defaultJWTPlaceholder = "change-this-secret-in-production"
+)
+
+// Validate 설정 값 검증 (문제를 하나씩 고치지 않도록 모두 모아서 반환)
+func (c *Config) Validate() error {
+	var errs []error
+	add := func(format string, args ...any) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+	positive := func(key string, d time.Duration) {
+		if d <= 0 {
+			add("%s must be positive, got %s", key, d)
+		}
+	}
+	between := func(key string, v, lo, hi int) {
+		if v < lo || v > hi {
+			add("%s must be between %d and %d, got %d", key, lo, hi, v)
+		}
+	}
+
+	// 서버
+	if err := validateListenAddr(c.Server.Port); err != nil {
+		add("PORT=%q: %v", c.Server.Port, err)
+	}
+	positive("READ_TIMEOUT", c.Server.ReadTimeout)
+	positive("WRITE_TIMEOUT", c.Server.WriteTimeout)
+	positive("IDLE_TIMEOUT", c.Server.IdleTimeout)
+	if c.Server.ConfigReloadInterval < 0 {
+		add("CONFIG_RELOAD_INTERVAL must not be negative, got %s", c.Server.ConfigReloadInterval)
+	}
+	if err := validateURL(c.Server.AppURL, "http", "https"); err != nil {
+		add("APP_URL=%q: %v", c.Server.AppURL, err)
+	}
+	if err := validateURL(c.Server.PublicWSURL, "ws", "wss"); err != nil {
+		add("PUBLIC_WS_URL=%q: %v", c.Server.PublicWSURL, err)
+	}
+
+	// WebSocket / 버퍼
+	between("WS_READ_BUFFER_SIZE", c.WebSocket.ReadBufferSize, minWSBufferSize, maxWSBufferSize)
+	between("WS_WRITE_BUFFER_SIZE", c.WebSocket.WriteBufferSize, minWSBufferSize, maxWSBufferSize)
+	between("WS_LISTENER_QUEUE_SIZE", c.WebSocket.ListenerQueueSize, minBufferSize, maxBufferSize)
+	between("WS_LISTENER_AUDIO_QUEUE_SIZE", c.WebSocket.ListenerAudioQueueSize, minBufferSize, maxBufferSize)
+	between("WS_WEBINAR_FANOUT_WORKERS", c.WebSocket.WebinarFanoutWorkers, 1, maxFanoutWorkers)
+	between("AUDIO_CHANNEL_BUFFER_SIZE", c.Audio.ChannelBufferSize, minBufferSize, maxBufferSize)
+	positive("WS_HANDSHAKE_TIMEOUT", c.WebSocket.HandshakeTimeout)
+	positive("WS_WRITE_TIMEOUT", c.WebSocket.WriteTimeout)
+	positive("WS_ROOM_TOKEN_TTL", c.WebSocket.RoomTokenTTL)
+	positive("WS_JOIN_TOKEN_TTL", c.WebSocket.JoinTokenTTL)
+	if c.Audio.MaxChannels == 0 {
+		add("AUDIO_MAX_CHANNELS must be at least 1")
+	}
+	if err := c.Buffers.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	// CORS
+	if _, err := ParseOrigins(c.CORS.AllowOrigins); err != nil {
+		add("CORS_ALLOW_ORIGINS: %v", err)
+	}
+
+	// 인증
+	if c.Auth.JWTSecret == defaultJWTPlaceholder {
+		add("JWT_SECRET must be changed from the default value")
+	}
+
+	// AWS 자격 증명: S3와 AWS 파이프라인이 같은 키를 사용
+	if (c.S3.AccessKeyID == "") != (c.S3.SecretAccessKey == "") {
+		add("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set together")
+	}
+	if c.AI.UseAWS && c.S3.AccessKeyID == "" {
+		add("AI_USE_AWS=true requires AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY")
+	}
+	if c.S3.BucketName != "" && c.S3.AccessKeyID == "" {
+		add("AWS_S3_BUCKET is set but AWS_ACCESS_KEY_ID/AWS_SECRET_ACCESS_KEY are missing")
+	}
+	switch c.S3.Encryption {
+	case "", "AES256", "aws:kms":
+	default:
+		add("S3_SSE=%q: must be AES256 or aws:kms", c.S3.Encryption)
+	}
+	positive("S3_PRESIGN_EXPIRY", c.S3.PresignExpiry)
+	if c.S3.MaxUploadSize <= 0 {
+		add("S3_MAX_UPLOAD_SIZE_MB must be positive")
+	}
+	if c.S3.StorageQuota < 0 {
+		add("S3_WORKSPACE_QUOTA_MB must not be negative")
+	}
+
+	// AI
+	switch c.AI.TTSInterrupt {
+	case "none", "signal", "drop":
+	default:
+		add("AI_TTS_INTERRUPT=%q: must be none, signal or drop", c.AI.TTSInterrupt)
+	}
+	errs = append(errs, validateLanguageSpecs("AI_NOISE_THRESHOLDS", c.AI.NoiseThresholds, func(v string) bool {
+		t, err := strconv.ParseFloat(v, 32)
+		return err == nil && t >= 0 && t <= 1
+	}, "confidence must be between 0 and 1")...)
+	errs = append(errs, validateLanguageSpecs("AI_PARTIAL_MIN_LENGTHS", c.AI.PartialMinLengths, func(v string) bool {
+		n, err := strconv.Atoi(v)
+		return err == nil && n > 0
+	}, "length must be a positive integer")...)
+	if c.AI.NoiseMinTextLength < 0 {
+		add("AI_NOISE_MIN_TEXT_LENGTH must not be negative, got %d", c.AI.NoiseMinTextLength)
+	}
+	if t := c.AI.ModerationToxicityThreshold; t < 0 || t > 1 {
+		add("AI_MODERATION_TOXICITY_THRESHOLD must be between 0 and 1, got %g", t)
+	}
+	if c.AI.SpeakerSlots < 1 {
+		add("AI_SPEAKER_SLOTS must be at least 1, got %d", c.AI.SpeakerSlots)
+	}
+
+	// 엣지 릴레이 (비밀 없이 열면 누구나 Room 방송을 구독할 수 있음)
+	if c.Relay.GRPCAddr != "" && c.Relay.Secret == "" {
+		add("RELAY_GRPC_ADDR is set but RELAY_SECRET is empty")
+	}
+
+	// Redis / 이메일
+	if c.Redis.Enabled && c.Redis.Addr == "" {
+		add("REDIS_ENABLED=true requires REDIS_ADDR")
+	}
+	if c.Email.Enabled && c.Email.SMTPHost != "" {
+		between("SMTP_PORT", c.Email.SMTPPort, 1, 65535)
+	}
+	if c.Scheduler.Enabled {
+		positive("MEETING_SCHEDULER_INTERVAL", c.Scheduler.Interval)
+	}
+
+	// 요청 제한
+	if c.RateLimit.WSUpgradesPerMinute < 0 || c.RateLimit.ChatMessagesPerSecond < 0 || c.RateLimit.AudioFramesPerSecond < 0 {
+		add("RATE_LIMIT_* rates must not be negative")
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateListenAddr ":8080" 또는 "host:8080" 형식 확인
+func validateListenAddr(addr string) error {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("must look like :8080 or host:8080")
+	}
+	n, err := strconv.Atoi(port)
+	if err != nil || n < 1 || n > 65535 {
+		return fmt.Errorf("port must be between 1 and 65535")
+	}
+	return nil
+}
+
+// validateURL 비어 있거나 지정한 scheme의 절대 URL인지 확인
+func validateURL(raw string, schemes ...string) error {
+	if raw == "" {
+		return nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("must be an absolute URL")
+	}
+	for _, scheme := range schemes {
+		if u.Scheme == scheme {
+			return nil
+		}
+	}
+	return fmt.Errorf("scheme must be %s", strings.Join(schemes, " or "))
+}
+
+// validateLanguageSpecs "lang:value" 목록 형식 확인
+func validateLanguageSpecs(key string, specs []string, valid func(string) bool, want string) []error {
+	var errs []error
+	for _, spec := range specs {
+		lang, value, ok := strings.Cut(spec, ":")
+		if !ok || strings.TrimSpace(lang) == "" {
+			errs = append(errs, fmt.Errorf("%s: %q must look like lang:value", key, spec))
+			continue
+		}
+		if !valid(strings.TrimSpace(value)) {
+			errs = append(errs, fmt.Errorf("%s: %q: %s", key, spec, want))
+		}
+	}
+	return errs
+}
+
+// ParseOrigins CORS 허용 origin 목록 파싱 ("https://a.com,https://*.b.com")
+// 쿠키 인증을 쓰므로 "*"는 허용하지 않음 (소문자, 끝의 /를 뺀 scheme://host 형태로 반환)
+func ParseOrigins(spec string) ([]string, error) {
+	var origins []string
+	for _, origin := range strings.Split(spec, ",") {
+		origin = strings.TrimSpace(origin)
+		if origin == "" {
+			continue
+		}
+		if origin == "*" {
+			return nil, fmt.Errorf("\"*\" cannot be used with credentialed requests, list the allowed origins")
+		}
+		// 와일드카드 서브도메인은 "*." 부분을 빼고 형식 확인
+		check := strings.Replace(origin, "://*.", "://", 1)
+		u, err := url.Parse(check)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || strings.Contains(u.Host, "*") ||
+			(u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+			return nil, fmt.Errorf("invalid origin %q (use scheme://host[:port])", origin)
+		}
+		origins = append(origins, strings.ToLower(strings.TrimSuffix(origin, "/")))
+	}
+	if len(origins) == 0 {
+		return nil, fmt.Errorf("at least one origin is required")
+	}
+	return origins, nil
+}
@@ -95,13 +95,42 @@ func ConnectDB() (*gorm.DB, error) {
 		&model.Participant{},
 		&model.Whiteboard{},
 		&model.ChatLog{},
+		&model.ChatAttachment{},
+		&model.EmailNotification{},
+		&model.EmailPreference{},
 		&model.VoiceRecord{},
 		&model.CalendarEvent{},
 		&model.EventAttendee{},
 		&model.WorkspaceFile{},
+		&model.WorkspaceFilePolicy{},
+		&model.WorkspaceStorageUsage{},
+		&model.FilePreviewJob{},
+		&model.FileScanJob{},
 		&model.Notification{},
 		&model.WhiteboardStroke{},
 		&model.WhiteboardSnapshot{},
+		&model.WhiteboardExport{},
+		&model.WorkspaceVocabulary{},
+		&model.TranscriptAccessLog{},
+		&model.MeetingSummary{},
+		&model.MeetingRecording{},
+		&model.TTSArtifact{},
+		&model.NoiseFilterRule{},
+		&model.NoiseFilterThreshold{},
+		&model.MeetingFinalization{},
+		&model.MeetingFinalizationStep{},
+		&model.WorkspaceCompliance{},
+		&model.UsageRecord{},
+		&model.LanguagePairQualityRecord{},
+		&model.MeetingKeyword{},
+		&model.KeywordAlert{},
+		&model.WorkspaceQuota{},
+		&model.RoomWebhook{},
+		&model.WorkspaceWebhook{},
+		&model.WebhookDelivery{},
+		&model.MeetingSessionBreak{},
+		&model.MeetingReminder{},
+		&model.TranscriptClaim{},
 	); err != nil {
 		log.Printf("⚠️ AutoMigrate warning: %v", err)
 	}
@@ -120,6 +149,9 @@ func ConnectDB() (*gorm.DB, error) {
 	);
 	CREATE INDEX IF NOT EXISTS idx_whiteboard_strokes_meeting_created ON whiteboard_strokes (meeting_id, created_at);
 	ALTER TABLE whiteboard_strokes ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+
+	-- 발화 순서 조회 (기존 기록의 순번은 oneTimeMigrations에서 채움)
+	CREATE INDEX IF NOT EXISTS idx_voice_records_meeting_seq ON voice_records (meeting_id, seq);
 	
 	-- Manual migration for User Status features
 	ALTER TABLE users ADD COLUMN IF NOT EXISTS default_status varchar(20) DEFAULT 'ONLINE';
@@ -140,6 +172,11 @@ func ConnectDB() (*gorm.DB, error) {
 		log.Printf("⚠️ Manual Table Creation Warning: %v", err)
 	}
 
+	// 데이터 마이그레이션이 실패하면 순번/유니크 인덱스가 없는 상태로 뜨지 않도록 시작 중단
+	if err := runOneTimeMigrations(db); err != nil {
+		return nil, fmt.Errorf("one-time migration failed: %w", err)
+	}
+
 	return db, nil
 }
 
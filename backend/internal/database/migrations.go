@@ -0,0 +1,60 @@
+package database
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// oneTimeMigration 데이터를 고치는 마이그레이션 (테이블 전체를 훑으므로 부팅마다 실행하지 않음)
+type oneTimeMigration struct {
+	Name string
+	SQL  string
+}
+
+// oneTimeMigrations 실행 순서대로 나열 (이미 배포된 항목의 이름/SQL은 바꾸지 않음)
+var oneTimeMigrations = []oneTimeMigration{
+	{
+		// 발화 순서: 순번 도입 전 기록은 저장 시각(마이크로초)으로 채움 (새 순번과 같은 척도)
+		Name: "voice_records_backfill_seq",
+		SQL:  `UPDATE voice_records SET seq = (EXTRACT(EPOCH FROM created_at) * 1000000)::bigint WHERE seq = 0`,
+	},
+	{
+		// 채팅 멱등성 키: 키 하나짜리 인덱스를 (meeting_id, sender_id, idempotency_key) 유니크 인덱스로 교체
+		// 이미 중복 저장된 메시지는 가장 먼저 저장된 것만 키를 남김
+		Name: "chat_logs_unique_idempotency_key",
+		SQL: `UPDATE chat_logs c SET idempotency_key = NULL
+			WHERE c.idempotency_key IS NOT NULL AND EXISTS (
+				SELECT 1 FROM chat_logs o
+				WHERE o.meeting_id = c.meeting_id AND o.sender_id = c.sender_id
+					AND o.idempotency_key = c.idempotency_key AND o.id < c.id
+			);
+		DROP INDEX IF EXISTS idx_chat_idempotency;
+		CREATE UNIQUE INDEX idx_chat_idempotency ON chat_logs (meeting_id, sender_id, idempotency_key);`,
+	},
+}
+
+// runOneTimeMigrations 아직 적용하지 않은 마이그레이션 실행 (schema_migrations에 이름 기록)
+// 이름 기록과 SQL을 한 트랜잭션에서 실행하므로 여러 인스턴스가 동시에 시작해도 한 번만 적용됨
+func runOneTimeMigrations(db *gorm.DB) error {
+	if err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		name varchar(100) PRIMARY KEY,
+		applied_at timestamptz NOT NULL DEFAULT now()
+	)`).Error; err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+
+	for _, m := range oneTimeMigrations {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			claim := tx.Exec(`INSERT INTO schema_migrations (name) VALUES (?) ON CONFLICT (name) DO NOTHING`, m.Name)
+			if claim.Error != nil || claim.RowsAffected == 0 {
+				return claim.Error
+			}
+			return tx.Exec(m.SQL).Error
+		})
+		if err != nil {
+			return fmt.Errorf("migration %s: %w", m.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package export
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 회의록 텍스트 내보내기 형식
+const (
+	FormatSRT  = "srt"
+	FormatVTT  = "vtt"
+	FormatJSON = "json"
+	FormatTXT  = "txt"
+)
+
+// 자막 표시 시간 (다음 발화가 먼저 시작하면 그때 종료)
+const (
+	minCueDuration     = 2 * time.Second
+	maxCueDuration     = 7 * time.Second
+	cueDurationPerRune = 80 * time.Millisecond
+)
+
+// ErrUnsupportedFormat 지원하지 않는 내보내기 형식
+var ErrUnsupportedFormat = errors.New("unsupported export format")
+
+// ContentType 형식별 MIME 타입
+func ContentType(format string) string {
+	switch format {
+	case FormatSRT:
+		return "application/x-subrip; charset=utf-8"
+	case FormatVTT:
+		return "text/vtt; charset=utf-8"
+	case FormatJSON:
+		return "application/json"
+	default:
+		return "text/plain; charset=utf-8"
+	}
+}
+
+// Render 회의록을 텍스트 형식으로 렌더링
+// SRT/VTT는 첫 번째 섹션만 자막 트랙으로, JSON/TXT는 모든 섹션을 포함
+func Render(doc *TranscriptDocument, format string) ([]byte, error) {
+	switch format {
+	case FormatSRT:
+		return renderCues(doc, false), nil
+	case FormatVTT:
+		return renderCues(doc, true), nil
+	case FormatJSON:
+		return renderJSON(doc)
+	case FormatTXT:
+		return renderText(doc), nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// origin 자막 시각 기준 (회의 시작 시간, 없으면 첫 발화)
+func (d *TranscriptDocument) origin() time.Time {
+	if d.StartedAt != nil {
+		return *d.StartedAt
+	}
+	var first time.Time
+	for _, section := range d.Sections {
+		for _, entry := range section.Entries {
+			if first.IsZero() || entry.Timestamp.Before(first) {
+				first = entry.Timestamp
+			}
+		}
+	}
+	return first
+}
+
+// offset 기준 시각부터의 경과 시간 (기준보다 이르면 0)
+func (d *TranscriptDocument) offset(origin, t time.Time) time.Duration {
+	if t.Before(origin) {
+		return 0
+	}
+	return t.Sub(origin)
+}
+
+func cueDuration(text string) time.Duration {
+	d := time.Duration(utf8.RuneCountInString(text)) * cueDurationPerRune
+	return min(max(d, minCueDuration), maxCueDuration)
+}
+
+// renderCues SRT/WebVTT 자막 (화자 이름을 대사 앞에 표시)
+func renderCues(doc *TranscriptDocument, vtt bool) []byte {
+	var b strings.Builder
+	if vtt {
+		b.WriteString("WEBVTT\n\n")
+	}
+	if len(doc.Sections) == 0 {
+		return []byte(b.String())
+	}
+
+	origin := doc.origin()
+	entries := doc.Sections[0].Entries
+	cue := 0
+	for i, entry := range entries {
+		// 세션 구분은 자막이 아니므로 VTT에서만 NOTE로 남김
+		if entry.Break {
+			if vtt {
+				fmt.Fprintf(&b, "NOTE %s\n\n", entry.Text)
+			}
+			continue
+		}
+		cue++
+
+		start := doc.offset(origin, entry.Timestamp)
+		end := start + cueDuration(entry.Text)
+		if i+1 < len(entries) {
+			if next := doc.offset(origin, entries[i+1].Timestamp); next > start && next < end {
+				end = next
+			}
+		}
+
+		text := entry.Text
+		if vtt {
+			if entry.Speaker != "" {
+				text = fmt.Sprintf("<v %s>%s", entry.Speaker, entry.Text)
+			}
+			fmt.Fprintf(&b, "%s --> %s\n%s\n\n", cueTime(start, '.'), cueTime(end, '.'), text)
+			continue
+		}
+		if entry.Speaker != "" {
+			text = entry.Speaker + ": " + entry.Text
+		}
+		fmt.Fprintf(&b, "%d\n%s --> %s\n%s\n\n", cue, cueTime(start, ','), cueTime(end, ','), text)
+	}
+	return []byte(b.String())
+}
+
+// cueTime HH:MM:SS,mmm (SRT) / HH:MM:SS.mmm (VTT)
+func cueTime(d time.Duration, sep byte) string {
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d%c%03d", ms/3600000, ms/60000%60, ms/1000%60, sep, ms%1000)
+}
+
+// renderText 섹션별 "[경과 시간] 화자: 대사" 목록
+func renderText(doc *TranscriptDocument) []byte {
+	var b strings.Builder
+	if doc.Title != "" {
+		b.WriteString(doc.Title + "\n\n")
+	}
+	for i, section := range doc.Sections {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		if len(doc.Sections) > 1 {
+			fmt.Fprintf(&b, "== %s ==\n", section.Heading)
+		}
+		for _, entry := range section.Entries {
+			if entry.Break {
+				fmt.Fprintf(&b, "\n--- %s ---\n\n", entry.Text)
+				continue
+			}
+			fmt.Fprintf(&b, "[%s] %s: %s\n", doc.elapsed(entry.Timestamp), entry.Speaker, entry.Text)
+		}
+	}
+	return []byte(b.String())
+}
+
+type jsonEntry struct {
+	Speaker   string    `json:"speaker"`
+	Timestamp time.Time `json:"timestamp"`
+	OffsetMs  int64     `json:"offset_ms"`
+	Text      string    `json:"text"`
+	Break     bool      `json:"session_break,omitempty"`
+}
+
+type jsonSection struct {
+	Language string      `json:"language,omitempty"`
+	Heading  string      `json:"heading"`
+	Entries  []jsonEntry `json:"entries"`
+}
+
+// renderJSON 섹션/발화 구조를 그대로 JSON으로
+func renderJSON(doc *TranscriptDocument) ([]byte, error) {
+	origin := doc.origin()
+	sections := make([]jsonSection, len(doc.Sections))
+	for i, section := range doc.Sections {
+		entries := make([]jsonEntry, len(section.Entries))
+		for j, entry := range section.Entries {
+			entries[j] = jsonEntry{
+				Speaker:   entry.Speaker,
+				Timestamp: entry.Timestamp,
+				OffsetMs:  doc.offset(origin, entry.Timestamp).Milliseconds(),
+				Text:      entry.Text,
+				Break:     entry.Break,
+			}
+		}
+		sections[i] = jsonSection{Language: section.Language, Heading: section.Heading, Entries: entries}
+	}
+	return json.MarshalIndent(map[string]any{
+		"title":      doc.Title,
+		"started_at": doc.StartedAt,
+		"sections":   sections,
+	}, "", "  ")
+}
@@ -0,0 +1,36 @@
+package export
+
+import (
+	"time"
+)
+
+// TranscriptDocument 내보낼 회의록 문서
+type TranscriptDocument struct {
+	Title     string
+	StartedAt *time.Time
+	Sections  []TranscriptSection
+}
+
+// TranscriptSection 언어별 섹션 (원문, 각 번역 언어)
+type TranscriptSection struct {
+	Language string // 언어 코드 (원문 섹션은 "")
+	Heading  string
+	Entries  []TranscriptEntry
+}
+
+// TranscriptEntry 발화 한 건
+type TranscriptEntry struct {
+	Speaker   string
+	Timestamp time.Time
+	Text      string
+	Break     bool // 세션 구분 표시 (종료 후 재개된 회의, Text = 표시 문구)
+}
+
+// elapsed 회의 시작 기준 경과 시간 (시작 시간이 없으면 시각)
+func (d *TranscriptDocument) elapsed(t time.Time) string {
+	if d.StartedAt == nil || t.Before(*d.StartedAt) {
+		return t.Format("15:04:05")
+	}
+	e := t.Sub(*d.StartedAt)
+	return time.Time{}.Add(e).Format("15:04:05")
+}
@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"log"
+	"sort"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"realtime-backend/internal/auth"
+	awsai "realtime-backend/internal/aws"
+)
+
+// defaultAdminCloseReason 강제 종료 사유를 주지 않았을 때 참가자에게 보내는 문구
+const defaultAdminCloseReason = "room closed by an administrator"
+
+// AdminRoomSummary 활성 Room 요약 (관리자 Room 목록 항목)
+type AdminRoomSummary struct {
+	RoomID         string               `json:"roomId"`
+	WorkspaceID    int64                `json:"workspaceId,omitempty"`
+	Listeners      int                  `json:"listeners"`
+	Speakers       int                  `json:"speakers"`
+	RelayListeners int                  `json:"relayListeners"`
+	Running        bool                 `json:"running"`                  // 브로드캐스터/오디오 처리 루프 실행 중
+	PipelineStatus awsai.PipelineStatus `json:"pipelineStatus,omitempty"` // AWS 파이프라인이 없으면 생략
+}
+
+// AdminRoomDetail Room 상세 (참가자 목록, 파이프라인 상태, 스트림/워커 풀 통계)
+type AdminRoomDetail struct {
+	AdminRoomSummary
+	Participants  []RosterParticipant               `json:"participants"`
+	Pipeline      *awsai.PipelineHealth             `json:"pipeline,omitempty"`
+	StreamManager map[string]interface{}            `json:"streamManager,omitempty"`
+	WorkerPools   map[string]map[string]interface{} `json:"workerPools,omitempty"`
+}
+
+// =============================================================================
+// Room Methods - admin inspection
+// =============================================================================
+
+// adminSummary 관리자 목록용 Room 요약
+func (r *Room) adminSummary() AdminRoomSummary {
+	r.mu.RLock()
+	summary := AdminRoomSummary{
+		RoomID:         r.ID,
+		WorkspaceID:    r.workspaceID,
+		Listeners:      r.Listeners.Len(),
+		Speakers:       r.Speakers.Len(),
+		RelayListeners: r.relayListenerCount(),
+		Running:        r.isRunning,
+	}
+	pipeline := r.awsPipeline
+	r.mu.RUnlock()
+
+	if pipeline != nil {
+		summary.PipelineStatus = pipeline.Status()
+	}
+	return summary
+}
+
+// AdminDetail 관리자 조회용 Room 상세
+func (r *Room) AdminDetail() AdminRoomDetail {
+	detail := AdminRoomDetail{
+		AdminRoomSummary: r.adminSummary(),
+		Participants:     r.Roster().Participants,
+	}
+
+	r.mu.RLock()
+	pipeline := r.awsPipeline
+	r.mu.RUnlock()
+
+	if pipeline != nil {
+		detail.Pipeline = pipeline.GetHealth()
+		detail.StreamManager = pipeline.StreamManagerStats()
+		detail.WorkerPools = pipeline.WorkerPoolStats()
+	}
+	return detail
+}
+
+// ForceClose 관리자 강제 종료: 참가자에게 room_closed를 보낸 뒤 연결을 끊고 Room 정리
+// 회의 상태는 바꾸지 않으므로 참가자가 다시 접속하면 새 Room이 만들어짐
+func (r *Room) ForceClose(reason string) {
+	if reason == "" {
+		reason = defaultAdminCloseReason
+	}
+	log.Printf("[Room %s] 🛑 Force-closed by admin: %s", r.ID, reason)
+	r.Broadcast(&BroadcastMessage{
+		Type: "room_closed",
+		Data: map[string]string{"reason": reason},
+	})
+
+	time.AfterFunc(moderationCloseDelay, func() {
+		for _, l := range r.Listeners.Values() {
+			l.disconnect()
+		}
+		r.hub.RemoveRoom(r.ID)
+	})
+}
+
+// =============================================================================
+// RoomHub - admin inspection
+// =============================================================================
+
+// AdminRooms 이 인스턴스의 활성 Room 요약 (Room ID 순)
+func (h *RoomHub) AdminRooms() []AdminRoomSummary {
+	h.mu.RLock()
+	rooms := make([]*Room, 0, len(h.rooms))
+	for _, room := range h.rooms {
+		rooms = append(rooms, room)
+	}
+	h.mu.RUnlock()
+
+	summaries := make([]AdminRoomSummary, 0, len(rooms))
+	for _, room := range rooms {
+		summaries = append(summaries, room.adminSummary())
+	}
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].RoomID < summaries[j].RoomID })
+	return summaries
+}
+
+// =============================================================================
+// AdminHandler - REST API
+// =============================================================================
+
+// AdminHandler 운영자용 실시간 Room 조회/강제 종료 핸들러 (auth.RequireAdmin 뒤에서 사용)
+type AdminHandler struct {
+	roomHub *RoomHub
+}
+
+// NewAdminHandler AdminHandler 생성
+func NewAdminHandler(roomHub *RoomHub) *AdminHandler {
+	return &AdminHandler{roomHub: roomHub}
+}
+
+// AdminCloseRoomRequest Room 강제 종료 요청 (본문 생략 가능)
+type AdminCloseRoomRequest struct {
+	Reason string `json:"reason"`
+}
+
+// ListRooms 활성 Room 목록 (GET /api/admin/rooms)
+func (h *AdminHandler) ListRooms(c *fiber.Ctx) error {
+	if h.roomHub == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "room hub not available",
+		})
+	}
+
+	rooms := h.roomHub.AdminRooms()
+	listeners, speakers := 0, 0
+	for _, room := range rooms {
+		listeners += room.Listeners
+		speakers += room.Speakers
+	}
+	return c.JSON(fiber.Map{
+		"rooms":     rooms,
+		"total":     len(rooms),
+		"listeners": listeners,
+		"speakers":  speakers,
+		"draining":  h.roomHub.Draining(),
+	})
+}
+
+// GetRoom Room 상세 (GET /api/admin/rooms/:roomId)
+func (h *AdminHandler) GetRoom(c *fiber.Ctx) error {
+	room, err := h.room(c)
+	if room == nil {
+		return err
+	}
+	return c.JSON(room.AdminDetail())
+}
+
+// CloseRoom Room 강제 종료 (POST /api/admin/rooms/:roomId/close)
+func (h *AdminHandler) CloseRoom(c *fiber.Ctx) error {
+	room, err := h.room(c)
+	if room == nil {
+		return err
+	}
+
+	var req AdminCloseRoomRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "invalid request body",
+			})
+		}
+	}
+
+	claims := c.Locals("claims").(*auth.Claims)
+	log.Printf("[Admin] User %d force-closing room %s", claims.UserID, room.ID)
+	room.ForceClose(req.Reason)
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"roomId": room.ID,
+		"closed": true,
+	})
+}
+
+// room 경로의 Room 조회 (없으면 오류 응답을 쓰고 nil과 응답 쓰기 결과 반환)
+func (h *AdminHandler) room(c *fiber.Ctx) (*Room, error) {
+	if h.roomHub == nil {
+		return nil, c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "room hub not available",
+		})
+	}
+	room := h.roomHub.GetRoom(c.Params("roomId"))
+	if room == nil {
+		return nil, c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "room not found on this instance",
+		})
+	}
+	return room, nil
+}
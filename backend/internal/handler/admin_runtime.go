@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"realtime-backend/internal/session"
+)
+
+// processStart 업타임 계산 기준 (패키지 초기화 시각)
+var processStart = time.Now()
+
+// profileRates 현재 적용된 block/mutex 프로파일 샘플링 값 (runtime에서 다시 읽을 수 없어 직접 보관)
+var profileRates struct {
+	sync.Mutex
+	block int
+	mutex int
+}
+
+// SetProfileRates block/mutex 프로파일 샘플링 설정 (0 = 끔, 서버 시작 시와 관리자 API에서 호출)
+// block: 이 나노초 이상 블로킹된 이벤트를 평균 1회 기록 (1 = 전부), mutex: 경합 1/n 기록
+func SetProfileRates(block, mutex int) {
+	if block < 0 {
+		block = 0
+	}
+	if mutex < 0 {
+		mutex = 0
+	}
+
+	profileRates.Lock()
+	defer profileRates.Unlock()
+	runtime.SetBlockProfileRate(block)
+	runtime.SetMutexProfileFraction(mutex)
+	profileRates.block = block
+	profileRates.mutex = mutex
+}
+
+func currentProfileRates() AdminProfileRates {
+	profileRates.Lock()
+	defer profileRates.Unlock()
+	return AdminProfileRates{BlockRate: profileRates.block, MutexFraction: profileRates.mutex}
+}
+
+// AdminProfileRates block/mutex 프로파일 샘플링 설정
+type AdminProfileRates struct {
+	BlockRate     int `json:"blockRate"`
+	MutexFraction int `json:"mutexFraction"`
+}
+
+// AdminRuntimeStats 프로세스 런타임 상태 (고루틴 누수/GC 압박 확인용)
+type AdminRuntimeStats struct {
+	GoVersion  string `json:"goVersion"`
+	Uptime     string `json:"uptime"`
+	Goroutines int    `json:"goroutines"`
+	GOMAXPROCS int    `json:"gomaxprocs"`
+	NumCPU     int    `json:"numCpu"`
+	CgoCalls   int64  `json:"cgoCalls"`
+
+	Memory AdminMemoryStats `json:"memory"`
+	GC     AdminGCStats     `json:"gc"`
+
+	// 고루틴 수와 비교할 연결/Room 수 (세션이 줄었는데 고루틴만 늘면 누수)
+	Rooms    int                  `json:"rooms"`
+	Sessions map[session.Kind]int `json:"sessions"`
+
+	ProfileRates AdminProfileRates `json:"profileRates"`
+}
+
+// AdminMemoryStats 힙/스택 사용량 (바이트)
+type AdminMemoryStats struct {
+	HeapAlloc    uint64 `json:"heapAlloc"`
+	HeapInuse    uint64 `json:"heapInuse"`
+	HeapIdle     uint64 `json:"heapIdle"`
+	HeapReleased uint64 `json:"heapReleased"`
+	HeapObjects  uint64 `json:"heapObjects"`
+	StackInuse   uint64 `json:"stackInuse"`
+	Sys          uint64 `json:"sys"`
+	TotalAlloc   uint64 `json:"totalAlloc"`
+	Mallocs      uint64 `json:"mallocs"`
+	Frees        uint64 `json:"frees"`
+}
+
+// AdminGCStats GC 통계
+type AdminGCStats struct {
+	NumGC         uint32  `json:"numGc"`
+	NumForcedGC   uint32  `json:"numForcedGc"`
+	NextGC        uint64  `json:"nextGc"`
+	LastGC        string  `json:"lastGc,omitempty"`
+	LastPause     string  `json:"lastPause"`
+	PauseTotal    string  `json:"pauseTotal"`
+	GCCPUFraction float64 `json:"gcCpuFraction"`
+}
+
+// RuntimeStats 고루틴 수/메모리/GC 통계 (GET /api/admin/runtime)
+// 상세 스택과 힙 프로파일은 /api/admin/debug/pprof/ 에서 확인
+func (h *AdminHandler) RuntimeStats(c *fiber.Ctx) error {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	stats := AdminRuntimeStats{
+		GoVersion:  runtime.Version(),
+		Uptime:     time.Since(processStart).Round(time.Second).String(),
+		Goroutines: runtime.NumGoroutine(),
+		GOMAXPROCS: runtime.GOMAXPROCS(0),
+		NumCPU:     runtime.NumCPU(),
+		CgoCalls:   runtime.NumCgoCall(),
+		Memory: AdminMemoryStats{
+			HeapAlloc:    mem.HeapAlloc,
+			HeapInuse:    mem.HeapInuse,
+			HeapIdle:     mem.HeapIdle,
+			HeapReleased: mem.HeapReleased,
+			HeapObjects:  mem.HeapObjects,
+			StackInuse:   mem.StackInuse,
+			Sys:          mem.Sys,
+			TotalAlloc:   mem.TotalAlloc,
+			Mallocs:      mem.Mallocs,
+			Frees:        mem.Frees,
+		},
+		GC: AdminGCStats{
+			NumGC:         mem.NumGC,
+			NumForcedGC:   mem.NumForcedGC,
+			NextGC:        mem.NextGC,
+			LastPause:     time.Duration(mem.PauseNs[(mem.NumGC+255)%256]).String(),
+			PauseTotal:    time.Duration(mem.PauseTotalNs).String(),
+			GCCPUFraction: mem.GCCPUFraction,
+		},
+		Sessions:     session.Default.Counts(),
+		ProfileRates: currentProfileRates(),
+	}
+	if mem.LastGC > 0 {
+		stats.GC.LastGC = time.Unix(0, int64(mem.LastGC)).Format(time.RFC3339)
+	}
+	if h.roomHub != nil {
+		h.roomHub.mu.RLock()
+		stats.Rooms = len(h.roomHub.rooms)
+		h.roomHub.mu.RUnlock()
+	}
+
+	return c.JSON(stats)
+}
+
+// UpdateProfileRates block/mutex 프로파일 샘플링 변경 (PUT /api/admin/runtime/profile-rates)
+// 재시작 없이 잠깐 켜서 프로파일을 받은 뒤 다시 0으로 끄는 용도 (켜 두면 잠금마다 비용이 듦)
+func (h *AdminHandler) UpdateProfileRates(c *fiber.Ctx) error {
+	var req AdminProfileRates
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+	if req.BlockRate < 0 || req.MutexFraction < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "rates must not be negative",
+		})
+	}
+
+	SetProfileRates(req.BlockRate, req.MutexFraction)
+	return c.JSON(currentProfileRates())
+}
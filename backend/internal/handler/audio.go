@@ -2,6 +2,7 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
@@ -13,20 +14,25 @@ import (
 	"gorm.io/gorm"
 
 	"realtime-backend/internal/ai"
+	awsai "realtime-backend/internal/aws"
 	"realtime-backend/internal/cache"
+	"realtime-backend/internal/codec"
 	"realtime-backend/internal/auth"
 	"realtime-backend/internal/config"
+	"realtime-backend/internal/metrics"
 	"realtime-backend/internal/model"
+	"realtime-backend/internal/ratelimit"
 	"realtime-backend/internal/session"
 )
 
 // AudioHandler 오디오 WebSocket 핸들러
 type AudioHandler struct {
-    cfg         *config.Config
-    db          *gorm.DB
-    aiClient    *ai.GrpcClient
-    roomHub     *RoomHub
-    redisClient *cache.RedisClient
+	cfg         *config.Config
+	db          *gorm.DB
+	aiClient    *ai.GrpcClient
+	roomHub     *RoomHub
+	redisClient *cache.RedisClient
+	limiter     *ratelimit.Limiter // nil = 요청 제한 없음
 }
 
 // NewAudioHandler AudioHandler 생성자
@@ -44,6 +50,15 @@ func NewAudioHandler(cfg *config.Config, db *gorm.DB) *AudioHandler {
 		}
 	}
 
+	// 요청 제한 (Redis가 있으면 WebSocket 업그레이드/채팅 버킷을 인스턴스 간 공유)
+	if cfg.RateLimit.Enabled {
+		if handler.redisClient != nil {
+			handler.limiter = ratelimit.New(handler.redisClient)
+		} else {
+			handler.limiter = ratelimit.New(nil)
+		}
+	}
+
 	// AI 모드 결정
 	if cfg.AI.Enabled {
 		if cfg.AI.UseAWS {
@@ -74,6 +89,7 @@ func NewAudioHandler(cfg *config.Config, db *gorm.DB) *AudioHandler {
 
 // Close 핸들러 리소스 정리
 func (h *AudioHandler) Close() error {
+	h.limiter.Close()
 	if h.aiClient != nil {
 		if err := h.aiClient.Close(); err != nil {
 			log.Printf("⚠️ Error closing AI client: %v", err)
@@ -87,6 +103,27 @@ func (h *AudioHandler) Close() error {
 	return nil
 }
 
+// RateLimiter returns the shared rate limiter (nil when rate limiting is disabled)
+func (h *AudioHandler) RateLimiter() *ratelimit.Limiter {
+	return h.limiter
+}
+
+// allowAudioFrame applies the per-speaker audio frame limit; throttled tracks whether the
+// previous frame was dropped so a flood is logged once rather than per frame
+func (h *AudioHandler) allowAudioFrame(key string, throttled *bool) bool {
+	rule := ratelimit.Rule{Rate: h.cfg.RateLimit.AudioFramesPerSecond, Burst: h.cfg.RateLimit.AudioFrameBurst}
+	if h.limiter.AllowLocal("audio:"+key, rule) {
+		*throttled = false
+		return true
+	}
+	metrics.RateLimited.Inc(metrics.RateLimitAudioFrame)
+	if !*throttled {
+		*throttled = true
+		log.Printf("⚠️ Audio frame rate limit exceeded for %s (%.0f/s, burst %d), dropping frames", key, rule.Rate, rule.Burst)
+	}
+	return false
+}
+
 // GetRoomHub returns the RoomHub instance (for setting DB)
 func (h *AudioHandler) GetRoomHub() *RoomHub {
 	return h.roomHub
@@ -133,6 +170,7 @@ type RoomTranscriptResponse struct {
 	SourceLang  string    `json:"sourceLang"`
 	TargetLang  string    `json:"targetLang,omitempty"`
 	IsFinal     bool      `json:"isFinal"`
+	Seq         uint64    `json:"seq,omitempty"`
 	Timestamp   time.Time `json:"timestamp"`
 }
 
@@ -145,8 +183,9 @@ func (h *AudioHandler) HandleWebSocket(c *websocket.Conn) {
 		}
 	}()
 
-	// 세션 초기화
+	// 세션 초기화 (핸드셰이크 전에 거부된 연결도 세션 관리자에서 제거)
 	sess := session.New(h.cfg.Audio.ChannelBufferSize)
+	defer sess.Handle.Close()
 
 	// 소스 언어 파라미터 추출 (발화자가 말하는 언어)
 	if sourceLang, ok := c.Locals("sourceLang").(string); ok && sourceLang != "" {
@@ -166,6 +205,13 @@ func (h *AudioHandler) HandleWebSocket(c *websocket.Conn) {
 		log.Printf("👤 [%s] Participant ID: %s", sess.ID, participantId)
 	}
 
+	// 입장 토큰의 허용 언어가 아니면 거부 (/ws/room 과 같은 규칙)
+	if roomClaims, ok := c.Locals("roomClaims").(*auth.RoomClaims); ok && !roomClaims.AllowsLanguage(sess.GetLanguage()) {
+		log.Printf("❌ [%s] Target language not allowed by join token: %s", sess.ID, sess.GetLanguage())
+		h.sendErrorResponse(c, sess.ID, "LANGUAGE_NOT_ALLOWED", "target language is not allowed by join token")
+		return
+	}
+
 	// 권한 확인 (CONNECT_VOICE)
 	workspaceIDStr := c.Params("workspaceId")
 	// workspaceID가 없으면 글로벌 WS일 수도 있지만, 여기서는 워크스페이스 컨텍스트 가정
@@ -226,6 +272,21 @@ func (h *AudioHandler) HandleWebSocket(c *websocket.Conn) {
 	var wg sync.WaitGroup
 	var writeMu sync.Mutex // WebSocket 쓰기 동기화
 
+	// 서버 종료 시 재접속 안내
+	sess.Handle.OnDrain(func(notice session.DrainNotice) {
+		data, _ := json.Marshal(map[string]any{
+			"type":             "server_closing",
+			"reason":           notice.Reason,
+			"reconnectAfterMs": notice.ReconnectAfterMs,
+		})
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		_ = c.SetWriteDeadline(time.Now().Add(h.cfg.WebSocket.WriteTimeout))
+		if err := sess.Handle.Write(c, websocket.TextMessage, "server_closing", data); err != nil {
+			log.Printf("⚠️ [%s] Failed to send server_closing: %v", sess.ID, err)
+		}
+	})
+
 	// AI 모드 또는 에코 모드 선택 (핸드셰이크 완료 후)
 	if h.aiClient != nil {
 		// AI 모드: 단일 gRPC 스트림으로 통합
@@ -259,7 +320,7 @@ func (h *AudioHandler) HandleWebSocket(c *websocket.Conn) {
 
 		go func() {
 			defer wg.Done()
-			h.echoWorker(c, sess)
+			h.echoWorker(c, sess, &writeMu)
 		}()
 	}
 
@@ -333,6 +394,7 @@ func (h *AudioHandler) receiveLoop(c *websocket.Conn, sess *session.Session) {
 	var lastLogTime time.Time
 	var packetsSinceLog int64
 	var bytesSinceLog int64
+	throttled := false // 오디오 프레임 제한 중 (로그는 제한이 시작될 때만)
 
 	for {
 		select {
@@ -342,7 +404,7 @@ func (h *AudioHandler) receiveLoop(c *websocket.Conn, sess *session.Session) {
 		default:
 		}
 
-		messageType, msg, err := c.ReadMessage()
+		messageType, msg, err := sess.Handle.Read(c)
 		if err != nil {
 			if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
 				log.Printf("ℹ️ [%s] Client disconnected normally", sess.ID)
@@ -362,6 +424,9 @@ func (h *AudioHandler) receiveLoop(c *websocket.Conn, sess *session.Session) {
 		if len(msg) == 0 {
 			continue
 		}
+		if !h.allowAudioFrame(sess.ID, &throttled) {
+			continue
+		}
 
 		// Deep Copy
 		dataCopy := make([]byte, len(msg))
@@ -601,7 +666,7 @@ func (h *AudioHandler) aiResponseWorker(c *websocket.Conn, sess *session.Session
 				continue
 			}
 
-			if err := c.WriteMessage(websocket.BinaryMessage, data); err != nil {
+			if err := sess.Handle.Write(c, websocket.BinaryMessage, "", data); err != nil {
 				writeMu.Unlock()
 				log.Printf("⚠️ [%s] Failed to send AI audio response: %v", sess.ID, err)
 				return
@@ -641,7 +706,7 @@ func (h *AudioHandler) transcriptWorker(c *websocket.Conn, sess *session.Session
 				continue
 			}
 
-			if err := c.WriteMessage(websocket.TextMessage, jsonData); err != nil {
+			if err := sess.Handle.Write(c, websocket.TextMessage, msg.Type, jsonData); err != nil {
 				writeMu.Unlock()
 				log.Printf("⚠️ [%s] Failed to send transcript: %v", sess.ID, err)
 				return
@@ -692,7 +757,7 @@ func (h *AudioHandler) processingWorkerEcho(sess *session.Session) {
 }
 
 // echoWorker 에코 패킷을 클라이언트로 전송
-func (h *AudioHandler) echoWorker(c *websocket.Conn, sess *session.Session) {
+func (h *AudioHandler) echoWorker(c *websocket.Conn, sess *session.Session, writeMu *sync.Mutex) {
 	log.Printf("📤 [%s] Echo worker started", sess.ID)
 	defer log.Printf("📤 [%s] Echo worker stopped", sess.ID)
 
@@ -706,15 +771,19 @@ func (h *AudioHandler) echoWorker(c *websocket.Conn, sess *session.Session) {
 				return
 			}
 
+			writeMu.Lock()
 			if err := c.SetWriteDeadline(time.Now().Add(h.cfg.WebSocket.WriteTimeout)); err != nil {
+				writeMu.Unlock()
 				log.Printf("⚠️ [%s] Failed to set write deadline: %v", sess.ID, err)
 				continue
 			}
 
-			if err := c.WriteMessage(websocket.BinaryMessage, data); err != nil {
+			if err := sess.Handle.Write(c, websocket.BinaryMessage, "", data); err != nil {
+				writeMu.Unlock()
 				log.Printf("⚠️ [%s] Failed to send echo: %v", sess.ID, err)
 				return
 			}
+			writeMu.Unlock()
 		}
 	}
 }
@@ -748,6 +817,22 @@ func (h *AudioHandler) HandleRoomWebSocket(c *websocket.Conn) {
 	roomID, _ := c.Locals("roomId").(string)
 	listenerID, _ := c.Locals("listenerId").(string)
 	targetLang, _ := c.Locals("targetLang").(string)
+	capabilities, _ := c.Locals("capabilities").(string)
+	audioMode, _ := c.Locals("audioMode").(string)
+	ttsInterrupt, _ := c.Locals("ttsInterrupt").(string)
+	audioProfile, _ := c.Locals("audioProfile").(*awsai.AudioProfile)
+	historyCount, _ := c.Locals("historyCount").(int)
+	resumeTokenIn, _ := c.Locals("resumeToken").(string)
+	resumeFrom, _ := c.Locals("resumeFrom").(uint64)
+
+	// Room 토큰 역할 (listener는 오디오/화자 정보 전송 불가, 토큰 없이 허용된 연결은 제한 없음)
+	roomRole, _ := c.Locals("roomRole").(string)
+	canSpeak := roomRole != auth.RoomRoleListener
+	audioRejected := false // 거부 알림은 첫 오디오 프레임에만
+	throttled := false     // 오디오 프레임 제한 중 (로그는 제한이 시작될 때만)
+
+	// 입장 토큰 (허용 번역 언어 확인용, 토큰 없이 허용된 연결은 nil)
+	roomClaims, _ := c.Locals("roomClaims").(*auth.RoomClaims)
 
 	if roomID == "" || listenerID == "" {
 		log.Printf("❌ Room WebSocket: missing roomId or listenerId")
@@ -758,23 +843,114 @@ func (h *AudioHandler) HandleRoomWebSocket(c *websocket.Conn) {
 	if targetLang == "" {
 		targetLang = "en" // 기본값
 	}
+	if roomClaims != nil && !roomClaims.AllowsLanguage(targetLang) {
+		log.Printf("❌ [Room %s] Listener %s requested language not allowed by join token: %s", roomID, listenerID, targetLang)
+		h.sendRoomError(c, "LANGUAGE_NOT_ALLOWED", "target language is not allowed by join token")
+		return
+	}
+	if audioMode == "" {
+		audioMode = AudioModeTTS
+	}
+	if ttsInterrupt == "" {
+		ttsInterrupt = TTSInterruptKeep
+	}
+
+	// 종료 중인 서버에는 새 Room을 만들지 않음 (클라이언트는 다른 인스턴스로 재접속)
+	if h.roomHub.Draining() {
+		h.sendRoomError(c, "SERVER_CLOSING", serverClosingMessage)
+		return
+	}
 
 	log.Printf("🏠 [Room %s] New listener connected: %s (target: %s)", roomID, listenerID, targetLang)
 
 	// Room 가져오기 또는 생성
 	room := h.roomHub.GetOrCreateRoom(roomID)
 
-	// 리스너 등록
-	room.AddListener(listenerID, targetLang, c)
+	// 강퇴된 참가자, 잠긴 Room의 새 참가자 거부
+	if err := room.CheckJoin(listenerID); err != nil {
+		code := "ROOM_LOCKED"
+		if errors.Is(err, ErrRemovedFromRoom) {
+			code = "REMOVED"
+		}
+		h.sendRoomError(c, code, err.Error())
+		return
+	}
+
+	// 세션 관리자 등록 (연결 통계, 생명주기 콜백)
+	sess := session.Default.Open(session.KindRoom, "")
+	sess.SetRoomID(roomID)
+	sess.SetUserID(listenerID)
+	sess.SetMeta("targetLang", targetLang)
+	sess.OnDrain(func(notice session.DrainNotice) {
+		room.broadcastLocal(&BroadcastMessage{
+			Type:             "server_closing",
+			Data:             notice,
+			TargetListenerID: listenerID,
+		})
+	})
+	defer sess.Close()
+
+	// 리스너 등록 (capability 협상, Room 언어 수 한도를 넘으면 사용 중인 언어로 대체)
+	caps := ParseClientCapabilities(capabilities)
+	room.LoadLanguageLimit()
+	room.LoadWebinar()
+	langFallback := room.AddListener(listenerID, targetLang, caps, c, sess)
+	if langFallback != nil {
+		targetLang = langFallback.Fallback
+		sess.SetMeta("targetLang", targetLang)
+	}
+	if audioMode != AudioModeTTS {
+		room.SetListenerAudioMode(listenerID, audioMode)
+	}
+	if ttsInterrupt != TTSInterruptKeep {
+		room.SetListenerTTSInterrupt(listenerID, ttsInterrupt)
+	}
+	if audioProfile != nil {
+		room.SetListenerAudioProfile(listenerID, audioProfile)
+	}
 
-	// Ready 응답 전송
-	readyResponse := fmt.Sprintf(`{"status":"ready","roomId":"%s","listenerId":"%s","targetLang":"%s"}`,
-		roomID, listenerID, targetLang)
-	if err := c.WriteMessage(websocket.TextMessage, []byte(readyResponse)); err != nil {
+	// Ready 응답 전송 (협상된 capability 포함)
+	readyResponse, _ := json.Marshal(map[string]any{
+		"status":       "ready",
+		"roomId":       roomID,
+		"listenerId":   listenerID,
+		"targetLang":   targetLang,
+		"capabilities": caps.List(),
+		"audioMode":    audioMode,
+		"ttsInterrupt": ttsInterrupt,
+		"audioFormat":  audioFormatResponse(audioProfile),
+		"inputCodecs":  codec.SupportedList(),
+		"resumeToken":  room.IssueResumeToken(listenerID, resumeTokenIn),
+		"canSpeak":     canSpeak && room.CanSendAudio(listenerID),
+		"webinar":      room.IsWebinar(),
+	})
+	if err := room.WriteToListener(listenerID, readyResponse); err != nil {
 		log.Printf("❌ [Room %s] Failed to send ready response: %v", roomID, err)
 		room.RemoveListener(listenerID)
 		return
 	}
+	room.SendSpeakerQueueState(listenerID)
+	room.SendBreakoutState(listenerID)
+	room.SendTTSLanguageState(listenerID)
+	room.SendSlowModeState(listenerID)
+	room.SendModerationState(listenerID)
+	room.SendRoster(listenerID)
+	room.SendWebinarState(listenerID)
+	if room.isModerator(listenerID) {
+		room.SendKeywordWatchlist(listenerID) // 감시 키워드는 호스트에게만
+	}
+	if langFallback != nil {
+		room.SendLanguageFallback(listenerID, langFallback)
+	}
+	if resumeTokenIn != "" {
+		// 재접속: 놓친 메시지만 재전송 (토큰이 만료됐으면 history로 대체)
+		if err := room.Resume(listenerID, resumeTokenIn, resumeFrom); err != nil {
+			room.sendResumeError(listenerID, err)
+			room.SendHistory(listenerID, targetLang, historyCount)
+		}
+	} else {
+		room.SendHistory(listenerID, targetLang, historyCount)
+	}
 
 	// 연결 종료 시 정리
 	defer func() {
@@ -783,6 +959,7 @@ func (h *AudioHandler) HandleRoomWebSocket(c *websocket.Conn) {
 		// When listener A disconnects, we need to clean up speaker B's Transcribe stream,
 		// not speaker A (who may not exist as a speaker).
 		room.RemoveSpeakersForSender(listenerID)
+		room.leaveSpeakerQueue(listenerID)
 		room.RemoveListener(listenerID)
 		log.Printf("🔌 [Room %s] Listener disconnected: %s", roomID, listenerID)
 		c.Close()
@@ -790,7 +967,7 @@ func (h *AudioHandler) HandleRoomWebSocket(c *websocket.Conn) {
 
 	// 오디오 수신 루프 (리스너가 캡처한 원격 참가자 오디오)
 	for {
-		messageType, msg, err := c.ReadMessage()
+		messageType, msg, err := sess.Read(c)
 		if err != nil {
 			if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
 				log.Printf("ℹ️ [Room %s] Listener %s disconnected normally", roomID, listenerID)
@@ -802,6 +979,13 @@ func (h *AudioHandler) HandleRoomWebSocket(c *websocket.Conn) {
 
 		// 바이너리 메시지 = 오디오 데이터
 		if messageType == websocket.BinaryMessage && len(msg) > 0 {
+			if !canSpeak {
+				if !audioRejected {
+					audioRejected = true
+					room.sendRoleError(listenerID, "audio")
+				}
+				continue
+			}
 			// 메시지 형식: [speakerId(36 bytes)][sourceLang(2 bytes)][audio data]
 			if len(msg) < 38 {
 				log.Printf("⚠️ [Room %s] Binary message too short: %d bytes (need >= 38)", roomID, len(msg))
@@ -812,7 +996,23 @@ func (h *AudioHandler) HandleRoomWebSocket(c *websocket.Conn) {
 
 			speakerID := strings.TrimSpace(string(msg[:36]))
 			sourceLang := strings.TrimSpace(string(msg[36:38]))
-			audioData := msg[38:]
+			// 웨비나: 지정 화자가 아닌 참가자의 오디오는 화자 등록 없이 버림
+			if !room.CanSendAudio(speakerID) {
+				if !audioRejected {
+					audioRejected = true
+					room.sendWebinarError(listenerID, ErrNotWebinarSpeaker)
+				}
+				continue
+			}
+			if !h.allowAudioFrame(roomID+":"+speakerID, &throttled) {
+				continue
+			}
+
+			// speaker_info로 Opus를 선언한 화자는 PCM으로 디코딩
+			audioData, ok := room.decodeSpeakerAudio(speakerID, msg[38:])
+			if !ok {
+				continue
+			}
 
 			// Speaker 정보 업데이트 - DB에서 가져오기 (speaker가 없을 때만 조회)
 			if !room.HasSpeaker(speakerID) {
@@ -838,10 +1038,47 @@ func (h *AudioHandler) HandleRoomWebSocket(c *websocket.Conn) {
 				TargetLang string `json:"targetLang"`
 				Nickname   string `json:"nickname"`
 				ProfileImg string `json:"profileImg"`
+				Enabled    *bool  `json:"enabled,omitempty"`
+
+				// speaker_info: 화자 오디오 코덱 (pcm | opus, 빈 값이면 변경 없음)
+				Codec string `json:"codec"`
+
+				// voice_preference
+				VoiceID      string `json:"voiceId"`
+				Engine       string `json:"engine"`
+				SpeakingRate int    `json:"speakingRate"`
+
+				// incremental_translation
+				Pairs []string `json:"pairs"`
+
+				// partial_min_lengths
+				Lengths map[string]int `json:"lengths"`
+
+				// audio_mode (tts | original | both), tts_interrupt (keep | drop),
+				// tts_interrupt_policy (none | signal | drop)
+				Mode string `json:"mode"`
+
+				// speaker_queue_mode, grant_speaker, revoke_speaker, breakout_move, webinar_speaker,
+				// mute_speaker, unmute_speaker, remove_participant (participantId 대상)
+				ParticipantID string `json:"participantId"`
+
+				// breakout_create, breakout_move, breakout_close (브레이크아웃 이름, 이동 시 빈 값이면 메인 Room)
+				Breakout string `json:"breakout"`
+
+				// keyword_watchlist (빈 배열이면 감시 중지)
+				Keywords []string `json:"keywords"`
 			}
 			if err := json.Unmarshal(msg, &controlMsg); err == nil {
+				if !canSpeak && speakerOnlyControls[controlMsg.Type] {
+					room.sendRoleError(listenerID, controlMsg.Type)
+					continue
+				}
 				switch controlMsg.Type {
 				case "speaker_info":
+					if !room.CanSendAudio(strings.TrimSpace(controlMsg.SpeakerID)) {
+						room.sendWebinarError(listenerID, ErrNotWebinarSpeaker)
+						break
+					}
 					room.AddOrUpdateSpeaker(
 						controlMsg.SpeakerID,
 						controlMsg.SourceLang,
@@ -850,6 +1087,12 @@ func (h *AudioHandler) HandleRoomWebSocket(c *websocket.Conn) {
 					)
 					log.Printf("📢 [Room %s] Speaker info updated: %s (%s)",
 						roomID, controlMsg.Nickname, controlMsg.SourceLang)
+					if controlMsg.Codec != "" {
+						if err := room.SetSpeakerCodec(strings.TrimSpace(controlMsg.SpeakerID), controlMsg.Codec); err != nil {
+							log.Printf("⚠️ [Room %s] Speaker %s requested codec %q: %v", roomID, controlMsg.SpeakerID, controlMsg.Codec, err)
+							room.sendSpeakerCodecError(listenerID, controlMsg.Codec, err)
+						}
+					}
 
 				case "speaker_leave":
 					// 스피커가 방을 나갔을 때 Transcribe 스트림 종료
@@ -858,17 +1101,208 @@ func (h *AudioHandler) HandleRoomWebSocket(c *websocket.Conn) {
 
 				case "update_target_language":
 					// 리스너의 타겟 언어 업데이트
-					if controlMsg.TargetLang != "" {
-						room.UpdateListenerTargetLang(listenerID, controlMsg.TargetLang)
-						log.Printf("🌐 [Room %s] Listener %s updated target language to: %s",
+					if targetLang := awsai.NormalizeLanguage(controlMsg.TargetLang); awsai.IsSupportedLanguage(targetLang) {
+						if roomClaims != nil && !roomClaims.AllowsLanguage(targetLang) {
+							room.sendPermissionError(listenerID, controlMsg.Type, "language not allowed by join token")
+						} else if fallback := room.UpdateListenerTargetLang(listenerID, targetLang); fallback != nil {
+							// Room 언어 수 한도 초과: 현재 언어 유지
+							room.SendLanguageFallback(listenerID, fallback)
+						} else {
+							sess.SetMeta("targetLang", targetLang)
+							log.Printf("🌐 [Room %s] Listener %s updated target language to: %s",
+								roomID, listenerID, targetLang)
+						}
+					} else if controlMsg.TargetLang != "" {
+						log.Printf("⚠️ [Room %s] Listener %s requested unsupported language: %s",
 							roomID, listenerID, controlMsg.TargetLang)
 					}
+
+				case "voice_preference":
+					// 리스너의 TTS 음성 설정 (voiceId, engine, speakingRate; 빈 값이면 기본 음성)
+					voice := &awsai.VoicePreference{
+						VoiceID:      controlMsg.VoiceID,
+						Engine:       controlMsg.Engine,
+						SpeakingRate: controlMsg.SpeakingRate,
+					}
+					if err := awsai.ValidateVoicePreference(voice); err != nil {
+						log.Printf("⚠️ [Room %s] Listener %s sent invalid voice preference: %v", roomID, listenerID, err)
+						break
+					}
+					room.SetListenerVoice(listenerID, voice)
+
+				case "incremental_translation":
+					// partial 문장 단위 실시간 번역+TTS 언어쌍 설정 (Room 단위, 호스트 전용, 빈 배열이면 비활성화)
+					if err := room.SetIncrementalPairs(listenerID, controlMsg.Pairs); err != nil {
+						room.sendModerationError(listenerID, controlMsg.Type, err)
+					}
+
+				case "partial_suppression":
+					// TTS 재생 중 partial 자막 억제 설정 (Room 단위, 호스트 전용)
+					if controlMsg.Enabled != nil {
+						if err := room.SetPartialSuppression(listenerID, *controlMsg.Enabled); err != nil {
+							room.sendModerationError(listenerID, controlMsg.Type, err)
+						}
+					}
+
+				case "audio_mode":
+					// 리스너의 오디오 수신 모드 (번역 TTS / 화자 원음 / 둘 다)
+					if mode, ok := ParseAudioMode(controlMsg.Mode); ok {
+						room.SetListenerAudioMode(listenerID, mode)
+					} else {
+						log.Printf("⚠️ [Room %s] Listener %s requested invalid audio mode: %s", roomID, listenerID, controlMsg.Mode)
+					}
+
+				case "tts_interrupt":
+					// 새 final이 이전 final을 대체했을 때 대기 중인 이전 TTS 수신 여부 (리스너 단위)
+					if mode, ok := ParseTTSInterrupt(controlMsg.Mode); ok {
+						room.SetListenerTTSInterrupt(listenerID, mode)
+					} else {
+						log.Printf("⚠️ [Room %s] Listener %s requested invalid TTS interrupt: %s", roomID, listenerID, controlMsg.Mode)
+					}
+
+				case "tts_interrupt_policy":
+					// 대체된 TTS 처리 정책 (Room 단위, 호스트 전용: none=알림 없음, signal=audio_cancel 알림, drop=알림 + 서버에서 버림)
+					if err := room.SetTTSInterruptPolicy(listenerID, controlMsg.Mode); err != nil {
+						room.sendModerationError(listenerID, controlMsg.Type, err)
+					}
+
+				case "partial_min_lengths":
+					// partial 자막 언어별 최소 글자 수 재정의 (Room 단위, 호스트 전용, 빈 객체면 워크스페이스 설정으로 복원)
+					if err := room.SetPartialMinLengths(listenerID, controlMsg.Lengths); err != nil {
+						room.sendModerationError(listenerID, controlMsg.Type, err)
+					}
+
+				case "speaker_queue_mode":
+					// 발언 대기열 활성화/비활성화 (호스트 전용)
+					if controlMsg.Enabled != nil {
+						if err := room.SetSpeakerQueueMode(listenerID, *controlMsg.Enabled); err != nil {
+							room.sendSpeakerQueueError(listenerID, err)
+						}
+					}
+
+				case "raise_hand":
+					if err := room.RaiseHand(listenerID, controlMsg.Nickname); err != nil {
+						room.sendSpeakerQueueError(listenerID, err)
+					}
+
+				case "lower_hand":
+					if err := room.LowerHand(listenerID); err != nil {
+						room.sendSpeakerQueueError(listenerID, err)
+					}
+
+				case "grant_speaker":
+					// 발언권 부여 (호스트 전용)
+					if err := room.GrantSpeaker(listenerID, controlMsg.ParticipantID); err != nil {
+						room.sendSpeakerQueueError(listenerID, err)
+					}
+
+				case "revoke_speaker":
+					// 발언권 회수 (호스트, 또는 본인이 발언 종료; participantId 비어 있으면 본인)
+					participantID := controlMsg.ParticipantID
+					if participantID == "" {
+						participantID = listenerID
+					}
+					if err := room.RevokeSpeaker(listenerID, participantID); err != nil {
+						room.sendSpeakerQueueError(listenerID, err)
+					}
+
+				case "breakout_create":
+					// 브레이크아웃 룸 생성 (호스트 전용)
+					if err := room.CreateBreakout(listenerID, controlMsg.Breakout); err != nil {
+						room.sendBreakoutError(listenerID, err)
+					}
+
+				case "breakout_move":
+					// 참가자를 브레이크아웃/메인 Room으로 이동 (호스트 전용)
+					if err := room.MoveToBreakout(listenerID, controlMsg.ParticipantID, controlMsg.Breakout); err != nil {
+						room.sendBreakoutError(listenerID, err)
+					}
+
+				case "breakout_close":
+					// 브레이크아웃 종료 (호스트 전용, 자막은 메인 회의록에 병합)
+					if err := room.CloseBreakout(listenerID, controlMsg.Breakout); err != nil {
+						room.sendBreakoutError(listenerID, err)
+					}
+
+				case "tts_language":
+					// 대상 언어별 TTS 켜기/끄기 (호스트 전용, 자막은 계속 전송)
+					if controlMsg.Enabled != nil {
+						if err := room.SetLanguageTTS(listenerID, controlMsg.TargetLang, *controlMsg.Enabled); err != nil {
+							room.sendTTSLanguageError(listenerID, err)
+						}
+					}
+
+				case "webinar_speaker":
+					// 웨비나 지정 화자 추가/해제 (호스트 전용)
+					if controlMsg.Enabled != nil {
+						if err := room.SetWebinarSpeaker(listenerID, controlMsg.ParticipantID, *controlMsg.Enabled); err != nil {
+							room.sendWebinarError(listenerID, err)
+						}
+					}
+
+				case "slow_mode":
+					// 슬로 모드 켜기/끄기 (호스트 전용)
+					if controlMsg.Enabled != nil {
+						if err := room.SetSlowMode(listenerID, *controlMsg.Enabled); err != nil {
+							room.sendSlowModeError(listenerID, err)
+						}
+					}
+
+				case "mute_speaker", "unmute_speaker":
+					// 화자 음소거/해제 (호스트 전용)
+					if err := room.MuteSpeaker(listenerID, controlMsg.ParticipantID, controlMsg.Type == "mute_speaker"); err != nil {
+						room.sendModerationError(listenerID, controlMsg.Type, err)
+					}
+
+				case "remove_participant":
+					// 참가자 강퇴 (호스트 전용)
+					if err := room.RemoveParticipant(listenerID, controlMsg.ParticipantID); err != nil {
+						room.sendModerationError(listenerID, controlMsg.Type, err)
+					}
+
+				case "keyword_watchlist":
+					// 키워드 감시 목록 교체 (호스트 전용, final 자막에서 발견되면 호스트에게 keyword_alert)
+					if err := room.SetKeywordWatchlist(listenerID, controlMsg.Keywords); err != nil {
+						room.sendModerationError(listenerID, controlMsg.Type, err)
+					}
+
+				case "lock_room", "unlock_room":
+					// Room 잠금/해제 (호스트 전용)
+					if err := room.SetRoomLocked(listenerID, controlMsg.Type == "lock_room"); err != nil {
+						room.sendModerationError(listenerID, controlMsg.Type, err)
+					}
+
+				case "end_meeting":
+					// 회의 종료 (호스트 전용, 모든 참가자 연결 종료)
+					if err := room.EndMeeting(listenerID); err != nil {
+						room.sendModerationError(listenerID, controlMsg.Type, err)
+					}
 				}
 			}
 		}
 	}
 }
 
+// speakerOnlyControls listener 역할 토큰으로는 보낼 수 없는 제어 메시지
+var speakerOnlyControls = map[string]bool{
+	"speaker_info":  true,
+	"speaker_leave": true,
+}
+
+// sendRoleError listener 역할 참가자에게 발화 권한 없음 알림
+func (r *Room) sendRoleError(listenerID, action string) {
+	r.sendPermissionError(listenerID, action, "listener role cannot send audio")
+}
+
+// sendPermissionError 토큰 권한 밖의 요청 거부 알림
+func (r *Room) sendPermissionError(listenerID, action, message string) {
+	r.Broadcast(&BroadcastMessage{
+		Type:             "permission_error",
+		Data:             map[string]string{"action": action, "message": message},
+		TargetListenerID: listenerID,
+	})
+}
+
 // sendRoomError Room WebSocket 에러 응답 전송
 func (h *AudioHandler) sendRoomError(c *websocket.Conn, code, message string) {
 	response := fmt.Sprintf(`{"status":"error","code":"%s","message":"%s"}`, code, message)
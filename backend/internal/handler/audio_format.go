@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	awsai "realtime-backend/internal/aws"
+)
+
+// =============================================================================
+// Listener audio format negotiation
+// =============================================================================
+
+// ParseAudioFormatQuery join 쿼리의 오디오 형식 조건으로 리스너 TTS 출력 형식 협상
+// audioCodecs=ogg_vorbis,mp3 (선호 순), audioSampleRates=16000,24000, maxBitrate=32000 (bps)
+// 아무 조건도 없으면 nil (기본 mp3 24kHz)
+func ParseAudioFormatQuery(codecs, sampleRates, maxBitrate string) (*awsai.AudioProfile, error) {
+	var codecList []string
+	for _, codec := range strings.Split(codecs, ",") {
+		if codec = strings.TrimSpace(codec); codec != "" {
+			codecList = append(codecList, codec)
+		}
+	}
+
+	var rates []int
+	for _, raw := range strings.Split(sampleRates, ",") {
+		if raw = strings.TrimSpace(raw); raw == "" {
+			continue
+		}
+		rate, err := strconv.Atoi(raw)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid sample rate: %s", raw)
+		}
+		rates = append(rates, rate)
+	}
+
+	bitrate := 0
+	if maxBitrate = strings.TrimSpace(maxBitrate); maxBitrate != "" {
+		var err error
+		if bitrate, err = strconv.Atoi(maxBitrate); err != nil || bitrate < 0 {
+			return nil, fmt.Errorf("invalid max bitrate: %s", maxBitrate)
+		}
+	}
+
+	if len(codecList) == 0 && len(rates) == 0 && bitrate == 0 {
+		return nil, nil
+	}
+	return awsai.NegotiateAudioProfile(codecList, rates, bitrate)
+}
+
+// audioFormatResponse ready 응답용 협상 결과 (nil이면 기본 형식)
+func audioFormatResponse(profile *awsai.AudioProfile) *awsai.AudioProfile {
+	format, sampleRate := profile.Output()
+	return &awsai.AudioProfile{Format: format, SampleRate: int(sampleRate)}
+}
+
+// ttsVoice 리스너가 받을 TTS 변형 (음성 선택 + 협상된 출력 형식)
+func (l *Listener) ttsVoice() *awsai.VoicePreference {
+	return l.Voice.WithOutput(l.AudioProfile)
+}
+
+// SetListenerAudioProfile 리스너의 TTS 출력 형식 변경 (nil = 기본 mp3)
+func (r *Room) SetListenerAudioProfile(listenerID string, profile *awsai.AudioProfile) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	listener, exists := r.Listeners.Get(listenerID)
+	if !exists {
+		return
+	}
+
+	if profile.IsDefault() {
+		profile = nil
+	}
+	listener.AudioProfile = profile
+
+	log.Printf("[Room %s] Listener %s audio format: %q", r.ID, listenerID, profile.Key())
+
+	if r.hub.useAWS && r.awsPipeline != nil {
+		r.awsPipeline.UpdateVoicePreferences(r.listenerVoicePreferences())
+	}
+}
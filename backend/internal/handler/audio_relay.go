@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"log"
+	"strings"
+
+	"realtime-backend/internal/metrics"
+)
+
+// 리스너별 오디오 수신 모드
+const (
+	AudioModeTTS      = "tts"      // 번역 TTS만 (기본값)
+	AudioModeOriginal = "original" // 화자 원음만 (이중 언어 사용자)
+	AudioModeBoth     = "both"     // 원음 + 번역 TTS
+)
+
+// 원음 릴레이 설정
+const (
+	relayBufferSize      = 200         // 릴레이 채널 버퍼 (자막/TTS 브로드캐스트와 분리)
+	relayAudioFormat     = "pcm_s16le" // 화자가 보내는 16-bit mono PCM 그대로 전달
+	relayAudioSampleRate = 16000
+)
+
+// ParseAudioMode 오디오 수신 모드 검증 (빈 값이면 기본값)
+func ParseAudioMode(mode string) (string, bool) {
+	switch mode = strings.ToLower(strings.TrimSpace(mode)); mode {
+	case "":
+		return AudioModeTTS, true
+	case AudioModeTTS, AudioModeOriginal, AudioModeBoth:
+		return mode, true
+	}
+	return "", false
+}
+
+// wantsTTS 번역 TTS 오디오 수신 여부
+func (l *Listener) wantsTTS() bool {
+	return l.AudioMode != AudioModeOriginal
+}
+
+// wantsOriginalAudio 화자 원음 수신 여부
+func (l *Listener) wantsOriginalAudio() bool {
+	return l.AudioMode == AudioModeOriginal || l.AudioMode == AudioModeBoth
+}
+
+// SetListenerAudioMode 리스너의 오디오 수신 모드 변경
+func (r *Room) SetListenerAudioMode(listenerID, mode string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	listener, exists := r.Listeners.Get(listenerID)
+	if !exists {
+		return
+	}
+	listener.AudioMode = mode
+
+	log.Printf("[Room %s] Listener %s changed audio mode: %s", r.ID, listenerID, mode)
+}
+
+// hasOriginalAudioListeners 원음을 받을 리스너가 있는지 확인 (없으면 릴레이 생략)
+func (r *Room) hasOriginalAudioListeners() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, l := range r.Listeners.Snapshot() {
+		if l.wantsOriginalAudio() {
+			return true
+		}
+	}
+	return false
+}
+
+// relayOriginalAudio 화자 원음을 원음 수신 리스너에게 전달
+// 프레임 수가 많아 자막/TTS용 broadcast 채널이 밀리지 않도록 별도 채널 사용
+func (r *Room) relayOriginalAudio(speakerID, sourceLang string, audioData []byte) {
+	if !r.hasOriginalAudioListeners() {
+		return
+	}
+
+	msg := &BroadcastMessage{
+		Type:            "original_audio",
+		SpeakerID:       speakerID,
+		Data:            map[string]any{"language": sourceLang, "format": relayAudioFormat, "sampleRate": relayAudioSampleRate},
+		AudioData:       audioData,
+		AudioFormat:     relayAudioFormat,
+		AudioSampleRate: relayAudioSampleRate,
+	}
+
+	select {
+	case r.relay <- msg:
+		r.queues.relay.Observe(len(r.relay))
+	default:
+		metrics.DroppedMessages.Inc(metrics.DropOriginalAudio)
+	}
+}
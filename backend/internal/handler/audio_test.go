@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	fastws "github.com/fasthttp/websocket"
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/config"
+)
+
+// A join token limited to English must not get a Japanese stream on either WebSocket endpoint
+func TestWebSocketRejectsLanguageNotAllowedByJoinToken(t *testing.T) {
+	h := &AudioHandler{cfg: &config.Config{
+		Audio:     config.AudioConfig{ChannelBufferSize: 1},
+		WebSocket: config.WebSocketConfig{WriteTimeout: time.Second},
+	}}
+	claims := &auth.RoomClaims{
+		ParticipantID: "1",
+		RoomID:        "meeting-1",
+		Role:          auth.RoomRoleSpeaker,
+		Languages:     []string{"en"},
+	}
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("roomClaims", claims)
+		c.Locals("roomRole", claims.Role)
+		c.Locals("roomId", claims.RoomID)
+		c.Locals("listenerId", claims.ParticipantID)
+		c.Locals("targetLang", "ja")
+		return c.Next()
+	})
+	app.Get("/ws/room", websocket.New(h.HandleRoomWebSocket))
+	app.Get("/ws/audio", websocket.New(h.HandleWebSocket))
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	go func() { _ = app.Listener(ln) }()
+	defer func() { _ = app.Shutdown() }()
+
+	for _, path := range []string{"/ws/room", "/ws/audio"} {
+		t.Run(path, func(t *testing.T) {
+			conn, _, err := fastws.DefaultDialer.Dial(fmt.Sprintf("ws://%s%s", ln.Addr(), path), nil)
+			if err != nil {
+				t.Fatalf("dial: %v", err)
+			}
+			defer conn.Close()
+
+			_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				t.Fatalf("read: %v", err)
+			}
+			if !strings.Contains(string(msg), `"code":"LANGUAGE_NOT_ALLOWED"`) {
+				t.Fatalf("expected LANGUAGE_NOT_ALLOWED, got %s", msg)
+			}
+		})
+	}
+}
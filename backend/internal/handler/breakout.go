@@ -0,0 +1,424 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	awsai "realtime-backend/internal/aws"
+	"realtime-backend/internal/cache"
+	"realtime-backend/internal/model"
+)
+
+// 브레이크아웃 룸 설정
+// 브레이크아웃 Room ID는 "{부모 Room ID}~{이름}" 형식이라 미팅/워크스페이스 조회가 부모 미팅으로 연결됨
+const (
+	breakoutSeparator   = "~"
+	maxBreakoutsPerRoom = 20
+)
+
+var breakoutNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
+var (
+	ErrNotBreakoutHost      = errors.New("only the host can manage breakout rooms")
+	ErrInvalidBreakoutName  = errors.New("breakout name must be 1-32 lowercase letters, digits or dashes")
+	ErrTooManyBreakouts     = errors.New("too many breakout rooms")
+	ErrBreakoutNotFound     = errors.New("breakout room not found")
+	ErrParticipantNotInRoom = errors.New("participant is not connected to this meeting")
+)
+
+// BreakoutRoomID 부모 Room의 브레이크아웃 Room ID
+func BreakoutRoomID(parentRoomID, name string) string {
+	return parentRoomID + breakoutSeparator + name
+}
+
+// ParseBreakoutRoomID 브레이크아웃 Room ID를 부모 Room ID와 이름으로 분리
+func ParseBreakoutRoomID(roomID string) (parentRoomID, name string, ok bool) {
+	idx := strings.LastIndex(roomID, breakoutSeparator)
+	if idx <= 0 || !breakoutNamePattern.MatchString(roomID[idx+1:]) {
+		return "", "", false
+	}
+	return roomID[:idx], roomID[idx+1:], true
+}
+
+// BreakoutState 부모 Room 참가자에게 브로드캐스트되는 브레이크아웃 목록
+type BreakoutState struct {
+	ParentRoomID string         `json:"parentRoomId"`
+	Breakouts    []BreakoutInfo `json:"breakouts"`
+}
+
+// BreakoutInfo 브레이크아웃 룸 정보
+type BreakoutInfo struct {
+	Name         string    `json:"name"`
+	RoomID       string    `json:"roomId"`
+	Participants int       `json:"participants"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// breakoutRegistry 부모 Room별 열린 브레이크아웃 (Room 잠금과 분리: Room 종료 중에도 조회 가능)
+type breakoutRegistry struct {
+	open map[string]map[string]time.Time // parentRoomID -> name -> createdAt
+	// 브레이크아웃이 열려 있어 종료 처리를 미룬 부모 Room
+	pendingFinalization map[string]bool
+	mu                  sync.Mutex
+}
+
+func newBreakoutRegistry() *breakoutRegistry {
+	return &breakoutRegistry{
+		open:                make(map[string]map[string]time.Time),
+		pendingFinalization: make(map[string]bool),
+	}
+}
+
+// add 브레이크아웃 등록 (이미 있으면 그대로)
+func (b *breakoutRegistry) add(parentRoomID, name string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	breakouts := b.open[parentRoomID]
+	if breakouts == nil {
+		breakouts = make(map[string]time.Time)
+		b.open[parentRoomID] = breakouts
+	}
+	if _, exists := breakouts[name]; exists {
+		return nil
+	}
+	if len(breakouts) >= maxBreakoutsPerRoom {
+		return ErrTooManyBreakouts
+	}
+	breakouts[name] = time.Now()
+	return nil
+}
+
+// remove 브레이크아웃 제거. 마지막 브레이크아웃이고 부모 종료 처리가 미뤄져 있었으면 true
+func (b *breakoutRegistry) remove(parentRoomID, name string) (finalizeParent bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	breakouts := b.open[parentRoomID]
+	delete(breakouts, name)
+	if len(breakouts) > 0 {
+		return false
+	}
+	delete(b.open, parentRoomID)
+
+	finalizeParent = b.pendingFinalization[parentRoomID]
+	delete(b.pendingFinalization, parentRoomID)
+	return finalizeParent
+}
+
+// deferFinalization 열린 브레이크아웃이 있으면 부모 종료 처리를 미루고 true
+func (b *breakoutRegistry) deferFinalization(parentRoomID string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.open[parentRoomID]) == 0 {
+		return false
+	}
+	b.pendingFinalization[parentRoomID] = true
+	return true
+}
+
+// list 부모 Room의 브레이크아웃 이름과 생성 시각
+func (b *breakoutRegistry) list(parentRoomID string) map[string]time.Time {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	breakouts := make(map[string]time.Time, len(b.open[parentRoomID]))
+	for name, createdAt := range b.open[parentRoomID] {
+		breakouts[name] = createdAt
+	}
+	return breakouts
+}
+
+func (b *breakoutRegistry) exists(parentRoomID, name string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, ok := b.open[parentRoomID][name]
+	return ok
+}
+
+// =============================================================================
+// Room Methods - Breakout Rooms
+// =============================================================================
+
+// parentRoomID 브레이크아웃이면 부모 Room ID, 아니면 자기 ID
+func (r *Room) parentRoomID() string {
+	if parentID, _, ok := ParseBreakoutRoomID(r.ID); ok {
+		return parentID
+	}
+	return r.ID
+}
+
+// inheritSettings 부모 Room의 Room 단위 설정을 브레이크아웃에 복사 (호출자가 hub.mu 보유)
+func (r *Room) inheritSettings(parent *Room) {
+	parent.mu.RLock()
+	defer parent.mu.RUnlock()
+
+	r.suppressPartials = parent.suppressPartials
+	r.incrementalPairs = parent.incrementalPairs
+	r.partialMinLengths = parent.partialMinLengths
+	r.ttsDisabled = maps.Clone(parent.ttsDisabled)
+	r.slowMode = parent.slowMode
+	r.workspaceID = parent.workspaceID
+}
+
+// CreateBreakout 브레이크아웃 룸 생성 (호스트 전용, 부모 Room의 모든 참가자에게 목록 전송)
+func (r *Room) CreateBreakout(hostID, name string) error {
+	if !r.isModerator(hostID) {
+		return ErrNotBreakoutHost
+	}
+	if !breakoutNamePattern.MatchString(name) {
+		return ErrInvalidBreakoutName
+	}
+
+	parentID := r.parentRoomID()
+	if err := r.hub.breakouts.add(parentID, name); err != nil {
+		return err
+	}
+
+	log.Printf("[Room %s] 🚪 Breakout room created: %s", parentID, name)
+	r.hub.broadcastBreakoutState(parentID)
+	return nil
+}
+
+// MoveToBreakout 참가자를 브레이크아웃(빈 이름이면 부모 Room)으로 이동 (호스트 전용)
+// 연결은 클라이언트가 옮기므로 대상 참가자에게 새 Room ID를 알림
+func (r *Room) MoveToBreakout(hostID, participantID, name string) error {
+	if !r.isModerator(hostID) {
+		return ErrNotBreakoutHost
+	}
+
+	parentID := r.parentRoomID()
+	targetRoomID := parentID
+	if name != "" {
+		if !r.hub.breakouts.exists(parentID, name) {
+			return ErrBreakoutNotFound
+		}
+		targetRoomID = BreakoutRoomID(parentID, name)
+	}
+
+	current := r.hub.findMeetingListener(parentID, participantID)
+	if current == nil {
+		return ErrParticipantNotInRoom
+	}
+
+	current.Broadcast(&BroadcastMessage{
+		Type: "breakout_move",
+		Data: map[string]string{
+			"roomId":       targetRoomID,
+			"breakout":     name,
+			"parentRoomId": parentID,
+		},
+		TargetListenerID: participantID,
+	})
+	log.Printf("[Room %s] 🚪 Moving %s to %s", parentID, participantID, targetRoomID)
+	return nil
+}
+
+// CloseBreakout 브레이크아웃 종료 (호스트 전용): 참가자는 부모 Room으로 돌아가고 자막은 부모 미팅에 병합
+func (r *Room) CloseBreakout(hostID, name string) error {
+	if !r.isModerator(hostID) {
+		return ErrNotBreakoutHost
+	}
+
+	parentID := r.parentRoomID()
+	if !r.hub.breakouts.exists(parentID, name) {
+		return ErrBreakoutNotFound
+	}
+
+	roomID := BreakoutRoomID(parentID, name)
+	r.hub.mu.RLock()
+	breakout := r.hub.rooms[roomID]
+	r.hub.mu.RUnlock()
+
+	if breakout == nil {
+		// 아무도 들어오지 않은 브레이크아웃
+		r.hub.closeBreakout(roomID)
+		return nil
+	}
+
+	// Room 종료 시 broadcast 채널이 닫히므로 직접 전송
+	msg := &BroadcastMessage{
+		Type: "breakout_closed",
+		Data: map[string]string{"roomId": parentID, "breakout": name},
+	}
+	for _, l := range breakout.Listeners.Values() {
+		breakout.sendToListener(l, msg)
+	}
+
+	// Shutdown → FinalizeMeeting → closeBreakout 순서로 자막 병합
+	r.hub.RemoveRoom(roomID)
+	return nil
+}
+
+// SendBreakoutState 새로 접속한 참가자에게 브레이크아웃 목록 전송 (열린 브레이크아웃이 있을 때만)
+func (r *Room) SendBreakoutState(listenerID string) {
+	state := r.hub.BreakoutState(r.parentRoomID())
+	if len(state.Breakouts) == 0 {
+		return
+	}
+	r.Broadcast(&BroadcastMessage{
+		Type:             "breakouts",
+		Data:             state,
+		TargetListenerID: listenerID,
+	})
+}
+
+// sendBreakoutError 요청한 참가자에게 브레이크아웃 오류 전송
+func (r *Room) sendBreakoutError(listenerID string, err error) {
+	r.Broadcast(&BroadcastMessage{
+		Type:             "breakout_error",
+		Data:             map[string]string{"message": err.Error()},
+		TargetListenerID: listenerID,
+	})
+}
+
+// =============================================================================
+// RoomHub - Breakout Rooms
+// =============================================================================
+
+// findMeetingListener 부모 Room과 브레이크아웃 중 참가자가 연결된 Room
+func (h *RoomHub) findMeetingListener(parentRoomID, participantID string) *Room {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for id, room := range h.rooms {
+		if id != parentRoomID && !strings.HasPrefix(id, parentRoomID+breakoutSeparator) {
+			continue
+		}
+		if room.Listeners.Has(participantID) {
+			return room
+		}
+	}
+	return nil
+}
+
+// BreakoutState 부모 Room의 브레이크아웃 목록과 참가자 수
+func (h *RoomHub) BreakoutState(parentRoomID string) BreakoutState {
+	breakouts := h.breakouts.list(parentRoomID)
+
+	state := BreakoutState{ParentRoomID: parentRoomID, Breakouts: make([]BreakoutInfo, 0, len(breakouts))}
+	h.mu.RLock()
+	for name, createdAt := range breakouts {
+		info := BreakoutInfo{Name: name, RoomID: BreakoutRoomID(parentRoomID, name), CreatedAt: createdAt}
+		if room, ok := h.rooms[info.RoomID]; ok {
+			info.Participants = room.Listeners.Len()
+		}
+		state.Breakouts = append(state.Breakouts, info)
+	}
+	h.mu.RUnlock()
+
+	sort.Slice(state.Breakouts, func(i, j int) bool {
+		return state.Breakouts[i].Name < state.Breakouts[j].Name
+	})
+	return state
+}
+
+// broadcastBreakoutState 브레이크아웃 목록을 부모 Room 참가자에게 전송
+func (h *RoomHub) broadcastBreakoutState(parentRoomID string) {
+	h.mu.RLock()
+	parent := h.rooms[parentRoomID]
+	h.mu.RUnlock()
+
+	if parent != nil {
+		parent.Broadcast(&BroadcastMessage{
+			Type: "breakouts",
+			Data: h.BreakoutState(parentRoomID),
+		})
+	}
+}
+
+// closeBreakout 종료된 브레이크아웃의 자막을 부모 미팅에 병합하고, 마지막 브레이크아웃이면 미뤄둔 부모 종료 처리 실행
+func (h *RoomHub) closeBreakout(roomID string) {
+	parentID, name, ok := ParseBreakoutRoomID(roomID)
+	if !ok {
+		return
+	}
+
+	if h.db != nil && h.redisClient != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		if err := h.mergeBreakoutTranscripts(ctx, roomID); err != nil {
+			log.Printf("[Room %s] Failed to merge breakout transcripts (retried by janitor): %v", roomID, err)
+		}
+		cancel()
+	}
+
+	finalizeParent := h.breakouts.remove(parentID, name)
+	log.Printf("[Room %s] 🚪 Breakout room closed: %s", parentID, name)
+	h.broadcastBreakoutState(parentID)
+
+	if finalizeParent {
+		log.Printf("[Room %s] Last breakout closed, running deferred finalization", parentID)
+		h.FinalizeMeeting(parentID)
+	}
+}
+
+// mergeBreakoutTranscripts 브레이크아웃의 Redis 자막을 브레이크아웃 이름을 붙여 부모 미팅 voice_records에 저장
+func (h *RoomHub) mergeBreakoutTranscripts(ctx context.Context, roomID string) error {
+	_, name, _ := ParseBreakoutRoomID(roomID)
+
+	meeting, err := FindMeetingByRoomID(h.db, roomID)
+	if err != nil {
+		return fmt.Errorf("meeting not found: %w", err)
+	}
+
+	redactPII := meeting.WorkspaceID != nil && h.WorkspaceRedactsPII(*meeting.WorkspaceID)
+	saved, err := h.persistTranscripts(ctx, roomID, meeting.ID, func(entries []cache.RoomTranscript) ([]model.VoiceRecord, func(tx *gorm.DB) error) {
+		records := make([]model.VoiceRecord, 0, len(entries))
+		for _, t := range entries {
+			if !t.IsFinal {
+				continue
+			}
+			record := voiceRecordFromTranscript(meeting.ID, t, redactPII)
+			record.Breakout = &name
+			records = append(records, record)
+		}
+		return records, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if saved > 0 {
+		log.Printf("[Room %s] Merged %d breakout transcripts into meeting %d", roomID, saved, meeting.ID)
+	}
+	return nil
+}
+
+// voiceRecordFromTranscript Redis 자막을 VoiceRecord로 변환
+func voiceRecordFromTranscript(meetingID int64, t cache.RoomTranscript, redactPII bool) model.VoiceRecord {
+	if redactPII {
+		t.Original, _ = awsai.RedactPII(t.Original)
+		t.Translated, _ = awsai.RedactPII(t.Translated)
+	}
+
+	record := model.VoiceRecord{
+		MeetingID:   meetingID,
+		SpeakerName: t.SpeakerName,
+		Original:    t.Original,
+		Crosstalk:   t.Crosstalk,
+		Seq:         int64(t.OrderKey()),
+		CreatedAt:   t.Timestamp.Truncate(time.Microsecond),
+	}
+	if t.SourceLang != "" {
+		record.SourceLang = &t.SourceLang
+	}
+	if t.Translated != "" {
+		record.Translated = &t.Translated
+	}
+	if t.TargetLang != "" {
+		record.TargetLang = &t.TargetLang
+	}
+	return record
+}
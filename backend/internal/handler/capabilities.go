@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// 클라이언트 capability 이름 (join 시 ?capabilities=binary_envelope,partials 형식으로 전달)
+const (
+	CapBinaryEnvelope = "binary_envelope" // 오디오 프레임에 메타데이터 헤더 포함
+	CapAudioHeader    = "audio_header"    // 오디오 프레임 직전에 JSON 헤더 메시지 전송 (바이너리 파싱이 어려운 클라이언트용)
+	CapProtobuf       = "protobuf"        // protobuf 메시지 인코딩
+	CapOpus           = "opus"            // Opus TTS 오디오
+	CapPartials       = "partials"        // 중간(partial) 자막 수신
+)
+
+// serverCapabilities 서버가 현재 지원하는 capability
+// 지원하지 않는 항목은 클라이언트가 요청해도 협상되지 않음 (JSON/MP3로 폴백)
+var serverCapabilities = map[string]bool{
+	CapBinaryEnvelope: true,
+	CapAudioHeader:    true,
+	CapProtobuf:       false,
+	CapOpus:           false,
+	CapPartials:       true,
+}
+
+// ClientCapabilities 리스너와 협상된 프로토콜 기능
+type ClientCapabilities struct {
+	BinaryEnvelope bool
+	AudioHeader    bool
+	Protobuf       bool
+	Opus           bool
+	Partials       bool
+}
+
+// LegacyCapabilities capabilities를 보내지 않는 기존 프론트엔드의 동작 (raw 오디오, JSON, partial 포함)
+func LegacyCapabilities() ClientCapabilities {
+	return ClientCapabilities{Partials: true}
+}
+
+// ParseClientCapabilities 쉼표로 구분된 capability 목록을 서버 지원 범위 내에서 협상
+// 빈 문자열이면 LegacyCapabilities, 알 수 없는 항목은 무시
+func ParseClientCapabilities(raw string) ClientCapabilities {
+	if strings.TrimSpace(raw) == "" {
+		return LegacyCapabilities()
+	}
+
+	var caps ClientCapabilities
+	for _, name := range strings.Split(raw, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !serverCapabilities[name] {
+			continue
+		}
+		switch name {
+		case CapBinaryEnvelope:
+			caps.BinaryEnvelope = true
+		case CapAudioHeader:
+			caps.AudioHeader = true
+		case CapProtobuf:
+			caps.Protobuf = true
+		case CapOpus:
+			caps.Opus = true
+		case CapPartials:
+			caps.Partials = true
+		}
+	}
+	return caps
+}
+
+// List 협상된 capability 이름 목록 (ready 응답용)
+func (c ClientCapabilities) List() []string {
+	list := make([]string, 0, 5)
+	if c.BinaryEnvelope {
+		list = append(list, CapBinaryEnvelope)
+	}
+	if c.AudioHeader {
+		list = append(list, CapAudioHeader)
+	}
+	if c.Protobuf {
+		list = append(list, CapProtobuf)
+	}
+	if c.Opus {
+		list = append(list, CapOpus)
+	}
+	if c.Partials {
+		list = append(list, CapPartials)
+	}
+	return list
+}
+
+// binary_envelope 프레임 앞부분: [magic "EUMA"][version 1B][헤더 길이 uint16 BE]
+const (
+	audioEnvelopeMagic   = "EUMA"
+	audioEnvelopeVersion = 1
+	audioEnvelopePrefix  = len(audioEnvelopeMagic) + 1 + 2
+)
+
+// audioEnvelopeHeader 오디오 프레임의 메타데이터 (binary_envelope 헤더, audio_header 메시지 data)
+// seq/transcriptId로 자막과 매칭하고, 순서가 뒤바뀌어 도착한 오디오를 정렬
+type audioEnvelopeHeader struct {
+	Type         string `json:"type"`
+	Seq          uint64 `json:"seq,omitempty"`
+	SpeakerID    string `json:"speakerId"`
+	TargetLang   string `json:"targetLang,omitempty"`
+	TranscriptID string `json:"transcriptId,omitempty"`
+	Format       string `json:"format"`
+	SampleRate   int    `json:"sampleRate,omitempty"`
+	Size         int    `json:"size"` // 오디오 바이트 수
+}
+
+func newAudioEnvelopeHeader(msg *BroadcastMessage) audioEnvelopeHeader {
+	format := msg.AudioFormat
+	if format == "" {
+		format = "mp3"
+	}
+	return audioEnvelopeHeader{
+		Type:         msg.Type,
+		Seq:          msg.Seq,
+		SpeakerID:    msg.SpeakerID,
+		TargetLang:   msg.TargetLang,
+		TranscriptID: msg.TranscriptID,
+		Format:       format,
+		SampleRate:   msg.AudioSampleRate,
+		Size:         len(msg.AudioData),
+	}
+}
+
+// encodeAudioEnvelope [magic "EUMA"][version][헤더 길이 uint16 BE][JSON 헤더][오디오] 형식으로 오디오 프레임 인코딩
+func encodeAudioEnvelope(msg *BroadcastMessage) ([]byte, error) {
+	header, err := json.Marshal(newAudioEnvelopeHeader(msg))
+	if err != nil {
+		return nil, err
+	}
+	if len(header) > math.MaxUint16 {
+		return nil, fmt.Errorf("audio envelope header too large: %d bytes", len(header))
+	}
+
+	frame := make([]byte, audioEnvelopePrefix+len(header)+len(msg.AudioData))
+	n := copy(frame, audioEnvelopeMagic)
+	frame[n] = audioEnvelopeVersion
+	binary.BigEndian.PutUint16(frame[n+1:], uint16(len(header)))
+	copy(frame[audioEnvelopePrefix:], header)
+	copy(frame[audioEnvelopePrefix+len(header):], msg.AudioData)
+	return frame, nil
+}
+
+// encodeAudioHeader audio_header capability용: 바로 다음 바이너리 프레임을 설명하는 JSON 메시지
+func encodeAudioHeader(msg *BroadcastMessage) ([]byte, error) {
+	return json.Marshal(struct {
+		Type string              `json:"type"`
+		Data audioEnvelopeHeader `json:"data"`
+	}{"audio_header", newAudioEnvelopeHeader(msg)})
+}
+
+// wantsMessage 리스너의 capability에 따라 메시지 전송 여부 결정
+func (c ClientCapabilities) wantsMessage(msg *BroadcastMessage) bool {
+	if msg.Type == "transcript" && !c.Partials {
+		if data, ok := msg.Data.(TranscriptData); ok && !data.IsFinal {
+			return false
+		}
+	}
+	return true
+}
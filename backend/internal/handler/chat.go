@@ -10,16 +10,20 @@ import (
 
 	"realtime-backend/internal/auth"
 	"realtime-backend/internal/model"
+	"realtime-backend/internal/repository"
+	"realtime-backend/internal/storage"
 )
 
 // ChatHandler 채팅 핸들러
 type ChatHandler struct {
-	db *gorm.DB
+	db    *gorm.DB
+	chats repository.ChatRepo
+	s3    *storage.S3Service // 첨부 파일 (nil = 비활성화)
 }
 
 // NewChatHandler ChatHandler 생성
 func NewChatHandler(db *gorm.DB) *ChatHandler {
-	return &ChatHandler{db: db}
+	return &ChatHandler{db: db, chats: repository.NewChatRepo(db)}
 }
 
 // ChatLogResponse 채팅 메시지 응답
@@ -30,13 +34,20 @@ type ChatLogResponse struct {
 	Message   string        `json:"message"`
 	Type      string        `json:"type"`
 	CreatedAt string        `json:"created_at"`
+	EditedAt  string        `json:"edited_at,omitempty"`
 	Sender    *UserResponse `json:"sender,omitempty"`
+
+	Attachment *ChatAttachmentPayload `json:"attachment,omitempty"`
 }
 
 // SendMessageRequest 메시지 전송 요청
 type SendMessageRequest struct {
 	Message string `json:"message"`
 	Type    string `json:"type,omitempty"` // TEXT, SYSTEM
+
+	// 클라이언트가 만든 전송 키 (Idempotency-Key 헤더로도 전달 가능)
+	// 같은 키로 다시 보내면 저장하지 않고 원본 메시지를 200으로 반환
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
 }
 
 // CreateChatRoomRequest 채팅방 생성 요청
@@ -95,24 +106,13 @@ func (h *ChatHandler) GetWorkspaceChats(c *fiber.Ctx) error {
 		})
 	}
 
-	// 채팅 로그 조회
-	var chatLogs []model.ChatLog
-	limit := c.QueryInt("limit", 50)
-	offset := c.QueryInt("offset", 0)
-
-	err = h.db.
-		Where("meeting_id = ?", meeting.ID).
-		Preload("Sender").
-		Order("created_at DESC").
-		Limit(limit).
-		Offset(offset).
-		Find(&chatLogs).Error
-
+	// 채팅 로그 조회 (최신 메시지부터, next_cursor로 더 오래된 메시지)
+	page, err := h.chats.List(c.UserContext(), repository.ChatFilter{MeetingID: meeting.ID},
+		pageRequest(c, 50), repository.WithPreload("Sender"))
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "failed to get chat logs",
-		})
+		return pageError(c, err, "failed to get chat logs")
 	}
+	chatLogs := page.Items
 
 	// 응답 변환 (역순으로 정렬하여 시간순으로)
 	responses := make([]ChatLogResponse, len(chatLogs))
@@ -121,9 +121,11 @@ func (h *ChatHandler) GetWorkspaceChats(c *fiber.Ctx) error {
 	}
 
 	return c.JSON(fiber.Map{
-		"meeting_id": meeting.ID,
-		"messages":   responses,
-		"total":      len(responses),
+		"meeting_id":  meeting.ID,
+		"messages":    responses,
+		"total":       len(responses),
+		"next_cursor": page.NextCursor,
+		"has_more":    page.HasMore,
 	})
 }
 
@@ -169,6 +171,16 @@ func (h *ChatHandler) SendMessage(c *fiber.Ctx) error {
 		req.Type = "TEXT"
 	}
 
+	if req.IdempotencyKey == "" {
+		req.IdempotencyKey = c.Get("Idempotency-Key")
+	}
+	idempotencyKey, err := normalizeIdempotencyKey(req.IdempotencyKey)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
 	// 워크스페이스 채팅 미팅 조회
 	var meeting model.Meeting
 	err = h.db.Where("workspace_id = ? AND type = ?", workspaceID, "WORKSPACE_CHAT").First(&meeting).Error
@@ -178,19 +190,25 @@ func (h *ChatHandler) SendMessage(c *fiber.Ctx) error {
 		})
 	}
 
-	// 채팅 로그 생성
+	// 채팅 로그 생성 (재전송된 메시지는 원본 반환)
 	chatLog := model.ChatLog{
-		MeetingID: meeting.ID,
-		SenderID:  &claims.UserID,
-		Message:   &req.Message,
-		Type:      req.Type,
+		MeetingID:      meeting.ID,
+		SenderID:       &claims.UserID,
+		Message:        &req.Message,
+		Type:           req.Type,
+		IdempotencyKey: idempotencyKey,
 	}
 
-	if err := h.db.Create(&chatLog).Error; err != nil {
+	original, err := createChatLog(h.db, &chatLog)
+	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "failed to send message",
 		})
 	}
+	if original != nil {
+		h.db.Preload("Sender").First(original, original.ID)
+		return c.JSON(h.toChatLogResponse(original))
+	}
 
 	// Sender 정보 로드
 	h.db.Preload("Sender").First(&chatLog, chatLog.ID)
@@ -219,6 +237,12 @@ func (h *ChatHandler) toChatLogResponse(log *model.ChatLog) ChatLogResponse {
 	if log.Message != nil {
 		resp.Message = *log.Message
 	}
+	if log.EditedAt != nil {
+		resp.EditedAt = log.EditedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if log.Attachment != nil {
+		resp.Attachment = chatAttachmentPayload(h.s3, log.Attachment)
+	}
 
 	if log.Sender != nil && log.Sender.ID != 0 {
 		resp.Sender = &UserResponse{
@@ -419,24 +443,13 @@ func (h *ChatHandler) GetChatRoomMessages(c *fiber.Ctx) error {
 		})
 	}
 
-	// 채팅 로그 조회
-	var chatLogs []model.ChatLog
-	limit := c.QueryInt("limit", 50)
-	offset := c.QueryInt("offset", 0)
-
-	err = h.db.
-		Where("meeting_id = ?", room.ID).
-		Preload("Sender").
-		Order("created_at DESC").
-		Limit(limit).
-		Offset(offset).
-		Find(&chatLogs).Error
-
+	// 채팅 로그 조회 (최신 메시지부터, next_cursor로 더 오래된 메시지)
+	page, err := h.chats.List(c.UserContext(), repository.ChatFilter{MeetingID: room.ID},
+		pageRequest(c, 50), repository.WithPreload("Sender"))
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "failed to get chat logs",
-		})
+		return pageError(c, err, "failed to get chat logs")
 	}
+	chatLogs := page.Items
 
 	// LastReadAt 업데이트 (메시지 읽음 처리)
 	now := time.Now()
@@ -445,9 +458,11 @@ func (h *ChatHandler) GetChatRoomMessages(c *fiber.Ctx) error {
 		Update("last_read_at", now)
 
 	return c.JSON(fiber.Map{
-		"room_id":  room.ID,
-		"messages": chatLogs,
-		"total":    len(chatLogs), // Pagination logic might need total count separatel but for now simple length
+		"room_id":     room.ID,
+		"messages":    chatLogs,
+		"total":       len(chatLogs), // Pagination logic might need total count separatel but for now simple length
+		"next_cursor": page.NextCursor,
+		"has_more":    page.HasMore,
 	})
 
 }
@@ -510,19 +525,35 @@ func (h *ChatHandler) SendChatRoomMessage(c *fiber.Ctx) error {
 		req.Type = "TEXT"
 	}
 
-	// 채팅 로그 생성
+	if req.IdempotencyKey == "" {
+		req.IdempotencyKey = c.Get("Idempotency-Key")
+	}
+	idempotencyKey, err := normalizeIdempotencyKey(req.IdempotencyKey)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	// 채팅 로그 생성 (재전송된 메시지는 원본 반환)
 	chatLog := model.ChatLog{
-		MeetingID: room.ID,
-		SenderID:  &claims.UserID,
-		Message:   &req.Message,
-		Type:      req.Type,
+		MeetingID:      room.ID,
+		SenderID:       &claims.UserID,
+		Message:        &req.Message,
+		Type:           req.Type,
+		IdempotencyKey: idempotencyKey,
 	}
 
-	if err := h.db.Create(&chatLog).Error; err != nil {
+	original, err := createChatLog(h.db, &chatLog)
+	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "failed to send message",
 		})
 	}
+	if original != nil {
+		h.db.Preload("Sender").First(original, original.ID)
+		return c.JSON(h.toChatLogResponse(original))
+	}
 
 	// Sender 정보 로드
 	h.db.Preload("Sender").First(&chatLog, chatLog.ID)
@@ -640,8 +671,8 @@ func (h *ChatHandler) DeleteChatRoom(c *fiber.Ctx) error {
 		})
 	}
 
-	// 채팅 로그 삭제
-	if err := h.db.Where("meeting_id = ?", room.ID).Delete(&model.ChatLog{}).Error; err != nil {
+	// 채팅 로그 삭제 (채팅방과 함께 영구 삭제)
+	if err := h.db.Unscoped().Where("meeting_id = ?", room.ID).Delete(&model.ChatLog{}).Error; err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "failed to delete chat logs",
 		})
@@ -0,0 +1,291 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"mime"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/metrics"
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/storage"
+)
+
+// maxChatAttachmentSize 채팅 첨부 파일 최대 크기
+const maxChatAttachmentSize = 25 << 20 // 25MB
+
+// chatAttachmentTypes 채팅에 첨부할 수 있는 MIME 타입 (image/*는 별도 허용)
+var chatAttachmentTypes = map[string]bool{
+	"application/pdf": true,
+	"application/zip": true,
+	"text/plain":      true,
+	"text/csv":        true,
+	"audio/mpeg":      true,
+	"video/mp4":       true,
+
+	// 오피스 문서
+	"application/msword":            true,
+	"application/vnd.ms-excel":      true,
+	"application/vnd.ms-powerpoint": true,
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document":   true,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         true,
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation": true,
+}
+
+// ChatAttachmentUploadRequest 첨부 파일 업로드 URL 요청
+type ChatAttachmentUploadRequest struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	FileSize    int64  `json:"file_size"`
+}
+
+// ChatAttachmentPayload 메시지에 포함되는 첨부 파일 정보 (URL은 presigned 다운로드 URL)
+type ChatAttachmentPayload struct {
+	ID       int64  `json:"id"`
+	FileName string `json:"file_name"`
+	MimeType string `json:"mime_type"`
+	FileSize int64  `json:"file_size"`
+	Key      string `json:"key"`
+	URL      string `json:"url,omitempty"`
+}
+
+// ChatAttachmentSendPayload "attachment" 메시지 (업로드 완료 후 전송, Message는 선택 설명)
+type ChatAttachmentSendPayload struct {
+	AttachmentID int64  `json:"attachment_id"`
+	Message      string `json:"message,omitempty"`
+}
+
+// SetStorage 첨부 파일용 S3 서비스 설정 (nil이면 첨부 비활성화)
+func (h *ChatHandler) SetStorage(s3 *storage.S3Service) {
+	h.s3 = s3
+}
+
+// SetStorage 첨부 파일용 S3 서비스 설정 (nil이면 첨부 비활성화)
+func (h *ChatWSHandler) SetStorage(s3 *storage.S3Service) {
+	h.s3 = s3
+}
+
+// validateChatAttachment 크기, 허용 MIME 타입, 확장자와 MIME 타입 일치 여부 확인
+func validateChatAttachment(fileName, contentType string, size int64) error {
+	if size <= 0 || size > maxChatAttachmentSize {
+		return fmt.Errorf("file_size must be between 1 and %d bytes", maxChatAttachmentSize)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return errors.New("invalid content_type")
+	}
+	if !strings.HasPrefix(mediaType, "image/") && !chatAttachmentTypes[mediaType] {
+		return fmt.Errorf("content_type %s is not allowed", mediaType)
+	}
+	if mediaType == "image/svg+xml" {
+		return errors.New("svg images are not allowed")
+	}
+
+	// 확장자가 알려진 타입이면 선언한 타입과 같아야 함 (이미지 확장자로 실행 파일을 올리는 경우 등)
+	if byExt := mime.TypeByExtension(strings.ToLower(filepath.Ext(fileName))); byExt != "" {
+		if extType, _, err := mime.ParseMediaType(byExt); err == nil && extType != mediaType {
+			return errors.New("file extension does not match content_type")
+		}
+	}
+	return nil
+}
+
+// CreateChatAttachmentUpload 채팅 첨부 파일 업로드 URL 발급 (POST /api/meetings/:id/chat/attachments)
+// 업로드가 끝나면 WebSocket "attachment" 메시지로 attachment_id를 보내 채팅에 게시
+func (h *ChatHandler) CreateChatAttachmentUpload(c *fiber.Ctx) error {
+	if h.s3 == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "S3 service is not configured",
+		})
+	}
+
+	claims := c.Locals("claims").(*auth.Claims)
+	meetingID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid meeting id",
+		})
+	}
+
+	var meeting model.Meeting
+	if err := h.db.First(&meeting, meetingID).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "meeting not found",
+		})
+	}
+	access, err := GetMeetingAccess(h.db, &meeting, claims.UserID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to check meeting access",
+		})
+	}
+	if !access.CanAccess() {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you are not a member of this meeting",
+		})
+	}
+
+	var req ChatAttachmentUploadRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+	req.FileName = sanitizeString(req.FileName)
+	if req.FileName == "" || req.ContentType == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "file_name and content_type are required",
+		})
+	}
+	if err := validateChatAttachment(req.FileName, req.ContentType, req.FileSize); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	presigned, err := h.s3.GenerateChatAttachmentURL(meetingWorkspaceID(&meeting), meeting.ID, req.FileName, req.ContentType, req.FileSize)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to generate presigned URL",
+		})
+	}
+
+	attachment := model.ChatAttachment{
+		MeetingID:  meeting.ID,
+		UploaderID: claims.UserID,
+		FileName:   req.FileName,
+		MimeType:   req.ContentType,
+		FileSize:   req.FileSize,
+		S3Key:      presigned.Key,
+	}
+	if err := h.db.Create(&attachment).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to save attachment",
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"attachment_id": attachment.ID,
+		"upload_url":    presigned.URL,
+		"key":           presigned.Key,
+		"expires_at":    presigned.ExpiresAt,
+		"headers":       presigned.Headers,
+	})
+}
+
+// handleAttachment 업로드된 첨부 파일을 메시지로 저장하고 모든 클라이언트에게 "attachment" 전송
+func (h *ChatWSHandler) handleAttachment(room *ChatRoom, client *ChatClient, roomID int64, payload interface{}) {
+	if h.s3 == nil {
+		client.Session.Write(client.Conn, websocket.TextMessage, "error", []byte(`{"type":"error","message":"attachments are not available"}`))
+		return
+	}
+
+	var req ChatAttachmentSendPayload
+	if !decodeChatPayload(payload, &req) {
+		return
+	}
+
+	// 웨비나 검토 중인 채팅에서는 참석자 첨부 불가 (검토 대기열은 텍스트만)
+	room.mu.RLock()
+	held := room.moderated && !client.Moderator
+	room.mu.RUnlock()
+	if held {
+		client.Session.Write(client.Conn, websocket.TextMessage, "error", []byte(`{"type":"error","message":"attendees cannot send attachments"}`))
+		return
+	}
+
+	var attachment model.ChatAttachment
+	err := h.db.Where("id = ? AND meeting_id = ? AND uploader_id = ? AND chat_log_id IS NULL", req.AttachmentID, roomID, client.UserID).
+		First(&attachment).Error
+	if err != nil {
+		client.Session.Write(client.Conn, websocket.TextMessage, "error", []byte(`{"type":"error","message":"attachment not found"}`))
+		return
+	}
+
+	// 업로드 완료 및 크기 확인 (presigned URL에 크기가 서명되어 있지만 업로드 전 전송 방지)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	info, err := h.s3.HeadObject(ctx, attachment.S3Key)
+	cancel()
+	if err != nil || info.Size != attachment.FileSize {
+		client.Session.Write(client.Conn, websocket.TextMessage, "error", []byte(`{"type":"error","message":"attachment has not been uploaded"}`))
+		return
+	}
+
+	message := strings.TrimSpace(req.Message)
+	if len(message) > 2000 {
+		message = message[:2000]
+	}
+	chatLog := model.ChatLog{
+		MeetingID: roomID,
+		SenderID:  &client.UserID,
+		Type:      "ATTACHMENT",
+	}
+	if message != "" {
+		chatLog.Message = &message
+	}
+
+	err = h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&chatLog).Error; err != nil {
+			return err
+		}
+		// 같은 첨부를 동시에 두 번 보내면 한쪽만 연결
+		result := tx.Model(&model.ChatAttachment{}).
+			Where("id = ? AND chat_log_id IS NULL", attachment.ID).
+			Update("chat_log_id", chatLog.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("채팅 첨부 저장 실패: room=%d, attachment=%d: %v", roomID, attachment.ID, err)
+		return
+	}
+
+	atomic.AddInt64(&room.messageCount, 1)
+	metrics.ChatMessagesTotal.Inc()
+	room.mu.Lock()
+	room.lastActivity = time.Now()
+	room.mu.Unlock()
+
+	out := ChatPayload{
+		ID:         chatLog.ID,
+		Message:    message,
+		SenderID:   client.UserID,
+		Nickname:   client.Nickname,
+		CreatedAt:  chatLog.CreatedAt.Format(time.RFC3339),
+		Attachment: chatAttachmentPayload(h.s3, &attachment),
+	}
+	h.broadcastTranslated(room, "attachment", out, senderLang(room, client))
+}
+
+// chatAttachmentPayload 첨부 파일 정보와 다운로드 URL (URL 생성 실패 시 URL 없이 반환)
+func chatAttachmentPayload(s3 *storage.S3Service, attachment *model.ChatAttachment) *ChatAttachmentPayload {
+	payload := &ChatAttachmentPayload{
+		ID:       attachment.ID,
+		FileName: attachment.FileName,
+		MimeType: attachment.MimeType,
+		FileSize: attachment.FileSize,
+		Key:      attachment.S3Key,
+	}
+	if s3 != nil {
+		if url, err := s3.GetFileURL(attachment.S3Key); err == nil {
+			payload.URL = url
+		}
+	}
+	return payload
+}
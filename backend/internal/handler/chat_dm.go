@@ -156,6 +156,7 @@ func (h *ChatHandler) GetMyDMs(c *fiber.Ctx) error {
 				(SELECT COUNT(*) 
 				 FROM chat_logs cl 
 				 WHERE cl.meeting_id = m.id 
+				   AND cl.deleted_at IS NULL
 				   AND cl.sender_id != ?
 				   AND (my_p.last_read_at IS NULL OR cl.created_at > my_p.last_read_at)),
 				0
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"encoding/json"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+
+	"realtime-backend/internal/model"
+)
+
+// chatEditWindow 작성 후 수정/삭제할 수 있는 시간
+const chatEditWindow = 15 * time.Minute
+
+// ChatEditPayload 메시지 수정/삭제 요청 (삭제는 ID만)
+type ChatEditPayload struct {
+	ID      int64  `json:"id"`
+	Message string `json:"message,omitempty"`
+}
+
+// ChatDeletedPayload 메시지 삭제 알림
+type ChatDeletedPayload struct {
+	ID        int64  `json:"id"`
+	DeletedAt string `json:"deleted_at"`
+}
+
+// handleEdit 작성자의 메시지 수정 (저장 후 모두에게 "message_edited" 전송, 검토 중인 웨비나 채팅의 참석자는 불가)
+func (h *ChatWSHandler) handleEdit(room *ChatRoom, client *ChatClient, roomID int64, payload interface{}) {
+	var req ChatEditPayload
+	if !decodeChatPayload(payload, &req) {
+		return
+	}
+	message := strings.TrimSpace(req.Message)
+	if message == "" {
+		return
+	}
+	if len(message) > 2000 {
+		message = message[:2000]
+	}
+
+	// 웨비나 채팅 검토: 승인된 메시지를 검토 없이 바꿀 수 없도록 참석자의 수정은 거부 (삭제는 허용)
+	room.mu.RLock()
+	held := room.moderated && !client.Moderator
+	room.mu.RUnlock()
+	if held {
+		client.Session.Write(client.Conn, websocket.TextMessage, "error", []byte(`{"type":"error","message":"messages cannot be edited in a moderated chat"}`))
+		return
+	}
+
+	chatLog, ok := h.editableChatLog(client, roomID, req.ID)
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	if err := h.db.Model(chatLog).Updates(map[string]interface{}{"message": message, "edited_at": now}).Error; err != nil {
+		log.Printf("채팅 메시지 수정 실패: id=%d: %v", chatLog.ID, err)
+		return
+	}
+	chatLog.Message = &message
+	chatLog.EditedAt = &now
+
+	edited := chatLogPayload(chatLog)
+	edited.Nickname = client.Nickname
+	h.broadcastTranslated(room, "message_edited", edited, senderLang(room, client))
+}
+
+// handleDelete 작성자의 메시지 삭제 (soft delete 후 모두에게 "message_deleted" 전송)
+func (h *ChatWSHandler) handleDelete(room *ChatRoom, client *ChatClient, roomID int64, payload interface{}) {
+	var req ChatEditPayload
+	if !decodeChatPayload(payload, &req) {
+		return
+	}
+
+	chatLog, ok := h.editableChatLog(client, roomID, req.ID)
+	if !ok {
+		return
+	}
+
+	if err := h.db.Delete(chatLog).Error; err != nil {
+		log.Printf("채팅 메시지 삭제 실패: id=%d: %v", chatLog.ID, err)
+		return
+	}
+
+	h.broadcast(room, WSMessage{Type: "message_deleted", Payload: ChatDeletedPayload{
+		ID:        chatLog.ID,
+		DeletedAt: time.Now().Format(time.RFC3339),
+	}})
+}
+
+// editableChatLog 수정/삭제 대상 메시지 조회 (본인이 보낸 일반/첨부 메시지, 작성 후 chatEditWindow 이내)
+// 조건에 맞지 않으면 요청한 클라이언트에게 에러 전송
+func (h *ChatWSHandler) editableChatLog(client *ChatClient, roomID, messageID int64) (*model.ChatLog, bool) {
+	var chatLog model.ChatLog
+	if err := h.db.Where("id = ? AND meeting_id = ?", messageID, roomID).First(&chatLog).Error; err != nil {
+		client.Session.Write(client.Conn, websocket.TextMessage, "error", []byte(`{"type":"error","message":"message not found"}`))
+		return nil, false
+	}
+	if chatLog.SenderID == nil || *chatLog.SenderID != client.UserID || (chatLog.Type != "TEXT" && chatLog.Type != "ATTACHMENT") {
+		client.Session.Write(client.Conn, websocket.TextMessage, "error", []byte(`{"type":"error","message":"only the author can change this message"}`))
+		return nil, false
+	}
+	if time.Since(chatLog.CreatedAt) > chatEditWindow {
+		client.Session.Write(client.Conn, websocket.TextMessage, "error", []byte(`{"type":"error","message":"message can no longer be changed"}`))
+		return nil, false
+	}
+	return &chatLog, true
+}
+
+// decodeChatPayload WSMessage.Payload(map)를 구조체로 변환
+func decodeChatPayload(payload interface{}, v interface{}) bool {
+	payloadBytes, _ := json.Marshal(payload)
+	return json.Unmarshal(payloadBytes, v) == nil
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/repository"
+)
+
+// 채팅 기록 설정
+const (
+	chatHistoryPageSize = 50 // 한 페이지 메시지 수 (WebSocket 입장 시에도 같은 크기)
+	chatHistoryTimeout  = 5 * time.Second
+)
+
+// ChatHistoryPayload WebSocket 입장 시 보내는 최근 메시지 (오래된 메시지부터)
+type ChatHistoryPayload struct {
+	Messages   []ChatPayload `json:"messages"`
+	NextCursor string        `json:"next_cursor,omitempty"` // GET /api/meetings/:id/chat?before= 로 이전 메시지 조회
+	HasMore    bool          `json:"has_more"`
+}
+
+// GetMeetingChat 회의 채팅 기록 조회 (GET /api/meetings/:id/chat?before=<cursor>&limit=50)
+// 최근 메시지 한 페이지를 시간순으로 반환, next_cursor를 before로 넘기면 그 이전 메시지
+func (h *ChatHandler) GetMeetingChat(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	meetingID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid meeting id",
+		})
+	}
+
+	var meeting model.Meeting
+	if err := h.db.First(&meeting, meetingID).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "meeting not found",
+		})
+	}
+	access, err := GetMeetingAccess(h.db, &meeting, claims.UserID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to check meeting access",
+		})
+	}
+	if !access.CanAccess() {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you are not a member of this meeting",
+		})
+	}
+
+	page, err := h.chats.List(c.UserContext(), repository.ChatFilter{MeetingID: meeting.ID}, repository.PageRequest{
+		Limit:  c.QueryInt("limit", chatHistoryPageSize),
+		Cursor: c.Query("before"),
+	}, repository.WithPreload("Sender"), repository.WithPreload("Attachment"))
+	if err != nil {
+		return pageError(c, err, "failed to get chat history")
+	}
+
+	// 최신순으로 읽었으므로 뒤집어서 시간순으로
+	responses := make([]ChatLogResponse, len(page.Items))
+	for i := range page.Items {
+		responses[len(page.Items)-1-i] = h.toChatLogResponse(&page.Items[i])
+	}
+
+	return c.JSON(fiber.Map{
+		"meeting_id":  meeting.ID,
+		"messages":    responses,
+		"next_cursor": page.NextCursor,
+		"has_more":    page.HasMore,
+	})
+}
+
+// sendHistory 입장한 클라이언트에게 최근 메시지 한 페이지 전송 (재접속 시 대화 맥락 유지)
+func (h *ChatWSHandler) sendHistory(client *ChatClient, roomID int64) {
+	ctx, cancel := context.WithTimeout(context.Background(), chatHistoryTimeout)
+	defer cancel()
+
+	page, err := h.chats.List(ctx, repository.ChatFilter{MeetingID: roomID},
+		repository.PageRequest{Limit: chatHistoryPageSize}, repository.WithPreload("Sender"), repository.WithPreload("Attachment"))
+	if err != nil {
+		log.Printf("채팅 기록 조회 실패: room=%d: %v", roomID, err)
+		return
+	}
+
+	history := ChatHistoryPayload{
+		Messages:   make([]ChatPayload, len(page.Items)),
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	}
+	for i, chatLog := range page.Items {
+		payload := chatLogPayload(&chatLog)
+		if chatLog.Attachment != nil {
+			payload.Attachment = chatAttachmentPayload(h.s3, chatLog.Attachment)
+		}
+		history.Messages[len(page.Items)-1-i] = payload
+	}
+
+	msg := WSMessage{Type: "history", Payload: history}
+	msgBytes, _ := json.Marshal(msg)
+	client.Session.Write(client.Conn, websocket.TextMessage, msg.Type, msgBytes)
+}
+
+// chatLogPayload 저장된 채팅 로그를 WebSocket 메시지 형식으로 변환
+func chatLogPayload(chatLog *model.ChatLog) ChatPayload {
+	payload := ChatPayload{
+		ID:        chatLog.ID,
+		CreatedAt: chatLog.CreatedAt.Format(time.RFC3339),
+	}
+	if chatLog.Message != nil {
+		payload.Message = *chatLog.Message
+	}
+	if chatLog.SenderID != nil {
+		payload.SenderID = *chatLog.SenderID
+	}
+	if chatLog.Sender != nil {
+		payload.Nickname = chatLog.Sender.Nickname
+	}
+	if chatLog.EditedAt != nil {
+		payload.EditedAt = chatLog.EditedAt.Format(time.RFC3339)
+	}
+	return payload
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"realtime-backend/internal/model"
+)
+
+// 채팅 전송 멱등성 설정
+const (
+	maxIdempotencyKeyLength = 64             // 멱등성 키 최대 길이
+	chatIdempotencyWindow   = 24 * time.Hour // 같은 키를 중복으로 보는 기간 (지나면 같은 키로 새 메시지 저장 가능)
+)
+
+// errInvalidIdempotencyKey 멱등성 키 길이 초과
+var errInvalidIdempotencyKey = errors.New("idempotency key must be at most 64 characters")
+
+// normalizeIdempotencyKey 클라이언트가 보낸 멱등성 키 정리 (빈 값이면 nil)
+func normalizeIdempotencyKey(key string) (*string, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return nil, nil
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, errInvalidIdempotencyKey
+	}
+	return &key, nil
+}
+
+// findDuplicateChatLog 같은 사용자가 같은 채팅에 같은 키로 chatIdempotencyWindow 안에 보낸 메시지 조회
+// (WS 재연결 후 재전송 등으로 이미 저장된 메시지면 원본 반환, 삭제된 메시지 포함)
+func findDuplicateChatLog(db *gorm.DB, meetingID, senderID int64, key *string) (*model.ChatLog, bool) {
+	if key == nil {
+		return nil, false
+	}
+	var chatLog model.ChatLog
+	err := db.Unscoped().
+		Where("meeting_id = ? AND sender_id = ? AND idempotency_key = ? AND created_at >= ?",
+			meetingID, senderID, *key, time.Now().Add(-chatIdempotencyWindow)).
+		First(&chatLog).Error
+	if err != nil {
+		return nil, false
+	}
+	return &chatLog, true
+}
+
+// createChatLog 채팅 로그 저장, 같은 키로 chatIdempotencyWindow 안에 저장된 메시지가 있으면 저장하지 않고 원본 반환
+// 중복 판정은 유니크 인덱스 idx_chat_idempotency에 맡기므로 같은 메시지가 동시에 재전송돼도 한 번만 저장됨
+// 기간이 지난 메시지의 키는 먼저 비워서 같은 키로 새로 보낸 메시지가 유니크 인덱스에 막히지 않게 함
+func createChatLog(db *gorm.DB, chatLog *model.ChatLog) (*model.ChatLog, error) {
+	if chatLog.IdempotencyKey == nil || chatLog.SenderID == nil {
+		return nil, db.Create(chatLog).Error
+	}
+
+	if err := releaseExpiredIdempotencyKey(db, chatLog.MeetingID, *chatLog.SenderID, *chatLog.IdempotencyKey); err != nil {
+		return nil, err
+	}
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(chatLog)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil, nil
+	}
+	if original, ok := findDuplicateChatLog(db, chatLog.MeetingID, *chatLog.SenderID, chatLog.IdempotencyKey); ok {
+		return original, nil
+	}
+	return nil, errors.New("chat log conflicted but the original was not found")
+}
+
+// releaseExpiredIdempotencyKey chatIdempotencyWindow가 지난 메시지에서 같은 키를 비움 (메시지 자체는 유지)
+func releaseExpiredIdempotencyKey(db *gorm.DB, meetingID, senderID int64, key string) error {
+	return db.Unscoped().Model(&model.ChatLog{}).
+		Where("meeting_id = ? AND sender_id = ? AND idempotency_key = ? AND created_at < ?",
+			meetingID, senderID, key, time.Now().Add(-chatIdempotencyWindow)).
+		Update("idempotency_key", nil).Error
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+
+	awsai "realtime-backend/internal/aws"
+)
+
+// chatTranslateTimeout 메시지 하나의 번역 대기 한도 (넘으면 번역 없이 원문만 전송)
+const chatTranslateTimeout = 3 * time.Second
+
+// ChatLanguagePayload 읽는 언어 변경 요청 ("set_language", 빈 값이면 번역 끔)
+type ChatLanguagePayload struct {
+	Lang string `json:"lang"`
+}
+
+// SetTranslator 채팅 자동 번역 설정 (음성 파이프라인과 같은 Translate 클라이언트/캐시 설정 사용, nil이면 번역 안 함)
+func (h *ChatWSHandler) SetTranslator(translator *awsai.TranslateClient, cache *awsai.PipelineCache) {
+	h.translator = translator
+	h.translations = cache
+}
+
+// chatLanguage 클라이언트가 요청한 읽는 언어 정규화 (지원하지 않으면 빈 값 = 원문만)
+func chatLanguage(lang string) string {
+	lang = awsai.NormalizeLanguage(lang)
+	if lang == "" || !awsai.IsSupportedLanguage(lang) {
+		return ""
+	}
+	return lang
+}
+
+// handleSetLanguage 클라이언트의 읽는 언어 변경
+func (h *ChatWSHandler) handleSetLanguage(room *ChatRoom, client *ChatClient, payload interface{}) {
+	var req ChatLanguagePayload
+	if !decodeChatPayload(payload, &req) {
+		return
+	}
+	room.mu.Lock()
+	client.Lang = chatLanguage(req.Lang)
+	room.mu.Unlock()
+}
+
+// senderLang 보낸 사람의 언어 (번역 원문 언어, 빈 값이면 자동 감지)
+func senderLang(room *ChatRoom, client *ChatClient) string {
+	room.mu.RLock()
+	defer room.mu.RUnlock()
+	return client.Lang
+}
+
+// broadcastTranslated 읽는 언어별로 번역을 붙여 모든 클라이언트에게 전송
+// 번역기가 없거나 번역할 언어가 없으면 원문만 전송 (broadcast와 같음)
+func (h *ChatWSHandler) broadcastTranslated(room *ChatRoom, msgType string, payload ChatPayload, sourceLang string) {
+	if h.translator == nil || payload.Message == "" {
+		h.broadcast(room, WSMessage{Type: msgType, Payload: payload})
+		return
+	}
+
+	// 원문과 다른 읽는 언어 수집
+	room.mu.RLock()
+	langs := make(map[string]bool)
+	for _, c := range room.clients {
+		if c.Lang != "" && c.Lang != sourceLang {
+			langs[c.Lang] = true
+		}
+	}
+	room.mu.RUnlock()
+	if len(langs) == 0 {
+		h.broadcast(room, WSMessage{Type: msgType, Payload: payload})
+		return
+	}
+
+	translated := h.translateChat(payload.Message, sourceLang, langs)
+
+	// 언어별로 한 번만 직렬화
+	payload.SourceLang = sourceLang
+	original, _ := json.Marshal(WSMessage{Type: msgType, Payload: payload})
+	encoded := make(map[string][]byte, len(translated))
+	for lang, text := range translated {
+		p := payload
+		p.Translated = text
+		p.TargetLang = lang
+		encoded[lang], _ = json.Marshal(WSMessage{Type: msgType, Payload: p})
+	}
+
+	room.mu.RLock()
+	defer room.mu.RUnlock()
+	for conn, c := range room.clients {
+		msgBytes, ok := encoded[c.Lang]
+		if !ok {
+			msgBytes = original
+		}
+		if err := c.Session.Write(conn, websocket.TextMessage, msgType, msgBytes); err != nil {
+			log.Printf("메시지 전송 실패: %v", err)
+		}
+	}
+}
+
+// translateChat 메시지를 여러 언어로 동시에 번역 (캐시 우선, 실패한 언어는 결과에서 제외)
+// sourceLang이 빈 값이면 Amazon Translate 자동 감지
+func (h *ChatWSHandler) translateChat(text, sourceLang string, langs map[string]bool) map[string]string {
+	if sourceLang == "" {
+		sourceLang = "auto"
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), chatTranslateTimeout)
+	defer cancel()
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]string, len(langs))
+	)
+	for lang := range langs {
+		if h.translations != nil {
+			if cached, ok := h.translations.GetTranslation(text, sourceLang, lang); ok {
+				mu.Lock()
+				results[lang] = cached.TranslatedText
+				mu.Unlock()
+				continue
+			}
+		}
+
+		wg.Add(1)
+		go func(lang string) {
+			defer wg.Done()
+			result, err := h.translator.Translate(ctx, text, sourceLang, lang)
+			if err != nil {
+				log.Printf("채팅 번역 실패: %s→%s: %v", sourceLang, lang, err)
+				return
+			}
+			if h.translations != nil {
+				h.translations.SetTranslation(text, sourceLang, lang, result)
+			}
+			mu.Lock()
+			results[lang] = result.TranslatedText
+			mu.Unlock()
+		}(lang)
+	}
+	wg.Wait()
+	return results
+}
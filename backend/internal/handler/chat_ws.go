@@ -1,28 +1,85 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"log"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gofiber/contrib/websocket"
 	"gorm.io/gorm"
 
+	awsai "realtime-backend/internal/aws"
+	"realtime-backend/internal/metrics"
 	"realtime-backend/internal/model"
+	"realtime-backend/internal/ratelimit"
+	"realtime-backend/internal/repository"
+	"realtime-backend/internal/session"
+	"realtime-backend/internal/storage"
 )
 
+// 채팅방 관리 설정
+const (
+	maxChatRooms          = 5000            // 동시에 열 수 있는 채팅방 수
+	chatRoomIdleTimeout   = 5 * time.Minute // 클라이언트 없이 남아 있는 방 정리 기준
+	chatRoomSweepInterval = time.Minute
+)
+
+// ErrTooManyChatRooms 채팅방 수 제한 초과
+var ErrTooManyChatRooms = errors.New("too many active chat rooms")
+
 // ChatWSHandler WebSocket 채팅 핸들러
 type ChatWSHandler struct {
 	db    *gorm.DB
 	rooms map[int64]*ChatRoom // roomId -> ChatRoom
 	mu    sync.RWMutex
+
+	stopCleanup chan struct{} // 유휴 채팅방 정리 루프 중지
+	closeOnce   sync.Once
+
+	limiter     *ratelimit.Limiter // 사용자별 메시지 전송 제한 (nil = 제한 없음)
+	messageRule ratelimit.Rule
+
+	chats repository.ChatRepo // 입장 시 최근 메시지 조회
+	s3    *storage.S3Service  // 첨부 파일 (nil = 비활성화)
+
+	translator   *awsai.TranslateClient // 채팅 자동 번역 (nil = 비활성화)
+	translations *awsai.PipelineCache
 }
 
 // ChatRoom 채팅방
 type ChatRoom struct {
 	clients map[*websocket.Conn]*ChatClient
 	mu      sync.RWMutex
+
+	createdAt    time.Time
+	lastActivity time.Time // 마지막 입장/퇴장/메시지 시각 (mu 보호)
+	messageCount int64     // atomic
+
+	// 웨비나 채팅 검토 (mu 보호): 참석자 메시지는 승인 전까지 보류
+	moderated bool
+	pending   pendingChats
+}
+
+// ChatRoomStats 채팅방별 통계
+type ChatRoomStats struct {
+	RoomID       int64     `json:"roomId"`
+	Clients      int       `json:"clients"`
+	Messages     int64     `json:"messages"`
+	CreatedAt    time.Time `json:"createdAt"`
+	LastActivity time.Time `json:"lastActivity"`
+}
+
+// ChatStats 채팅 WebSocket 전체 통계
+type ChatStats struct {
+	Rooms    int             `json:"rooms"`
+	MaxRooms int             `json:"maxRooms"`
+	Clients  int             `json:"clients"`
+	PerRoom  []ChatRoomStats `json:"perRoom"`
 }
 
 // ChatClient 채팅 클라이언트
@@ -32,11 +89,14 @@ type ChatClient struct {
 	Conn        *websocket.Conn
 	Permissions []string
 	IsOwner     bool
+	Session     *session.Handle // 세션 관리자 등록 정보 (연결 통계)
+	Moderator   bool            // 웨비나 채팅 검토 권한 (워크스페이스 소유자, 회의 호스트/발표자)
+	Lang        string          // 읽는 언어 (다른 언어 메시지는 번역을 함께 전송, 빈 값 = 원문만), room.mu로 보호
 }
 
 // WSMessage WebSocket 메시지
 type WSMessage struct {
-	Type    string      `json:"type"` // message, typing, stop_typing, join, leave
+	Type    string      `json:"type"` // message, attachment, edit, delete, set_language, typing, stop_typing, join, leave, approve_message, reject_message
 	Payload interface{} `json:"payload,omitempty"`
 }
 
@@ -47,6 +107,25 @@ type ChatPayload struct {
 	SenderID  int64  `json:"sender_id"`
 	Nickname  string `json:"nickname"`
 	CreatedAt string `json:"created_at,omitempty"`
+	EditedAt  string `json:"edited_at,omitempty"` // 수정된 메시지만
+
+	// 자동 번역 (받는 사람의 읽는 언어가 원문과 다를 때만)
+	SourceLang string `json:"source_lang,omitempty"`
+	Translated string `json:"translated,omitempty"`
+	TargetLang string `json:"target_lang,omitempty"`
+
+	Attachment *ChatAttachmentPayload `json:"attachment,omitempty"` // "attachment" 메시지만
+
+	// 클라이언트가 만든 전송 키 (같은 키로 다시 보내면 저장하지 않고 원본 ID로 응답)
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+}
+
+// MessageAckPayload 멱등성 키가 있는 전송에 대한 보낸 사람 확인 응답
+type MessageAckPayload struct {
+	ID             int64  `json:"id"`
+	IdempotencyKey string `json:"idempotency_key"`
+	Duplicate      bool   `json:"duplicate"` // 이미 저장된 메시지 (원본 ID)
+	CreatedAt      string `json:"created_at"`
 }
 
 // TypingPayload 타이핑 페이로드
@@ -55,28 +134,149 @@ type TypingPayload struct {
 	Nickname string `json:"nickname"`
 }
 
-// NewChatWSHandler ChatWSHandler 생성
+// NewChatWSHandler ChatWSHandler 생성 (유휴 채팅방 정리 루프 시작)
 func NewChatWSHandler(db *gorm.DB) *ChatWSHandler {
-	return &ChatWSHandler{
-		db:    db,
-		rooms: make(map[int64]*ChatRoom),
+	h := &ChatWSHandler{
+		db:          db,
+		rooms:       make(map[int64]*ChatRoom),
+		stopCleanup: make(chan struct{}),
+		chats:       repository.NewChatRepo(db),
+	}
+	metrics.Default.OnScrape(h.collectMetrics)
+	go h.runCleanup()
+	return h
+}
+
+// SetRateLimiter 사용자별 메시지 전송 제한 설정 (서버 시작 전에 호출)
+func (h *ChatWSHandler) SetRateLimiter(limiter *ratelimit.Limiter, rule ratelimit.Rule) {
+	h.limiter = limiter
+	h.messageRule = rule
+}
+
+// joinRoom 채팅방에 클라이언트 등록 (없으면 생성)
+// h.mu를 잡은 채로 등록해서 마지막 클라이언트 퇴장으로 방이 삭제되는 것과 경합하지 않음
+func (h *ChatWSHandler) joinRoom(roomID int64, client *ChatClient) (*ChatRoom, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	room, ok := h.rooms[roomID]
+	if !ok {
+		if len(h.rooms) >= maxChatRooms {
+			return nil, ErrTooManyChatRooms
+		}
+		now := time.Now()
+		room = &ChatRoom{
+			clients:      make(map[*websocket.Conn]*ChatClient),
+			createdAt:    now,
+			lastActivity: now,
+		}
+		h.rooms[roomID] = room
+	}
+
+	room.mu.Lock()
+	room.clients[client.Conn] = client
+	room.lastActivity = time.Now()
+	room.mu.Unlock()
+
+	return room, nil
+}
+
+// leaveRoom 클라이언트 제거, 마지막 클라이언트였으면 채팅방 삭제
+func (h *ChatWSHandler) leaveRoom(roomID int64, room *ChatRoom, conn *websocket.Conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	room.mu.Lock()
+	delete(room.clients, conn)
+	room.lastActivity = time.Now()
+	empty := len(room.clients) == 0
+	room.mu.Unlock()
+
+	if empty && h.rooms[roomID] == room {
+		delete(h.rooms, roomID)
+		log.Printf("채팅방 정리: room=%d", roomID)
 	}
 }
 
-// getOrCreateRoom 채팅방 조회 또는 생성
-func (h *ChatWSHandler) getOrCreateRoom(roomID int64) *ChatRoom {
+// runCleanup 주기적으로 유휴 채팅방 정리 (leaveRoom에서 빠진 경우 대비)
+func (h *ChatWSHandler) runCleanup() {
+	ticker := time.NewTicker(chatRoomSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.stopCleanup:
+			return
+		case <-ticker.C:
+			h.CleanupIdleRooms(chatRoomIdleTimeout)
+		}
+	}
+}
+
+// CleanupIdleRooms 클라이언트가 없고 maxIdle 이상 활동이 없는 채팅방 삭제
+func (h *ChatWSHandler) CleanupIdleRooms(maxIdle time.Duration) int {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	if room, ok := h.rooms[roomID]; ok {
-		return room
+	removed := 0
+	for roomID, room := range h.rooms {
+		room.mu.RLock()
+		idle := len(room.clients) == 0 && time.Since(room.lastActivity) >= maxIdle
+		room.mu.RUnlock()
+
+		if idle {
+			delete(h.rooms, roomID)
+			removed++
+		}
+	}
+	if removed > 0 {
+		log.Printf("유휴 채팅방 %d개 정리 (남은 방: %d)", removed, len(h.rooms))
+	}
+	return removed
+}
+
+// Stats 채팅방/클라이언트 통계
+func (h *ChatWSHandler) Stats() ChatStats {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	stats := ChatStats{
+		Rooms:    len(h.rooms),
+		MaxRooms: maxChatRooms,
+		PerRoom:  make([]ChatRoomStats, 0, len(h.rooms)),
 	}
+	for roomID, room := range h.rooms {
+		room.mu.RLock()
+		roomStats := ChatRoomStats{
+			RoomID:       roomID,
+			Clients:      len(room.clients),
+			Messages:     atomic.LoadInt64(&room.messageCount),
+			CreatedAt:    room.createdAt,
+			LastActivity: room.lastActivity,
+		}
+		room.mu.RUnlock()
 
-	room := &ChatRoom{
-		clients: make(map[*websocket.Conn]*ChatClient),
+		stats.Clients += roomStats.Clients
+		stats.PerRoom = append(stats.PerRoom, roomStats)
 	}
-	h.rooms[roomID] = room
-	return room
+	return stats
+}
+
+// collectMetrics /metrics 스크랩 전 채팅 게이지 갱신
+func (h *ChatWSHandler) collectMetrics() {
+	stats := h.Stats()
+	metrics.ChatRooms.Set(float64(stats.Rooms))
+	metrics.ChatClients.Set(float64(stats.Clients))
+}
+
+// Close 정리 루프 중지
+func (h *ChatWSHandler) Close() {
+	h.closeOnce.Do(func() {
+		close(h.stopCleanup)
+		if h.translations != nil {
+			h.translations.Close()
+		}
+	})
 }
 
 // HandleWebSocket WebSocket 연결 처리
@@ -98,6 +298,7 @@ func (h *ChatWSHandler) HandleWebSocket(c *websocket.Conn) {
 	workspaceID, ok2 := c.Locals("workspaceId").(int64)
 	userID, ok3 := userIDInterface.(int64)
 	nickname, ok4 := nicknameInterface.(string)
+	lang, _ := c.Locals("lang").(string)
 
 	if !ok1 || !ok2 || !ok3 || !ok4 {
 		c.WriteMessage(websocket.TextMessage, []byte(`{"type":"error","message":"invalid session"}`))
@@ -118,7 +319,12 @@ func (h *ChatWSHandler) HandleWebSocket(c *websocket.Conn) {
 		isOwner = true
 	}
 
-	room := h.getOrCreateRoom(roomID)
+	moderated, moderator := h.chatModeration(roomID, userID, isOwner)
+
+	sess := session.Default.Open(session.KindChat, "")
+	sess.SetRoomID(strconv.FormatInt(roomID, 10))
+	sess.SetUserID(strconv.FormatInt(userID, 10))
+	defer sess.Close()
 
 	client := &ChatClient{
 		UserID:      userID,
@@ -126,27 +332,44 @@ func (h *ChatWSHandler) HandleWebSocket(c *websocket.Conn) {
 		Conn:        c,
 		Permissions: permissions,
 		IsOwner:     isOwner,
+		Session:     sess,
+		Moderator:   moderator,
+		Lang:        chatLanguage(lang),
 	}
 
 	// 클라이언트 등록
-	room.mu.Lock()
-	room.clients[c] = client
-	room.mu.Unlock()
+	room, err := h.joinRoom(roomID, client)
+	if err != nil {
+		log.Printf("채팅방 입장 거부: room=%d, user=%d: %v", roomID, userID, err)
+		c.WriteMessage(websocket.TextMessage, []byte(`{"type":"error","message":"too many active chat rooms"}`))
+		c.Close()
+		return
+	}
 
 	log.Printf("채팅 클라이언트 연결: room=%d, user=%d", roomID, userID)
 
-	// 연결 해제 시 정리
-	defer func() {
+	// 최근 메시지 한 페이지 (재접속해도 이전 대화가 보이도록)
+	h.sendHistory(client, roomID)
+
+	if moderated {
 		room.mu.Lock()
-		delete(room.clients, c)
+		room.moderated = true
 		room.mu.Unlock()
+		if moderator {
+			h.sendPendingMessages(room, client)
+		}
+	}
+
+	// 연결 해제 시 정리 (마지막 클라이언트면 채팅방도 삭제)
+	defer func() {
+		h.leaveRoom(roomID, room, c)
 		c.Close()
 		log.Printf("채팅 클라이언트 연결 해제: room=%d, user=%d", roomID, userID)
 	}()
 
 	// 메시지 수신 루프
 	for {
-		_, msgBytes, err := c.ReadMessage()
+		_, msgBytes, err := sess.Read(c)
 		if err != nil {
 			break
 		}
@@ -157,7 +380,7 @@ func (h *ChatWSHandler) HandleWebSocket(c *websocket.Conn) {
 		}
 
 		switch msg.Type {
-		case "message":
+		case "message", "attachment":
 			// 권한 체크
 			canSend := client.IsOwner
 			if !canSend {
@@ -169,11 +392,24 @@ func (h *ChatWSHandler) HandleWebSocket(c *websocket.Conn) {
 				}
 			}
 
-			if canSend {
-				h.handleMessage(room, client, roomID, msg.Payload)
-			} else {
+			if !canSend {
 				c.WriteMessage(websocket.TextMessage, []byte(`{"type":"error","message":"no permission to send messages"}`))
+			} else if !h.limiter.Allow(context.Background(), "chat:user:"+strconv.FormatInt(userID, 10), h.messageRule) {
+				metrics.RateLimited.Inc(metrics.RateLimitChatMessage)
+				c.WriteMessage(websocket.TextMessage, []byte(`{"type":"error","message":"too many messages, please slow down"}`))
+			} else if msg.Type == "attachment" {
+				h.handleAttachment(room, client, roomID, msg.Payload)
+			} else {
+				h.handleMessage(room, client, roomID, msg.Payload)
 			}
+		case "edit":
+			h.handleEdit(room, client, roomID, msg.Payload)
+		case "delete":
+			h.handleDelete(room, client, roomID, msg.Payload)
+		case "approve_message", "reject_message":
+			h.handleReview(room, client, roomID, msg.Type == "approve_message", msg.Payload)
+		case "set_language":
+			h.handleSetLanguage(room, client, msg.Payload)
 		case "typing":
 			h.broadcastTyping(room, client, true)
 		case "stop_typing":
@@ -199,32 +435,90 @@ func (h *ChatWSHandler) handleMessage(room *ChatRoom, client *ChatClient, roomID
 		chatPayload.Message = chatPayload.Message[:2000]
 	}
 
+	idempotencyKey, err := normalizeIdempotencyKey(chatPayload.IdempotencyKey)
+	if err != nil {
+		client.Conn.WriteMessage(websocket.TextMessage, []byte(`{"type":"error","message":"invalid idempotency key"}`))
+		return
+	}
+
+	// 재전송된 메시지는 다시 저장/브로드캐스트하지 않고 원본 ID로 응답 (검토 대기열에 다시 넣지 않도록 먼저 확인)
+	if original, ok := findDuplicateChatLog(h.db, roomID, client.UserID, idempotencyKey); ok {
+		h.sendMessageAck(client, original, true)
+		return
+	}
+
+	// 웨비나 채팅 검토: 참석자 메시지는 호스트가 승인할 때까지 보류
+	room.mu.RLock()
+	held := room.moderated && !client.Moderator
+	room.mu.RUnlock()
+	if held {
+		h.holdMessage(room, client, chatPayload.Message, idempotencyKey)
+		return
+	}
+
+	h.publishMessage(room, client, roomID, chatPayload.Message, idempotencyKey)
+}
+
+// publishMessage 메시지를 저장하고 모든 클라이언트에게 전송 (멱등성 키가 있으면 보낸 사람에게 확인 응답)
+// 저장된 메시지 ID 반환 (저장 실패 시 false)
+func (h *ChatWSHandler) publishMessage(room *ChatRoom, client *ChatClient, roomID int64, message string, idempotencyKey *string) (int64, bool) {
 	// DB에 저장 (roomID가 meeting.ID)
-	message := chatPayload.Message
 	chatLog := model.ChatLog{
-		MeetingID: roomID,
-		SenderID:  &client.UserID,
-		Message:   &message,
-		Type:      "TEXT",
+		MeetingID:      roomID,
+		SenderID:       &client.UserID,
+		Message:        &message,
+		Type:           "TEXT",
+		IdempotencyKey: idempotencyKey,
 	}
 
-	if err := h.db.Create(&chatLog).Error; err != nil {
-		return
+	original, err := createChatLog(h.db, &chatLog)
+	if err != nil {
+		return 0, false
+	}
+	// 확인 직후 같은 메시지가 동시에 저장됨: 원본 ID로 응답
+	if original != nil {
+		h.sendMessageAck(client, original, true)
+		return original.ID, true
 	}
 
+	atomic.AddInt64(&room.messageCount, 1)
+	metrics.ChatMessagesTotal.Inc()
+	room.mu.Lock()
+	room.lastActivity = time.Now()
+	room.mu.Unlock()
+
 	// 브로드캐스트 메시지 생성
-	broadcastMsg := WSMessage{
-		Type: "message",
-		Payload: ChatPayload{
-			ID:        chatLog.ID,
-			Message:   message,
-			SenderID:  client.UserID,
-			Nickname:  client.Nickname,
-			CreatedAt: chatLog.CreatedAt.Format(time.RFC3339),
-		},
+	payload := ChatPayload{
+		ID:        chatLog.ID,
+		Message:   message,
+		SenderID:  client.UserID,
+		Nickname:  client.Nickname,
+		CreatedAt: chatLog.CreatedAt.Format(time.RFC3339),
+	}
+	if idempotencyKey != nil {
+		payload.IdempotencyKey = *idempotencyKey
 	}
 
-	h.broadcast(room, broadcastMsg)
+	h.broadcastTranslated(room, "message", payload, senderLang(room, client))
+	if idempotencyKey != nil {
+		h.sendMessageAck(client, &chatLog, false)
+	}
+	return chatLog.ID, true
+}
+
+// sendMessageAck 보낸 사람에게 저장된 메시지 ID 확인 응답 전송
+func (h *ChatWSHandler) sendMessageAck(client *ChatClient, chatLog *model.ChatLog, duplicate bool) {
+	msg := WSMessage{
+		Type: "message_ack",
+		Payload: MessageAckPayload{
+			ID:             chatLog.ID,
+			IdempotencyKey: *chatLog.IdempotencyKey,
+			Duplicate:      duplicate,
+			CreatedAt:      chatLog.CreatedAt.Format(time.RFC3339),
+		},
+	}
+	msgBytes, _ := json.Marshal(msg)
+	client.Session.Write(client.Conn, websocket.TextMessage, msg.Type, msgBytes)
 }
 
 // broadcastTyping 타이핑 상태 브로드캐스트
@@ -249,7 +543,7 @@ func (h *ChatWSHandler) broadcastTyping(room *ChatRoom, client *ChatClient, isTy
 	msgBytes, _ := json.Marshal(msg)
 	for conn, c := range room.clients {
 		if c.UserID != client.UserID {
-			conn.WriteMessage(websocket.TextMessage, msgBytes)
+			c.Session.Write(conn, websocket.TextMessage, msgType, msgBytes)
 		}
 	}
 }
@@ -260,8 +554,8 @@ func (h *ChatWSHandler) broadcast(room *ChatRoom, msg WSMessage) {
 	defer room.mu.RUnlock()
 
 	msgBytes, _ := json.Marshal(msg)
-	for conn := range room.clients {
-		if err := conn.WriteMessage(websocket.TextMessage, msgBytes); err != nil {
+	for conn, c := range room.clients {
+		if err := c.Session.Write(conn, websocket.TextMessage, msg.Type, msgBytes); err != nil {
 			log.Printf("메시지 전송 실패: %v", err)
 		}
 	}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/storage"
+)
+
+// ComplianceHandler 워크스페이스 컴플라이언스 설정 (PII 마스킹 등) 핸들러
+type ComplianceHandler struct {
+	db      *gorm.DB
+	roomHub *RoomHub
+}
+
+// NewComplianceHandler ComplianceHandler 생성
+func NewComplianceHandler(db *gorm.DB, roomHub *RoomHub) *ComplianceHandler {
+	return &ComplianceHandler{db: db, roomHub: roomHub}
+}
+
+// UpdateComplianceRequest 컴플라이언스 설정 변경 요청 (보낸 항목만 변경, kms_key_arn "" = 기본 암호화로 되돌림)
+type UpdateComplianceRequest struct {
+	RedactPII *bool   `json:"redact_pii"`
+	KMSKeyARN *string `json:"kms_key_arn"`
+}
+
+// GetCompliance 워크스페이스 컴플라이언스 설정 조회 (설정이 없으면 기본값)
+func (h *ComplianceHandler) GetCompliance(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid workspace id"})
+	}
+
+	if !h.isWorkspaceMember(int64(workspaceID), claims.UserID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "you are not a member of this workspace"})
+	}
+
+	compliance := model.WorkspaceCompliance{WorkspaceID: int64(workspaceID)}
+	if err := h.db.Where("workspace_id = ?", workspaceID).Limit(1).Find(&compliance).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to get compliance settings"})
+	}
+
+	return c.JSON(compliance)
+}
+
+// UpdateCompliance 워크스페이스 컴플라이언스 설정 변경 (활성 Room에 즉시 반영)
+func (h *ComplianceHandler) UpdateCompliance(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid workspace id"})
+	}
+
+	// 권한 확인 (ADMIN)
+	hasPermission, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "ADMIN")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !hasPermission {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "you do not have permission to update compliance settings"})
+	}
+
+	var req UpdateComplianceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+	if req.RedactPII == nil && req.KMSKeyARN == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "redact_pii or kms_key_arn is required"})
+	}
+	if req.KMSKeyARN != nil && *req.KMSKeyARN != "" && !storage.ValidKMSKeyARN(*req.KMSKeyARN) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "kms_key_arn must be a KMS key or alias ARN"})
+	}
+
+	compliance := model.WorkspaceCompliance{WorkspaceID: int64(workspaceID)}
+	if err := h.db.Where("workspace_id = ?", workspaceID).Limit(1).Find(&compliance).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to get compliance settings"})
+	}
+	if req.RedactPII != nil {
+		compliance.RedactPII = *req.RedactPII
+	}
+	if req.KMSKeyARN != nil {
+		compliance.KMSKeyARN = req.KMSKeyARN
+		if *req.KMSKeyARN == "" {
+			compliance.KMSKeyARN = nil
+		}
+	}
+	compliance.UpdatedBy = claims.UserID
+
+	if err := h.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "workspace_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"redact_pii", "kms_key_arn", "updated_by", "updated_at"}),
+	}).Create(&compliance).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to update compliance settings"})
+	}
+
+	if h.roomHub != nil {
+		h.roomHub.RefreshWorkspaceCompliance(int64(workspaceID))
+	}
+	return c.JSON(compliance)
+}
+
+func (h *ComplianceHandler) isWorkspaceMember(workspaceID, userID int64) bool {
+	var count int64
+	h.db.Model(&model.WorkspaceMember{}).
+		Where("workspace_id = ? AND user_id = ? AND status = ?", workspaceID, userID, model.MemberStatusActive.String()).
+		Count(&count)
+	return count > 0
+}
+
+// WorkspaceKMSKeyResolver 컴플라이언스 설정의 워크스페이스 KMS 키 조회 (S3Service.SetKMSKeyResolver용)
+func WorkspaceKMSKeyResolver(db *gorm.DB) storage.KMSKeyResolver {
+	return func(workspaceID int64) string {
+		var compliance model.WorkspaceCompliance
+		if err := db.Select("kms_key_arn").Where("workspace_id = ?", workspaceID).Limit(1).Find(&compliance).Error; err != nil {
+			log.Printf("[Compliance] Failed to load KMS key for workspace %d: %v", workspaceID, err)
+			return ""
+		}
+		if compliance.KMSKeyARN == nil {
+			return ""
+		}
+		return *compliance.KMSKeyARN
+	}
+}
+
+// meetingWorkspaceID 회의가 속한 워크스페이스 (없으면 0)
+func meetingWorkspaceID(meeting *model.Meeting) int64 {
+	if meeting.WorkspaceID == nil {
+		return 0
+	}
+	return *meeting.WorkspaceID
+}
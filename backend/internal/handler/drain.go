@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"gorm.io/gorm/clause"
+
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/session"
+)
+
+// 서버 종료 시 Room 정리 설정
+const (
+	drainPollInterval    = 100 * time.Millisecond
+	serverClosingMessage = "server is shutting down, reconnect shortly" // 종료 중 새로 접속한 클라이언트에게
+)
+
+// Draining 서버 종료 중 여부 (새 Room 접속 거부)
+func (h *RoomHub) Draining() bool {
+	return h.draining.Load()
+}
+
+// Drain 서버 종료 전 정리: 새 Room 접속을 막고 연결된 클라이언트에 server_closing을 보낸 뒤,
+// 진행 중인 TTS가 리스너에게 전달될 때까지 기다리고 (ctx 만료 시 중단) 모든 Room의 Redis 자막을 DB에 저장
+// 회의를 종료 처리하지는 않으므로 다른 인스턴스로 재접속한 회의는 그대로 이어짐
+func (h *RoomHub) Drain(ctx context.Context, notice session.DrainNotice) {
+	h.draining.Store(true)
+
+	notified := session.Default.Drain(notice)
+	log.Printf("[RoomHub] 📣 Sent server_closing to %d connection(s)", notified)
+
+	h.mu.RLock()
+	rooms := make([]*Room, 0, len(h.rooms))
+	for _, room := range h.rooms {
+		rooms = append(rooms, room)
+	}
+	h.mu.RUnlock()
+
+	if pending := h.waitForPendingTTS(ctx, rooms); pending > 0 {
+		log.Printf("[RoomHub] ⚠️ Drain timed out with %d TTS clip(s) still pending", pending)
+	}
+
+	saved := 0
+	for _, room := range rooms {
+		if err := h.saveRoomTranscripts(ctx, room.ID); err != nil {
+			log.Printf("[Room %s] Failed to save transcripts on shutdown: %v", room.ID, err)
+			continue
+		}
+		saved++
+	}
+	log.Printf("[RoomHub] Drained %d room(s), transcripts saved for %d", len(rooms), saved)
+}
+
+// waitForPendingTTS 모든 Room의 TTS 합성과 전송 대기열이 빌 때까지 대기, 남은 수 반환
+func (h *RoomHub) waitForPendingTTS(ctx context.Context, rooms []*Room) int {
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+
+	for {
+		pending := 0
+		for _, room := range rooms {
+			pending += room.pendingTTS()
+		}
+		if pending == 0 {
+			return 0
+		}
+		select {
+		case <-ctx.Done():
+			return pending
+		case <-ticker.C:
+		}
+	}
+}
+
+// pendingTTS 합성 중이거나 리스너에게 아직 보내지 않은 TTS/메시지 수
+func (r *Room) pendingTTS() int {
+	r.mu.RLock()
+	pipeline := r.awsPipeline
+	pending := len(r.broadcast)
+	for _, l := range r.Listeners.Snapshot() {
+		pending += l.queue.depth()
+	}
+	r.mu.RUnlock()
+
+	if pipeline != nil {
+		pending += pipeline.PendingTTS()
+	}
+	return pending
+}
+
+// saveRoomTranscripts 종료 처리 없이 Room의 Redis 자막을 voice_records에 저장
+// 종료 처리와 같은 cursor를 쓰므로 회의가 끝날 때 다시 저장되지 않고, Redis에는 그대로 남겨
+// 재접속한 리스너의 이전 자막(history)으로 계속 쓸 수 있음
+func (h *RoomHub) saveRoomTranscripts(ctx context.Context, roomID string) error {
+	if h.db == nil || h.redisClient == nil {
+		return nil
+	}
+	// 브레이크아웃 자막은 브레이크아웃이 닫힐 때 부모 회의에 병합
+	if _, _, ok := ParseBreakoutRoomID(roomID); ok {
+		return nil
+	}
+
+	meeting, err := FindMeetingByRoomID(h.db, roomID)
+	if err != nil {
+		return nil
+	}
+
+	if err := h.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.MeetingFinalization{
+		MeetingID: meeting.ID,
+		RoomID:    roomID,
+		Status:    model.FinalizationStatusPending,
+	}).Error; err != nil {
+		return err
+	}
+	var fin model.MeetingFinalization
+	if err := h.db.Where("meeting_id = ?", meeting.ID).First(&fin).Error; err != nil {
+		return err
+	}
+	// 종료 처리가 실행 중이면 그쪽에서 저장
+	if fin.Status == model.FinalizationStatusRunning && fin.LockedUntil != nil && fin.LockedUntil.After(time.Now()) {
+		return nil
+	}
+	fin.RoomID = roomID
+
+	return h.saveMeetingTranscripts(ctx, &fin, meeting)
+}
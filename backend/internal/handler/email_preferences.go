@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm/clause"
+
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/model"
+)
+
+// UpdateEmailPreferencesRequest 이메일 수신 설정 변경 (보낸 항목만 변경)
+type UpdateEmailPreferencesRequest struct {
+	WorkspaceInvites *bool `json:"workspace_invites,omitempty"`
+	MeetingReminders *bool `json:"meeting_reminders,omitempty"`
+	MeetingSummaries *bool `json:"meeting_summaries,omitempty"`
+}
+
+// GetEmailPreferences 내 이메일 수신 설정 조회 (설정한 적 없으면 모두 수신)
+func (h *UserHandler) GetEmailPreferences(c *fiber.Ctx) error {
+	claims, err := auth.GetClaimsFromContext(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+	return c.JSON(h.emailPreferences(claims.UserID))
+}
+
+// UpdateEmailPreferences 내 이메일 수신 설정 변경
+func (h *UserHandler) UpdateEmailPreferences(c *fiber.Ctx) error {
+	claims, err := auth.GetClaimsFromContext(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "unauthorized"})
+	}
+
+	var req UpdateEmailPreferencesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+	}
+
+	pref := h.emailPreferences(claims.UserID)
+	if req.WorkspaceInvites != nil {
+		pref.WorkspaceInvites = *req.WorkspaceInvites
+	}
+	if req.MeetingReminders != nil {
+		pref.MeetingReminders = *req.MeetingReminders
+	}
+	if req.MeetingSummaries != nil {
+		pref.MeetingSummaries = *req.MeetingSummaries
+	}
+
+	err = h.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"workspace_invites", "meeting_reminders", "meeting_summaries", "updated_at"}),
+	}).Create(&pref).Error
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to update email preferences"})
+	}
+	return c.JSON(pref)
+}
+
+// emailPreferences 저장된 설정 (없으면 모두 수신)
+func (h *UserHandler) emailPreferences(userID int64) model.EmailPreference {
+	pref := model.EmailPreference{
+		UserID:           userID,
+		WorkspaceInvites: true,
+		MeetingReminders: true,
+		MeetingSummaries: true,
+	}
+	h.db.Where("user_id = ?", userID).Limit(1).Find(&pref)
+	return pref
+}
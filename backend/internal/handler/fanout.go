@@ -0,0 +1,282 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"realtime-backend/internal/cache"
+	"realtime-backend/internal/metrics"
+)
+
+// Multi-instance fan-out over Redis pub/sub. Rooms live in each instance's memory, so
+// an instance publishes the transcripts/TTS of its own speakers and delivers what the
+// other instances publish to its own listeners. Listener target languages are exchanged
+// as heartbeats so every instance's pipeline also translates for remote listeners.
+const (
+	fanoutQueueSize      = 256
+	fanoutPublishTimeout = 2 * time.Second
+	fanoutHeartbeat      = 30 * time.Second
+	fanoutLangsTTL       = 3 * fanoutHeartbeat // Remote languages expire if heartbeats stop
+)
+
+// fanoutTypes are the message types shared across instances (room state stays local)
+var fanoutTypes = map[string]bool{"transcript": true, "audio": true}
+
+// fanoutMessage is the wire form of a BroadcastMessage, including fields hidden from listeners
+type fanoutMessage struct {
+	Type            string          `json:"type"`
+	SpeakerID       string          `json:"speakerId"`
+	TargetLang      string          `json:"targetLang,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+	AudioData       []byte          `json:"audioData,omitempty"`
+	VoiceKey        string          `json:"voiceKey,omitempty"`
+	TranscriptID    string          `json:"transcriptId,omitempty"`
+	AudioFormat     string          `json:"audioFormat,omitempty"`
+	AudioSampleRate int             `json:"audioSampleRate,omitempty"`
+}
+
+// remoteLangs are the listener languages last announced by another instance
+type remoteLangs struct {
+	langs []string
+	seen  time.Time
+}
+
+// roomFanout is a room's fan-out state (nil on Room when fan-out is disabled)
+type roomFanout struct {
+	out      chan *cache.FanoutEvent
+	announce chan struct{} // Publish local languages now (listener set changed)
+	pubsub   *redis.PubSub
+	done     chan struct{} // Closed when the subscriber exits
+
+	remote map[string]remoteLangs // instanceID -> languages (guarded by Room.mu)
+}
+
+// fanoutEnabled reports whether rooms are shared with other instances through Redis
+func (h *RoomHub) fanoutEnabled() bool {
+	return h.cfg != nil && h.cfg.Redis.Fanout && h.redisClient != nil
+}
+
+// startFanout subscribes the room to its fan-out channel and starts the publisher
+func (r *Room) startFanout() {
+	f := &roomFanout{
+		out:      make(chan *cache.FanoutEvent, fanoutQueueSize),
+		announce: make(chan struct{}, 1),
+		pubsub:   r.hub.redisClient.SubscribeFanout(r.ctx, r.ID),
+		done:     make(chan struct{}),
+		remote:   make(map[string]remoteLangs),
+	}
+	r.fanout = f
+
+	go r.runFanoutPublisher(f)
+	go r.runFanoutSubscriber(f)
+	f.announceLanguages()
+}
+
+// stopFanout unsubscribes and waits for the subscriber so nothing is delivered after shutdown
+func (r *Room) stopFanout() {
+	if r.fanout == nil {
+		return
+	}
+	r.fanout.pubsub.Close()
+	<-r.fanout.done
+}
+
+// announceLanguages asks the publisher to send this instance's listener languages
+func (f *roomFanout) announceLanguages() {
+	if f == nil {
+		return
+	}
+	select {
+	case f.announce <- struct{}{}:
+	default:
+	}
+}
+
+// countRemoteLanguages adds languages of listeners on other instances (r.mu held)
+func (f *roomFanout) countRemoteLanguages(counts map[string]int) {
+	if f == nil {
+		return
+	}
+	now := time.Now()
+	for _, remote := range f.remote {
+		if now.Sub(remote.seen) > fanoutLangsTTL {
+			continue
+		}
+		for _, lang := range remote.langs {
+			counts[lang]++
+		}
+	}
+}
+
+// publishFanout queues a transcript/TTS of a local speaker for the other instances
+func (r *Room) publishFanout(msg *BroadcastMessage) {
+	if r.fanout == nil || msg.TargetListenerID != "" || !fanoutTypes[msg.Type] {
+		return
+	}
+
+	wire := fanoutMessage{
+		Type:            msg.Type,
+		SpeakerID:       msg.SpeakerID,
+		TargetLang:      msg.TargetLang,
+		AudioData:       msg.AudioData,
+		VoiceKey:        msg.VoiceKey,
+		TranscriptID:    msg.TranscriptID,
+		AudioFormat:     msg.AudioFormat,
+		AudioSampleRate: msg.AudioSampleRate,
+	}
+	if msg.Data != nil {
+		data, err := json.Marshal(msg.Data)
+		if err != nil {
+			return
+		}
+		wire.Data = data
+	}
+	payload, err := json.Marshal(wire)
+	if err != nil {
+		return
+	}
+
+	select {
+	case r.fanout.out <- &cache.FanoutEvent{Kind: cache.FanoutKindMessage, Message: payload}:
+	default:
+		metrics.DroppedMessages.Inc(metrics.DropRoomFanout)
+		log.Printf("[Room %s] Fan-out buffer full, dropping %s", r.ID, msg.Type)
+	}
+}
+
+// localTargetLanguages returns the target languages of this instance's listeners
+// (including listeners of edge relays subscribed here)
+func (r *Room) localTargetLanguages() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := make(map[string]int)
+	for _, l := range r.Listeners.Snapshot() {
+		counts[l.TargetLang]++
+	}
+	r.countRelayLanguages(counts)
+
+	langs := make([]string, 0, len(counts))
+	for lang := range counts {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// runFanoutPublisher publishes queued events in order, plus language heartbeats
+func (r *Room) runFanoutPublisher(f *roomFanout) {
+	ticker := time.NewTicker(fanoutHeartbeat)
+	defer ticker.Stop()
+
+	publish := func(event *cache.FanoutEvent) {
+		event.Origin = r.hub.instanceID
+		ctx, cancel := context.WithTimeout(context.Background(), fanoutPublishTimeout)
+		defer cancel()
+		if err := r.hub.redisClient.PublishFanout(ctx, r.ID, event); err != nil {
+			log.Printf("[Room %s] Fan-out publish failed (%s): %v", r.ID, event.Kind, err)
+		}
+	}
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			publish(&cache.FanoutEvent{Kind: cache.FanoutKindLeave})
+			return
+		case event := <-f.out:
+			publish(event)
+		case <-f.announce:
+			publish(&cache.FanoutEvent{Kind: cache.FanoutKindLangs, Langs: r.localTargetLanguages()})
+		case <-ticker.C:
+			publish(&cache.FanoutEvent{Kind: cache.FanoutKindLangs, Langs: r.localTargetLanguages()})
+		}
+	}
+}
+
+// runFanoutSubscriber delivers other instances' events to local listeners
+func (r *Room) runFanoutSubscriber(f *roomFanout) {
+	defer close(f.done)
+
+	for redisMsg := range f.pubsub.Channel() {
+		var event cache.FanoutEvent
+		if err := json.Unmarshal([]byte(redisMsg.Payload), &event); err != nil || event.Origin == r.hub.instanceID {
+			continue
+		}
+
+		switch event.Kind {
+		case cache.FanoutKindMessage:
+			var wire fanoutMessage
+			if err := json.Unmarshal(event.Message, &wire); err != nil {
+				continue
+			}
+			if r.ctx.Err() == nil {
+				r.broadcastLocal(wire.broadcastMessage())
+			}
+		case cache.FanoutKindLangs, cache.FanoutKindLeave:
+			if r.updateRemoteLanguages(f, event.Origin, event.Langs) {
+				// A new instance joined: tell it our languages without waiting for the heartbeat
+				f.announceLanguages()
+			}
+		}
+	}
+}
+
+// updateRemoteLanguages records another instance's languages and retargets the pipeline
+// if the union changed; returns true if the instance was not known before
+func (r *Room) updateRemoteLanguages(f *roomFanout, origin string, langs []string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	before := r.listenerTargetLanguages()
+	_, known := f.remote[origin]
+	if len(langs) == 0 {
+		delete(f.remote, origin)
+	} else {
+		f.remote[origin] = remoteLangs{langs: langs, seen: time.Now()}
+	}
+	for id, remote := range f.remote {
+		if time.Since(remote.seen) > fanoutLangsTTL {
+			delete(f.remote, id)
+		}
+	}
+
+	after := r.listenerTargetLanguages()
+	if r.hub.useAWS && r.awsPipeline != nil && !slices.Equal(before, after) {
+		log.Printf("[Room %s] 🔄 Updating target languages (remote listeners): %v", r.ID, after)
+		r.awsPipeline.UpdateTargetLanguages(after)
+	}
+	return !known && len(langs) > 0
+}
+
+// broadcastMessage converts the wire form back into a BroadcastMessage
+func (m *fanoutMessage) broadcastMessage() *BroadcastMessage {
+	msg := &BroadcastMessage{
+		Type:            m.Type,
+		SpeakerID:       m.SpeakerID,
+		TargetLang:      m.TargetLang,
+		AudioData:       m.AudioData,
+		VoiceKey:        m.VoiceKey,
+		TranscriptID:    m.TranscriptID,
+		AudioFormat:     m.AudioFormat,
+		AudioSampleRate: m.AudioSampleRate,
+	}
+	if len(m.Data) == 0 {
+		return msg
+	}
+	// Transcripts are decoded so resume/history treat them like local ones
+	if m.Type == "transcript" {
+		var data TranscriptData
+		if err := json.Unmarshal(m.Data, &data); err == nil {
+			msg.Data = data
+			return msg
+		}
+	}
+	msg.Data = m.Data
+	return msg
+}
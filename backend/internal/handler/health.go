@@ -1,17 +1,45 @@
 package handler
 
 import (
+	"context"
+	"log"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
+
+	"realtime-backend/internal/cache"
+	"realtime-backend/internal/scan"
+	"realtime-backend/internal/storage"
+)
+
+// 헬스체크 설정
+const (
+	healthCheckTimeout = 2 * time.Second // 의존 서비스 하나 확인 한도
+	healthCacheTTL     = 5 * time.Second // 프로브가 몰려도 DB/Redis/AWS를 이 주기보다 자주 호출하지 않음
+)
+
+// 의존 서비스 상태
+const (
+	checkHealthy       = "healthy"
+	checkUnhealthy     = "unhealthy"
+	checkDegraded      = "degraded"
+	checkNotConfigured = "not_configured"
 )
 
 // HealthHandler 헬스체크 핸들러
 type HealthHandler struct {
 	db        *gorm.DB
 	aiAddress string
+	redis     *cache.RedisClient
+	s3        *storage.S3Service
+	scanner   scan.Scanner
+
+	mu       sync.Mutex
+	cached   map[string]ComponentCheck
+	cachedAt time.Time
 }
 
 // NewHealthHandler HealthHandler 생성
@@ -19,6 +47,21 @@ func NewHealthHandler(db *gorm.DB, aiAddress string) *HealthHandler {
 	return &HealthHandler{db: db, aiAddress: aiAddress}
 }
 
+// SetRedis Redis 연결 확인 추가 (설정되면 readiness 필수 항목)
+func (h *HealthHandler) SetRedis(redis *cache.RedisClient) {
+	h.redis = redis
+}
+
+// SetStorage S3 버킷 접근 확인 추가
+func (h *HealthHandler) SetStorage(s3 *storage.S3Service) {
+	h.s3 = s3
+}
+
+// SetScanner 바이러스 검사 엔진 연결 확인 추가
+func (h *HealthHandler) SetScanner(scanner scan.Scanner) {
+	h.scanner = scanner
+}
+
 // ComponentCheck 컴포넌트 상태
 type ComponentCheck struct {
 	Status  string `json:"status"`
@@ -33,79 +76,137 @@ type HealthResponse struct {
 	Checks    map[string]ComponentCheck `json:"checks"`
 }
 
-// Check 전체 상태 확인 (DB + AI Server)
+// Check 전체 상태 확인 (DB, Redis, S3, 바이러스 검사, AI Server)
 func (h *HealthHandler) Check(c *fiber.Ctx) error {
+	return h.respond(c, h.dependencyChecks())
+}
+
+// Liveness K8s liveness probe용 (프로세스가 응답하는지만, 의존 서비스 장애로 재시작되지 않도록)
+func (h *HealthHandler) Liveness(c *fiber.Ctx) error {
+	return c.SendString("OK")
+}
+
+// Readiness K8s readiness probe / 로드밸런서용
+// DB와 (설정된 경우) Redis가 응답하지 않으면 503으로 트래픽을 받지 않음
+// S3/바이러스 검사/AI Server 장애는 모든 인스턴스에 똑같이 영향을 주므로 degraded로만 표시
+func (h *HealthHandler) Readiness(c *fiber.Ctx) error {
+	checks := h.dependencyChecks()
+	delete(checks, "ai_server")
+	return h.respond(c, checks)
+}
+
+// respond 필수 항목이 unhealthy면 503, 나머지 장애는 degraded (200)
+func (h *HealthHandler) respond(c *fiber.Ctx, checks map[string]ComponentCheck) error {
 	response := HealthResponse{
-		Status:    "healthy",
+		Status:    checkHealthy,
 		Timestamp: time.Now().Format(time.RFC3339),
-		Checks:    make(map[string]ComponentCheck),
+		Checks:    checks,
 	}
-
-	// 1. Database 체크
-	dbStart := time.Now()
-	sqlDB, err := h.db.DB()
-	if err != nil {
-		response.Status = "unhealthy"
-		response.Checks["database"] = ComponentCheck{
-			Status: "unhealthy",
-			Error:  "failed to get database connection",
-		}
-	} else if err := sqlDB.Ping(); err != nil {
-		response.Status = "unhealthy"
-		response.Checks["database"] = ComponentCheck{
-			Status: "unhealthy",
-			Error:  "database ping failed",
-		}
-	} else {
-		response.Checks["database"] = ComponentCheck{
-			Status:  "healthy",
-			Latency: time.Since(dbStart).String(),
-		}
-	}
-
-	// 2. AI Server 체크 (gRPC 연결 확인)
-	if h.aiAddress != "" {
-		aiStart := time.Now()
-		conn, err := net.DialTimeout("tcp", h.aiAddress, 2*time.Second)
-		if err != nil {
-			response.Checks["ai_server"] = ComponentCheck{
-				Status: "degraded",
-				Error:  "AI server unreachable",
+	for _, check := range checks {
+		switch check.Status {
+		case checkUnhealthy:
+			response.Status = checkUnhealthy
+		case checkDegraded:
+			if response.Status == checkHealthy {
+				response.Status = checkDegraded
 			}
-		} else {
-			conn.Close()
-			response.Checks["ai_server"] = ComponentCheck{
-				Status:  "healthy",
-				Latency: time.Since(aiStart).String(),
-			}
-		}
-	} else {
-		response.Checks["ai_server"] = ComponentCheck{
-			Status: "not_configured",
 		}
 	}
 
 	statusCode := fiber.StatusOK
-	if response.Status == "unhealthy" {
+	if response.Status == checkUnhealthy {
 		statusCode = fiber.StatusServiceUnavailable
 	}
-
 	return c.Status(statusCode).JSON(response)
 }
 
-// Liveness K8s liveness probe용 (단순 체크)
-func (h *HealthHandler) Liveness(c *fiber.Ctx) error {
-	return c.SendString("OK")
+// dependencyChecks 의존 서비스를 동시에 확인 (healthCacheTTL 동안 결과 재사용, 호출한 쪽이 수정할 수 있도록 복사본 반환)
+func (h *HealthHandler) dependencyChecks() map[string]ComponentCheck {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.cached == nil || time.Since(h.cachedAt) >= healthCacheTTL {
+		h.cached = h.runChecks()
+		h.cachedAt = time.Now()
+	}
+
+	checks := make(map[string]ComponentCheck, len(h.cached))
+	for name, check := range h.cached {
+		checks[name] = check
+	}
+	return checks
 }
 
-// Readiness K8s readiness probe용 (DB 연결 체크)
-func (h *HealthHandler) Readiness(c *fiber.Ctx) error {
+func (h *HealthHandler) runChecks() map[string]ComponentCheck {
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+
+	checks := make(map[string]ComponentCheck)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	set := func(name string, check ComponentCheck) {
+		mu.Lock()
+		checks[name] = check
+		mu.Unlock()
+	}
+	// required: 실패하면 unhealthy (트래픽 차단), 아니면 degraded
+	run := func(name string, required bool, ping func(context.Context) error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start := time.Now()
+			err := ping(ctx)
+			check := ComponentCheck{Status: checkHealthy, Latency: time.Since(start).String()}
+			if err != nil {
+				// 응답에는 내부 주소 등이 드러나지 않도록 원인은 로그에만 남김
+				log.Printf("[Health] %s check failed: %v", name, err)
+				check = ComponentCheck{Status: checkDegraded, Error: name + " unreachable"}
+				if required {
+					check.Status = checkUnhealthy
+				}
+			}
+			set(name, check)
+		}()
+	}
+
+	run("database", true, h.pingDatabase)
+	if h.redis != nil {
+		run("redis", true, h.redis.Health)
+	} else {
+		set("redis", ComponentCheck{Status: checkNotConfigured})
+	}
+	if h.s3 != nil {
+		run("aws_s3", false, h.s3.Ping)
+	} else {
+		set("aws_s3", ComponentCheck{Status: checkNotConfigured})
+	}
+	if h.scanner != nil {
+		run("virus_scan", false, h.scanner.Ping)
+	}
+	if h.aiAddress != "" {
+		run("ai_server", false, h.pingAIServer)
+	} else {
+		set("ai_server", ComponentCheck{Status: checkNotConfigured})
+	}
+
+	wg.Wait()
+	return checks
+}
+
+func (h *HealthHandler) pingDatabase(ctx context.Context) error {
 	sqlDB, err := h.db.DB()
 	if err != nil {
-		return c.Status(fiber.StatusServiceUnavailable).SendString("NOT READY")
+		return err
 	}
-	if err := sqlDB.Ping(); err != nil {
-		return c.Status(fiber.StatusServiceUnavailable).SendString("NOT READY")
+	return sqlDB.PingContext(ctx)
+}
+
+// pingAIServer gRPC 포트 연결 확인
+func (h *HealthHandler) pingAIServer(ctx context.Context) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", h.aiAddress)
+	if err != nil {
+		return err
 	}
-	return c.SendString("READY")
+	return conn.Close()
 }
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"realtime-backend/internal/cache"
+)
+
+// 늦게 참가한 리스너에게 보내는 이전 자막 설정
+const (
+	MaxHistoryTranscripts = 100
+	historyFetchFactor    = 5 // Redis에는 번역 언어마다 한 건씩 저장되므로 넉넉히 읽어서 발화 단위로 묶음
+	historyReadTimeout    = 2 * time.Second
+)
+
+// HistoryTranscript 이전 final 자막 한 건 (리스너 언어로 선택)
+type HistoryTranscript struct {
+	TranscriptData
+	SpeakerName string    `json:"speakerName,omitempty"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+// HistoryData "history" 메시지 (오래된 순)
+type HistoryData struct {
+	TargetLang  string              `json:"targetLang"`
+	Transcripts []HistoryTranscript `json:"transcripts"`
+}
+
+// ParseHistoryCount join 쿼리의 history 값 파싱 ("" = 기본값, 0 = 받지 않음, 최대 MaxHistoryTranscripts)
+func ParseHistoryCount(raw string, defaultCount int) (int, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return min(max(defaultCount, 0), MaxHistoryTranscripts), true
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return min(n, MaxHistoryTranscripts), true
+}
+
+// =============================================================================
+// Room Methods - Late-joiner history
+// =============================================================================
+
+// SendHistory 회의 중간에 들어온 리스너에게 최근 final 자막 count개를 리스너 언어로 전송
+func (r *Room) SendHistory(listenerID, targetLang string, count int) {
+	if count <= 0 || r.hub.redisClient == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), historyReadTimeout)
+	defer cancel()
+
+	entries, err := r.hub.redisClient.GetRecentTranscripts(ctx, r.ID, int64(count*historyFetchFactor))
+	if err != nil {
+		log.Printf("[Room %s] Failed to load transcript history: %v", r.ID, err)
+		return
+	}
+
+	history := selectHistory(entries, targetLang, count)
+	if len(history) == 0 {
+		return
+	}
+	r.Broadcast(&BroadcastMessage{
+		Type:             "history",
+		Data:             HistoryData{TargetLang: targetLang, Transcripts: history},
+		TargetListenerID: listenerID,
+	})
+}
+
+// selectHistory Redis 자막을 발화 단위로 묶고 리스너 언어 번역(없으면 원문)을 골라 최근 count개 반환
+func selectHistory(entries []cache.RoomTranscript, targetLang string, count int) []HistoryTranscript {
+	type utterance struct {
+		entry cache.RoomTranscript
+		exact bool // 리스너 언어 번역이거나 리스너 언어로 말한 원문
+	}
+
+	order := make([]string, 0, len(entries))
+	utterances := make(map[string]*utterance, len(entries))
+	for _, e := range entries {
+		if !e.IsFinal {
+			continue
+		}
+		key := e.SpeakerID + "|" + e.SourceLang + "|" + e.Original
+		exact := e.TargetLang == targetLang || (e.SourceLang == targetLang && e.Translated == "")
+
+		u, ok := utterances[key]
+		if !ok {
+			order = append(order, key)
+			utterances[key] = &utterance{entry: e, exact: exact}
+			continue
+		}
+		if exact && !u.exact {
+			u.entry, u.exact = e, true
+		}
+	}
+
+	history := make([]HistoryTranscript, 0, len(order))
+	for _, key := range order {
+		e := utterances[key].entry
+		t := HistoryTranscript{
+			TranscriptData: TranscriptData{
+				TranscriptID:  e.TranscriptID,
+				ParticipantID: e.SpeakerID,
+				Original:      e.Original,
+				IsFinal:       true,
+				Language:      e.SourceLang,
+				Crosstalk:     e.Crosstalk,
+				Moderated:     e.Moderated,
+			},
+			SpeakerName: e.SpeakerName,
+			Timestamp:   e.Timestamp,
+		}
+		// 다른 언어 번역만 있으면 원문만 보냄
+		if e.TargetLang == targetLang {
+			t.Translated = e.Translated
+		}
+		history = append(history, t)
+	}
+
+	if len(history) > count {
+		history = history[len(history)-count:]
+	}
+	return history
+}
@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"realtime-backend/internal/metrics"
+	"realtime-backend/internal/model"
+)
+
+// 정리 작업 주기
+const janitorInterval = 5 * time.Minute
+
+// JanitorReport 정리 작업 1회 결과
+type JanitorReport struct {
+	RanAt         time.Time     `json:"ranAt"`
+	Duration      time.Duration `json:"duration"`
+	OrphanStreams int           `json:"orphanStreams"` // 소유 화자가 없는 Transcribe 스트림
+	EmptyRooms    int           `json:"emptyRooms"`    // 연결이 없는데 실행 중인 Room
+	RedisKeys     int           `json:"redisKeys"`     // 이미 저장 완료된 회의의 Redis 자막 키
+	WorkerPools   int           `json:"workerPools"`   // context가 끝났는데 닫히지 않은 워커 풀
+}
+
+// janitorState 의심 리소스 추적 (연속 2회 발견 시에만 정리해서 생성 직후 리소스를 건드리지 않음)
+type janitorState struct {
+	suspects   map[string]bool
+	lastReport *JanitorReport
+	mu         sync.Mutex
+}
+
+// StartJanitor 누수된 리소스를 주기적으로 정리
+func (h *RoomHub) StartJanitor(interval time.Duration) {
+	if interval <= 0 {
+		interval = janitorInterval
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				h.RunJanitor()
+			case <-h.stopRecovery:
+				return
+			}
+		}
+	}()
+}
+
+// LastJanitorReport 마지막 정리 결과 (아직 실행 전이면 nil)
+func (h *RoomHub) LastJanitorReport() *JanitorReport {
+	h.janitor.mu.Lock()
+	defer h.janitor.mu.Unlock()
+	return h.janitor.lastReport
+}
+
+// RunJanitor 누수 리소스를 한 번 정리하고 결과 반환
+func (h *RoomHub) RunJanitor() *JanitorReport {
+	h.janitor.mu.Lock()
+	defer h.janitor.mu.Unlock()
+
+	start := time.Now()
+	report := &JanitorReport{RanAt: start}
+	suspects := make(map[string]bool)
+
+	h.mu.RLock()
+	rooms := make([]*Room, 0, len(h.rooms))
+	for _, room := range h.rooms {
+		rooms = append(rooms, room)
+	}
+	h.mu.RUnlock()
+
+	for _, room := range rooms {
+		room.mu.RLock()
+		empty := room.isRunning && room.Listeners.Len() == 0 && room.Speakers.Len() == 0 && room.relayListenerCount() == 0 && !room.isWarm(time.Now())
+		pipeline := room.awsPipeline
+		room.mu.RUnlock()
+
+		// 연결이 없는데 실행 중인 Room
+		if empty {
+			key := "room:" + room.ID
+			if h.janitor.suspects[key] {
+				log.Printf("[Janitor] Removing empty running room %s", room.ID)
+				h.RemoveRoom(room.ID)
+				report.EmptyRooms++
+				continue
+			}
+			suspects[key] = true
+		}
+
+		if pipeline == nil {
+			continue
+		}
+
+		// 소유 화자(Speaker 또는 오디오를 보내는 리스너)가 없는 Transcribe 스트림
+		for speakerID, langs := range pipeline.StreamSpeakers() {
+			if room.ownsSpeaker(speakerID) {
+				continue
+			}
+			for _, lang := range langs {
+				key := "stream:" + room.ID + ":" + speakerID + ":" + lang
+				if h.janitor.suspects[key] {
+					log.Printf("[Janitor] Closing orphan stream %s:%s in room %s", speakerID, lang, room.ID)
+					pipeline.RemoveSpeakerStream(speakerID, lang)
+					report.OrphanStreams++
+					continue
+				}
+				suspects[key] = true
+			}
+		}
+
+		// 닫히지 않은 워커 풀
+		report.WorkerPools += pipeline.ReapWorkerPools()
+	}
+
+	report.RedisKeys = h.cleanupPersistedTranscripts()
+
+	h.janitor.suspects = suspects
+	report.Duration = time.Since(start)
+	h.janitor.lastReport = report
+
+	metrics.JanitorCleaned.Add(float64(report.OrphanStreams), "stream")
+	metrics.JanitorCleaned.Add(float64(report.EmptyRooms), "room")
+	metrics.JanitorCleaned.Add(float64(report.RedisKeys), "redis_key")
+	metrics.JanitorCleaned.Add(float64(report.WorkerPools), "worker_pool")
+
+	if report.OrphanStreams+report.EmptyRooms+report.RedisKeys+report.WorkerPools > 0 {
+		log.Printf("[Janitor] 🧹 Cleaned streams=%d rooms=%d redisKeys=%d workerPools=%d (%v)",
+			report.OrphanStreams, report.EmptyRooms, report.RedisKeys, report.WorkerPools, report.Duration)
+	}
+	return report
+}
+
+// cleanupPersistedTranscripts 회의 종료 처리가 끝난 Room의 남은 Redis 자막 키 삭제
+// (종료 처리 후 늦게 도착한 자막이 키를 다시 만든 경우, 커밋 후 삭제하지 못한 claim)
+func (h *RoomHub) cleanupPersistedTranscripts() int {
+	if h.redisClient == nil || h.db == nil {
+		return 0
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	roomIDs, err := h.redisClient.TranscriptRoomIDs(ctx)
+	if err != nil {
+		log.Printf("[Janitor] Failed to scan Redis transcript keys: %v", err)
+		return 0
+	}
+	claimRoomIDs, err := h.redisClient.TranscriptClaimRoomIDs(ctx)
+	if err != nil {
+		log.Printf("[Janitor] Failed to scan Redis transcript claims: %v", err)
+	}
+	for _, roomID := range claimRoomIDs {
+		if !slices.Contains(roomIDs, roomID) {
+			roomIDs = append(roomIDs, roomID)
+		}
+	}
+
+	deleted := 0
+	for _, roomID := range roomIDs {
+		h.mu.RLock()
+		_, active := h.rooms[roomID]
+		h.mu.RUnlock()
+		if active {
+			continue
+		}
+
+		// 병합에 실패하고 남은 브레이크아웃 자막은 지우지 않고 다시 병합
+		if _, _, ok := ParseBreakoutRoomID(roomID); ok {
+			if err := h.mergeBreakoutTranscripts(ctx, roomID); err != nil {
+				log.Printf("[Janitor] Failed to merge breakout transcripts of room %s: %v", roomID, err)
+				continue
+			}
+			deleted++
+			continue
+		}
+
+		if !h.transcriptsPersisted(ctx, roomID) {
+			continue
+		}
+
+		if err := h.redisClient.DeleteRoom(ctx, roomID); err != nil {
+			log.Printf("[Janitor] Failed to delete Redis transcripts of room %s: %v", roomID, err)
+			continue
+		}
+		h.releaseCommittedClaims(ctx, roomID)
+		deleted++
+	}
+	return deleted
+}
+
+// transcriptsPersisted Room의 Redis 자막을 지워도 되는지 확인
+// 회의가 종료되었고, 어떤 인스턴스도 Room을 소유하고 있지 않으며(다른 인스턴스에서 진행 중인 Room 보호),
+// 종료 처리가 완료되어 cursor가 Redis의 마지막 final 자막까지 저장했을 때만 true
+// (재개된 회의는 이전 종료 처리가 COMPLETED로 남아 있어도 새 자막이 cursor 뒤에 있음)
+func (h *RoomHub) transcriptsPersisted(ctx context.Context, roomID string) bool {
+	meeting, err := FindMeetingByRoomID(h.db, roomID)
+	if err != nil || meeting.Status != "ENDED" || meeting.EndedAt == nil {
+		return false
+	}
+
+	held, err := h.redisClient.RoomLeaseHeld(ctx, roomID)
+	if err != nil {
+		log.Printf("[Janitor] Failed to check ownership lease of room %s: %v", roomID, err)
+		return false
+	}
+	if held {
+		return false
+	}
+
+	var fin model.MeetingFinalization
+	if err := h.db.Where("meeting_id = ? AND status = ?", meeting.ID, model.FinalizationStatusCompleted).
+		First(&fin).Error; err != nil {
+		return false
+	}
+
+	transcripts, err := h.redisClient.GetTranscripts(ctx, roomID)
+	if err != nil {
+		log.Printf("[Janitor] Failed to read Redis transcripts of room %s: %v", roomID, err)
+		return false
+	}
+	for _, t := range transcripts {
+		if !t.IsFinal {
+			continue
+		}
+		// voice_records로 옮기지 않은 final 자막이 있으면 유지 (cursor와 같은 마이크로초 정밀도로 비교)
+		if fin.TranscriptCursor == nil || t.Timestamp.Truncate(time.Microsecond).After(*fin.TranscriptCursor) {
+			return false
+		}
+	}
+	return true
+}
+
+// ownsSpeaker 화자가 Room에 등록되어 있거나 어떤 리스너가 그 화자의 오디오를 보내고 있는지 확인
+func (r *Room) ownsSpeaker(speakerID string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.Speakers.Get(speakerID); ok {
+		return true
+	}
+	for _, speakers := range r.SenderToSpeakers {
+		if speakers[speakerID] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,345 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/ai"
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/model"
+)
+
+// 키워드 감시 설정
+const (
+	maxWatchKeywords    = 50  // 회의당 감시 키워드 수
+	maxWatchKeywordLen  = 100 // 키워드 길이 (바이트, MeetingKeyword.Keyword 컬럼 크기)
+	keywordContextRunes = 300 // 알림에 담는 문장 길이
+)
+
+var (
+	ErrTooManyKeywords = fmt.Errorf("at most %d keywords can be watched", maxWatchKeywords)
+	ErrKeywordTooLong  = fmt.Errorf("keywords must be at most %d bytes", maxWatchKeywordLen)
+)
+
+// KeywordAlertEvent 호스트에게만 보내는 키워드 발견 알림 (keyword_alert 메시지)
+type KeywordAlertEvent struct {
+	ID           int64     `json:"id,omitempty"` // 저장된 KeywordAlert ID (DB 없으면 0)
+	Keyword      string    `json:"keyword"`
+	TranscriptID string    `json:"transcriptId,omitempty"`
+	SpeakerID    string    `json:"speakerId"`
+	SpeakerName  string    `json:"speakerName,omitempty"`
+	Language     string    `json:"language"`           // 키워드가 발견된 문장의 언어
+	Translated   bool      `json:"translated"`         // 번역문에서 발견
+	Context      string    `json:"context"`            // 키워드가 발견된 문장
+	Original     string    `json:"original,omitempty"` // 번역문에서 발견된 경우 원문
+	At           time.Time `json:"at"`
+}
+
+// keywordWatchlist 호스트가 설정한 감시 키워드 (Room.mu로 보호)
+type keywordWatchlist struct {
+	keywords []string // 소문자
+	loaded   bool     // DB에서 불러왔는지 (Room당 한 번)
+}
+
+// NormalizeKeywords 키워드 정리 (공백 제거, 소문자, 중복/빈 값 제거, 개수/길이 제한)
+func NormalizeKeywords(keywords []string) ([]string, error) {
+	normalized := make([]string, 0, len(keywords))
+	seen := make(map[string]bool, len(keywords))
+	for _, keyword := range keywords {
+		keyword = strings.ToLower(strings.Join(strings.Fields(keyword), " "))
+		if keyword == "" || seen[keyword] {
+			continue
+		}
+		if len(keyword) > maxWatchKeywordLen {
+			return nil, ErrKeywordTooLong
+		}
+		seen[keyword] = true
+		normalized = append(normalized, keyword)
+	}
+	if len(normalized) > maxWatchKeywords {
+		return nil, ErrTooManyKeywords
+	}
+	return normalized, nil
+}
+
+// =============================================================================
+// Room Methods - Keyword watchlist
+// =============================================================================
+
+// SetKeywordWatchlist 감시 키워드 교체 (호스트 전용, 빈 목록이면 감시 중지, 회의에 저장)
+func (r *Room) SetKeywordWatchlist(hostID string, keywords []string) error {
+	if !r.isModerator(hostID) {
+		return ErrNotModerator
+	}
+	normalized, err := NormalizeKeywords(keywords)
+	if err != nil {
+		return err
+	}
+
+	if meeting, err := r.findMeeting(); err == nil {
+		createdBy, _ := strconv.ParseInt(hostID, 10, 64)
+		if err := saveMeetingKeywords(r.hub.db, meeting.ID, createdBy, normalized); err != nil {
+			log.Printf("[Room %s] Failed to save keyword watchlist: %v", r.ID, err)
+			return errors.New("failed to save keyword watchlist")
+		}
+	}
+
+	r.mu.Lock()
+	r.keywords.keywords = normalized
+	r.keywords.loaded = true
+	r.mu.Unlock()
+
+	log.Printf("[Room %s] 🔎 Keyword watchlist set by %s: %d keywords", r.ID, hostID, len(normalized))
+	r.SendKeywordWatchlist(hostID)
+	return nil
+}
+
+// SendKeywordWatchlist 현재 감시 키워드 전송 (호스트 입장 시, 변경 후)
+func (r *Room) SendKeywordWatchlist(listenerID string) {
+	r.Broadcast(&BroadcastMessage{
+		Type:             "keyword_watchlist",
+		Data:             map[string][]string{"keywords": r.keywordWatchlist()},
+		TargetListenerID: listenerID,
+	})
+}
+
+// keywordWatchlist 감시 키워드 (처음 호출 시 회의에 저장된 목록을 불러옴)
+func (r *Room) keywordWatchlist() []string {
+	r.mu.RLock()
+	keywords, loaded := r.keywords.keywords, r.keywords.loaded
+	r.mu.RUnlock()
+	if loaded {
+		return keywords
+	}
+
+	var stored []string
+	if r.hub.db != nil {
+		if meeting, err := r.findMeeting(); err == nil {
+			if err := r.hub.db.Model(&model.MeetingKeyword{}).
+				Where("meeting_id = ?", meeting.ID).
+				Order("id ASC").
+				Pluck("keyword", &stored).Error; err != nil {
+				log.Printf("[Room %s] Failed to load keyword watchlist: %v", r.ID, err)
+			}
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.keywords.loaded { // 그 사이 호스트가 설정했으면 그 목록 유지
+		r.keywords.keywords = stored
+		r.keywords.loaded = true
+	}
+	return r.keywords.keywords
+}
+
+// scanKeywords final 자막의 원문과 번역문에서 감시 키워드를 찾아 호스트에게 알리고 저장
+// 키워드마다 한 번만 알림 (원문 우선, 없으면 처음 발견된 번역문)
+func (r *Room) scanKeywords(t *ai.TranscriptMessage, speakerID string) {
+	keywords := r.keywordWatchlist()
+	if len(keywords) == 0 {
+		return
+	}
+
+	type candidate struct {
+		lang       string
+		text       string
+		translated bool
+	}
+	candidates := []candidate{{lang: t.OriginalLanguage, text: t.OriginalText}}
+	for _, trans := range t.Translations {
+		candidates = append(candidates, candidate{lang: trans.TargetLanguage, text: trans.TranslatedText, translated: true})
+	}
+	lowered := make([]string, len(candidates))
+	for i, c := range candidates {
+		lowered[i] = strings.ToLower(c.text)
+	}
+
+	var alerts []KeywordAlertEvent
+	for _, keyword := range keywords {
+		for i, c := range candidates {
+			if c.text == "" || !strings.Contains(lowered[i], keyword) {
+				continue
+			}
+			alert := KeywordAlertEvent{
+				Keyword:      keyword,
+				TranscriptID: t.ID,
+				SpeakerID:    speakerID,
+				SpeakerName:  r.speakerDisplayName(speakerID),
+				Language:     c.lang,
+				Translated:   c.translated,
+				Context:      truncateRunes(c.text, keywordContextRunes),
+				At:           time.Now(),
+			}
+			if c.translated {
+				alert.Original = truncateRunes(t.OriginalText, keywordContextRunes)
+			}
+			alerts = append(alerts, alert)
+			break
+		}
+	}
+	if len(alerts) == 0 || r.hub.db == nil {
+		return
+	}
+
+	meeting, err := r.findMeeting()
+	if err != nil {
+		log.Printf("[Room %s] Cannot deliver keyword alerts: %v", r.ID, err)
+		return
+	}
+
+	for _, alert := range alerts {
+		record := model.KeywordAlert{
+			MeetingID:    meeting.ID,
+			Keyword:      alert.Keyword,
+			TranscriptID: alert.TranscriptID,
+			SpeakerID:    alert.SpeakerID,
+			Language:     alert.Language,
+			Translated:   alert.Translated,
+			Context:      alert.Context,
+			Original:     alert.Original,
+		}
+		if err := r.hub.db.Create(&record).Error; err != nil {
+			log.Printf("[Room %s] Failed to save keyword alert %q: %v", r.ID, alert.Keyword, err)
+		} else {
+			alert.ID = record.ID
+			alert.At = record.CreatedAt
+		}
+
+		r.Broadcast(&BroadcastMessage{
+			Type:             "keyword_alert",
+			Data:             alert,
+			TargetListenerID: fmt.Sprintf("%d", meeting.HostID),
+		})
+	}
+	log.Printf("[Room %s] 🔎 %d keyword alert(s) for transcript %s", r.ID, len(alerts), t.ID)
+}
+
+// speakerDisplayName 화자 닉네임 (등록되지 않았으면 "")
+func (r *Room) speakerDisplayName(speakerID string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.speakerNickname(speakerID)
+}
+
+// saveMeetingKeywords 회의 감시 키워드 교체
+func saveMeetingKeywords(db *gorm.DB, meetingID, createdBy int64, keywords []string) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("meeting_id = ?", meetingID).Delete(&model.MeetingKeyword{}).Error; err != nil {
+			return err
+		}
+		if len(keywords) == 0 {
+			return nil
+		}
+		rows := make([]model.MeetingKeyword, len(keywords))
+		for i, keyword := range keywords {
+			rows[i] = model.MeetingKeyword{MeetingID: meetingID, Keyword: keyword, CreatedBy: createdBy}
+		}
+		return tx.Create(&rows).Error
+	})
+}
+
+// truncateRunes 최대 n글자로 자르기 (잘리면 … 추가)
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "…"
+}
+
+// =============================================================================
+// REST - keyword alerts review
+// =============================================================================
+
+// KeywordAlertHandler 회의 키워드 감시 목록/알림 조회 핸들러
+type KeywordAlertHandler struct {
+	db *gorm.DB
+}
+
+// NewKeywordAlertHandler KeywordAlertHandler 생성
+func NewKeywordAlertHandler(db *gorm.DB) *KeywordAlertHandler {
+	return &KeywordAlertHandler{db: db}
+}
+
+// GetKeywordAlerts 회의 중 발견된 키워드 알림 (호스트 전용, ?keyword= 로 필터)
+func (h *KeywordAlertHandler) GetKeywordAlerts(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+	meetingID, err := c.ParamsInt("meetingId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid meeting id",
+		})
+	}
+
+	var meeting model.Meeting
+	if err := h.db.Where("id = ? AND workspace_id = ?", meetingID, workspaceID).First(&meeting).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "meeting not found",
+		})
+	}
+	if !h.isMeetingHost(&meeting, claims.UserID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "only the host can review keyword alerts",
+		})
+	}
+
+	var keywords []string
+	if err := h.db.Model(&model.MeetingKeyword{}).
+		Where("meeting_id = ?", meeting.ID).
+		Order("id ASC").
+		Pluck("keyword", &keywords).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get keyword watchlist",
+		})
+	}
+
+	query := h.db.Where("meeting_id = ?", meeting.ID)
+	if keyword := strings.ToLower(strings.TrimSpace(c.Query("keyword"))); keyword != "" {
+		query = query.Where("keyword = ?", keyword)
+	}
+	var alerts []model.KeywordAlert
+	if err := query.Order("created_at ASC, id ASC").Find(&alerts).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get keyword alerts",
+		})
+	}
+
+	counts := make(map[string]int)
+	for _, alert := range alerts {
+		counts[alert.Keyword]++
+	}
+
+	return c.JSON(fiber.Map{
+		"meeting_id": meeting.ID,
+		"keywords":   keywords,
+		"counts":     counts,
+		"alerts":     alerts,
+		"total":      len(alerts),
+	})
+}
+
+// isMeetingHost 미팅 호스트이거나 HOST 역할 참가자인지 확인
+func (h *KeywordAlertHandler) isMeetingHost(meeting *model.Meeting, userID int64) bool {
+	if meeting.HostID == userID {
+		return true
+	}
+	var count int64
+	h.db.Model(&model.Participant{}).
+		Where("meeting_id = ? AND user_id = ? AND role = ?", meeting.ID, userID, participantRoleHost).
+		Count(&count)
+	return count > 0
+}
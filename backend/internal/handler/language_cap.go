@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"realtime-backend/internal/model"
+)
+
+// Per-room cap on simultaneously active target languages. Every extra language adds a
+// Translate call and a Polly synthesis per final transcript, so a listener asking for a
+// language beyond the cap gets an existing one instead, plus a language_unavailable notice.
+
+// LanguageFallback tells a listener the requested language was not activated
+type LanguageFallback struct {
+	Requested    string   `json:"requested"`
+	Fallback     string   `json:"fallback"`
+	MaxLanguages int      `json:"maxLanguages"`
+	Active       []string `json:"active"`
+	Message      string   `json:"message"`
+}
+
+// LoadWorkspaceLanguageLimit 워크스페이스 Room당 동시 대상 언어 수 (설정이 없으면 서버 기본값, 0 = 무제한)
+func (h *RoomHub) LoadWorkspaceLanguageLimit(workspaceID int64) int {
+	limit := 0
+	if h.cfg != nil {
+		limit = h.cfg.AI.MaxTargetLanguages
+	}
+	if h.db == nil || workspaceID == 0 {
+		return limit
+	}
+
+	var quotas []model.WorkspaceQuota
+	if err := h.db.Where("workspace_id = ?", workspaceID).Limit(1).Find(&quotas).Error; err != nil {
+		log.Printf("[RoomHub] Failed to load language limit for workspace %d: %v", workspaceID, err)
+		return limit
+	}
+	if len(quotas) > 0 {
+		limit = quotas[0].MaxTargetLanguages
+	}
+	return limit
+}
+
+// LoadLanguageLimit resolves the room's language cap from its meeting's workspace (once per room;
+// quota updates are pushed by RefreshWorkspaceQuota). Call before AddListener.
+func (r *Room) LoadLanguageLimit() {
+	r.mu.RLock()
+	loaded := r.langLimitLoaded
+	r.mu.RUnlock()
+	if loaded {
+		return
+	}
+
+	var workspaceID int64
+	if r.hub.db != nil {
+		if meeting, err := r.findMeeting(); err == nil && meeting.WorkspaceID != nil {
+			workspaceID = *meeting.WorkspaceID
+		}
+	}
+	limit := r.hub.LoadWorkspaceLanguageLimit(workspaceID)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.langLimitLoaded {
+		return
+	}
+	r.langLimitLoaded = true
+	r.maxTargetLangs = limit
+	if r.workspaceID == 0 {
+		r.workspaceID = workspaceID
+	}
+}
+
+// setLanguageLimit applies a changed cap; listeners already above it keep their languages
+func (r *Room) setLanguageLimit(limit int) {
+	r.mu.Lock()
+	r.langLimitLoaded = true
+	r.maxTargetLangs = limit
+	r.mu.Unlock()
+}
+
+// capTargetLanguage returns the language listenerID actually gets when asking for requested
+// (r.mu held). The listener's own current language doesn't count toward the cap; fallback ""
+// means the room's most-listened language.
+func (r *Room) capTargetLanguage(listenerID, requested, fallback string) (string, *LanguageFallback) {
+	if r.maxTargetLangs <= 0 {
+		return requested, nil
+	}
+
+	counts := make(map[string]int)
+	for id, l := range r.Listeners.Snapshot() {
+		if id != listenerID {
+			counts[l.TargetLang]++
+		}
+	}
+	r.fanout.countRemoteLanguages(counts)
+	r.countRelayLanguages(counts)
+	if counts[requested] > 0 || len(counts) < r.maxTargetLangs {
+		return requested, nil
+	}
+
+	active := languagesByCount(counts)
+	if fallback == "" {
+		fallback = active[0]
+	}
+	log.Printf("[Room %s] 🚫 Listener %s asked for %s beyond the %d-language cap %v, using %s",
+		r.ID, listenerID, requested, r.maxTargetLangs, active, fallback)
+
+	return fallback, &LanguageFallback{
+		Requested:    requested,
+		Fallback:     fallback,
+		MaxLanguages: r.maxTargetLangs,
+		Active:       active,
+		Message: fmt.Sprintf("language %s is not available in this room (max %d languages), falling back to %s",
+			requested, r.maxTargetLangs, fallback),
+	}
+}
+
+// SendLanguageFallback tells the listener its requested language was replaced
+func (r *Room) SendLanguageFallback(listenerID string, fallback *LanguageFallback) {
+	r.broadcastLocal(&BroadcastMessage{
+		Type:             "language_unavailable",
+		Data:             fallback,
+		TargetListenerID: listenerID,
+	})
+}
+
+// languagesByCount orders languages by listener count (desc), then code
+func languagesByCount(counts map[string]int) []string {
+	langs := make([]string, 0, len(counts))
+	for lang := range counts {
+		langs = append(langs, lang)
+	}
+	sort.Slice(langs, func(i, j int) bool {
+		if counts[langs[i]] != counts[langs[j]] {
+			return counts[langs[i]] > counts[langs[j]]
+		}
+		return langs[i] < langs[j]
+	})
+	return langs
+}
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"realtime-backend/internal/auth"
+	awsai "realtime-backend/internal/aws"
+	"realtime-backend/internal/model"
+)
+
+// saveQualityRecords 언어쌍별 품질 누적분을 LanguagePairQualityRecord로 저장
+func (r *Room) saveQualityRecords(quality []awsai.PairQuality, periodStart, periodEnd time.Time, meetingID, workspaceID *int64) {
+	records := make([]model.LanguagePairQualityRecord, 0, len(quality))
+	for _, q := range quality {
+		records = append(records, model.LanguagePairQualityRecord{
+			WorkspaceID:       workspaceID,
+			MeetingID:         meetingID,
+			RoomID:            r.ID,
+			SourceLang:        q.SourceLang,
+			TargetLang:        q.TargetLang,
+			PeriodStart:       periodStart,
+			PeriodEnd:         periodEnd,
+			Finals:            q.Finals,
+			ConfidenceSum:     q.ConfidenceSum,
+			ConfidenceCount:   q.ConfidenceCount,
+			SourceChars:       q.SourceChars,
+			TargetChars:       q.TargetChars,
+			CacheHits:         q.CacheHits,
+			CacheMisses:       q.CacheMisses,
+			TranslateFailures: q.TranslateFailures,
+			TTSRequests:       q.TTSRequests,
+			TTSFailures:       q.TTSFailures,
+		})
+	}
+
+	if err := r.hub.db.Create(&records).Error; err != nil {
+		log.Printf("[Room %s] Failed to save language quality records: %v", r.ID, err)
+	}
+}
+
+// =============================================================================
+// REST - language pair quality report
+// =============================================================================
+
+// languagePairTotals 언어쌍별 합계 (DB 집계 결과)
+type languagePairTotals struct {
+	SourceLang        string
+	TargetLang        string
+	Meetings          int64
+	Finals            int64
+	ConfidenceSum     float64
+	ConfidenceCount   int64
+	SourceChars       int64
+	TargetChars       int64
+	CacheHits         int64
+	CacheMisses       int64
+	TranslateFailures int64
+	TTSRequests       int64 `gorm:"column:tts_requests"`
+	TTSFailures       int64 `gorm:"column:tts_failures"`
+}
+
+// LanguagePairQuality 언어쌍별 번역 품질 지표
+type LanguagePairQuality struct {
+	SourceLang           string  `json:"source_lang"`
+	TargetLang           string  `json:"target_lang"`
+	Meetings             int64   `json:"meetings"`
+	Finals               int64   `json:"finals"`
+	AvgConfidence        float64 `json:"avg_confidence"`         // STT 평균 신뢰도 (0 = 측정값 없음)
+	LengthRatio          float64 `json:"length_ratio"`           // 번역문/원문 글자 수 비율
+	CacheHitRate         float64 `json:"cache_hit_rate"`         // 번역 캐시 적중률
+	TranslateFailureRate float64 `json:"translate_failure_rate"` // 번역 실패(원문 대체 포함) 비율
+	TTSFailureRate       float64 `json:"tts_failure_rate"`       // TTS 실패 비율
+	TTSRequests          int64   `json:"tts_requests"`
+}
+
+// GetLanguageQuality 워크스페이스 언어쌍별 번역 품질 조회
+// (?from=&to= RFC3339, 기본: 최근 30일, ?meeting_id= 특정 회의만). 품질이 나쁜 언어쌍부터 정렬
+func (h *UsageHandler) GetLanguageQuality(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid workspace id"})
+	}
+
+	// 권한 확인 (ADMIN)
+	hasPermission, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "ADMIN")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !hasPermission {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "you do not have permission to view language quality"})
+	}
+
+	to := time.Now()
+	from := to.AddDate(0, 0, -30)
+	if v := c.Query("from"); v != "" {
+		if from, err = time.Parse(time.RFC3339, v); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid from (RFC3339)"})
+		}
+	}
+	if v := c.Query("to"); v != "" {
+		if to, err = time.Parse(time.RFC3339, v); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid to (RFC3339)"})
+		}
+	}
+
+	query := h.db.Model(&model.LanguagePairQualityRecord{}).
+		Select("source_lang, target_lang, COUNT(DISTINCT meeting_id) AS meetings, SUM(finals) AS finals, "+
+			"SUM(confidence_sum) AS confidence_sum, SUM(confidence_count) AS confidence_count, "+
+			"SUM(source_chars) AS source_chars, SUM(target_chars) AS target_chars, "+
+			"SUM(cache_hits) AS cache_hits, SUM(cache_misses) AS cache_misses, SUM(translate_failures) AS translate_failures, "+
+			"SUM(tts_requests) AS tts_requests, SUM(tts_failures) AS tts_failures").
+		Where("workspace_id = ? AND period_start >= ? AND period_start < ?", workspaceID, from, to)
+	if v := c.Query("meeting_id"); v != "" {
+		meetingID, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || meetingID <= 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting_id"})
+		}
+		query = query.Where("meeting_id = ?", meetingID)
+	}
+
+	var totals []languagePairTotals
+	if err := query.Group("source_lang, target_lang").Scan(&totals).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to get language quality"})
+	}
+
+	pairs := make([]LanguagePairQuality, 0, len(totals))
+	for _, t := range totals {
+		pairs = append(pairs, t.quality())
+	}
+	sortWorstPairsFirst(pairs)
+
+	return c.JSON(fiber.Map{
+		"workspace_id": workspaceID,
+		"from":         from,
+		"to":           to,
+		"pairs":        pairs,
+		"total":        len(pairs),
+	})
+}
+
+// quality 합계에서 비율 지표 계산
+func (t languagePairTotals) quality() LanguagePairQuality {
+	q := LanguagePairQuality{
+		SourceLang:  t.SourceLang,
+		TargetLang:  t.TargetLang,
+		Meetings:    t.Meetings,
+		Finals:      t.Finals,
+		TTSRequests: t.TTSRequests,
+	}
+	if t.ConfidenceCount > 0 {
+		q.AvgConfidence = t.ConfidenceSum / float64(t.ConfidenceCount)
+	}
+	if t.SourceChars > 0 {
+		q.LengthRatio = float64(t.TargetChars) / float64(t.SourceChars)
+	}
+	if translations := t.CacheHits + t.CacheMisses; translations > 0 {
+		q.CacheHitRate = float64(t.CacheHits) / float64(translations)
+	}
+	if attempts := t.CacheHits + t.CacheMisses + t.TranslateFailures; attempts > 0 {
+		q.TranslateFailureRate = float64(t.TranslateFailures) / float64(attempts)
+	}
+	if t.TTSRequests > 0 {
+		q.TTSFailureRate = float64(t.TTSFailures) / float64(t.TTSRequests)
+	}
+	return q
+}
+
+// sortWorstPairsFirst 번역 실패율, TTS 실패율 높은 순, 같으면 STT 신뢰도 낮은 순
+func sortWorstPairsFirst(pairs []LanguagePairQuality) {
+	sort.SliceStable(pairs, func(i, j int) bool {
+		a, b := pairs[i], pairs[j]
+		if a.TranslateFailureRate != b.TranslateFailureRate {
+			return a.TranslateFailureRate > b.TranslateFailureRate
+		}
+		if a.TTSFailureRate != b.TTSFailureRate {
+			return a.TTSFailureRate > b.TTSFailureRate
+		}
+		if a.AvgConfidence != b.AvgConfidence {
+			return a.AvgConfidence < b.AvgConfidence
+		}
+		return a.Finals > b.Finals
+	})
+}
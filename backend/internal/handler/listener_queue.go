@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	awsai "realtime-backend/internal/aws"
+	"realtime-backend/internal/config"
+	"realtime-backend/internal/metrics"
+)
+
+// Defaults when the hub has no config
+const (
+	defaultListenerQueueSize      = 64
+	defaultListenerAudioQueueSize = 16
+	defaultSlowListenerEvictAfter = 10 * time.Second
+)
+
+// listenerQueue is a listener's outbound buffer, drained by its own writer goroutine so
+// one slow client never stalls the room broadcaster. Audio has a separate queue that drops
+// its oldest frame when full; other messages are dropped on arrival instead. A listener
+// whose queues keep overflowing for longer than evictAfter is disconnected.
+type listenerQueue struct {
+	messages chan *BroadcastMessage // Transcripts and control messages
+	audio    chan *BroadcastMessage // TTS and relayed original audio
+	done     chan struct{}
+	stopOnce sync.Once
+
+	evictAfter time.Duration // <= 0 = never evict
+	fullSince  atomic.Int64  // UnixNano of the first overflow in the current run, 0 = has room
+	high       awsai.QueueWatermark
+}
+
+func newListenerQueue(cfg *config.Config) *listenerQueue {
+	size, audioSize, evictAfter := defaultListenerQueueSize, defaultListenerAudioQueueSize, defaultSlowListenerEvictAfter
+	if cfg != nil {
+		if cfg.WebSocket.ListenerQueueSize > 0 {
+			size = cfg.WebSocket.ListenerQueueSize
+		}
+		if cfg.WebSocket.ListenerAudioQueueSize > 0 {
+			audioSize = cfg.WebSocket.ListenerAudioQueueSize
+		}
+		evictAfter = cfg.WebSocket.SlowListenerEvictAfter
+	}
+	return &listenerQueue{
+		messages:   make(chan *BroadcastMessage, size),
+		audio:      make(chan *BroadcastMessage, audioSize),
+		done:       make(chan struct{}),
+		evictAfter: evictAfter,
+	}
+}
+
+// push queues msg without blocking and reports whether the listener should be evicted
+func (q *listenerQueue) push(msg *BroadcastMessage) bool {
+	if len(msg.AudioData) > 0 {
+		dropped := false
+		for {
+			select {
+			case q.audio <- msg:
+				q.high.Observe(q.depth())
+				return q.overflowing(dropped)
+			default:
+			}
+			// Make room by dropping the oldest frame: it would play late anyway
+			select {
+			case <-q.audio:
+				dropped = true
+				metrics.DroppedMessages.Inc(metrics.DropListenerAudio)
+			default:
+			}
+		}
+	}
+
+	select {
+	case q.messages <- msg:
+		q.high.Observe(q.depth())
+		return q.overflowing(false)
+	default:
+		metrics.DroppedMessages.Inc(metrics.DropListenerQueue)
+		return q.overflowing(true)
+	}
+}
+
+// overflowing tracks how long pushes have been overflowing; a push that found room resets it
+func (q *listenerQueue) overflowing(full bool) bool {
+	if !full {
+		q.fullSince.Store(0)
+		return false
+	}
+	now := time.Now().UnixNano()
+	if q.fullSince.CompareAndSwap(0, now) {
+		return false
+	}
+	return q.evictAfter > 0 && time.Duration(now-q.fullSince.Load()) >= q.evictAfter
+}
+
+func (q *listenerQueue) depth() int {
+	return len(q.messages) + len(q.audio)
+}
+
+// snapshot is the queue depth for the queue depth API
+func (q *listenerQueue) snapshot(reset bool) awsai.QueueDepth {
+	return q.high.Snapshot(q.depth(), cap(q.messages)+cap(q.audio), reset)
+}
+
+// stop ends the writer goroutine; true only for the first call
+func (q *listenerQueue) stop() bool {
+	stopped := false
+	q.stopOnce.Do(func() {
+		close(q.done)
+		stopped = true
+	})
+	return stopped
+}
+
+func (q *listenerQueue) stopped() bool {
+	select {
+	case <-q.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// detach stops the listener's writer and waits for a write in progress, so the socket is
+// never touched after its handler returns (contrib/websocket pools and reuses Conn values)
+func (l *Listener) detach() bool {
+	stopped := l.queue.stop()
+	l.writeMu.Lock()
+	l.writeMu.Unlock()
+	return stopped
+}
+
+// disconnect closes the listener's socket unless its handler already detached it.
+// Winning stop() first means the handler cannot return and hand its pooled Conn to another
+// client until the close below is done (detach waits on writeMu)
+func (l *Listener) disconnect() bool {
+	if !l.queue.stop() {
+		return false
+	}
+	l.writeMu.Lock()
+	defer l.writeMu.Unlock()
+	_ = l.Conn.Close()
+	return true
+}
+
+// =============================================================================
+// Room Methods - Listener writers
+// =============================================================================
+
+// runListenerWriter writes the listener's queued messages to its socket until the listener is removed
+func (r *Room) runListenerWriter(listener *Listener) {
+	q := listener.queue
+	for {
+		// Transcripts and control messages go ahead of queued audio
+		select {
+		case <-q.done:
+			return
+		case msg := <-q.messages:
+			r.writeToListener(listener, msg)
+			continue
+		default:
+		}
+
+		select {
+		case <-q.done:
+			return
+		case msg := <-q.messages:
+			r.writeToListener(listener, msg)
+		case msg := <-q.audio:
+			// The transcript may have been superseded while the clip waited in the queue
+			if listener.DropSuperseded && r.isSuperseded(msg.TranscriptID) {
+				metrics.DroppedMessages.Inc(metrics.DropSuperseded)
+				continue
+			}
+			r.writeToListener(listener, msg)
+		}
+	}
+}
+
+// evictListener disconnects a listener whose send queue stayed full
+func (r *Room) evictListener(listener *Listener) {
+	if !listener.queue.stop() {
+		return
+	}
+	metrics.SlowListenersEvicted.Inc()
+	log.Printf("[Room %s] 🐢 Evicting slow listener %s (send queue full for %v)",
+		r.ID, listener.ID, listener.queue.evictAfter)
+
+	// Closing the socket ends the handler's read loop, which removes the listener
+	if err := listener.Conn.Close(); err != nil {
+		log.Printf("[Room %s] Failed to close slow listener %s: %v", r.ID, listener.ID, err)
+	}
+}
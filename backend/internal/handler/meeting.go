@@ -11,31 +11,37 @@ import (
 
 	"realtime-backend/internal/auth"
 	"realtime-backend/internal/model"
+	"realtime-backend/internal/repository"
 )
 
 // MeetingHandler 미팅 핸들러
 type MeetingHandler struct {
-	db *gorm.DB
+	db           *gorm.DB
+	meetings     repository.MeetingRepo
+	jwtManager   *auth.JWTManager
+	joinTokenTTL time.Duration
 }
 
-// NewMeetingHandler MeetingHandler 생성
-func NewMeetingHandler(db *gorm.DB) *MeetingHandler {
-	return &MeetingHandler{db: db}
+// NewMeetingHandler MeetingHandler 생성 (joinTokenTTL: 회의 입장 토큰 유효 시간)
+func NewMeetingHandler(db *gorm.DB, jwtManager *auth.JWTManager, joinTokenTTL time.Duration) *MeetingHandler {
+	return &MeetingHandler{db: db, meetings: repository.NewMeetingRepo(db), jwtManager: jwtManager, joinTokenTTL: joinTokenTTL}
 }
 
 // MeetingResponse 미팅 응답
 type MeetingResponse struct {
-	ID           int64                 `json:"id"`
-	WorkspaceID  *int64                `json:"workspace_id,omitempty"`
-	HostID       int64                 `json:"host_id"`
-	Title        string                `json:"title"`
-	Code         string                `json:"code"`
-	Type         string                `json:"type"`
-	Status       string                `json:"status"`
-	StartedAt    *string               `json:"started_at,omitempty"`
-	EndedAt      *string               `json:"ended_at,omitempty"`
-	Host         *UserResponse         `json:"host,omitempty"`
-	Participants []ParticipantResponse `json:"participants,omitempty"`
+	ID            int64                 `json:"id"`
+	WorkspaceID   *int64                `json:"workspace_id,omitempty"`
+	HostID        int64                 `json:"host_id"`
+	Title         string                `json:"title"`
+	Code          string                `json:"code"`
+	Type          string                `json:"type"`
+	Status        string                `json:"status"`
+	ChatModerated bool                  `json:"chat_moderated,omitempty"`
+	ScheduledAt   *string               `json:"scheduled_at,omitempty"`
+	StartedAt     *string               `json:"started_at,omitempty"`
+	EndedAt       *string               `json:"ended_at,omitempty"`
+	Host          *UserResponse         `json:"host,omitempty"`
+	Participants  []ParticipantResponse `json:"participants,omitempty"`
 }
 
 // ParticipantResponse 참가자 응답
@@ -51,10 +57,16 @@ type ParticipantResponse struct {
 // CreateMeetingRequest 미팅 생성 요청
 type CreateMeetingRequest struct {
 	Title string `json:"title"`
-	Type  string `json:"type"` // VIDEO, VOICE_ONLY
+	Type  string `json:"type"` // VIDEO, VOICE_ONLY, WEBINAR
+
+	// 예정 시작 시각 (RFC3339, 있으면 시작 전 알림 발송)
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+
+	// 웨비나 채팅 검토 (WEBINAR에서만 적용)
+	ChatModerated bool `json:"chat_moderated,omitempty"`
 }
 
-// GetWorkspaceMeetings 워크스페이스 미팅 목록
+// GetWorkspaceMeetings 워크스페이스 미팅 목록 (최근 생성 순, ?status=&type= 필터, ?cursor= 로 이어서 조회)
 func (h *MeetingHandler) GetWorkspaceMeetings(c *fiber.Ctx) error {
 	claims := c.Locals("claims").(*auth.Claims)
 	workspaceID, err := c.ParamsInt("workspaceId")
@@ -71,28 +83,30 @@ func (h *MeetingHandler) GetWorkspaceMeetings(c *fiber.Ctx) error {
 		})
 	}
 
-	var meetings []model.Meeting
-	err = h.db.
-		Where("workspace_id = ? AND type != ?", workspaceID, "WORKSPACE_CHAT").
-		Preload("Host").
-		Preload("Participants.User").
-		Order("id DESC").
-		Find(&meetings).Error
-
+	filter := repository.MeetingFilter{
+		WorkspaceID:  int64(workspaceID),
+		ExcludeTypes: []string{"WORKSPACE_CHAT"},
+		Status:       c.Query("status"),
+	}
+	if meetingType := c.Query("type"); meetingType != "" {
+		filter.Types = []string{meetingType}
+	}
+	page, err := h.meetings.List(c.UserContext(), filter, pageRequest(c, repository.MaxLimit),
+		repository.WithPreload("Host"), repository.WithPreload("Participants.User"))
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "failed to get meetings",
-		})
+		return pageError(c, err, "failed to get meetings")
 	}
 
-	responses := make([]MeetingResponse, len(meetings))
-	for i, m := range meetings {
+	responses := make([]MeetingResponse, len(page.Items))
+	for i, m := range page.Items {
 		responses[i] = h.toMeetingResponse(&m)
 	}
 
 	return c.JSON(fiber.Map{
-		"meetings": responses,
-		"total":    len(responses),
+		"meetings":    responses,
+		"total":       len(responses),
+		"next_cursor": page.NextCursor,
+		"has_more":    page.HasMore,
 	})
 }
 
@@ -151,6 +165,9 @@ func (h *MeetingHandler) CreateMeeting(c *fiber.Ctx) error {
 		Code:        code,
 		Type:        req.Type,
 		Status:      "SCHEDULED",
+		ScheduledAt: req.ScheduledAt,
+
+		ChatModerated: req.ChatModerated && req.Type == model.MeetingTypeWebinar.String(),
 	}
 
 	if err := h.db.Create(&meeting).Error; err != nil {
@@ -326,12 +343,19 @@ func (h *MeetingHandler) toMeetingResponse(m *model.Meeting) MeetingResponse {
 		Code:   m.Code,
 		Type:   m.Type,
 		Status: m.Status,
+
+		ChatModerated: m.ChatModerated,
 	}
 
 	if m.WorkspaceID != nil {
 		resp.WorkspaceID = m.WorkspaceID
 	}
 
+	if m.ScheduledAt != nil {
+		t := m.ScheduledAt.Format("2006-01-02T15:04:05Z07:00")
+		resp.ScheduledAt = &t
+	}
+
 	if m.StartedAt != nil {
 		t := m.StartedAt.Format("2006-01-02T15:04:05Z07:00")
 		resp.StartedAt = &t
@@ -0,0 +1,469 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"realtime-backend/internal/cache"
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/summary"
+)
+
+// 회의 종료 처리 설정
+const (
+	finalizationLease       = 10 * time.Minute // 실행 중 잠금 유지 시간 (만료되면 다른 곳에서 이어서 실행)
+	finalizationMaxAttempts = 5                // 재시작 시 FAILED 처리를 다시 시도하는 최대 횟수
+	summaryDuplicateWindow  = 3 * time.Second  // 번역 언어별로 중복 저장된 같은 발화를 하나로 합치는 시간 범위
+)
+
+// finalizationSteps 회의 종료 처리 단계 (순서대로 실행, 완료된 단계는 건너뜀)
+var finalizationSteps = []string{
+	model.FinalizeStepTranscripts,
+	model.FinalizeStepSummary,
+	model.FinalizeStepAttendance,
+}
+
+// FinalizeMeeting 회의 종료 처리 시작 (상태는 즉시 기록, 단계 실행은 백그라운드)
+// 같은 회의에 대해 이미 실행 중이면 아무것도 하지 않음
+func (h *RoomHub) FinalizeMeeting(roomID string) {
+	if h.db == nil {
+		return
+	}
+
+	// 브레이크아웃은 회의를 끝내지 않고 자막만 부모 미팅에 병합
+	// (Room 종료 중 hub 잠금을 잡고 있을 수 있어 백그라운드에서 처리)
+	if _, _, ok := ParseBreakoutRoomID(roomID); ok {
+		go h.closeBreakout(roomID)
+		return
+	}
+	// 참가자가 모두 브레이크아웃으로 이동해 부모 Room이 비었으면 마지막 브레이크아웃 종료 시 처리
+	if h.breakouts.deferFinalization(roomID) {
+		log.Printf("[Room %s] Breakout rooms still open, deferring finalization", roomID)
+		return
+	}
+
+	meeting, err := FindMeetingByRoomID(h.db, roomID)
+	if err != nil {
+		log.Printf("[Room %s] Meeting not found, skipping finalization: %v", roomID, err)
+		return
+	}
+
+	fin, claimed, err := h.claimFinalization(meeting.ID, roomID)
+	if err != nil {
+		log.Printf("[Room %s] Failed to start finalization: %v", roomID, err)
+		return
+	}
+	if !claimed {
+		log.Printf("[Room %s] Finalization already running for meeting %d", roomID, meeting.ID)
+		return
+	}
+
+	h.emitWebhook(model.WebhookEventMeetingEnded, roomID, &meeting.ID, nil)
+	go h.runFinalization(fin, meeting)
+}
+
+// StartFinalizationRecovery 중단/실패한 회의 종료 처리를 즉시, 그리고 주기적으로 재시도
+func (h *RoomHub) StartFinalizationRecovery(interval time.Duration) {
+	go func() {
+		h.ResumeMeetingFinalizations()
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				h.ResumeMeetingFinalizations()
+			case <-h.stopRecovery:
+				return
+			}
+		}
+	}()
+}
+
+// ResumeMeetingFinalizations 중단되었거나 실패한 회의 종료 처리를 이어서 실행 (서버 시작 시)
+func (h *RoomHub) ResumeMeetingFinalizations() {
+	if h.db == nil {
+		return
+	}
+
+	var pending []model.MeetingFinalization
+	err := h.db.Where("(status = ? AND locked_until < ?) OR (status = ? AND attempts < ?)",
+		model.FinalizationStatusRunning, time.Now(), model.FinalizationStatusFailed, finalizationMaxAttempts).
+		Find(&pending).Error
+	if err != nil {
+		log.Printf("[RoomHub] Failed to load pending finalizations: %v", err)
+		return
+	}
+
+	for _, p := range pending {
+		var meeting model.Meeting
+		if err := h.db.First(&meeting, p.MeetingID).Error; err != nil {
+			log.Printf("[RoomHub] Meeting %d not found, skipping finalization: %v", p.MeetingID, err)
+			continue
+		}
+
+		fin, claimed, err := h.claimFinalization(p.MeetingID, p.RoomID)
+		if err != nil || !claimed {
+			continue
+		}
+		log.Printf("[RoomHub] ♻️ Resuming finalization of meeting %d (attempt %d)", p.MeetingID, fin.Attempts)
+		go h.runFinalization(fin, &meeting)
+	}
+}
+
+// claimFinalization 회의 종료 처리 잠금 획득
+// 완료된 처리를 다시 시작하면 (재입장 후 종료) 새 회차로 보고 단계 상태를 초기화
+func (h *RoomHub) claimFinalization(meetingID int64, roomID string) (*model.MeetingFinalization, bool, error) {
+	var fin model.MeetingFinalization
+	claimed := false
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.MeetingFinalization{
+			MeetingID: meetingID,
+			RoomID:    roomID,
+			Status:    model.FinalizationStatusPending,
+		}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("meeting_id = ?", meetingID).First(&fin).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if fin.Status == model.FinalizationStatusRunning && fin.LockedUntil != nil && fin.LockedUntil.After(now) {
+			return nil
+		}
+
+		if fin.Status == model.FinalizationStatusCompleted {
+			if err := tx.Where("meeting_id = ?", meetingID).Delete(&model.MeetingFinalizationStep{}).Error; err != nil {
+				return err
+			}
+			fin.Attempts = 0
+		}
+
+		lockedUntil := now.Add(finalizationLease)
+		fin.RoomID = roomID
+		fin.Status = model.FinalizationStatusRunning
+		fin.LockedUntil = &lockedUntil
+		fin.Attempts++
+		fin.LastError = nil
+		claimed = true
+		return tx.Save(&fin).Error
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &fin, claimed, nil
+}
+
+// runFinalization 완료되지 않은 단계를 순서대로 실행
+func (h *RoomHub) runFinalization(fin *model.MeetingFinalization, meeting *model.Meeting) {
+	completed := make(map[string]bool)
+	var steps []model.MeetingFinalizationStep
+	h.db.Where("meeting_id = ?", fin.MeetingID).Find(&steps)
+	for _, s := range steps {
+		completed[s.Step] = s.Status == model.FinalizationStatusCompleted
+	}
+
+	for _, step := range finalizationSteps {
+		if completed[step] {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+		err := h.runFinalizationStep(ctx, step, fin, meeting)
+		cancel()
+
+		if err != nil {
+			log.Printf("[RoomHub] ❌ Finalization step %s failed for meeting %d: %v", step, fin.MeetingID, err)
+			h.recordFinalizationStep(fin.MeetingID, step, err)
+			h.finishFinalization(fin, err)
+			return
+		}
+		h.recordFinalizationStep(fin.MeetingID, step, nil)
+	}
+
+	h.finishFinalization(fin, nil)
+	log.Printf("[RoomHub] ✅ Finalized meeting %d", fin.MeetingID)
+}
+
+func (h *RoomHub) runFinalizationStep(ctx context.Context, step string, fin *model.MeetingFinalization, meeting *model.Meeting) error {
+	switch step {
+	case model.FinalizeStepTranscripts:
+		return h.flushMeetingTranscripts(ctx, fin, meeting)
+	case model.FinalizeStepSummary:
+		return h.summarizeMeeting(ctx, meeting)
+	case model.FinalizeStepAttendance:
+		return h.closeMeetingAttendance(meeting)
+	}
+	return fmt.Errorf("unknown finalization step %s", step)
+}
+
+// recordFinalizationStep 단계 결과 저장
+func (h *RoomHub) recordFinalizationStep(meetingID int64, step string, stepErr error) {
+	record := model.MeetingFinalizationStep{
+		MeetingID: meetingID,
+		Step:      step,
+		Status:    model.FinalizationStatusCompleted,
+		Attempts:  1,
+	}
+	if stepErr != nil {
+		msg := stepErr.Error()
+		record.Status = model.FinalizationStatusFailed
+		record.LastError = &msg
+	} else {
+		now := time.Now()
+		record.CompletedAt = &now
+	}
+
+	if err := h.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "meeting_id"}, {Name: "step"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"status":       record.Status,
+			"attempts":     gorm.Expr("meeting_finalization_steps.attempts + 1"),
+			"last_error":   record.LastError,
+			"completed_at": record.CompletedAt,
+			"updated_at":   time.Now(),
+		}),
+	}).Create(&record).Error; err != nil {
+		log.Printf("[RoomHub] Failed to record finalization step %s for meeting %d: %v", step, meetingID, err)
+	}
+}
+
+// finishFinalization 처리 결과 저장 및 잠금 해제
+func (h *RoomHub) finishFinalization(fin *model.MeetingFinalization, runErr error) {
+	updates := map[string]interface{}{
+		"status":       model.FinalizationStatusCompleted,
+		"locked_until": nil,
+		"last_error":   nil,
+	}
+	if runErr != nil {
+		updates["status"] = model.FinalizationStatusFailed
+		updates["last_error"] = runErr.Error()
+	}
+	if err := h.db.Model(&model.MeetingFinalization{}).Where("id = ?", fin.ID).Updates(updates).Error; err != nil {
+		log.Printf("[RoomHub] Failed to update finalization of meeting %d: %v", fin.MeetingID, err)
+	}
+}
+
+// flushMeetingTranscripts Redis 자막을 voice_records로 이동 (claim 단위로 정확히 한 번 저장, transcript_claim.go)
+func (h *RoomHub) flushMeetingTranscripts(ctx context.Context, fin *model.MeetingFinalization, meeting *model.Meeting) error {
+	if h.redisClient == nil {
+		return nil
+	}
+
+	saved, err := h.persistTranscripts(ctx, fin.RoomID, meeting.ID, h.cursorTranscriptSink(fin, meeting))
+	if err != nil {
+		return err
+	}
+	if saved > 0 {
+		log.Printf("[Room %s] Saved %d transcripts to database (meeting_id: %d)", fin.RoomID, saved, meeting.ID)
+	} else {
+		log.Printf("[Room %s] No new final transcripts to save", fin.RoomID)
+	}
+	return nil
+}
+
+// saveMeetingTranscripts Redis에서 지우지 않고 cursor 이후의 final 자막만 voice_records에 저장 (서버 종료 시)
+// 자막 저장과 cursor 갱신을 한 트랜잭션으로 처리하므로 나중에 flush할 때 다시 저장되지 않음
+func (h *RoomHub) saveMeetingTranscripts(ctx context.Context, fin *model.MeetingFinalization, meeting *model.Meeting) error {
+	transcripts, err := h.redisClient.GetTranscripts(ctx, fin.RoomID)
+	if err != nil {
+		return fmt.Errorf("failed to read transcripts from Redis: %w", err)
+	}
+
+	records, commit := h.cursorTranscriptSink(fin, meeting)(transcripts)
+	if len(records) == 0 {
+		return nil
+	}
+	err = h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.CreateInBatches(&records, voiceRecordInsertSize).Error; err != nil {
+			return err
+		}
+		return commit(tx)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save transcripts: %w", err)
+	}
+	log.Printf("[Room %s] Saved %d transcripts to database (meeting_id: %d)", fin.RoomID, len(records), meeting.ID)
+	return nil
+}
+
+// cursorTranscriptSink cursor 이후의 final 자막만 저장하고 같은 트랜잭션에서 cursor를 갱신
+func (h *RoomHub) cursorTranscriptSink(fin *model.MeetingFinalization, meeting *model.Meeting) transcriptSink {
+	// 컴플라이언스 설정: 실시간 자막에서 빠진 PII(설정 변경 전 기록 등)도 저장 전에 마스킹
+	redactPII := meeting.WorkspaceID != nil && h.WorkspaceRedactsPII(*meeting.WorkspaceID)
+
+	return func(entries []cache.RoomTranscript) ([]model.VoiceRecord, func(tx *gorm.DB) error) {
+		var cursor time.Time
+		if fin.TranscriptCursor != nil {
+			cursor = *fin.TranscriptCursor
+		}
+
+		records := make([]model.VoiceRecord, 0, len(entries))
+		latest := cursor
+		for _, t := range entries {
+			// Only save final transcripts to avoid duplicates
+			// (Postgres keeps microseconds, so compare at that precision)
+			ts := t.Timestamp.Truncate(time.Microsecond)
+			if !t.IsFinal || !ts.After(cursor) {
+				continue
+			}
+			if ts.After(latest) {
+				latest = ts
+			}
+			records = append(records, voiceRecordFromTranscript(meeting.ID, t, redactPII))
+		}
+		if len(records) == 0 {
+			return nil, nil
+		}
+
+		return records, func(tx *gorm.DB) error {
+			if err := tx.Model(&model.MeetingFinalization{}).Where("id = ?", fin.ID).
+				Update("transcript_cursor", latest).Error; err != nil {
+				return err
+			}
+			fin.TranscriptCursor = &latest
+			return nil
+		}
+	}
+}
+
+// summarizeMeeting 회의록을 요약해 MeetingSummary로 저장
+// 현재 회의록 버전으로 만든 요약이 있으면 건너뜀 (재실행 시 LLM 재호출 방지)
+func (h *RoomHub) summarizeMeeting(ctx context.Context, meeting *model.Meeting) error {
+	_, err := h.generateMeetingSummary(ctx, meeting.ID, false)
+	return err
+}
+
+// generateMeetingSummary 회의록 요약 생성 후 저장 (요약기가 없거나 발화가 없으면 nil)
+// force가 아니면 현재 회의록 버전으로 이미 만든 요약을 그대로 반환
+func (h *RoomHub) generateMeetingSummary(ctx context.Context, meetingID int64, force bool) (*model.MeetingSummary, error) {
+	if h.summarizer == nil {
+		return nil, nil
+	}
+
+	lock, _ := h.summaryLocks.LoadOrStore(meetingID, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	var records []model.VoiceRecord
+	if err := h.db.Where("meeting_id = ?", meetingID).Order(voiceRecordOrder).Find(&records).Error; err != nil {
+		return nil, err
+	}
+	utterances := summaryUtterances(records)
+	if len(utterances) == 0 {
+		return nil, nil
+	}
+
+	var maxID int64
+	for _, rec := range records {
+		if rec.ID > maxID {
+			maxID = rec.ID
+		}
+	}
+	version := transcriptVersion(int64(len(records)), maxID)
+
+	var existing model.MeetingSummary
+	err := h.db.Where("meeting_id = ?", meetingID).First(&existing).Error
+	if err == nil && !force && summaryIsCurrent(&existing, version, records) {
+		return &existing, nil
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	result, err := h.summarizer.Summarize(ctx, utterances)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize meeting: %w", err)
+	}
+
+	keyPoints, _ := json.Marshal(result.KeyPoints)
+	actionItems, _ := json.Marshal(result.ActionItems)
+	speakerStats, _ := json.Marshal(result.Speakers)
+
+	record := model.MeetingSummary{
+		MeetingID:         meetingID,
+		KeyPoints:         string(keyPoints),
+		ActionItems:       string(actionItems),
+		SpeakerStats:      string(speakerStats),
+		Provider:          result.Provider,
+		UtteranceCount:    len(utterances),
+		TranscriptVersion: version,
+		CreatedAt:         time.Now(),
+	}
+
+	// 같은 회의가 다시 종료되면 (재입장 후) 최신 요약으로 덮어씀
+	if err := h.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "meeting_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"key_points", "action_items", "speaker_stats", "provider", "utterance_count", "transcript_version", "created_at"}),
+	}).Create(&record).Error; err != nil {
+		return nil, fmt.Errorf("failed to save meeting summary: %w", err)
+	}
+	h.InvalidateMeetingSummary(meetingID)
+	h.emitWebhook(model.WebhookEventSummaryReady, "", &meetingID, WebhookSummaryData{
+		UtteranceCount: record.UtteranceCount,
+		Provider:       record.Provider,
+	})
+
+	log.Printf("[RoomHub] 📝 Saved meeting summary (meeting_id: %d, version: %s, %d key points, %d action items)",
+		meetingID, version, len(result.KeyPoints), len(result.ActionItems))
+	return &record, nil
+}
+
+// summaryIsCurrent 요약이 현재 회의록 버전으로 만들어졌는지
+// 버전 기록 이전의 요약은 최신 자막보다 나중에 만들어졌으면 최신으로 간주
+func summaryIsCurrent(existing *model.MeetingSummary, version string, records []model.VoiceRecord) bool {
+	if existing.TranscriptVersion != "" {
+		return existing.TranscriptVersion == version
+	}
+	return len(records) > 0 && !existing.CreatedAt.Before(records[len(records)-1].CreatedAt)
+}
+
+// closeMeetingAttendance 아직 퇴장 처리되지 않은 참가자의 퇴장 시각 기록
+func (h *RoomHub) closeMeetingAttendance(meeting *model.Meeting) error {
+	result := h.db.Model(&model.Participant{}).
+		Where("meeting_id = ? AND left_at IS NULL", meeting.ID).
+		Update("left_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		log.Printf("[RoomHub] Closed attendance of %d participants (meeting_id: %d)", result.RowsAffected, meeting.ID)
+	}
+	return nil
+}
+
+// summaryUtterances VoiceRecord를 발화 단위로 변환 (번역 언어별 중복 제거)
+func summaryUtterances(records []model.VoiceRecord) []summary.Utterance {
+	lastSeen := make(map[string]time.Time)
+	utterances := make([]summary.Utterance, 0, len(records))
+	for _, rec := range records {
+		key := rec.SpeakerName + "\x00" + rec.Original
+		if t, ok := lastSeen[key]; ok && rec.CreatedAt.Sub(t).Abs() < summaryDuplicateWindow {
+			continue
+		}
+		lastSeen[key] = rec.CreatedAt
+
+		u := summary.Utterance{
+			SpeakerName: rec.SpeakerName,
+			Text:        rec.Original,
+			Timestamp:   rec.CreatedAt,
+		}
+		if rec.SourceLang != nil {
+			u.Language = *rec.SourceLang
+		}
+		utterances = append(utterances, u)
+	}
+	return utterances
+}
@@ -0,0 +1,243 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/auth"
+	awsai "realtime-backend/internal/aws"
+	"realtime-backend/internal/model"
+)
+
+// errNotMeetingMember 워크스페이스 멤버/호스트/참가자가 아님
+var errNotMeetingMember = errors.New("not a member of this meeting")
+
+// JoinTokenRequest 회의 입장 토큰 요청 (본문 생략 가능)
+type JoinTokenRequest struct {
+	Role      string   `json:"role"`      // speaker, listener (빈 값이면 허용된 최대 역할)
+	Languages []string `json:"languages"` // 받을 번역 언어 (빈 값이면 제한 없음)
+}
+
+// JoinTokenResponse 회의 입장 토큰 응답 (/ws/room, /ws/audio 에 ?token= 으로 전달)
+type JoinTokenResponse struct {
+	Token         string    `json:"token"`
+	RoomID        string    `json:"room_id"`
+	ParticipantID string    `json:"participant_id"`
+	Role          string    `json:"role"`
+	Languages     []string  `json:"languages,omitempty"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+// IssueJoinToken 회의 입장 토큰 발급 (POST /api/meetings/:id/join-token)
+// 워크스페이스 멤버(또는 호스트/초대된 참가자)만 발급 가능하고, WebSocket은 토큰의 Room/참가자/역할/언어를 강제
+func (h *MeetingHandler) IssueJoinToken(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	meetingID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid meeting id",
+		})
+	}
+
+	var req JoinTokenRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "invalid request body",
+			})
+		}
+	}
+
+	languages, err := normalizeJoinLanguages(req.Languages)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var meeting model.Meeting
+	if err := h.db.First(&meeting, meetingID).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "meeting not found",
+		})
+	}
+	if meeting.Status == "ENDED" {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "meeting has ended",
+		})
+	}
+
+	maxRole, err := h.joinRole(&meeting, claims.UserID)
+	if errors.Is(err, errNotMeetingMember) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you are not a member of this meeting",
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to check permission",
+		})
+	}
+
+	role := maxRole
+	switch req.Role {
+	case "":
+	case auth.RoomRoleListener:
+		role = auth.RoomRoleListener
+	case auth.RoomRoleSpeaker:
+		if maxRole != auth.RoomRoleSpeaker {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "you cannot join this meeting as a speaker",
+			})
+		}
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "role must be speaker or listener",
+		})
+	}
+
+	participantID := strconv.FormatInt(claims.UserID, 10)
+	roomID := fmt.Sprintf("meeting-%d", meeting.ID)
+	expiresAt := time.Now().Add(h.joinTokenTTL)
+	token, err := h.jwtManager.GenerateJoinToken(auth.RoomClaims{
+		UserID:        claims.UserID,
+		ParticipantID: participantID,
+		RoomID:        roomID,
+		Role:          role,
+		MeetingID:     meeting.ID,
+		Languages:     languages,
+	}, h.joinTokenTTL)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to generate join token",
+		})
+	}
+
+	return c.JSON(JoinTokenResponse{
+		Token:         token,
+		RoomID:        roomID,
+		ParticipantID: participantID,
+		Role:          role,
+		Languages:     languages,
+		ExpiresAt:     expiresAt,
+	})
+}
+
+// joinRole 입장 가능한 최대 역할
+// 호스트와 CONNECT_VOICE 권한이 있는 워크스페이스 멤버는 speaker, 게스트와 권한 없는 멤버는 listener
+func (h *MeetingHandler) joinRole(meeting *model.Meeting, userID int64) (string, error) {
+	access, err := GetMeetingAccess(h.db, meeting, userID)
+	if err != nil {
+		return "", err
+	}
+	if access.Host {
+		return auth.RoomRoleSpeaker, nil
+	}
+	guest := access.Guest()
+
+	if access.WorkspaceMember {
+		if guest {
+			return auth.RoomRoleListener, nil
+		}
+		return h.workspaceMemberRole(*meeting.WorkspaceID, userID)
+	}
+
+	// 워크스페이스 밖 사용자는 회의에 초대된 참가자만
+	if access.Participant == nil {
+		return "", errNotMeetingMember
+	}
+	if guest {
+		return auth.RoomRoleListener, nil
+	}
+	return auth.RoomRoleSpeaker, nil
+}
+
+// workspaceMemberRole 워크스페이스 멤버의 역할 (CONNECT_VOICE 권한이 있으면 speaker, 없으면 listener)
+func (h *MeetingHandler) workspaceMemberRole(workspaceID, userID int64) (string, error) {
+	canSpeak, err := auth.CheckPermission(h.db, workspaceID, userID, "CONNECT_VOICE")
+	if err != nil {
+		return "", err
+	}
+	if !canSpeak {
+		return auth.RoomRoleListener, nil
+	}
+	return auth.RoomRoleSpeaker, nil
+}
+
+// ParseWorkspaceChannelRoomID 워크스페이스 음성 채널 Room ID(workspace-{workspaceId}-{channel})에서 워크스페이스 ID 추출
+func ParseWorkspaceChannelRoomID(roomID string) (int64, bool) {
+	rest, ok := strings.CutPrefix(roomID, "workspace-")
+	if !ok {
+		return 0, false
+	}
+	idStr, channel, ok := strings.Cut(rest, "-")
+	if !ok || channel == "" {
+		return 0, false
+	}
+	workspaceID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || workspaceID <= 0 {
+		return 0, false
+	}
+	return workspaceID, true
+}
+
+// channelRole 회의 행이 없는 워크스페이스 음성 채널 Room의 역할 (워크스페이스 멤버/소유자만, 아니면 빈 역할)
+func (h *MeetingHandler) channelRole(workspaceID, userID int64) (string, error) {
+	member, err := isWorkspaceMemberOrOwner(h.db, workspaceID, userID)
+	if err != nil || !member {
+		return "", err
+	}
+	return h.workspaceMemberRole(workspaceID, userID)
+}
+
+// RoomRole 사용자가 Room(회의 또는 워크스페이스 음성 채널)에 들어갈 수 있는 최대 역할과 회의 ID
+// (입장 토큰 없이 로그인 쿠키로 /ws/room·/ws/audio 에 접속할 때와 /api/video/token 에서 사용, middleware.RoomRoleResolver)
+// 회의도 채널도 아니거나 멤버가 아니면 빈 역할
+func (h *MeetingHandler) RoomRole(roomID string, userID int64) (string, int64, error) {
+	meeting, err := FindMeetingByRoomID(h.db, roomID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		if workspaceID, ok := ParseWorkspaceChannelRoomID(roomID); ok {
+			role, err := h.channelRole(workspaceID, userID)
+			return role, 0, err
+		}
+		return "", 0, nil
+	}
+	if err != nil {
+		return "", 0, err
+	}
+
+	role, err := h.joinRole(meeting, userID)
+	if errors.Is(err, errNotMeetingMember) {
+		return "", 0, nil
+	}
+	if err != nil {
+		return "", 0, err
+	}
+	return role, meeting.ID, nil
+}
+
+// normalizeJoinLanguages 요청 언어 코드 정규화 (지원하지 않는 언어가 있으면 에러, 중복 제거)
+func normalizeJoinLanguages(languages []string) ([]string, error) {
+	if len(languages) == 0 {
+		return nil, nil
+	}
+	normalized := make([]string, 0, len(languages))
+	seen := make(map[string]bool, len(languages))
+	for _, lang := range languages {
+		code := awsai.NormalizeLanguage(lang)
+		if !awsai.IsSupportedLanguage(code) {
+			return nil, fmt.Errorf("unsupported language: %s", lang)
+		}
+		if !seen[code] {
+			seen[code] = true
+			normalized = append(normalized, code)
+		}
+	}
+	return normalized, nil
+}
@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/model"
+)
+
+var errMeetingNotEnded = errors.New("meeting is not ended")
+
+// SessionBreakData "session_break" 메시지: 종료됐던 회의가 같은 회의록으로 재개됨
+type SessionBreakData struct {
+	Session   int       `json:"session"`
+	PausedAt  time.Time `json:"pausedAt"`
+	ResumedAt time.Time `json:"resumedAt"`
+}
+
+// MeetingResumeHandler 종료된 회의 재개 핸들러
+type MeetingResumeHandler struct {
+	db      *gorm.DB
+	roomHub *RoomHub
+}
+
+// NewMeetingResumeHandler MeetingResumeHandler 생성
+func NewMeetingResumeHandler(db *gorm.DB, roomHub *RoomHub) *MeetingResumeHandler {
+	return &MeetingResumeHandler{db: db, roomHub: roomHub}
+}
+
+// ResumeMeeting 종료된 회의를 재개 창 안에서 다시 진행 중으로 (호스트 전용)
+// 새 회의를 만들지 않고 같은 meeting ID에 세션 구분만 남겨 회의록 타임라인을 이어감
+func (h *MeetingResumeHandler) ResumeMeeting(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+	meetingID, err := c.ParamsInt("meetingId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid meeting id",
+		})
+	}
+
+	var meeting model.Meeting
+	if err := h.db.Where("id = ? AND workspace_id = ?", meetingID, workspaceID).First(&meeting).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "meeting not found",
+		})
+	}
+
+	// 호스트만 재개 가능
+	if meeting.HostID != claims.UserID {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "only host can resume the meeting",
+		})
+	}
+	if meeting.Status != "ENDED" || meeting.EndedAt == nil {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": "only ended meetings can be resumed",
+		})
+	}
+
+	window := h.resumeWindow()
+	if window <= 0 || time.Since(*meeting.EndedAt) > window {
+		return c.Status(fiber.StatusGone).JSON(fiber.Map{
+			"error": "resume window has expired",
+		})
+	}
+
+	now := time.Now()
+	sessionBreak := model.MeetingSessionBreak{
+		MeetingID: meeting.ID,
+		PausedAt:  *meeting.EndedAt,
+		ResumedAt: now,
+		ResumedBy: claims.UserID,
+	}
+	err = h.db.Transaction(func(tx *gorm.DB) error {
+		// 동시에 두 번 재개하지 않도록 ENDED인 경우에만 상태 변경
+		result := tx.Model(&model.Meeting{}).
+			Where("id = ? AND status = ?", meeting.ID, "ENDED").
+			Updates(map[string]interface{}{"status": "IN_PROGRESS", "ended_at": nil})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errMeetingNotEnded
+		}
+
+		// 이전 종료 처리를 다시 대기 상태로 (cursor는 유지해 이미 저장한 자막은 다시 저장하지 않음)
+		// COMPLETED로 남아 있으면 janitor와 종료 처리가 재개된 세션도 저장된 것으로 봄
+		reset := tx.Model(&model.MeetingFinalization{}).
+			Where("meeting_id = ? AND status IN ?", meeting.ID,
+				[]string{model.FinalizationStatusCompleted, model.FinalizationStatusFailed}).
+			Updates(map[string]interface{}{
+				"status":       model.FinalizationStatusPending,
+				"attempts":     0,
+				"locked_until": nil,
+				"last_error":   nil,
+			})
+		if reset.Error != nil {
+			return reset.Error
+		}
+		if reset.RowsAffected > 0 {
+			if err := tx.Where("meeting_id = ?", meeting.ID).Delete(&model.MeetingFinalizationStep{}).Error; err != nil {
+				return err
+			}
+		}
+
+		var previous int64
+		if err := tx.Model(&model.MeetingSessionBreak{}).Where("meeting_id = ?", meeting.ID).Count(&previous).Error; err != nil {
+			return err
+		}
+		sessionBreak.Session = int(previous) + 2
+		return tx.Create(&sessionBreak).Error
+	})
+	if errors.Is(err, errMeetingNotEnded) {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": "only ended meetings can be resumed",
+		})
+	}
+	if err != nil {
+		log.Printf("[Meeting %d] Failed to resume meeting: %v", meeting.ID, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to resume meeting",
+		})
+	}
+
+	meeting.Status = "IN_PROGRESS"
+	meeting.EndedAt = nil
+	log.Printf("[Meeting %d] ▶️ Resumed as session %d by user %d", meeting.ID, sessionBreak.Session, claims.UserID)
+
+	// 아직 남아 있는 Room이면 접속 중인 리스너에게 세션 구분 알림
+	for _, room := range h.existingRooms(&meeting) {
+		room.Broadcast(&BroadcastMessage{
+			Type: "session_break",
+			Data: SessionBreakData{
+				Session:   sessionBreak.Session,
+				PausedAt:  sessionBreak.PausedAt,
+				ResumedAt: sessionBreak.ResumedAt,
+			},
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"meeting": meeting,
+		"session": sessionBreak,
+	})
+}
+
+// resumeWindow 회의 종료 후 재개 가능한 시간
+func (h *MeetingResumeHandler) resumeWindow() time.Duration {
+	if h.roomHub == nil || h.roomHub.cfg == nil {
+		return 0
+	}
+	return h.roomHub.cfg.AI.MeetingResumeWindow
+}
+
+// existingRooms 이 인스턴스에 열려 있는 회의의 Room (없으면 새로 만들지 않음)
+func (h *MeetingResumeHandler) existingRooms(meeting *model.Meeting) []*Room {
+	if h.roomHub == nil {
+		return nil
+	}
+	return h.roomHub.meetingRooms(*meeting)
+}
@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"gorm.io/gorm/clause"
+
+	"realtime-backend/internal/config"
+	"realtime-backend/internal/model"
+)
+
+// 스케줄러 기본값
+const (
+	meetingSchedulerInterval = 30 * time.Second
+	// 로컬 Room이 없는 회의는 Room을 가진 인스턴스가 먼저 종료하도록 이만큼 더 기다린 뒤 DB만 종료 처리
+	meetingAutoEndGrace = 10 * time.Minute
+)
+
+// meetingActiveSince 마지막 세션 시작 시각 (재개된 회의는 재개 시각 기준)
+const meetingActiveSince = "COALESCE((SELECT MAX(resumed_at) FROM meeting_session_breaks WHERE meeting_session_breaks.meeting_id = meetings.id), meetings.started_at)"
+
+// StartMeetingScheduler 예정 회의 시작 전 알림, 예정 시각 자동 시작, 최대 시간 초과 회의 자동 종료를 주기적으로 실행
+func (h *RoomHub) StartMeetingScheduler(cfg config.SchedulerConfig) {
+	if cfg.Interval <= 0 {
+		cfg.Interval = meetingSchedulerInterval
+	}
+	go func() {
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				h.RunMeetingScheduler(cfg, time.Now())
+			case <-h.stopRecovery:
+				return
+			}
+		}
+	}()
+	log.Printf("⏰ [Scheduler] 회의 스케줄러 시작 (주기 %v, 알림 %v 전, 자동 시작 %v, 최대 %v)",
+		cfg.Interval, cfg.ReminderOffsets, cfg.AutoStart, cfg.MaxDuration)
+}
+
+// RunMeetingScheduler 스케줄러 한 주기 실행
+func (h *RoomHub) RunMeetingScheduler(cfg config.SchedulerConfig, now time.Time) {
+	if h.db == nil {
+		return
+	}
+	for _, offset := range cfg.ReminderOffsets {
+		h.sendMeetingReminders(offset, now)
+	}
+	if cfg.AutoStart {
+		h.autoStartMeetings(now)
+	}
+	if cfg.MaxDuration > 0 {
+		h.autoEndMeetings(cfg.MaxDuration, now)
+	}
+}
+
+// sendMeetingReminders offset 안에 시작할 예정 회의의 호스트/참가자에게 알림 (회의/시점마다 한 번)
+func (h *RoomHub) sendMeetingReminders(offset time.Duration, now time.Time) {
+	var meetings []model.Meeting
+	err := h.db.Preload("Participants").
+		Where("status = ? AND scheduled_at > ? AND scheduled_at <= ?", "SCHEDULED", now, now.Add(offset)).
+		Find(&meetings).Error
+	if err != nil {
+		log.Printf("⚠️ [Scheduler] 예정 회의 조회 실패: %v", err)
+		return
+	}
+
+	offsetMinutes := int(offset.Minutes())
+	for _, m := range meetings {
+		// 먼저 발송 기록을 선점한 인스턴스만 알림 생성
+		reminder := model.MeetingReminder{MeetingID: m.ID, OffsetMinutes: offsetMinutes, ScheduledAt: *m.ScheduledAt}
+		claimed := h.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&reminder)
+		if claimed.Error != nil {
+			log.Printf("⚠️ [Scheduler] 알림 기록 실패: meeting=%d: %v", m.ID, claimed.Error)
+			continue
+		}
+		if claimed.RowsAffected == 0 {
+			continue
+		}
+
+		minutes := int(m.ScheduledAt.Sub(now).Round(time.Minute).Minutes())
+		content := fmt.Sprintf("%s 회의가 %d분 후 시작됩니다.", m.Title, minutes)
+		if minutes <= 0 {
+			content = fmt.Sprintf("%s 회의가 곧 시작됩니다.", m.Title)
+		}
+		relatedType := "MEETING"
+		for _, userID := range meetingUserIDs(&m) {
+			if err := CreateNotification(h.db, userID, nil, model.NotificationTypeMeetingAlert.String(), content, &relatedType, &m.ID); err != nil {
+				log.Printf("⚠️ [Scheduler] 회의 알림 생성 실패: meeting=%d, user=%d: %v", m.ID, userID, err)
+			}
+		}
+	}
+}
+
+// autoStartMeetings 예정 시각이 지난 SCHEDULED 회의를 IN_PROGRESS로 변경
+func (h *RoomHub) autoStartMeetings(now time.Time) {
+	result := h.db.Model(&model.Meeting{}).
+		Where("status = ? AND scheduled_at <= ?", "SCHEDULED", now).
+		Updates(map[string]any{"status": "IN_PROGRESS", "started_at": now})
+	if result.Error != nil {
+		log.Printf("⚠️ [Scheduler] 회의 자동 시작 실패: %v", result.Error)
+		return
+	}
+	if result.RowsAffected > 0 {
+		log.Printf("▶️ [Scheduler] 예정 회의 %d개 자동 시작", result.RowsAffected)
+	}
+}
+
+// autoEndMeetings 최대 시간을 넘긴 회의 종료 (이 인스턴스의 Room은 참가자에게 알리고 닫음)
+func (h *RoomHub) autoEndMeetings(maxDuration time.Duration, now time.Time) {
+	excluded := []string{model.MeetingTypeChatRoom.String(), model.MeetingTypeDM.String()}
+
+	var overdue []model.Meeting
+	err := h.db.Select("id", "code").
+		Where("status = ? AND type NOT IN ? AND "+meetingActiveSince+" < ?", "IN_PROGRESS", excluded, now.Add(-maxDuration)).
+		Find(&overdue).Error
+	if err != nil {
+		log.Printf("⚠️ [Scheduler] 진행 중 회의 조회 실패: %v", err)
+		return
+	}
+	if len(overdue) == 0 {
+		return
+	}
+
+	for _, room := range h.meetingRooms(overdue...) {
+		log.Printf("[Room %s] ⏱️ Meeting exceeded max duration %v, ending", room.ID, maxDuration)
+		room.endMeeting("scheduler")
+	}
+
+	// Room이 어느 인스턴스에도 없이 남은 회의는 DB만 정리
+	result := h.db.Model(&model.Meeting{}).
+		Where("status = ? AND type NOT IN ? AND "+meetingActiveSince+" < ?", "IN_PROGRESS", excluded, now.Add(-maxDuration-meetingAutoEndGrace)).
+		Updates(map[string]any{"status": "ENDED", "ended_at": now})
+	if result.Error != nil {
+		log.Printf("⚠️ [Scheduler] 회의 자동 종료 실패: %v", result.Error)
+	} else if result.RowsAffected > 0 {
+		log.Printf("⏹️ [Scheduler] 최대 시간 초과 회의 %d개 종료", result.RowsAffected)
+	}
+}
+
+// meetingUserIDs 호스트와 회원 참가자 (게스트 제외, 중복 제거)
+func meetingUserIDs(m *model.Meeting) []int64 {
+	seen := map[int64]bool{m.HostID: true}
+	userIDs := []int64{m.HostID}
+	for _, p := range m.Participants {
+		if p.UserID != nil && !seen[*p.UserID] {
+			seen[*p.UserID] = true
+			userIDs = append(userIDs, *p.UserID)
+		}
+	}
+	return userIDs
+}
@@ -0,0 +1,296 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/model"
+)
+
+// 회의 요약 조회 설정
+const (
+	summaryCachePrefix     = "meeting_summary:"
+	defaultSummaryCacheTTL = 24 * time.Hour
+	summaryCacheTimeout    = 2 * time.Second  // Redis 조회/저장 제한 시간
+	summaryGenerateTimeout = 60 * time.Second // 조회/재생성 요청에서 요약을 만드는 제한 시간
+)
+
+// errSummaryNotFound 요약할 회의록이 없거나 요약 기능이 꺼져 있음
+var errSummaryNotFound = errors.New("meeting summary not found")
+
+// MeetingSummaryResponse 회의 요약 응답 (렌더링 결과를 그대로 Redis에 캐시)
+type MeetingSummaryResponse struct {
+	MeetingID         int64           `json:"meeting_id"`
+	KeyPoints         json.RawMessage `json:"key_points"`
+	ActionItems       json.RawMessage `json:"action_items"`
+	SpeakerStats      json.RawMessage `json:"speaker_stats"`
+	Provider          string          `json:"provider"`
+	UtteranceCount    int             `json:"utterance_count"`
+	TranscriptVersion string          `json:"transcript_version"`
+	Stale             bool            `json:"stale"` // 요약 이후 회의록이 수정됐지만 다시 만들지 못함
+	CreatedAt         time.Time       `json:"created_at"`
+}
+
+// transcriptVersion 회의록 버전 (기록 수:마지막 ID) - 기록이 추가/삭제되면 바뀜
+func transcriptVersion(count, maxID int64) string {
+	return fmt.Sprintf("%d:%d", count, maxID)
+}
+
+// meetingTranscriptVersion DB에 저장된 회의록의 현재 버전
+func meetingTranscriptVersion(db *gorm.DB, meetingID int64) (string, error) {
+	var row struct {
+		Count int64
+		MaxID int64
+	}
+	err := db.Model(&model.VoiceRecord{}).
+		Select("COUNT(*) AS count, COALESCE(MAX(id), 0) AS max_id").
+		Where("meeting_id = ?", meetingID).
+		Scan(&row).Error
+	if err != nil {
+		return "", err
+	}
+	return transcriptVersion(row.Count, row.MaxID), nil
+}
+
+func summaryCacheKey(meetingID int64, version string) string {
+	return fmt.Sprintf("%s%d:%s", summaryCachePrefix, meetingID, version)
+}
+
+// summaryCacheTTL 요약 응답 캐시 유지 시간
+func (h *RoomHub) summaryCacheTTL() time.Duration {
+	if h.cfg != nil && h.cfg.AI.SummaryCacheTTL > 0 {
+		return h.cfg.AI.SummaryCacheTTL
+	}
+	return defaultSummaryCacheTTL
+}
+
+// MeetingSummary 현재 회의록 버전의 요약 응답 (JSON)
+// Redis 캐시 → DB 순으로 조회하고, 회의록이 요약 이후 수정됐으면 다시 생성
+func (h *RoomHub) MeetingSummary(ctx context.Context, meetingID int64) ([]byte, error) {
+	version, err := meetingTranscriptVersion(h.db, meetingID)
+	if err != nil {
+		return nil, err
+	}
+	key := summaryCacheKey(meetingID, version)
+
+	if h.redisClient != nil {
+		cacheCtx, cancel := context.WithTimeout(ctx, summaryCacheTimeout)
+		cached, err := h.redisClient.GetBytes(cacheCtx, key)
+		cancel()
+		if err != nil {
+			log.Printf("[RoomHub] Failed to read cached summary (meeting_id: %d): %v", meetingID, err)
+		} else if cached != nil {
+			return cached, nil
+		}
+	}
+
+	var stored model.MeetingSummary
+	err = h.db.Where("meeting_id = ?", meetingID).First(&stored).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	found := err == nil
+
+	if !found || stored.TranscriptVersion != version {
+		generated, genErr := h.generateMeetingSummary(ctx, meetingID, false)
+		if genErr != nil {
+			log.Printf("[RoomHub] Failed to regenerate summary (meeting_id: %d): %v", meetingID, genErr)
+		}
+		if generated != nil {
+			stored, found = *generated, true
+		}
+	}
+	if !found {
+		return nil, errSummaryNotFound
+	}
+
+	return h.renderMeetingSummary(ctx, &stored, version)
+}
+
+// RegenerateMeetingSummary 캐시와 관계없이 요약을 다시 생성
+func (h *RoomHub) RegenerateMeetingSummary(ctx context.Context, meetingID int64) ([]byte, error) {
+	generated, err := h.generateMeetingSummary(ctx, meetingID, true)
+	if err != nil {
+		return nil, err
+	}
+	if generated == nil {
+		return nil, errSummaryNotFound
+	}
+	return h.renderMeetingSummary(ctx, generated, generated.TranscriptVersion)
+}
+
+// renderMeetingSummary 요약을 응답 JSON으로 만들고 최신 버전이면 캐시
+func (h *RoomHub) renderMeetingSummary(ctx context.Context, s *model.MeetingSummary, version string) ([]byte, error) {
+	resp := MeetingSummaryResponse{
+		MeetingID:         s.MeetingID,
+		KeyPoints:         json.RawMessage(s.KeyPoints),
+		ActionItems:       json.RawMessage(s.ActionItems),
+		SpeakerStats:      json.RawMessage(s.SpeakerStats),
+		Provider:          s.Provider,
+		UtteranceCount:    s.UtteranceCount,
+		TranscriptVersion: s.TranscriptVersion,
+		Stale:             s.TranscriptVersion != version,
+		CreatedAt:         s.CreatedAt,
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// 오래된 요약은 캐시하지 않음 (요약기가 돌아오면 다음 조회에서 다시 생성)
+	if h.redisClient != nil && !resp.Stale {
+		cacheCtx, cancel := context.WithTimeout(ctx, summaryCacheTimeout)
+		defer cancel()
+		if err := h.redisClient.SetBytes(cacheCtx, summaryCacheKey(s.MeetingID, version), data, h.summaryCacheTTL()); err != nil {
+			log.Printf("[RoomHub] Failed to cache summary (meeting_id: %d): %v", s.MeetingID, err)
+		}
+	}
+	return data, nil
+}
+
+// InvalidateMeetingSummary 회의의 캐시된 요약 응답을 모든 버전에서 삭제 (회의록 수정 시 호출)
+func (h *RoomHub) InvalidateMeetingSummary(meetingID int64) {
+	if h.redisClient == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), summaryCacheTimeout)
+	defer cancel()
+
+	pattern := fmt.Sprintf("%s%d:*", summaryCachePrefix, meetingID)
+	if _, err := h.redisClient.DeleteByPattern(ctx, pattern); err != nil {
+		log.Printf("[RoomHub] Failed to invalidate cached summary (meeting_id: %d): %v", meetingID, err)
+	}
+}
+
+// =============================================================================
+// Meeting summary API
+// =============================================================================
+
+// MeetingSummaryHandler 회의 요약 조회 핸들러
+type MeetingSummaryHandler struct {
+	db      *gorm.DB
+	roomHub *RoomHub
+}
+
+// NewMeetingSummaryHandler MeetingSummaryHandler 생성
+func NewMeetingSummaryHandler(db *gorm.DB, roomHub *RoomHub) *MeetingSummaryHandler {
+	return &MeetingSummaryHandler{db: db, roomHub: roomHub}
+}
+
+// GetMeetingSummary 회의 요약 조회 (회의록 읽기 권한 필요)
+func (h *MeetingSummaryHandler) GetMeetingSummary(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	meeting, err := h.loadMeeting(c)
+	if err != nil {
+		return err
+	}
+	if meeting == nil {
+		return nil
+	}
+
+	access, err := GetTranscriptAccess(h.db, meeting, claims.UserID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !access.CanRead {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you do not have permission to read this transcript",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), summaryGenerateTimeout)
+	defer cancel()
+
+	data, err := h.roomHub.MeetingSummary(ctx, meeting.ID)
+	return h.sendSummary(c, data, err)
+}
+
+// RegenerateMeetingSummary 캐시를 무시하고 회의 요약 다시 생성 (관리자 전용)
+func (h *MeetingSummaryHandler) RegenerateMeetingSummary(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	meeting, err := h.loadMeeting(c)
+	if err != nil {
+		return err
+	}
+	if meeting == nil {
+		return nil
+	}
+
+	workspaceID, _ := c.ParamsInt("workspaceId")
+	isAdmin, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "ADMIN")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !isAdmin {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "only admins can regenerate meeting summaries",
+		})
+	}
+	if h.roomHub.summarizer == nil {
+		return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
+			"error": "meeting summaries are disabled",
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Context(), summaryGenerateTimeout)
+	defer cancel()
+
+	data, err := h.roomHub.RegenerateMeetingSummary(ctx, meeting.ID)
+	if err == nil {
+		log.Printf("[RoomHub] 🔄 Summary regenerated by user %d (meeting_id: %d)", claims.UserID, meeting.ID)
+	}
+	return h.sendSummary(c, data, err)
+}
+
+// loadMeeting 경로의 워크스페이스/미팅 확인 (오류 응답을 보냈으면 nil 반환)
+func (h *MeetingSummaryHandler) loadMeeting(c *fiber.Ctx) (*model.Meeting, error) {
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return nil, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+	meetingID, err := c.ParamsInt("meetingId")
+	if err != nil {
+		return nil, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid meeting id",
+		})
+	}
+
+	if h.roomHub == nil {
+		return nil, c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "room hub not available",
+		})
+	}
+
+	var meeting model.Meeting
+	if err := h.db.Where("id = ? AND workspace_id = ?", meetingID, workspaceID).First(&meeting).Error; err != nil {
+		return nil, c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "meeting not found",
+		})
+	}
+	return &meeting, nil
+}
+
+func (h *MeetingSummaryHandler) sendSummary(c *fiber.Ctx, data []byte, err error) error {
+	if errors.Is(err, errSummaryNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "summary not available",
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get meeting summary",
+		})
+	}
+	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	return c.Send(data)
+}
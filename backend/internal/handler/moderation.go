@@ -0,0 +1,280 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"realtime-backend/internal/model"
+)
+
+// 호스트 제어 설정
+const (
+	participantRoleHost  = "HOST"          // 공동 호스트도 Participant.Role = HOST
+	moderationCloseDelay = 2 * time.Second // 강퇴/회의 종료 이벤트가 전달된 뒤 연결을 끊기까지 대기
+)
+
+var (
+	ErrNotModerator       = errors.New("only the host can moderate this room")
+	ErrCannotModerateHost = errors.New("the host cannot be muted or removed")
+	ErrRoomLocked         = errors.New("room is locked by the host")
+	ErrRemovedFromRoom    = errors.New("you were removed from this room by the host")
+	ErrMissingParticipant = errors.New("participantId is required")
+)
+
+// 호스트 제어 이벤트 종류 (moderation 메시지의 action)
+const (
+	ModerationMute       = "mute"
+	ModerationUnmute     = "unmute"
+	ModerationRemove     = "remove"
+	ModerationLock       = "lock"
+	ModerationUnlock     = "unlock"
+	ModerationEndMeeting = "end_meeting"
+)
+
+// ModerationEvent 모든 참가자에게 브로드캐스트되는 호스트 제어 이벤트
+type ModerationEvent struct {
+	Action        string `json:"action"`
+	ParticipantID string `json:"participantId,omitempty"` // mute/unmute/remove 대상
+	By            string `json:"by"`
+}
+
+// ModerationState 새로 접속한 참가자에게 보내는 현재 제어 상태
+type ModerationState struct {
+	Locked bool     `json:"locked"`
+	Muted  []string `json:"muted"`
+}
+
+// roomModeration 호스트가 설정한 Room 제어 상태 (Room.mu로 보호)
+type roomModeration struct {
+	locked  bool
+	muted   map[string]bool
+	removed map[string]bool // 강퇴된 참가자 (이 Room이 살아 있는 동안 재입장 불가)
+	joined  map[string]bool // 입장했던 참가자 (잠긴 뒤에도 재접속 허용)
+}
+
+func (m *roomModeration) init() {
+	if m.muted == nil {
+		m.muted = make(map[string]bool)
+		m.removed = make(map[string]bool)
+		m.joined = make(map[string]bool)
+	}
+}
+
+// =============================================================================
+// Room Methods - Host controls
+// =============================================================================
+
+// isModerator 리스너가 미팅 호스트이거나 HOST 역할 참가자인지 확인
+func (r *Room) isModerator(listenerID string) bool {
+	if r.hub.db == nil {
+		return false
+	}
+	meeting, err := r.findMeeting()
+	if err != nil {
+		return false
+	}
+	if listenerID == fmt.Sprintf("%d", meeting.HostID) {
+		return true
+	}
+
+	userID, err := strconv.ParseInt(listenerID, 10, 64)
+	if err != nil {
+		return false
+	}
+	var count int64
+	r.hub.db.Model(&model.Participant{}).
+		Where("meeting_id = ? AND user_id = ? AND role = ? AND left_at IS NULL", meeting.ID, userID, participantRoleHost).
+		Count(&count)
+	return count > 0
+}
+
+// CheckJoin 입장 허용 여부 (강퇴된 참가자, 잠긴 Room의 새 참가자는 거부; 호스트는 항상 허용)
+func (r *Room) CheckJoin(listenerID string) error {
+	r.mu.RLock()
+	removed := r.moderation.removed[listenerID]
+	blocked := r.moderation.locked && !r.moderation.joined[listenerID]
+	r.mu.RUnlock()
+
+	if removed {
+		return ErrRemovedFromRoom
+	}
+	if blocked && !r.isModerator(listenerID) {
+		return ErrRoomLocked
+	}
+
+	r.mu.Lock()
+	r.moderation.init()
+	r.moderation.joined[listenerID] = true
+	r.mu.Unlock()
+	return nil
+}
+
+// isSpeakerMuted 호스트가 음소거(또는 강퇴)한 화자인지 확인
+func (r *Room) isSpeakerMuted(speakerID string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.moderation.muted[speakerID] || r.moderation.removed[speakerID]
+}
+
+// MuteSpeaker 화자 음소거/해제 (호스트 전용, 음소거 중인 화자의 오디오는 Transcribe로 보내지 않음)
+func (r *Room) MuteSpeaker(moderatorID, participantID string, muted bool) error {
+	if err := r.checkModerationTarget(moderatorID, participantID); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.moderation.init()
+	changed := r.moderation.muted[participantID] != muted
+	if muted {
+		r.moderation.muted[participantID] = true
+	} else {
+		delete(r.moderation.muted, participantID)
+	}
+	r.mu.Unlock()
+
+	if !changed {
+		return nil
+	}
+	action := ModerationUnmute
+	if muted {
+		action = ModerationMute
+	}
+	log.Printf("[Room %s] 🔇 Host %s: %s %s", r.ID, moderatorID, action, participantID)
+	r.broadcastModeration(ModerationEvent{Action: action, ParticipantID: participantID, By: moderatorID})
+	return nil
+}
+
+// RemoveParticipant 참가자 강퇴 (호스트 전용): 발화 스트림을 닫고 이벤트 전달 후 연결 종료
+func (r *Room) RemoveParticipant(moderatorID, participantID string) error {
+	if err := r.checkModerationTarget(moderatorID, participantID); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.moderation.init()
+	r.moderation.removed[participantID] = true
+	listener, _ := r.Listeners.Get(participantID)
+	r.mu.Unlock()
+
+	log.Printf("[Room %s] 🚫 Host %s removed participant %s", r.ID, moderatorID, participantID)
+	r.leaveSpeakerQueue(participantID)
+	r.broadcastModeration(ModerationEvent{Action: ModerationRemove, ParticipantID: participantID, By: moderatorID})
+	r.RemoveSpeaker(participantID)
+
+	// 이벤트가 전달된 뒤 연결 종료 (읽기 루프가 끝나면서 리스너 정리)
+	// 그 사이 클라이언트가 먼저 끊었으면 Conn이 재사용됐을 수 있으므로 닫지 않음
+	if listener != nil {
+		time.AfterFunc(moderationCloseDelay, func() {
+			listener.disconnect()
+		})
+	}
+	return nil
+}
+
+// SetRoomLocked Room 잠금/해제 (호스트 전용, 잠기면 입장했던 참가자와 호스트만 접속 가능)
+func (r *Room) SetRoomLocked(moderatorID string, locked bool) error {
+	if !r.isModerator(moderatorID) {
+		return ErrNotModerator
+	}
+
+	r.mu.Lock()
+	r.moderation.init()
+	changed := r.moderation.locked != locked
+	r.moderation.locked = locked
+	r.mu.Unlock()
+
+	if !changed {
+		return nil
+	}
+	action := ModerationUnlock
+	if locked {
+		action = ModerationLock
+	}
+	log.Printf("[Room %s] 🔒 Host %s: %s room", r.ID, moderatorID, action)
+	r.broadcastModeration(ModerationEvent{Action: action, By: moderatorID})
+	return nil
+}
+
+// EndMeeting 회의 종료 (호스트 전용): 미팅을 ENDED로 기록하고 모든 참가자 연결을 끊은 뒤 Room 정리
+func (r *Room) EndMeeting(moderatorID string) error {
+	if !r.isModerator(moderatorID) {
+		return ErrNotModerator
+	}
+
+	log.Printf("[Room %s] 🏁 Host %s ended the meeting", r.ID, moderatorID)
+	r.endMeeting(moderatorID)
+	return nil
+}
+
+// endMeeting marks the meeting ended, notifies everyone and closes the room after a short delay
+func (r *Room) endMeeting(by string) {
+	if meeting, err := r.findMeeting(); err == nil {
+		now := time.Now()
+		if err := r.hub.db.Model(meeting).Updates(map[string]any{"status": "ENDED", "ended_at": &now}).Error; err != nil {
+			log.Printf("[Room %s] Failed to mark meeting %d ended: %v", r.ID, meeting.ID, err)
+		}
+	}
+
+	r.broadcastModeration(ModerationEvent{Action: ModerationEndMeeting, By: by})
+
+	time.AfterFunc(moderationCloseDelay, func() {
+		for _, l := range r.Listeners.Values() {
+			l.disconnect()
+		}
+		r.hub.RemoveRoom(r.ID)
+	})
+}
+
+// checkModerationTarget 호스트 권한과 대상 참가자 확인 (호스트끼리는 제어 불가)
+func (r *Room) checkModerationTarget(moderatorID, participantID string) error {
+	if participantID == "" {
+		return ErrMissingParticipant
+	}
+	if !r.isModerator(moderatorID) {
+		return ErrNotModerator
+	}
+	if r.isModerator(participantID) {
+		return ErrCannotModerateHost
+	}
+	return nil
+}
+
+func (r *Room) broadcastModeration(event ModerationEvent) {
+	r.Broadcast(&BroadcastMessage{
+		Type: "moderation",
+		Data: event,
+	})
+}
+
+// SendModerationState 새로 접속한 참가자에게 잠금/음소거 상태 전송 (제어 이력이 없으면 생략)
+func (r *Room) SendModerationState(listenerID string) {
+	r.mu.RLock()
+	state := ModerationState{Locked: r.moderation.locked, Muted: make([]string, 0, len(r.moderation.muted))}
+	for id := range r.moderation.muted {
+		state.Muted = append(state.Muted, id)
+	}
+	r.mu.RUnlock()
+
+	if !state.Locked && len(state.Muted) == 0 {
+		return
+	}
+	sort.Strings(state.Muted)
+	r.Broadcast(&BroadcastMessage{
+		Type:             "moderation_state",
+		Data:             state,
+		TargetListenerID: listenerID,
+	})
+}
+
+// sendModerationError 요청한 참가자에게 호스트 제어 오류 전송
+func (r *Room) sendModerationError(listenerID, action string, err error) {
+	r.Broadcast(&BroadcastMessage{
+		Type:             "moderation_error",
+		Data:             map[string]string{"action": action, "message": err.Error()},
+		TargetListenerID: listenerID,
+	})
+}
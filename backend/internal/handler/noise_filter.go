@@ -0,0 +1,402 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"realtime-backend/internal/auth"
+	awsai "realtime-backend/internal/aws"
+	"realtime-backend/internal/model"
+)
+
+// 노이즈 필터 설정
+const maxNoiseFilterRules = 200 // 워크스페이스별 최대 커스텀 패턴 수
+
+// NoiseFilterHandler 워크스페이스 노이즈 필터 (패턴, 언어별 임계값) 핸들러
+type NoiseFilterHandler struct {
+	db      *gorm.DB
+	roomHub *RoomHub
+}
+
+// NewNoiseFilterHandler NoiseFilterHandler 생성
+func NewNoiseFilterHandler(db *gorm.DB, roomHub *RoomHub) *NoiseFilterHandler {
+	return &NoiseFilterHandler{db: db, roomHub: roomHub}
+}
+
+// CreateNoiseRuleRequest 노이즈 패턴 추가 요청
+type CreateNoiseRuleRequest struct {
+	LanguageCode string `json:"language_code"` // 빈 값 = 모든 언어
+	Pattern      string `json:"pattern"`
+	IsRegex      bool   `json:"is_regex"`
+}
+
+// UpsertNoiseThresholdRequest 언어별 임계값 설정 요청
+type UpsertNoiseThresholdRequest struct {
+	MinConfidence float32 `json:"min_confidence"`
+}
+
+// UpsertPartialMinLengthRequest 언어별 partial 자막 최소 글자 수 설정 요청
+type UpsertPartialMinLengthRequest struct {
+	MinLength int `json:"min_length"`
+}
+
+// GetNoiseFilter 워크스페이스 노이즈 필터 조회
+func (h *NoiseFilterHandler) GetNoiseFilter(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	if !h.isWorkspaceMember(int64(workspaceID), claims.UserID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you are not a member of this workspace",
+		})
+	}
+
+	var rules []model.NoiseFilterRule
+	if err := h.db.Where("workspace_id = ?", workspaceID).Order("id ASC").Find(&rules).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get noise filter rules",
+		})
+	}
+
+	var thresholds []model.NoiseFilterThreshold
+	if err := h.db.Where("workspace_id = ?", workspaceID).Order("language_code ASC").Find(&thresholds).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get noise filter thresholds",
+		})
+	}
+
+	// 워크스페이스 설정이 없는 언어에 적용되는 기본 partial 최소 글자 수
+	defaultPartialMinLengths := awsai.DefaultPartialMinLengths
+	if h.roomHub != nil {
+		_, _, defaultPartialMinLengths = h.roomHub.LoadWorkspaceNoiseFilter(0)
+	}
+
+	return c.JSON(fiber.Map{
+		"rules":                       rules,
+		"thresholds":                  thresholds,
+		"default_partial_min_lengths": defaultPartialMinLengths,
+	})
+}
+
+// CreateNoiseRule 노이즈 패턴 추가 (활성 Room에 즉시 반영)
+func (h *NoiseFilterHandler) CreateNoiseRule(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	// 권한 확인
+	hasPermission, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "MANAGE_NOISE_FILTER")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !hasPermission {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "you do not have permission to manage the noise filter"})
+	}
+
+	var req CreateNoiseRuleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	if req.LanguageCode != "" && !isSupportedVocabularyLanguage(req.LanguageCode) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "unsupported language",
+		})
+	}
+	if !req.IsRegex {
+		req.Pattern = sanitizeString(req.Pattern)
+	}
+	if err := awsai.ValidateNoiseRule(awsai.NoiseRule{Pattern: req.Pattern, Regex: req.IsRegex}); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var count int64
+	h.db.Model(&model.NoiseFilterRule{}).Where("workspace_id = ?", workspaceID).Count(&count)
+	if count >= maxNoiseFilterRules {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "too many noise filter rules",
+		})
+	}
+
+	rule := model.NoiseFilterRule{
+		WorkspaceID:  int64(workspaceID),
+		LanguageCode: req.LanguageCode,
+		Pattern:      req.Pattern,
+		IsRegex:      req.IsRegex,
+		CreatedBy:    claims.UserID,
+	}
+	if err := h.db.Create(&rule).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to create noise filter rule",
+		})
+	}
+
+	h.refresh(int64(workspaceID))
+	return c.Status(fiber.StatusCreated).JSON(rule)
+}
+
+// DeleteNoiseRule 노이즈 패턴 삭제
+func (h *NoiseFilterHandler) DeleteNoiseRule(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+	ruleID, err := c.ParamsInt("ruleId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid rule id",
+		})
+	}
+
+	// 권한 확인
+	hasPermission, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "MANAGE_NOISE_FILTER")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !hasPermission {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "you do not have permission to manage the noise filter"})
+	}
+
+	result := h.db.Where("id = ? AND workspace_id = ?", ruleID, workspaceID).Delete(&model.NoiseFilterRule{})
+	if result.Error != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to delete noise filter rule",
+		})
+	}
+	if result.RowsAffected == 0 {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "noise filter rule not found",
+		})
+	}
+
+	h.refresh(int64(workspaceID))
+	return c.JSON(fiber.Map{
+		"message": "noise filter rule deleted successfully",
+	})
+}
+
+// UpsertNoiseThreshold 언어별 최소 confidence 설정
+func (h *NoiseFilterHandler) UpsertNoiseThreshold(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	lang := c.Params("lang")
+	if !isSupportedVocabularyLanguage(lang) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "unsupported language",
+		})
+	}
+
+	// 권한 확인
+	hasPermission, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "MANAGE_NOISE_FILTER")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !hasPermission {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "you do not have permission to manage the noise filter"})
+	}
+
+	var req UpsertNoiseThresholdRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+	if req.MinConfidence < 0 || req.MinConfidence > 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "min_confidence must be between 0 and 1",
+		})
+	}
+
+	threshold := model.NoiseFilterThreshold{
+		WorkspaceID:   int64(workspaceID),
+		LanguageCode:  lang,
+		MinConfidence: req.MinConfidence,
+	}
+	if err := h.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "workspace_id"}, {Name: "language_code"}},
+		DoUpdates: clause.AssignmentColumns([]string{"min_confidence", "updated_at"}),
+	}).Create(&threshold).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to save noise filter threshold",
+		})
+	}
+
+	h.refresh(int64(workspaceID))
+	return c.JSON(threshold)
+}
+
+// UpsertPartialMinLength 언어별 partial 자막 최소 글자 수 설정
+func (h *NoiseFilterHandler) UpsertPartialMinLength(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	lang := c.Params("lang")
+	if !isSupportedVocabularyLanguage(lang) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "unsupported language",
+		})
+	}
+
+	// 권한 확인
+	hasPermission, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "MANAGE_NOISE_FILTER")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !hasPermission {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "you do not have permission to manage the noise filter"})
+	}
+
+	var req UpsertPartialMinLengthRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+	if err := awsai.ValidatePartialMinLength(req.MinLength); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	threshold := model.NoiseFilterThreshold{
+		WorkspaceID:      int64(workspaceID),
+		LanguageCode:     lang,
+		PartialMinLength: req.MinLength,
+	}
+	if err := h.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "workspace_id"}, {Name: "language_code"}},
+		DoUpdates: clause.AssignmentColumns([]string{"partial_min_length", "updated_at"}),
+	}).Create(&threshold).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to save partial min length",
+		})
+	}
+
+	h.refresh(int64(workspaceID))
+	return c.JSON(threshold)
+}
+
+// DeletePartialMinLength 언어별 partial 자막 최소 글자 수 삭제 (기본값으로 복원)
+func (h *NoiseFilterHandler) DeletePartialMinLength(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	lang := c.Params("lang")
+
+	// 권한 확인
+	hasPermission, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "MANAGE_NOISE_FILTER")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !hasPermission {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "you do not have permission to manage the noise filter"})
+	}
+
+	result := h.db.Model(&model.NoiseFilterThreshold{}).
+		Where("workspace_id = ? AND language_code = ? AND partial_min_length > 0", workspaceID, lang).
+		Update("partial_min_length", 0)
+	if result.Error != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to delete partial min length",
+		})
+	}
+	if result.RowsAffected == 0 {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "partial min length not found",
+		})
+	}
+
+	h.refresh(int64(workspaceID))
+	return c.JSON(fiber.Map{
+		"message": fmt.Sprintf("partial min length for %s deleted successfully", lang),
+	})
+}
+
+// DeleteNoiseThreshold 언어별 임계값 삭제 (기본값으로 복원)
+func (h *NoiseFilterHandler) DeleteNoiseThreshold(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	lang := c.Params("lang")
+
+	// 권한 확인
+	hasPermission, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "MANAGE_NOISE_FILTER")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !hasPermission {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "you do not have permission to manage the noise filter"})
+	}
+
+	result := h.db.Where("workspace_id = ? AND language_code = ?", workspaceID, lang).Delete(&model.NoiseFilterThreshold{})
+	if result.Error != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to delete noise filter threshold",
+		})
+	}
+	if result.RowsAffected == 0 {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "noise filter threshold not found",
+		})
+	}
+
+	h.refresh(int64(workspaceID))
+	return c.JSON(fiber.Map{
+		"message": fmt.Sprintf("noise filter threshold for %s deleted successfully", lang),
+	})
+}
+
+// refresh 활성 Room에 변경 사항 반영
+func (h *NoiseFilterHandler) refresh(workspaceID int64) {
+	if h.roomHub != nil {
+		h.roomHub.RefreshWorkspaceNoiseFilter(workspaceID)
+	}
+}
+
+func (h *NoiseFilterHandler) isWorkspaceMember(workspaceID, userID int64) bool {
+	var count int64
+	h.db.Model(&model.WorkspaceMember{}).
+		Where("workspace_id = ? AND user_id = ? AND status = ?", workspaceID, userID, model.MemberStatusActive.String()).
+		Count(&count)
+	return count > 0
+}
@@ -1,13 +1,16 @@
 package handler
 
 import (
+	"errors"
 	"fmt"
+	"log"
 
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
 
 	"realtime-backend/internal/auth"
 	"realtime-backend/internal/model"
+	"realtime-backend/internal/notify/email"
 )
 
 // NotificationHandler 알림 핸들러
@@ -294,7 +297,15 @@ func CreateNotification(db *gorm.DB, receiverID int64, senderID *int64, notifica
 func CreateWorkspaceInviteNotification(db *gorm.DB, inviterID, inviteeID, workspaceID int64, workspaceName, inviterName string) error {
 	content := fmt.Sprintf("%s님이 %s 워크스페이스에 초대했습니다.", inviterName, workspaceName)
 	relatedType := "WORKSPACE"
-	return CreateNotification(db, inviteeID, &inviterID, model.NotificationTypeWorkspaceInvite.String(), content, &relatedType, &workspaceID)
+	if err := CreateNotification(db, inviteeID, &inviterID, model.NotificationTypeWorkspaceInvite.String(), content, &relatedType, &workspaceID); err != nil {
+		return err
+	}
+
+	// 초대 메일 (수신 거부면 생략, 발송은 이메일 스케줄러가 담당)
+	if err := email.EnqueueWorkspaceInvite(db, inviteeID, workspaceID, workspaceName, inviterName); err != nil && !errors.Is(err, email.ErrUnsubscribed) {
+		log.Printf("초대 메일 대기열 추가 실패: workspace=%d, user=%d: %v", workspaceID, inviteeID, err)
+	}
+	return nil
 }
 
 // 응답 변환
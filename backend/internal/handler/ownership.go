@@ -0,0 +1,275 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"realtime-backend/internal/cache"
+)
+
+// Room ownership lease. With fan-out enabled, clients of one room can land on different
+// instances; only the instance holding the room's lease in Redis runs the AWS pipeline.
+// The others forward their speakers' audio to it over Redis and receive the resulting
+// transcripts/TTS through the fan-out channel, so a room never opens duplicate
+// Transcribe streams.
+const (
+	defaultRoomLeaseTTL = 15 * time.Second
+	roomLeaseTimeout    = 2 * time.Second
+)
+
+// roomOwnership is a room's lease state (nil on Room when ownership is not shared)
+type roomOwnership struct {
+	ttl     time.Duration
+	owned   atomic.Bool
+	renewed time.Time     // Last successful renewal (lease loop only)
+	changed chan struct{} // Ownership changed: the audio processor starts/stops the pipeline
+	pubsub  *redis.PubSub
+	done    chan struct{} // Closed when the lease loop exits
+	audio   chan struct{} // Closed when the audio subscriber exits
+}
+
+// ownershipEnabled reports whether rooms elect a single pipeline owner through Redis
+func (h *RoomHub) ownershipEnabled() bool {
+	return h.fanoutEnabled() && h.useAWS && h.roomLeaseTTL() > 0
+}
+
+func (h *RoomHub) roomLeaseTTL() time.Duration {
+	if h.cfg != nil && h.cfg.Redis.RoomLeaseTTL != 0 {
+		return h.cfg.Redis.RoomLeaseTTL
+	}
+	return defaultRoomLeaseTTL
+}
+
+// isOwner reports whether this instance runs the room's pipeline (always true without a lease)
+func (o *roomOwnership) isOwner() bool {
+	return o == nil || o.owned.Load()
+}
+
+// changes returns the ownership change signal (nil channel without a lease)
+func (o *roomOwnership) changes() <-chan struct{} {
+	if o == nil {
+		return nil
+	}
+	return o.changed
+}
+
+// startOwnership tries to take the lease before the pipeline starts, then keeps renewing it
+// and listens for audio forwarded by the other instances
+func (r *Room) startOwnership() {
+	o := &roomOwnership{
+		ttl:     r.hub.roomLeaseTTL(),
+		changed: make(chan struct{}, 1),
+		pubsub:  r.hub.redisClient.SubscribeRoomAudio(r.ctx, r.ID),
+		done:    make(chan struct{}),
+		audio:   make(chan struct{}),
+	}
+	r.ownership = o
+
+	r.renewLease(o)
+	go r.runLeaseLoop(o)
+	go r.runAudioForwardSubscriber(o)
+}
+
+// stopOwnership stops renewing, releases the lease if held and stops receiving forwarded audio
+func (r *Room) stopOwnership() {
+	o := r.ownership
+	if o == nil {
+		return
+	}
+	<-o.done
+	o.pubsub.Close()
+	<-o.audio
+
+	if o.owned.Load() {
+		ctx, cancel := context.WithTimeout(context.Background(), roomLeaseTimeout)
+		defer cancel()
+		if err := r.hub.redisClient.ReleaseRoomLease(ctx, r.ID, r.hub.instanceID); err != nil {
+			log.Printf("[Room %s] Failed to release room lease: %v", r.ID, err)
+		}
+		o.owned.Store(false)
+	}
+}
+
+// runLeaseLoop renews (or tries to take over) the lease three times per TTL
+func (r *Room) runLeaseLoop(o *roomOwnership) {
+	defer close(o.done)
+
+	ticker := time.NewTicker(o.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-ticker.C:
+			r.renewLease(o)
+		}
+	}
+}
+
+// renewLease takes or renews the lease and signals the audio processor when ownership changes
+func (r *Room) renewLease(o *roomOwnership) {
+	ctx, cancel := context.WithTimeout(r.ctx, roomLeaseTimeout)
+	defer cancel()
+
+	owned := o.owned.Load()
+	owner, err := r.hub.redisClient.AcquireRoomLease(ctx, r.ID, r.hub.instanceID, o.ttl)
+	switch {
+	case err != nil:
+		if r.ctx.Err() != nil {
+			return
+		}
+		log.Printf("[Room %s] Room lease renewal failed: %v", r.ID, err)
+		// Keep running until the lease would have expired; another instance may take it after that
+		if !owned || time.Since(o.renewed) < o.ttl {
+			return
+		}
+		owned = false
+	case owner == r.hub.instanceID:
+		o.renewed = time.Now()
+		owned = true
+	default:
+		owned = false
+	}
+
+	if o.owned.Swap(owned) == owned {
+		return
+	}
+	switch {
+	case owned:
+		log.Printf("[Room %s] 👑 Acquired room lease, running the pipeline on this instance", r.ID)
+	case owner != "":
+		log.Printf("[Room %s] Room lease held by %s, forwarding audio", r.ID, owner)
+	default:
+		log.Printf("[Room %s] Room lease expired, forwarding audio until it is taken again", r.ID)
+	}
+	select {
+	case o.changed <- struct{}{}:
+	default:
+	}
+}
+
+// syncPipelineOwnership starts or stops the pipeline after an ownership change
+// (audio processor goroutine only); returns whether the pipeline is running
+func (r *Room) syncPipelineOwnership(running bool) bool {
+	owned := r.ownership.isOwner()
+	switch {
+	case owned && !running:
+		if err := r.startStream(); err != nil {
+			log.Printf("[Room %s] Failed to start stream after taking over: %v", r.ID, err)
+			return false
+		}
+		return true
+	case !owned && running:
+		r.mu.Lock()
+		pipeline := r.awsPipeline
+		r.awsPipeline = nil
+		r.mu.Unlock()
+		if pipeline != nil {
+			r.flushUsage(pipeline)
+			pipeline.Close()
+		}
+		log.Printf("[Room %s] Lost room lease, pipeline stopped", r.ID)
+		return false
+	}
+	return running
+}
+
+// forwardAudio sends a local speaker's audio to the lease owner; returns false if this
+// instance owns the pipeline and should process it itself
+func (r *Room) forwardAudio(msg *AudioMessage) bool {
+	if r.ownership.isOwner() {
+		return false
+	}
+
+	frame := &cache.RoomAudioFrame{
+		Origin:      r.hub.instanceID,
+		SpeakerID:   msg.SpeakerID,
+		SourceLang:  msg.SourceLang,
+		SpeakerName: msg.SpeakerName,
+		ProfileImg:  msg.ProfileImg,
+		Audio:       msg.AudioData,
+	}
+	r.mu.RLock()
+	if speaker, _ := r.Speakers.Get(msg.SpeakerID); speaker != nil {
+		frame.SpeakerName = speaker.Nickname
+		frame.ProfileImg = speaker.ProfileImg
+	}
+	r.mu.RUnlock()
+
+	ctx, cancel := context.WithTimeout(r.ctx, roomLeaseTimeout)
+	defer cancel()
+	if err := r.hub.redisClient.PublishRoomAudio(ctx, r.ID, frame); err != nil && r.ctx.Err() == nil {
+		log.Printf("[Room %s] Failed to forward audio from %s: %v", r.ID, msg.SpeakerID, err)
+	}
+	return true
+}
+
+// runAudioForwardSubscriber feeds audio forwarded by other instances into the pipeline
+// while this instance owns the room
+func (r *Room) runAudioForwardSubscriber(o *roomOwnership) {
+	defer close(o.audio)
+
+	for redisMsg := range o.pubsub.Channel() {
+		var frame cache.RoomAudioFrame
+		if err := json.Unmarshal([]byte(redisMsg.Payload), &frame); err != nil || frame.Origin == r.hub.instanceID {
+			continue
+		}
+		if !o.owned.Load() || r.ctx.Err() != nil {
+			continue
+		}
+		r.enqueueAudio(&AudioMessage{
+			SpeakerID:   frame.SpeakerID,
+			SourceLang:  frame.SourceLang,
+			AudioData:   frame.Audio,
+			SpeakerName: frame.SpeakerName,
+			ProfileImg:  frame.ProfileImg,
+		})
+	}
+}
+
+// finalizesMeeting reports whether this instance should finalize the meeting on shutdown.
+// While other instances still serve the room, the last one to leave finalizes it.
+func (r *Room) finalizesMeeting() bool {
+	o := r.ownership
+	if o == nil {
+		return true
+	}
+
+	r.mu.RLock()
+	remote := 0
+	if r.fanout != nil {
+		for _, langs := range r.fanout.remote {
+			if time.Since(langs.seen) <= fanoutLangsTTL {
+				remote++
+			}
+		}
+	}
+	r.mu.RUnlock()
+	if remote > 0 {
+		log.Printf("[Room %s] %d other instance(s) still serving the room, skipping finalization", r.ID, remote)
+		return false
+	}
+	if o.owned.Load() {
+		return true
+	}
+
+	// Nobody else left: take the lease so only one instance finalizes
+	ctx, cancel := context.WithTimeout(context.Background(), roomLeaseTimeout)
+	defer cancel()
+	owner, err := r.hub.redisClient.AcquireRoomLease(ctx, r.ID, r.hub.instanceID, o.ttl)
+	if err != nil {
+		// Finalization is idempotent, so finalize rather than risk never doing it
+		return true
+	}
+	if owner == r.hub.instanceID {
+		o.owned.Store(true)
+		return true
+	}
+	return owner == ""
+}
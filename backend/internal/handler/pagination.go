@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"realtime-backend/internal/repository"
+)
+
+// pageRequest 목록 API 공통 쿼리 (?limit=&offset=&cursor=, cursor가 있으면 offset 무시)
+func pageRequest(c *fiber.Ctx, defaultLimit int) repository.PageRequest {
+	return repository.PageRequest{
+		Limit:  c.QueryInt("limit", defaultLimit),
+		Offset: max(c.QueryInt("offset", 0), 0),
+		Cursor: c.Query("cursor"),
+	}
+}
+
+// pageError 목록 조회 오류 응답 (잘못된 커서는 400)
+func pageError(c *fiber.Ctx, err error, message string) error {
+	if errors.Is(err, repository.ErrInvalidCursor) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid cursor",
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error": message,
+	})
+}
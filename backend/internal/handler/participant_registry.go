@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// ParticipantRegistry is a concurrency-safe, copy-on-write map of room participants
+// (listeners or speakers) keyed by participant ID.
+//
+// Writers copy the current map, modify the copy and publish it atomically, so a map
+// returned by Snapshot is never mutated afterwards and can be iterated without any lock
+// while joins and leaves continue. Rooms hold a handful to a few hundred participants,
+// so the copy per join/leave is cheap compared to the reads on every broadcast.
+//
+// The registry only guards membership. Fields of the stored values (Listener.TargetLang,
+// Speaker.SourceLang, ...) are still guarded by Room.mu, and multi-step updates that must
+// be atomic with other Room state (language caps, pipeline retargeting) still run under
+// Room.mu.
+type ParticipantRegistry[T any] struct {
+	writeMu sync.Mutex // Serializes writers; readers never block
+	current atomic.Pointer[map[string]T]
+}
+
+// NewParticipantRegistry creates an empty registry
+func NewParticipantRegistry[T any]() *ParticipantRegistry[T] {
+	reg := &ParticipantRegistry[T]{}
+	empty := make(map[string]T)
+	reg.current.Store(&empty)
+	return reg
+}
+
+// Snapshot returns the current membership. The map is shared and must not be modified.
+func (reg *ParticipantRegistry[T]) Snapshot() map[string]T {
+	return *reg.current.Load()
+}
+
+// Get returns the participant with the given ID
+func (reg *ParticipantRegistry[T]) Get(id string) (T, bool) {
+	value, ok := reg.Snapshot()[id]
+	return value, ok
+}
+
+// Has reports whether the participant is registered
+func (reg *ParticipantRegistry[T]) Has(id string) bool {
+	_, ok := reg.Snapshot()[id]
+	return ok
+}
+
+// Len returns the number of participants
+func (reg *ParticipantRegistry[T]) Len() int {
+	return len(reg.Snapshot())
+}
+
+// IDs returns the participant IDs in sorted order
+func (reg *ParticipantRegistry[T]) IDs() []string {
+	snapshot := reg.Snapshot()
+	ids := make([]string, 0, len(snapshot))
+	for id := range snapshot {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Values returns the participants of one snapshot (in no particular order)
+func (reg *ParticipantRegistry[T]) Values() []T {
+	snapshot := reg.Snapshot()
+	values := make([]T, 0, len(snapshot))
+	for _, value := range snapshot {
+		values = append(values, value)
+	}
+	return values
+}
+
+// Range calls fn for every participant of one snapshot until fn returns false
+func (reg *ParticipantRegistry[T]) Range(fn func(id string, value T) bool) {
+	for id, value := range reg.Snapshot() {
+		if !fn(id, value) {
+			return
+		}
+	}
+}
+
+// Set registers or replaces a participant and returns the replaced one, if any
+func (reg *ParticipantRegistry[T]) Set(id string, value T) (previous T, replaced bool) {
+	reg.writeMu.Lock()
+	defer reg.writeMu.Unlock()
+
+	current := reg.Snapshot()
+	previous, replaced = current[id]
+	next := make(map[string]T, len(current)+1)
+	for k, v := range current {
+		next[k] = v
+	}
+	next[id] = value
+	reg.current.Store(&next)
+	return previous, replaced
+}
+
+// Delete removes a participant and returns it, if it was registered
+func (reg *ParticipantRegistry[T]) Delete(id string) (removed T, ok bool) {
+	reg.writeMu.Lock()
+	defer reg.writeMu.Unlock()
+
+	current := reg.Snapshot()
+	removed, ok = current[id]
+	if !ok {
+		return removed, false
+	}
+	next := make(map[string]T, len(current))
+	for k, v := range current {
+		if k != id {
+			next[k] = v
+		}
+	}
+	reg.current.Store(&next)
+	return removed, true
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestParticipantRegistrySetGetDelete(t *testing.T) {
+	reg := NewParticipantRegistry[int]()
+
+	if _, replaced := reg.Set("b", 1); replaced {
+		t.Fatalf("Set on empty registry reported a replaced participant")
+	}
+	reg.Set("a", 2)
+	if previous, replaced := reg.Set("b", 3); !replaced || previous != 1 {
+		t.Fatalf("Set(b) = %d, %v; want 1, true", previous, replaced)
+	}
+
+	if value, ok := reg.Get("b"); !ok || value != 3 {
+		t.Fatalf("Get(b) = %d, %v; want 3, true", value, ok)
+	}
+	if !reg.Has("a") || reg.Has("c") {
+		t.Fatalf("Has: a=%v c=%v; want true, false", reg.Has("a"), reg.Has("c"))
+	}
+	if got, want := reg.IDs(), []string{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("IDs() = %v; want %v", got, want)
+	}
+
+	if removed, ok := reg.Delete("a"); !ok || removed != 2 {
+		t.Fatalf("Delete(a) = %d, %v; want 2, true", removed, ok)
+	}
+	if _, ok := reg.Delete("a"); ok {
+		t.Fatalf("Delete(a) twice reported a removed participant")
+	}
+	if reg.Len() != 1 {
+		t.Fatalf("Len() = %d; want 1", reg.Len())
+	}
+}
+
+func TestParticipantRegistrySnapshotIsStable(t *testing.T) {
+	reg := NewParticipantRegistry[int]()
+	reg.Set("a", 1)
+	reg.Set("b", 2)
+
+	snapshot := reg.Snapshot()
+	reg.Set("c", 3)
+	reg.Delete("a")
+
+	if len(snapshot) != 2 || snapshot["a"] != 1 || snapshot["b"] != 2 {
+		t.Fatalf("snapshot changed after writes: %v", snapshot)
+	}
+	if got, want := reg.IDs(), []string{"b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("IDs() = %v; want %v", got, want)
+	}
+}
+
+func TestParticipantRegistryRangeStops(t *testing.T) {
+	reg := NewParticipantRegistry[int]()
+	for i := 0; i < 5; i++ {
+		reg.Set(fmt.Sprint(i), i)
+	}
+
+	visited := 0
+	reg.Range(func(string, int) bool {
+		visited++
+		return visited < 2
+	})
+	if visited != 2 {
+		t.Fatalf("Range visited %d participants after returning false; want 2", visited)
+	}
+}
+
+// Run with -race: readers iterate snapshots while writers join and leave
+func TestParticipantRegistryConcurrentAccess(t *testing.T) {
+	const writers, perWriter = 8, 200
+	reg := NewParticipantRegistry[int]()
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				reg.Range(func(id string, value int) bool {
+					return id != "" && value >= 0
+				})
+				_ = reg.Values()
+				_ = reg.IDs()
+				_, _ = reg.Get("w0-0")
+			}
+		}()
+	}
+
+	var writeWG sync.WaitGroup
+	for w := 0; w < writers; w++ {
+		writeWG.Add(1)
+		go func(w int) {
+			defer writeWG.Done()
+			for i := 0; i < perWriter; i++ {
+				id := fmt.Sprintf("w%d-%d", w, i)
+				reg.Set(id, i)
+				if i%2 == 1 {
+					reg.Delete(id)
+				}
+			}
+		}(w)
+	}
+	writeWG.Wait()
+	close(stop)
+	wg.Wait()
+
+	if got, want := reg.Len(), writers*perWriter/2; got != want {
+		t.Fatalf("Len() = %d; want %d", got, want)
+	}
+}
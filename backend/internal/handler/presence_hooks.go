@@ -0,0 +1,261 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"realtime-backend/internal/metrics"
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/retry"
+)
+
+// 접속 상태 이벤트 종류
+const (
+	PresenceListenerJoined = "listener.joined"
+	PresenceListenerLeft   = "listener.left"
+	PresenceSpeakerJoined  = "speaker.joined"
+	PresenceSpeakerLeft    = "speaker.left"
+)
+
+// 접속 상태 훅 호출 설정
+const (
+	presenceHookTimeout   = 5 * time.Second  // 웹훅 요청 한 번
+	presenceHookDeadline  = 20 * time.Second // 훅 호출 하나 (재시도 포함)
+	presenceDefaultQueue  = 256
+	presenceWebhookHeader = "X-Eum-Event"
+)
+
+// presenceWebhookPolicy 웹훅 재시도 (4xx는 재시도하지 않음)
+var presenceWebhookPolicy = retry.Policy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Second,
+	MaxBackoff:     4 * time.Second,
+	Jitter:         0.2,
+}
+
+// PresenceEvent 리스너/화자 입장·퇴장 이벤트 (Room, 회의, 사용자 정보 포함)
+type PresenceEvent struct {
+	Event         string    `json:"event"`
+	RoomID        string    `json:"room_id"`
+	MeetingID     *int64    `json:"meeting_id,omitempty"`
+	WorkspaceID   *int64    `json:"workspace_id,omitempty"`
+	ParticipantID string    `json:"participant_id"`
+	UserID        *int64    `json:"user_id,omitempty"` // 참가자 ID가 사용자 ID일 때
+	Nickname      string    `json:"nickname,omitempty"`
+	Language      string    `json:"language,omitempty"` // 리스너 번역 언어 또는 화자 발화 언어
+	OccurredAt    time.Time `json:"occurred_at"`
+}
+
+// PresenceHook 접속 상태 이벤트 수신자 (Slack 상태, 내부 대시보드 등)
+// 훅은 전용 goroutine에서 순서대로 호출되므로 오래 걸리는 작업은 스스로 제한해야 함
+type PresenceHook interface {
+	OnPresence(ctx context.Context, event PresenceEvent) error
+}
+
+// PresenceHookFunc 함수를 PresenceHook으로 사용
+type PresenceHookFunc func(ctx context.Context, event PresenceEvent) error
+
+// OnPresence PresenceHook 구현
+func (f PresenceHookFunc) OnPresence(ctx context.Context, event PresenceEvent) error {
+	return f(ctx, event)
+}
+
+// presenceHooks 등록된 훅과 이벤트 큐 (Room 잠금 중에도 막히지 않도록 큐에 넣고 반환)
+type presenceHooks struct {
+	mu     sync.RWMutex
+	hooks  []PresenceHook
+	queue  chan PresenceEvent
+	start  sync.Once
+	stopCh chan struct{}
+}
+
+func newPresenceHooks(queueSize int) *presenceHooks {
+	if queueSize <= 0 {
+		queueSize = presenceDefaultQueue
+	}
+	return &presenceHooks{
+		queue:  make(chan PresenceEvent, queueSize),
+		stopCh: make(chan struct{}),
+	}
+}
+
+// =============================================================================
+// RoomHub - presence hooks
+// =============================================================================
+
+// AddPresenceHook 입장/퇴장 훅 등록 (첫 등록 시 호출 goroutine 시작)
+func (h *RoomHub) AddPresenceHook(hook PresenceHook) {
+	h.presence.mu.Lock()
+	h.presence.hooks = append(h.presence.hooks, hook)
+	h.presence.mu.Unlock()
+
+	h.presence.start.Do(func() {
+		go h.runPresenceHooks()
+	})
+}
+
+// emitPresence 이벤트를 큐에 넣음 (훅이 없으면 무시, 큐가 가득 차면 버림)
+func (h *RoomHub) emitPresence(event PresenceEvent) {
+	h.presence.mu.RLock()
+	empty := len(h.presence.hooks) == 0
+	h.presence.mu.RUnlock()
+	if empty {
+		return
+	}
+
+	event.OccurredAt = time.Now()
+	select {
+	case h.presence.queue <- event:
+	default:
+		metrics.DroppedMessages.Inc(metrics.DropPresenceHook)
+		log.Printf("[Presence] Queue full, dropping %s for %s in room %s", event.Event, event.ParticipantID, event.RoomID)
+	}
+}
+
+// runPresenceHooks 큐의 이벤트에 회의/사용자 정보를 채워 모든 훅에 전달
+func (h *RoomHub) runPresenceHooks() {
+	for {
+		select {
+		case event := <-h.presence.queue:
+			h.enrichPresence(&event)
+
+			h.presence.mu.RLock()
+			hooks := h.presence.hooks
+			h.presence.mu.RUnlock()
+
+			for _, hook := range hooks {
+				ctx, cancel := context.WithTimeout(context.Background(), presenceHookDeadline)
+				if err := hook.OnPresence(ctx, event); err != nil {
+					log.Printf("[Presence] Hook failed for %s (%s): %v", event.Event, event.RoomID, err)
+				}
+				cancel()
+			}
+		case <-h.presence.stopCh:
+			return
+		}
+	}
+}
+
+// enrichPresence 회의 ID, 사용자 ID와 닉네임 채우기 (DB 조회는 훅 goroutine에서만)
+func (h *RoomHub) enrichPresence(event *PresenceEvent) {
+	if userID, err := strconv.ParseInt(event.ParticipantID, 10, 64); err == nil {
+		event.UserID = &userID
+	}
+	if h.db == nil {
+		return
+	}
+	if event.Nickname == "" && event.UserID != nil {
+		var user model.User
+		if err := h.db.Select("nickname").First(&user, *event.UserID).Error; err == nil {
+			event.Nickname = user.Nickname
+		}
+	}
+	if meeting, err := FindMeetingByRoomID(h.db, event.RoomID); err == nil {
+		event.MeetingID = &meeting.ID
+		if event.WorkspaceID == nil {
+			event.WorkspaceID = meeting.WorkspaceID
+		}
+	}
+}
+
+// stopPresenceHooks 훅 goroutine 중지 (남은 이벤트는 버림)
+func (h *RoomHub) stopPresenceHooks() {
+	select {
+	case <-h.presence.stopCh:
+	default:
+		close(h.presence.stopCh)
+	}
+}
+
+// =============================================================================
+// Room Methods - presence events
+// =============================================================================
+
+// emitPresence 이 Room의 참가자 입장/퇴장 이벤트 (r.mu 보유 상태에서 호출 가능)
+func (r *Room) emitPresence(event, participantID, nickname, language string) {
+	presence := PresenceEvent{
+		Event:         event,
+		RoomID:        r.ID,
+		ParticipantID: participantID,
+		Nickname:      nickname,
+		Language:      language,
+	}
+	if r.workspaceID != 0 {
+		workspaceID := r.workspaceID
+		presence.WorkspaceID = &workspaceID
+	}
+	r.hub.emitPresence(presence)
+}
+
+// speakerNickname 화자로 등록된 참가자의 닉네임 (리스너만이면 "", r.mu 보유 상태에서 호출)
+func (r *Room) speakerNickname(participantID string) string {
+	if speaker, ok := r.Speakers.Get(participantID); ok {
+		return speaker.Nickname
+	}
+	return ""
+}
+
+// =============================================================================
+// Webhook presence hook
+// =============================================================================
+
+// WebhookPresenceHook 이벤트를 JSON으로 POST하는 훅 (X-Eum-Signature: HMAC-SHA256)
+type WebhookPresenceHook struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+// NewWebhookPresenceHook 웹훅 훅 생성 (secret "" = 서명 안 함)
+func NewWebhookPresenceHook(url, secret string) *WebhookPresenceHook {
+	return &WebhookPresenceHook{
+		url:    url,
+		secret: secret,
+		client: &http.Client{Timeout: presenceHookTimeout},
+	}
+}
+
+// OnPresence PresenceHook 구현 (5xx/네트워크 오류는 재시도)
+func (w *WebhookPresenceHook) OnPresence(ctx context.Context, event PresenceEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return retry.Do(ctx, presenceWebhookPolicy, func(ctx context.Context) error {
+		return w.post(ctx, event.Event, body)
+	})
+}
+
+func (w *WebhookPresenceHook) post(ctx context.Context, event string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return retry.Stop(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(presenceWebhookHeader, event)
+	if w.secret != "" {
+		req.Header.Set("X-Eum-Signature", "sha256="+signRoomWebhook(w.secret, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		err := fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+		if resp.StatusCode < 500 {
+			return retry.Stop(err)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"sort"
+
+	awsai "realtime-backend/internal/aws"
+)
+
+// roomWatermarks are the high watermarks of a room's internal channels
+type roomWatermarks struct {
+	broadcast awsai.QueueWatermark
+	relay     awsai.QueueWatermark
+	audioIn   awsai.QueueWatermark
+}
+
+// RoomQueues is the depth of every queue in one room, from speaker audio to listener writes
+type RoomQueues struct {
+	RoomID    string                      `json:"roomId"`
+	AudioIn   awsai.QueueDepth            `json:"audioIn"`   // Speaker audio waiting for the pipeline
+	Broadcast awsai.QueueDepth            `json:"broadcast"` // Transcripts/TTS waiting for fan-out
+	Relay     awsai.QueueDepth            `json:"relay"`     // Original speaker audio waiting for fan-out
+	Listeners map[string]awsai.QueueDepth `json:"listeners"` // Messages waiting in each listener's send queue
+	Pipeline  *awsai.PipelineQueues       `json:"pipeline,omitempty"`
+}
+
+// QueueDepths returns the current depth and high watermark of every queue in the room.
+// reset restarts the high watermarks so the next call shows peaks since this one.
+func (r *Room) QueueDepths(reset bool) RoomQueues {
+	queues := RoomQueues{
+		RoomID:    r.ID,
+		AudioIn:   r.queues.audioIn.Snapshot(len(r.audioIn), cap(r.audioIn), reset),
+		Broadcast: r.queues.broadcast.Snapshot(len(r.broadcast), cap(r.broadcast), reset),
+		Relay:     r.queues.relay.Snapshot(len(r.relay), cap(r.relay), reset),
+		Listeners: make(map[string]awsai.QueueDepth),
+	}
+
+	r.mu.RLock()
+	for id, l := range r.Listeners.Snapshot() {
+		queues.Listeners[id] = l.queue.snapshot(reset)
+	}
+	pipeline := r.awsPipeline
+	r.mu.RUnlock()
+
+	if pipeline != nil {
+		pq := pipeline.QueueDepths(reset)
+		queues.Pipeline = &pq
+	}
+	return queues
+}
+
+// QueueDepths returns queue depths for every room (or only roomID if set), sorted by room ID
+func (h *RoomHub) QueueDepths(roomID string, reset bool) []RoomQueues {
+	h.mu.RLock()
+	rooms := make([]*Room, 0, len(h.rooms))
+	for id, room := range h.rooms {
+		if roomID == "" || id == roomID {
+			rooms = append(rooms, room)
+		}
+	}
+	h.mu.RUnlock()
+
+	result := make([]RoomQueues, 0, len(rooms))
+	for _, room := range rooms {
+		result = append(result, room.QueueDepths(reset))
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].RoomID < result[j].RoomID })
+	return result
+}
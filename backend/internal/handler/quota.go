@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"log"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm/clause"
+
+	"realtime-backend/internal/auth"
+	awsai "realtime-backend/internal/aws"
+	"realtime-backend/internal/model"
+)
+
+// =============================================================================
+// RoomHub - usage quotas
+// =============================================================================
+
+// LoadWorkspaceQuota 워크스페이스 월간 한도 조회 (설정이 없으면 서버 기본값)
+func (h *RoomHub) LoadWorkspaceQuota(workspaceID int64) awsai.QuotaLimits {
+	var limits awsai.QuotaLimits
+	if h.cfg != nil {
+		limits.TranscribeMinutes = h.cfg.AI.QuotaTranscribeMinutes
+		limits.PollyChars = h.cfg.AI.QuotaPollyChars
+	}
+	if h.db == nil || workspaceID == 0 {
+		return limits
+	}
+
+	var quotas []model.WorkspaceQuota
+	if err := h.db.Where("workspace_id = ?", workspaceID).Limit(1).Find(&quotas).Error; err != nil {
+		log.Printf("[RoomHub] Failed to load quota for workspace %d: %v", workspaceID, err)
+		return limits
+	}
+	if len(quotas) > 0 {
+		limits.TranscribeMinutes = quotas[0].TranscribeMinutes
+		limits.PollyChars = quotas[0].PollyChars
+	}
+	return limits
+}
+
+// WorkspaceMonthUsage 이번 달 저장된 워크스페이스 사용량 합계 (아직 저장되지 않은 분은 제외)
+func (h *RoomHub) WorkspaceMonthUsage(workspaceID int64) awsai.UsageSnapshot {
+	var totals UsageTotals
+	if h.db == nil || workspaceID == 0 {
+		return awsai.UsageSnapshot{}
+	}
+
+	if err := h.db.Model(&model.UsageRecord{}).
+		Select("COALESCE(SUM(transcribe_seconds), 0) AS transcribe_seconds, COALESCE(SUM(translate_chars), 0) AS translate_chars, "+
+			"COALESCE(SUM(polly_chars), 0) AS polly_chars, COALESCE(SUM(estimated_cost_usd), 0) AS estimated_cost_usd").
+		Where("workspace_id = ? AND period_start >= ?", workspaceID, awsai.QuotaPeriodStart(time.Now())).
+		Scan(&totals).Error; err != nil {
+		log.Printf("[RoomHub] Failed to load monthly usage for workspace %d: %v", workspaceID, err)
+	}
+
+	return awsai.UsageSnapshot{
+		TranscribeSeconds: totals.TranscribeSeconds,
+		TranslateChars:    totals.TranslateChars,
+		PollyChars:        totals.PollyChars,
+		EstimatedCostUSD:  totals.EstimatedCostUSD,
+	}
+}
+
+// RefreshWorkspaceQuota 변경된 한도와 이번 달 사용량을 워크스페이스 활성 Room에 반영
+func (h *RoomHub) RefreshWorkspaceQuota(workspaceID int64) {
+	limits := h.LoadWorkspaceQuota(workspaceID)
+	used := h.WorkspaceMonthUsage(workspaceID)
+	langLimit := h.LoadWorkspaceLanguageLimit(workspaceID)
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, room := range h.rooms {
+		room.mu.RLock()
+		pipeline := room.awsPipeline
+		matches := room.workspaceID == workspaceID
+		room.mu.RUnlock()
+
+		if matches {
+			room.setLanguageLimit(langLimit)
+		}
+		if matches && pipeline != nil {
+			pipeline.UpdateQuota(limits, used)
+		}
+	}
+}
+
+// refreshQuotas 사용량 저장 후 활성 Room이 있는 모든 워크스페이스의 한도/사용량 갱신
+func (h *RoomHub) refreshQuotas() {
+	workspaces := make(map[int64]bool)
+
+	h.mu.RLock()
+	for _, room := range h.rooms {
+		room.mu.RLock()
+		if room.workspaceID != 0 && room.awsPipeline != nil {
+			workspaces[room.workspaceID] = true
+		}
+		room.mu.RUnlock()
+	}
+	h.mu.RUnlock()
+
+	for workspaceID := range workspaces {
+		h.RefreshWorkspaceQuota(workspaceID)
+	}
+}
+
+// newUsageQuota 파이프라인 시작 시 워크스페이스 한도 생성 (워크스페이스 없는 회의는 nil = 무제한)
+// 한도가 없어도 생성해서 관리자가 나중에 설정한 한도가 진행 중인 회의에도 적용되도록 함
+func (h *RoomHub) newUsageQuota(workspaceID int64) *awsai.UsageQuota {
+	if workspaceID == 0 {
+		return nil
+	}
+	return awsai.NewUsageQuota(h.LoadWorkspaceQuota(workspaceID), h.WorkspaceMonthUsage(workspaceID))
+}
+
+// notifyQuotaExceeded 한도 초과로 음성 인식/TTS가 중단되었음을 모든 참가자에게 알림
+func (r *Room) notifyQuotaExceeded(event *awsai.QuotaExceededEvent) {
+	log.Printf("[Room %s] ⛔ Workspace %d %s quota exceeded (%d/%d)", r.ID, r.workspaceID, event.Resource, event.Used, event.Limit)
+	r.Broadcast(&BroadcastMessage{
+		Type: "quota_exceeded",
+		Data: event,
+	})
+}
+
+// =============================================================================
+// REST - usage quotas
+// =============================================================================
+
+// UpdateQuotaRequest 워크스페이스 한도 변경 요청 (0 = 무제한)
+type UpdateQuotaRequest struct {
+	TranscribeMinutes  *int64 `json:"transcribe_minutes"`
+	PollyChars         *int64 `json:"polly_chars"`
+	MaxTargetLanguages *int   `json:"max_target_languages"`
+}
+
+// GetWorkspaceQuota 워크스페이스 월간 한도와 이번 달 사용량 조회
+func (h *UsageHandler) GetWorkspaceQuota(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid workspace id"})
+	}
+
+	if !h.isWorkspaceMember(int64(workspaceID), claims.UserID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "you are not a member of this workspace"})
+	}
+	if h.roomHub == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": "usage tracking is not available"})
+	}
+
+	limits := h.roomHub.LoadWorkspaceQuota(int64(workspaceID))
+	used := h.roomHub.WorkspaceMonthUsage(int64(workspaceID))
+	for _, live := range h.roomHub.WorkspaceLiveUsage(int64(workspaceID)) {
+		// 저장되지 않은 분은 다음 기록 때 반영되므로 근사값
+		used.TranscribeSeconds += live.TranscribeSeconds
+		used.PollyChars += live.PollyChars
+	}
+	usedMinutes := int64(used.TranscribeSeconds / 60)
+
+	remaining := fiber.Map{}
+	if limits.TranscribeMinutes > 0 {
+		remaining["transcribe_minutes"] = max(limits.TranscribeMinutes-usedMinutes, 0)
+	}
+	if limits.PollyChars > 0 {
+		remaining["polly_chars"] = max(limits.PollyChars-used.PollyChars, 0)
+	}
+
+	return c.JSON(fiber.Map{
+		"workspace_id": workspaceID,
+		"period":       awsai.QuotaPeriod(time.Now()),
+		"limits": fiber.Map{
+			"transcribe_minutes":   limits.TranscribeMinutes,
+			"polly_chars":          limits.PollyChars,
+			"max_target_languages": h.roomHub.LoadWorkspaceLanguageLimit(int64(workspaceID)),
+		},
+		"used": fiber.Map{
+			"transcribe_minutes": usedMinutes,
+			"polly_chars":        used.PollyChars,
+		},
+		"remaining": remaining,
+	})
+}
+
+// UpdateWorkspaceQuota 워크스페이스 월간 한도 변경 (활성 Room에 즉시 반영)
+func (h *UsageHandler) UpdateWorkspaceQuota(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid workspace id"})
+	}
+
+	// 권한 확인 (ADMIN)
+	hasPermission, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "ADMIN")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !hasPermission {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "you do not have permission to update quotas"})
+	}
+
+	var req UpdateQuotaRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid request body"})
+	}
+	if req.TranscribeMinutes == nil && req.PollyChars == nil && req.MaxTargetLanguages == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "transcribe_minutes, polly_chars or max_target_languages is required"})
+	}
+	if (req.TranscribeMinutes != nil && *req.TranscribeMinutes < 0) || (req.PollyChars != nil && *req.PollyChars < 0) ||
+		(req.MaxTargetLanguages != nil && *req.MaxTargetLanguages < 0) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "quota must not be negative"})
+	}
+
+	// 지정하지 않은 항목은 현재 값 유지
+	current := awsai.QuotaLimits{}
+	currentLangLimit := 0
+	if h.roomHub != nil {
+		current = h.roomHub.LoadWorkspaceQuota(int64(workspaceID))
+		currentLangLimit = h.roomHub.LoadWorkspaceLanguageLimit(int64(workspaceID))
+	}
+	quota := model.WorkspaceQuota{
+		WorkspaceID:        int64(workspaceID),
+		TranscribeMinutes:  current.TranscribeMinutes,
+		PollyChars:         current.PollyChars,
+		MaxTargetLanguages: currentLangLimit,
+		UpdatedBy:          claims.UserID,
+	}
+	if req.TranscribeMinutes != nil {
+		quota.TranscribeMinutes = *req.TranscribeMinutes
+	}
+	if req.PollyChars != nil {
+		quota.PollyChars = *req.PollyChars
+	}
+	if req.MaxTargetLanguages != nil {
+		quota.MaxTargetLanguages = *req.MaxTargetLanguages
+	}
+
+	if err := h.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "workspace_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"transcribe_minutes", "polly_chars", "max_target_languages", "updated_by", "updated_at"}),
+	}).Create(&quota).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to update quota"})
+	}
+
+	if h.roomHub != nil {
+		h.roomHub.RefreshWorkspaceQuota(int64(workspaceID))
+	}
+	return c.JSON(quota)
+}
+
+func (h *UsageHandler) isWorkspaceMember(workspaceID, userID int64) bool {
+	var count int64
+	h.db.Model(&model.WorkspaceMember{}).
+		Where("workspace_id = ? AND user_id = ? AND status = ?", workspaceID, userID, model.MemberStatusActive.String()).
+		Count(&count)
+	return count > 0
+}
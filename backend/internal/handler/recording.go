@@ -0,0 +1,696 @@
+package handler
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/auth"
+	awsai "realtime-backend/internal/aws"
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/storage"
+)
+
+// 회의 녹음 설정
+const (
+	recordingSampleRate      = speakerSampleRate // 화자 믹스 샘플레이트 (Transcribe 입력과 동일)
+	defaultRecordingChunk    = time.Minute
+	minRecordingChunk        = 10 * time.Second
+	recordingUploadTimeout   = 30 * time.Second
+	recordingStopTimeout     = time.Minute // 종료 시 남은 업로드 대기 시간
+	recordingCleanupInterval = time.Hour
+	recordingCleanupTimeout  = 5 * time.Minute // S3 일괄 삭제 전체 제한 시간
+)
+
+var (
+	ErrRecordingUnavailable = errors.New("recording requires S3 storage")
+	ErrRecordingActive      = errors.New("recording already in progress")
+	ErrRecordingNotActive   = errors.New("recording is not in progress")
+)
+
+// RecordingStatus 녹음 상태 (참가자 브로드캐스트/API 응답)
+type RecordingStatus struct {
+	Active    bool       `json:"active"`
+	SessionID string     `json:"sessionId,omitempty"`
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+	TTS       bool       `json:"tts"`
+	Chunks    int        `json:"chunks"` // 업로드한 조각 수
+}
+
+// roomRecorder Room 녹음기
+// 화자 PCM은 도착 시각에 맞춰 하나의 트랙으로 믹스하고, TTS(기본 음성 MP3)는 언어별로 이어 붙여
+// chunk 길이마다 S3에 업로드한 뒤 MeetingRecording으로 기록
+type roomRecorder struct {
+	room        *Room
+	storage     *storage.S3Service
+	meetingID   int64
+	workspaceID int64 // 암호화 키 선택용 (0 = 워크스페이스 밖 회의)
+	sessionID   string
+	startedAt   time.Time
+	chunk       time.Duration
+	withTTS     bool
+
+	mu         sync.Mutex
+	seq        int
+	chunkStart time.Time
+	mix        []int16                  // 현재 조각의 화자 믹스
+	cursors    map[string]int           // speakerID -> 다음 샘플 위치 (같은 화자 프레임이 겹치지 않도록)
+	tts        map[string][]byte        // targetLang -> 현재 조각의 TTS MP3
+	ttsStart   map[string]time.Duration // targetLang -> 조각 안 첫 TTS 시각
+	uploaded   int
+	stopped    bool
+
+	uploads sync.WaitGroup
+	stop    chan struct{}
+}
+
+func newRoomRecorder(room *Room, s3 *storage.S3Service, meeting *model.Meeting, chunk time.Duration, withTTS bool) *roomRecorder {
+	if chunk < minRecordingChunk {
+		chunk = defaultRecordingChunk
+	}
+	now := time.Now()
+	rec := &roomRecorder{
+		room:        room,
+		storage:     s3,
+		meetingID:   meeting.ID,
+		workspaceID: meetingWorkspaceID(meeting),
+		sessionID:   uuid.New().String(),
+		startedAt:   now,
+		chunk:       chunk,
+		withTTS:     withTTS,
+		chunkStart:  now,
+		cursors:     make(map[string]int),
+		tts:         make(map[string][]byte),
+		ttsStart:    make(map[string]time.Duration),
+		stop:        make(chan struct{}),
+	}
+	go rec.run()
+	return rec
+}
+
+// run 오디오가 없어도 조각 길이마다 업로드
+func (rec *roomRecorder) run() {
+	ticker := time.NewTicker(rec.chunk / 4)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			rec.mu.Lock()
+			rec.rotateLocked(time.Now())
+			rec.mu.Unlock()
+		case <-rec.stop:
+			return
+		}
+	}
+}
+
+// WriteSpeaker 화자 PCM(16-bit LE mono) 프레임을 도착 시각 위치에 믹스
+func (rec *roomRecorder) WriteSpeaker(speakerID string, pcm []byte, now time.Time) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if rec.stopped {
+		return
+	}
+	rec.rotateLocked(now)
+
+	samples := len(pcm) / 2
+	maxSamples := int(rec.chunk.Seconds() * recordingSampleRate)
+
+	// 프레임 끝이 도착 시각이 되도록 배치하되, 같은 화자의 이전 프레임과 겹치지 않게
+	arrival := int(now.Sub(rec.chunkStart).Seconds() * recordingSampleRate)
+	pos := max(arrival-samples, rec.cursors[speakerID], 0)
+	end := min(pos+samples, maxSamples)
+	if end <= pos {
+		return
+	}
+	if end > len(rec.mix) {
+		rec.mix = append(rec.mix, make([]int16, end-len(rec.mix))...)
+	}
+	for i := pos; i < end; i++ {
+		s := int32(rec.mix[i]) + int32(int16(binary.LittleEndian.Uint16(pcm[(i-pos)*2:])))
+		rec.mix[i] = int16(min(max(s, -32768), 32767))
+	}
+	rec.cursors[speakerID] = end
+}
+
+// WriteTTS 기본 음성 MP3 TTS 클립을 언어별 조각에 추가 (MP3 프레임은 이어 붙여도 재생 가능)
+func (rec *roomRecorder) WriteTTS(targetLang, voiceKey, format string, audio []byte, now time.Time) {
+	if !rec.withTTS || voiceKey != "" || (format != "" && format != awsai.AudioFormatMP3) {
+		return
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	if rec.stopped {
+		return
+	}
+	rec.rotateLocked(now)
+
+	if _, ok := rec.tts[targetLang]; !ok {
+		rec.ttsStart[targetLang] = now.Sub(rec.chunkStart)
+	}
+	rec.tts[targetLang] = append(rec.tts[targetLang], audio...)
+}
+
+// rotateLocked 조각 길이가 지났으면 현재 조각 업로드 후 새 조각 시작 (rec.mu 보유)
+func (rec *roomRecorder) rotateLocked(now time.Time) {
+	if now.Sub(rec.chunkStart) < rec.chunk {
+		return
+	}
+	rec.flushLocked(now)
+}
+
+// flushLocked 현재 조각을 업로드하고 비움 (rec.mu 보유)
+func (rec *roomRecorder) flushLocked(now time.Time) {
+	chunkOffset := rec.chunkStart.Sub(rec.startedAt)
+
+	if len(rec.mix) > 0 {
+		duration := time.Duration(len(rec.mix)) * time.Second / recordingSampleRate
+		rec.upload(model.RecordingKindSpeakers, "", "audio/wav", "wav", encodeWAV(rec.mix, recordingSampleRate),
+			chunkOffset, chunkOffset+duration)
+	}
+
+	langs := make([]string, 0, len(rec.tts))
+	for lang := range rec.tts {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	for _, lang := range langs {
+		rec.upload(model.RecordingKindTTS, lang, "audio/mpeg", "mp3", rec.tts[lang],
+			chunkOffset+rec.ttsStart[lang], now.Sub(rec.startedAt))
+	}
+
+	rec.chunkStart = now
+	rec.mix = nil
+	rec.cursors = make(map[string]int)
+	rec.tts = make(map[string][]byte)
+	rec.ttsStart = make(map[string]time.Duration)
+}
+
+// upload 조각을 백그라운드로 S3에 올리고 성공하면 MeetingRecording 저장 (rec.mu 보유)
+func (rec *roomRecorder) upload(kind, lang, contentType, ext string, data []byte, start, end time.Duration) {
+	rec.seq++
+	rec.uploaded++
+	name := "speakers"
+	if kind == model.RecordingKindTTS {
+		name = "tts-" + lang
+	}
+
+	row := model.MeetingRecording{
+		MeetingID:     rec.meetingID,
+		SessionID:     rec.sessionID,
+		Kind:          kind,
+		Language:      lang,
+		Sequence:      rec.seq,
+		S3Key:         fmt.Sprintf("recordings/meetings/%d/%s/%05d-%s.%s", rec.meetingID, rec.sessionID, rec.seq, name, ext),
+		ContentType:   contentType,
+		SizeBytes:     int64(len(data)),
+		StartOffsetMs: start.Milliseconds(),
+		EndOffsetMs:   end.Milliseconds(),
+		RecordedAt:    rec.startedAt,
+	}
+
+	rec.uploads.Add(1)
+	go func() {
+		defer rec.uploads.Done()
+
+		ctx, cancel := context.WithTimeout(context.Background(), recordingUploadTimeout)
+		defer cancel()
+		if err := rec.storage.PutObject(ctx, rec.workspaceID, row.S3Key, contentType, data); err != nil {
+			log.Printf("[Room %s] ❌ Failed to upload recording chunk %s: %v", rec.room.ID, row.S3Key, err)
+			return
+		}
+		if rec.room.hub.db == nil {
+			return
+		}
+		if err := rec.room.hub.db.Create(&row).Error; err != nil {
+			log.Printf("[Room %s] Failed to save recording chunk %s: %v", rec.room.ID, row.S3Key, err)
+		}
+	}()
+}
+
+// Stop 남은 조각을 업로드하고 업로드가 끝날 때까지 대기 (제한 시간 내)
+func (rec *roomRecorder) Stop() {
+	rec.mu.Lock()
+	if rec.stopped {
+		rec.mu.Unlock()
+		return
+	}
+	rec.stopped = true
+	close(rec.stop)
+	rec.flushLocked(time.Now())
+	rec.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		rec.uploads.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(recordingStopTimeout):
+		log.Printf("[Room %s] Timed out waiting for recording uploads", rec.room.ID)
+	}
+}
+
+// Status 현재 녹음 상태
+func (rec *roomRecorder) Status() RecordingStatus {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	startedAt := rec.startedAt
+	return RecordingStatus{
+		Active:    !rec.stopped,
+		SessionID: rec.sessionID,
+		StartedAt: &startedAt,
+		TTS:       rec.withTTS,
+		Chunks:    rec.uploaded,
+	}
+}
+
+// encodeWAV 16-bit mono PCM을 WAV 파일로 인코딩
+func encodeWAV(samples []int16, sampleRate int) []byte {
+	dataLen := len(samples) * 2
+	buf := make([]byte, 44+dataLen)
+	copy(buf[0:], "RIFF")
+	binary.LittleEndian.PutUint32(buf[4:], uint32(36+dataLen))
+	copy(buf[8:], "WAVE")
+	copy(buf[12:], "fmt ")
+	binary.LittleEndian.PutUint32(buf[16:], 16)
+	binary.LittleEndian.PutUint16(buf[20:], 1) // PCM
+	binary.LittleEndian.PutUint16(buf[22:], 1) // mono
+	binary.LittleEndian.PutUint32(buf[24:], uint32(sampleRate))
+	binary.LittleEndian.PutUint32(buf[28:], uint32(sampleRate*2))
+	binary.LittleEndian.PutUint16(buf[32:], 2)
+	binary.LittleEndian.PutUint16(buf[34:], 16)
+	copy(buf[36:], "data")
+	binary.LittleEndian.PutUint32(buf[40:], uint32(dataLen))
+	for i, s := range samples {
+		binary.LittleEndian.PutUint16(buf[44+i*2:], uint16(s))
+	}
+	return buf
+}
+
+// =============================================================================
+// Room Methods - Recording
+// =============================================================================
+
+// StartRecording 회의 녹음 시작 (withTTS면 번역 TTS도 언어별로 보관)
+func (r *Room) StartRecording(withTTS bool) (RecordingStatus, error) {
+	if r.hub.storage == nil || r.hub.db == nil {
+		return RecordingStatus{}, ErrRecordingUnavailable
+	}
+	meeting, err := r.findMeeting()
+	if err != nil {
+		return RecordingStatus{}, err
+	}
+
+	chunk := defaultRecordingChunk
+	if r.hub.cfg != nil && r.hub.cfg.AI.RecordingChunkDuration > 0 {
+		chunk = r.hub.cfg.AI.RecordingChunkDuration
+	}
+
+	r.mu.Lock()
+	if r.recorder != nil {
+		r.mu.Unlock()
+		return RecordingStatus{}, ErrRecordingActive
+	}
+	rec := newRoomRecorder(r, r.hub.storage, meeting, chunk, withTTS)
+	r.recorder = rec
+	r.mu.Unlock()
+
+	status := rec.Status()
+	log.Printf("[Room %s] ⏺️ Recording started (session: %s, tts: %v)", r.ID, status.SessionID, withTTS)
+	r.broadcastRecordingStatus(status)
+	return status, nil
+}
+
+// StopRecording 회의 녹음 종료 (남은 조각 업로드까지 대기)
+func (r *Room) StopRecording() (RecordingStatus, error) {
+	r.mu.Lock()
+	rec := r.recorder
+	r.recorder = nil
+	r.mu.Unlock()
+
+	if rec == nil {
+		return RecordingStatus{}, ErrRecordingNotActive
+	}
+	rec.Stop()
+
+	status := rec.Status()
+	log.Printf("[Room %s] ⏹️ Recording stopped (session: %s, %d chunks)", r.ID, status.SessionID, status.Chunks)
+	r.broadcastRecordingStatus(status)
+	return status, nil
+}
+
+// RecordingStatus 현재 녹음 상태
+func (r *Room) RecordingStatus() RecordingStatus {
+	r.mu.RLock()
+	rec := r.recorder
+	r.mu.RUnlock()
+
+	if rec == nil {
+		return RecordingStatus{}
+	}
+	return rec.Status()
+}
+
+// activeRecorder 녹음 중이면 녹음기 반환
+func (r *Room) activeRecorder() *roomRecorder {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.recorder
+}
+
+// broadcastRecordingStatus 녹음 시작/종료를 모든 참가자에게 알림
+func (r *Room) broadcastRecordingStatus(status RecordingStatus) {
+	r.Broadcast(&BroadcastMessage{
+		Type: "recording",
+		Data: status,
+	})
+}
+
+// =============================================================================
+// RoomHub - Recording storage & retention
+// =============================================================================
+
+// SetStorage 녹음 업로드에 쓸 S3 서비스 설정 (nil이면 녹음 비활성화)
+func (h *RoomHub) SetStorage(s3 *storage.S3Service) {
+	h.storage = s3
+}
+
+// StartRecordingCleanup 보관 기간이 지난 녹음을 주기적으로 삭제 (보관 기간 0이면 실행 안 함)
+func (h *RoomHub) StartRecordingCleanup(interval time.Duration) {
+	if h.cfg == nil || h.cfg.AI.RecordingRetention <= 0 {
+		return
+	}
+	if interval <= 0 {
+		interval = recordingCleanupInterval
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				h.CleanupExpiredRecordings()
+			case <-h.stopRecovery:
+				return
+			}
+		}
+	}()
+}
+
+// CleanupExpiredRecordings 보관 기간이 지난 녹음 조각과 TTS 아티팩트를 S3와 DB에서 삭제
+func (h *RoomHub) CleanupExpiredRecordings() {
+	if h.db == nil || h.storage == nil || h.cfg.AI.RecordingRetention <= 0 {
+		return
+	}
+
+	var expired []model.MeetingRecording
+	cutoff := time.Now().Add(-h.cfg.AI.RecordingRetention)
+	if err := h.db.Where("created_at < ?", cutoff).Limit(500).Find(&expired).Error; err != nil {
+		log.Printf("[RoomHub] Failed to load expired recordings: %v", err)
+		return
+	}
+
+	keys := make([]string, len(expired))
+	for i, rec := range expired {
+		keys[i] = rec.S3Key
+	}
+	failed, ok := h.deleteObjects(keys, "recordings")
+	if !ok {
+		return
+	}
+
+	deleted := 0
+	for _, rec := range expired {
+		if failed[rec.S3Key] {
+			continue
+		}
+		if err := h.db.Delete(&rec).Error; err != nil {
+			log.Printf("[RoomHub] Failed to delete recording row %d: %v", rec.ID, err)
+			continue
+		}
+		deleted++
+	}
+	if deleted > 0 {
+		log.Printf("[RoomHub] 🗑️ Deleted %d expired recording chunks", deleted)
+	}
+
+	var artifacts []model.TTSArtifact
+	if err := h.db.Where("created_at < ?", cutoff).Limit(500).Find(&artifacts).Error; err != nil {
+		log.Printf("[RoomHub] Failed to load expired TTS artifacts: %v", err)
+		return
+	}
+
+	keys = make([]string, len(artifacts))
+	for i, artifact := range artifacts {
+		keys[i] = artifact.S3Key
+	}
+	failed, ok = h.deleteObjects(keys, "TTS artifacts")
+	if !ok {
+		return
+	}
+
+	deleted = 0
+	for _, artifact := range artifacts {
+		if failed[artifact.S3Key] {
+			continue
+		}
+		if err := h.db.Delete(&artifact).Error; err != nil {
+			log.Printf("[RoomHub] Failed to delete TTS artifact row %d: %v", artifact.ID, err)
+			continue
+		}
+		deleted++
+	}
+	if deleted > 0 {
+		log.Printf("[RoomHub] 🗑️ Deleted %d expired TTS artifacts", deleted)
+	}
+}
+
+// deleteObjects S3 객체 일괄 삭제 후 삭제하지 못한 키 반환 (ok=false면 어떤 키가 지워졌는지 알 수 없음)
+func (h *RoomHub) deleteObjects(keys []string, what string) (map[string]bool, bool) {
+	if len(keys) == 0 {
+		return nil, true
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), recordingCleanupTimeout)
+	defer cancel()
+
+	err := h.storage.DeleteFiles(ctx, keys)
+	if err == nil {
+		return nil, true
+	}
+	log.Printf("[RoomHub] Failed to delete expired %s: %v", what, err)
+	failed := storage.FailedDeleteKeys(err)
+	return failed, failed != nil
+}
+
+// =============================================================================
+// Recording API
+// =============================================================================
+
+// RecordingHandler 회의 녹음 핸들러
+type RecordingHandler struct {
+	db      *gorm.DB
+	roomHub *RoomHub
+	s3      *storage.S3Service
+}
+
+// NewRecordingHandler RecordingHandler 생성
+func NewRecordingHandler(db *gorm.DB, roomHub *RoomHub, s3 *storage.S3Service) *RecordingHandler {
+	return &RecordingHandler{db: db, roomHub: roomHub, s3: s3}
+}
+
+// StartRecordingRequest 녹음 시작 요청
+type StartRecordingRequest struct {
+	TTS bool `json:"tts"` // 번역 TTS도 언어별로 보관
+}
+
+// RecordingResponse 녹음 조각 응답 (재생용 presigned URL 포함)
+type RecordingResponse struct {
+	model.MeetingRecording
+	URL string `json:"url,omitempty"`
+}
+
+// StartRecording 진행 중인 회의 녹음 시작 (호스트 전용)
+func (h *RecordingHandler) StartRecording(c *fiber.Ctx) error {
+	room, err := h.hostRoom(c)
+	if room == nil {
+		return err
+	}
+
+	var req StartRecordingRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "invalid request body",
+			})
+		}
+	}
+
+	status, err := room.StartRecording(req.TTS)
+	switch {
+	case errors.Is(err, ErrRecordingUnavailable):
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, ErrRecordingActive):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+	case err != nil:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to start recording"})
+	}
+	return c.Status(fiber.StatusCreated).JSON(status)
+}
+
+// StopRecording 회의 녹음 종료 (호스트 전용)
+func (h *RecordingHandler) StopRecording(c *fiber.Ctx) error {
+	room, err := h.hostRoom(c)
+	if room == nil {
+		return err
+	}
+
+	status, err := room.StopRecording()
+	if errors.Is(err, ErrRecordingNotActive) {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.JSON(status)
+}
+
+// GetRecordings 회의 녹음 조각 목록 (회의록 읽기 권한 필요, 재생용 presigned URL 포함)
+func (h *RecordingHandler) GetRecordings(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+	meetingID, err := c.ParamsInt("meetingId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid meeting id",
+		})
+	}
+
+	var meeting model.Meeting
+	if err := h.db.Where("id = ? AND workspace_id = ?", meetingID, workspaceID).First(&meeting).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "meeting not found",
+		})
+	}
+
+	access, err := GetTranscriptAccess(h.db, &meeting, claims.UserID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !access.CanRead {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you do not have permission to access this meeting's recordings",
+		})
+	}
+
+	var recordings []model.MeetingRecording
+	if err := h.db.Where("meeting_id = ?", meeting.ID).
+		Order("recorded_at ASC, sequence ASC").
+		Find(&recordings).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get recordings",
+		})
+	}
+
+	responses := make([]RecordingResponse, len(recordings))
+	for i, rec := range recordings {
+		responses[i] = RecordingResponse{MeetingRecording: rec}
+		if h.s3 != nil {
+			if url, err := h.s3.GetFileURL(rec.S3Key); err == nil {
+				responses[i].URL = url
+			}
+		}
+	}
+
+	// CloudFront면 조각을 이어 재생할 수 있도록 회의 녹음 전체에 대한 서명 쿠키도 발급
+	if h.s3 != nil && h.s3.CloudFrontEnabled() {
+		cookies, err := h.s3.SignedCookies(fmt.Sprintf("recordings/meetings/%d/", meeting.ID))
+		if err != nil {
+			log.Printf("[Recording] Failed to sign CloudFront cookies for meeting %d: %v", meeting.ID, err)
+		}
+		for _, cookie := range cookies {
+			c.Cookie(&fiber.Cookie{
+				Name:     cookie.Name,
+				Value:    cookie.Value,
+				Domain:   cookie.Domain,
+				Path:     cookie.Path,
+				Expires:  cookie.Expires,
+				Secure:   cookie.Secure,
+				HTTPOnly: cookie.HttpOnly,
+				SameSite: fiber.CookieSameSiteNoneMode,
+			})
+		}
+	}
+
+	resp := fiber.Map{
+		"meeting_id": meeting.ID,
+		"recordings": responses,
+	}
+	if h.roomHub != nil {
+		h.roomHub.mu.RLock()
+		room := h.roomHub.rooms[fmt.Sprintf("meeting-%d", meeting.ID)]
+		h.roomHub.mu.RUnlock()
+		if room != nil {
+			resp["status"] = room.RecordingStatus()
+		}
+	}
+	return c.JSON(resp)
+}
+
+// hostRoom 진행 중인 회의 Room 조회 (호스트만, 실패 시 오류 응답을 보내고 nil 반환)
+func (h *RecordingHandler) hostRoom(c *fiber.Ctx) (*Room, error) {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return nil, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+	meetingID, err := c.ParamsInt("meetingId")
+	if err != nil {
+		return nil, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid meeting id",
+		})
+	}
+
+	if h.roomHub == nil {
+		return nil, c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "room hub not available",
+		})
+	}
+
+	var meeting model.Meeting
+	if err := h.db.Where("id = ? AND workspace_id = ?", meetingID, workspaceID).First(&meeting).Error; err != nil {
+		return nil, c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "meeting not found",
+		})
+	}
+	if meeting.HostID != claims.UserID {
+		return nil, c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "only host can manage recording",
+		})
+	}
+
+	h.roomHub.mu.RLock()
+	room := h.roomHub.rooms[fmt.Sprintf("meeting-%d", meeting.ID)]
+	h.roomHub.mu.RUnlock()
+	if room == nil {
+		return nil, c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": "meeting is not in progress",
+		})
+	}
+	return room, nil
+}
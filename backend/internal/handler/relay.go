@@ -0,0 +1,278 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"realtime-backend/internal/metrics"
+	"realtime-backend/pb"
+)
+
+// Edge relays: lightweight nodes close to international audiences subscribe to a room's
+// broadcast stream over gRPC and serve their own WebSocket listeners. The pipeline stays
+// on this instance; relay listeners count toward the room's target languages like local
+// ones and always receive the default TTS voice.
+const (
+	relayQueueSize      = 256
+	relaySecretMetadata = "x-relay-secret"
+)
+
+// relaySkipTypes are the message types relays never need (their listeners are TTS-only)
+var relaySkipTypes = map[string]bool{"original_audio": true}
+
+// roomRelay is one relay node's subscription to a room (listeners guarded by Room.mu)
+type roomRelay struct {
+	id        string
+	region    string
+	out       chan *pb.RelayEvent
+	listeners map[string]string // listenerID -> target language
+}
+
+// RelayServer serves relay subscriptions (pb.RelayServiceServer)
+type RelayServer struct {
+	pb.UnimplementedRelayServiceServer
+	hub    *RoomHub
+	secret string
+}
+
+// NewRelayServer creates a relay gRPC service; an empty secret rejects every relay
+func NewRelayServer(hub *RoomHub, secret string) *RelayServer {
+	return &RelayServer{hub: hub, secret: secret}
+}
+
+// SubscribeRoom streams a room's broadcasts to a relay until the relay or the room goes away
+func (s *RelayServer) SubscribeRoom(stream pb.RelayService_SubscribeRoomServer) error {
+	if !s.authorized(stream) {
+		return status.Error(codes.Unauthenticated, "invalid relay secret")
+	}
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	hello := req.GetHello()
+	if hello == nil || hello.RoomId == "" {
+		return status.Error(codes.InvalidArgument, "first message must be hello with a room id")
+	}
+	if s.hub.Draining() {
+		return status.Error(codes.Unavailable, "server is shutting down")
+	}
+
+	room := s.hub.GetOrCreateRoom(hello.RoomId)
+	relay := room.addRelay(hello.RelayId, hello.Region)
+	defer room.removeRelay(relay)
+
+	// Listener updates from the relay; any receive error ends the subscription
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			if update := req.GetListeners(); update != nil {
+				room.updateRelayListeners(relay, update.Listeners)
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-room.ctx.Done():
+			return status.Error(codes.Unavailable, "room closed")
+		case err := <-recvErr:
+			return err
+		case event := <-relay.out:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *RelayServer) authorized(stream pb.RelayService_SubscribeRoomServer) bool {
+	if s.secret == "" {
+		return false
+	}
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	values := md.Get(relaySecretMetadata)
+	return len(values) == 1 && subtle.ConstantTimeCompare([]byte(values[0]), []byte(s.secret)) == 1
+}
+
+// =============================================================================
+// Room Methods - Edge relays
+// =============================================================================
+
+func (r *Room) addRelay(id, region string) *roomRelay {
+	relay := &roomRelay{
+		id:        id,
+		region:    region,
+		out:       make(chan *pb.RelayEvent, relayQueueSize),
+		listeners: make(map[string]string),
+	}
+
+	r.mu.Lock()
+	if r.relays == nil {
+		r.relays = make(map[*roomRelay]struct{})
+	}
+	r.relays[relay] = struct{}{}
+	total := len(r.relays)
+	// Relay listeners may be the only ones on this instance (speakers on another instance)
+	if !r.isRunning {
+		r.isRunning = true
+		go r.runBroadcaster()
+		go r.runAudioProcessor()
+	}
+	r.mu.Unlock()
+
+	log.Printf("[Room %s] 🛰️ Relay %s (%s) subscribed, relays: %d", r.ID, id, region, total)
+	return relay
+}
+
+func (r *Room) removeRelay(relay *roomRelay) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.relays, relay)
+	log.Printf("[Room %s] 🛰️ Relay %s unsubscribed (%d listeners), relays: %d",
+		r.ID, relay.id, len(relay.listeners), len(r.relays))
+	if len(relay.listeners) > 0 {
+		r.fanout.announceLanguages()
+		r.retargetPipeline()
+	}
+}
+
+// updateRelayListeners replaces the relay's listener set and retargets the pipeline
+func (r *Room) updateRelayListeners(relay *roomRelay, listeners []*pb.RelayListener) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	relay.listeners = make(map[string]string, len(listeners))
+	for _, l := range listeners {
+		if l.ListenerId != "" && l.TargetLanguage != "" {
+			relay.listeners[l.ListenerId] = l.TargetLanguage
+		}
+	}
+	r.fanout.announceLanguages()
+	r.retargetPipeline()
+}
+
+// retargetPipeline pushes the current listener languages and voices to the pipeline (r.mu held)
+func (r *Room) retargetPipeline() {
+	if r.hub.useAWS && r.awsPipeline != nil {
+		targetLangs := r.listenerTargetLanguages()
+		log.Printf("[Room %s] 🔄 Updating target languages: %v", r.ID, targetLangs)
+		r.awsPipeline.UpdateTargetLanguages(targetLangs)
+		r.awsPipeline.UpdateVoicePreferences(r.listenerVoicePreferences())
+	}
+}
+
+// relayListenerCount is the number of listeners connected through relays (r.mu held)
+func (r *Room) relayListenerCount() int {
+	count := 0
+	for relay := range r.relays {
+		count += len(relay.listeners)
+	}
+	return count
+}
+
+// countRelayLanguages adds the target languages of relay listeners (r.mu held)
+func (r *Room) countRelayLanguages(counts map[string]int) {
+	for relay := range r.relays {
+		for _, lang := range relay.listeners {
+			counts[lang]++
+		}
+	}
+}
+
+// publishRelays forwards a broadcast to every relay with a listener that would receive it
+func (r *Room) publishRelays(msg *BroadcastMessage) {
+	if relaySkipTypes[msg.Type] {
+		return
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if len(r.relays) == 0 {
+		return
+	}
+
+	var event *pb.RelayEvent
+	for relay := range r.relays {
+		if !relay.wants(msg) {
+			continue
+		}
+		if event == nil {
+			if event = relayEvent(msg); event == nil {
+				return
+			}
+		}
+		select {
+		case relay.out <- event:
+		default:
+			metrics.DroppedMessages.Inc(metrics.DropEdgeRelay)
+			log.Printf("[Room %s] Relay %s buffer full, dropping %s", r.ID, relay.id, msg.Type)
+		}
+	}
+}
+
+// wants reports whether any of the relay's listeners receives msg (r.mu held)
+func (relay *roomRelay) wants(msg *BroadcastMessage) bool {
+	if msg.TargetListenerID != "" {
+		_, ok := relay.listeners[msg.TargetListenerID]
+		return ok
+	}
+	if len(relay.listeners) == 0 {
+		return false
+	}
+	switch msg.Type {
+	case "transcript":
+		if msg.TargetLang == "" {
+			return true
+		}
+	case "audio":
+		if msg.VoiceKey != "" {
+			return false
+		}
+	default:
+		return true
+	}
+	for _, lang := range relay.listeners {
+		if lang == msg.TargetLang {
+			return true
+		}
+	}
+	return false
+}
+
+// relayEvent converts a broadcast into its wire form (nil if the data can't be encoded)
+func relayEvent(msg *BroadcastMessage) *pb.RelayEvent {
+	event := &pb.RelayEvent{
+		Type:             msg.Type,
+		Seq:              msg.Seq,
+		SpeakerId:        msg.SpeakerID,
+		TargetLanguage:   msg.TargetLang,
+		TargetListenerId: msg.TargetListenerID,
+		AudioData:        msg.AudioData,
+		VoiceKey:         msg.VoiceKey,
+		TranscriptId:     msg.TranscriptID,
+		AudioFormat:      msg.AudioFormat,
+		AudioSampleRate:  uint32(msg.AudioSampleRate),
+	}
+	if msg.Data != nil {
+		data, err := json.Marshal(msg.Data)
+		if err != nil {
+			return nil
+		}
+		event.Data = data
+	}
+	return event
+}
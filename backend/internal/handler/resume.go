@@ -0,0 +1,245 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 재접속 리스너가 놓친 자막/TTS 메타데이터를 다시 받기 위한 설정
+const (
+	resumeBufferSize = 200             // 언어별 보관 메시지 수
+	resumeTokenTTL   = 2 * time.Minute // 연결이 끊긴 뒤 토큰 유효 시간
+	resumeAllLangs   = ""              // 번역 없는 원문 자막 (모든 리스너 대상)
+)
+
+var (
+	ErrInvalidResumeToken = errors.New("resume token is invalid or expired")
+	ErrInvalidResumeFrom  = errors.New("resumeFrom must be a non-negative sequence number")
+)
+
+// TTSMetaData 재전송되는 TTS 메타데이터 (오디오 바이트는 다시 보내지 않음)
+type TTSMetaData struct {
+	TranscriptID string `json:"transcriptId,omitempty"`
+	VoiceKey     string `json:"voiceKey,omitempty"`
+	Format       string `json:"format,omitempty"`
+	SampleRate   int    `json:"sampleRate,omitempty"`
+}
+
+// ResumeData "resume" 메시지: fromSeq 이후 놓친 메시지 (seq 오름차순)
+type ResumeData struct {
+	FromSeq  uint64              `json:"fromSeq"`
+	LastSeq  uint64              `json:"lastSeq"`
+	Complete bool                `json:"complete"` // false면 버퍼가 fromSeq까지 남아 있지 않아 일부 누락
+	Messages []*BroadcastMessage `json:"messages"`
+}
+
+// resumeToken 재접속 토큰 (연결 중에는 만료되지 않음)
+type resumeToken struct {
+	listenerID string
+	expiresAt  time.Time // zero = 연결 중
+}
+
+// resumeBuffer Room의 재전송 버퍼: 전역 seq, 언어별 링 버퍼, 재접속 토큰
+type resumeBuffer struct {
+	mu     sync.Mutex
+	seq    uint64
+	rings  map[string][]*BroadcastMessage // targetLang -> 최근 메시지 (오래된 순)
+	tokens map[string]*resumeToken
+}
+
+// ParseResumeFrom join 쿼리의 resumeFrom 파싱 ("" = 0)
+func ParseResumeFrom(raw string) (uint64, bool) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return 0, true
+	}
+	seq, err := strconv.ParseUint(raw, 10, 64)
+	return seq, err == nil
+}
+
+// replayable 재전송 대상: final 자막과 브로드캐스트 TTS
+func replayable(msg *BroadcastMessage) bool {
+	if msg.TargetListenerID != "" {
+		return false
+	}
+	switch msg.Type {
+	case "transcript":
+		data, ok := msg.Data.(TranscriptData)
+		return ok && data.IsFinal
+	case "audio":
+		return true
+	}
+	return false
+}
+
+// record 재전송 대상이면 seq를 붙이고 언어별 버퍼에 보관 (TTS는 메타데이터만)
+func (b *resumeBuffer) record(msg *BroadcastMessage) {
+	if !replayable(msg) {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	msg.Seq = b.seq
+
+	stored := &BroadcastMessage{
+		Type:       msg.Type,
+		Seq:        msg.Seq,
+		SpeakerID:  msg.SpeakerID,
+		TargetLang: msg.TargetLang,
+		Data:       msg.Data,
+		VoiceKey:   msg.VoiceKey,
+	}
+	if msg.Type == "audio" {
+		stored.Type = "tts_meta"
+		stored.Data = TTSMetaData{
+			TranscriptID: msg.TranscriptID,
+			VoiceKey:     msg.VoiceKey,
+			Format:       msg.AudioFormat,
+			SampleRate:   msg.AudioSampleRate,
+		}
+	}
+
+	if b.rings == nil {
+		b.rings = make(map[string][]*BroadcastMessage)
+	}
+	ring := append(b.rings[msg.TargetLang], stored)
+	if len(ring) > resumeBufferSize {
+		ring = ring[len(ring)-resumeBufferSize:]
+	}
+	b.rings[msg.TargetLang] = ring
+}
+
+// issue 리스너의 재접속 토큰 발급 (유효한 기존 토큰이면 그대로 재사용)
+func (b *resumeBuffer) issue(listenerID, previous string) string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.tokens == nil {
+		b.tokens = make(map[string]*resumeToken)
+	}
+	now := time.Now()
+	for token, t := range b.tokens {
+		if !t.expiresAt.IsZero() && now.After(t.expiresAt) {
+			delete(b.tokens, token)
+		}
+	}
+
+	if t, ok := b.tokens[previous]; ok && t.listenerID == listenerID {
+		t.expiresAt = time.Time{}
+		return previous
+	}
+	token := uuid.New().String()
+	b.tokens[token] = &resumeToken{listenerID: listenerID}
+	return token
+}
+
+// release 연결이 끊긴 리스너의 토큰 만료 시작
+func (b *resumeBuffer) release(listenerID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, t := range b.tokens {
+		if t.listenerID == listenerID && t.expiresAt.IsZero() {
+			t.expiresAt = time.Now().Add(resumeTokenTTL)
+		}
+	}
+}
+
+// valid 토큰이 해당 리스너의 유효한 토큰인지
+func (b *resumeBuffer) valid(token, listenerID string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	t, ok := b.tokens[token]
+	return ok && t.listenerID == listenerID && (t.expiresAt.IsZero() || time.Now().Before(t.expiresAt))
+}
+
+// since fromSeq 이후 targetLang 리스너가 받았어야 할 메시지 (seq 오름차순)
+func (b *resumeBuffer) since(fromSeq uint64, targetLang string) ResumeData {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	langs := []string{resumeAllLangs}
+	if targetLang != resumeAllLangs {
+		langs = append(langs, targetLang)
+	}
+
+	data := ResumeData{FromSeq: fromSeq, LastSeq: b.seq, Complete: true}
+	for _, lang := range langs {
+		ring := b.rings[lang]
+		// 버퍼가 가득 차 밀려났으면 가장 오래된 메시지 전에 놓친 것이 있을 수 있음
+		if len(ring) == resumeBufferSize && ring[0].Seq > fromSeq+1 {
+			data.Complete = false
+		}
+		for _, msg := range ring {
+			if msg.Seq > fromSeq {
+				data.Messages = append(data.Messages, msg)
+			}
+		}
+	}
+	sort.Slice(data.Messages, func(i, j int) bool { return data.Messages[i].Seq < data.Messages[j].Seq })
+	return data
+}
+
+// =============================================================================
+// Room Methods - Resumable listener cursor
+// =============================================================================
+
+// IssueResumeToken 리스너 재접속 토큰 (ready 응답에 포함, 재접속 시 같은 토큰을 보내면 유지)
+func (r *Room) IssueResumeToken(listenerID, previous string) string {
+	return r.resume.issue(listenerID, previous)
+}
+
+// Resume 재접속한 리스너에게 fromSeq 이후 놓친 final 자막과 TTS 메타데이터 전송
+func (r *Room) Resume(listenerID, token string, fromSeq uint64) error {
+	if !r.resume.valid(token, listenerID) {
+		return ErrInvalidResumeToken
+	}
+
+	r.mu.RLock()
+	listener, ok := r.Listeners.Get(listenerID)
+	r.mu.RUnlock()
+	if !ok {
+		return ErrInvalidResumeToken
+	}
+
+	data := r.resume.since(fromSeq, listener.TargetLang)
+	messages := data.Messages[:0:0]
+	for _, msg := range data.Messages {
+		if msg.SpeakerID == listenerID {
+			continue
+		}
+		// TTS는 리스너가 받는 음성/모드와 일치할 때만
+		if msg.Type == "tts_meta" && (msg.VoiceKey != listener.ttsVoice().Key() || !listener.wantsTTS()) {
+			continue
+		}
+		messages = append(messages, msg)
+	}
+	data.Messages = messages
+
+	log.Printf("[Room %s] ⏯️ Listener %s resumed from seq %d (%d messages, complete: %v)",
+		r.ID, listenerID, fromSeq, len(messages), data.Complete)
+	r.Broadcast(&BroadcastMessage{
+		Type:             "resume",
+		Data:             data,
+		TargetListenerID: listenerID,
+	})
+	return nil
+}
+
+// sendResumeError 재접속 실패 알림 (클라이언트는 history로 대체)
+func (r *Room) sendResumeError(listenerID string, err error) {
+	r.Broadcast(&BroadcastMessage{
+		Type:             "resume_error",
+		Data:             map[string]string{"message": err.Error()},
+		TargetListenerID: listenerID,
+	})
+}
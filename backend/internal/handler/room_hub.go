@@ -3,19 +3,26 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gofiber/contrib/websocket"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 
 	"realtime-backend/internal/ai"
 	awsai "realtime-backend/internal/aws"
 	"realtime-backend/internal/cache"
 	"realtime-backend/internal/config"
+	"realtime-backend/internal/metrics"
 	"realtime-backend/internal/model"
+	"realtime-backend/internal/session"
+	"realtime-backend/internal/storage"
+	"realtime-backend/internal/summary"
 )
 
 // =============================================================================
@@ -26,37 +33,132 @@ import (
 type RoomHub struct {
 	rooms         map[string]*Room
 	mu            sync.RWMutex
-	aiClient      *ai.GrpcClient        // Python gRPC 클라이언트
-	useAWS        bool                  // AWS 직접 사용 여부
-	cfg           *config.Config        // 앱 설정
-	redisClient   *cache.RedisClient    // Redis/Valkey 클라이언트
-	db            *gorm.DB              // Database for saving transcripts
-	awsClientPool *awsai.AWSClientPool  // 공유 AWS 클라이언트 풀
+	aiClient      *ai.GrpcClient       // Python gRPC 클라이언트
+	useAWS        bool                 // AWS 직접 사용 여부
+	cfg           *config.Config       // 앱 설정
+	redisClient   *cache.RedisClient   // Redis/Valkey 클라이언트
+	db            *gorm.DB             // Database for saving transcripts
+	awsClientPool *awsai.AWSClientPool // 공유 AWS 클라이언트 풀
+	summarizer    *summary.Summarizer  // 회의 종료 시 요약 생성 (nil = 비활성)
+	summaryLocks  sync.Map             // meetingID -> *sync.Mutex (같은 회의 요약을 동시에 다시 만들지 않도록)
+	storage       *storage.S3Service   // 회의 녹음 업로드 (nil = 녹음 비활성)
+	stopRecovery  chan struct{}        // 회의 종료 처리 복구 루프 중지
+	janitor       janitorState         // 누수 리소스 정리 상태
+	breakouts     *breakoutRegistry    // 부모 Room별 열린 브레이크아웃
+	instanceID    string               // 이 서버 인스턴스 ID (멀티 인스턴스 fan-out에서 자기 메시지 구분)
+	draining      atomic.Bool          // 서버 종료 중 (새 Room 접속 거부)
+	presence      *presenceHooks       // 리스너/화자 입장·퇴장 훅 (외부 접속 상태 시스템)
+	webhooks      *webhookDispatcher   // 워크스페이스 웹훅 (회의 이벤트 발송)
+
+	// 재시작 없이 바뀌는 설정 (노이즈 필터 기본값 등은 cfg 대신 여기서 읽음)
+	tunables atomic.Pointer[config.Tunables]
 }
 
 // Room represents a single room with listeners and speakers
 type Room struct {
 	ID               string
-	Listeners        map[string]*Listener
-	Speakers         map[string]*Speaker
+	Listeners        *ParticipantRegistry[*Listener] // Copy-on-write; values' fields still guarded by mu
+	Speakers         *ParticipantRegistry[*Speaker]
 	SenderToSpeakers map[string]map[string]bool // FIX: Track which speakers each sender (listener) has sent audio for
 	grpcStream       *ai.ChatStream             // Python gRPC 스트림
 	awsPipeline      *awsai.Pipeline            // AWS 파이프라인
 	broadcast        chan *BroadcastMessage
+	relay            chan *BroadcastMessage // Original speaker audio (kept off the transcript/TTS queue)
 	audioIn          chan *AudioMessage
 	ctx              context.Context
 	cancel           context.CancelFunc
 	mu               sync.RWMutex
 	hub              *RoomHub
 	isRunning        bool
+
+	// TTS 재생 중 partial 자막 억제 여부 (Room 단위)
+	suppressPartials bool
+
+	// partial 문장 단위 실시간 번역+TTS 언어쌍 (Room 단위, "ko:ja", "*" 등)
+	incrementalPairs []string
+
+	// partial 자막 언어별 최소 글자 수 (Room 단위 재정의, 워크스페이스 설정보다 우선)
+	partialMinLengths map[string]int
+
+	// 호스트가 TTS를 끈 대상 언어 (자막만 전송)
+	ttsDisabled map[string]bool
+
+	// 슬로 모드: partial 전송 간격을 늘리고 발화당 TTS 1회 (모바일 위주 청중)
+	slowMode bool
+
+	// 호스트 제어 (음소거, 강퇴, 잠금)
+	moderation roomModeration
+
+	// 호스트 키워드 감시 목록 (final 자막에서 발견되면 호스트에게 알림)
+	keywords keywordWatchlist
+
+	// PCM이 아닌 코덱(Opus)으로 오디오를 보내는 화자의 디코더
+	speakerDecoders map[string]*speakerDecoder
+
+	// 회의 녹음기 (녹음 중이 아니면 nil)
+	recorder *roomRecorder
+
+	// broadcast/relay/audioIn 최대 적재량 (큐 깊이 API)
+	queues roomWatermarks
+
+	// 재접속 리스너에게 놓친 자막/TTS 메타데이터를 다시 보내는 버퍼와 토큰
+	resume resumeBuffer
+
+	// gRPC 경로 final 자막 순번 (AWS 파이프라인은 파이프라인에서 매김)
+	sequencer ai.Sequencer
+
+	// 미팅이 속한 워크스페이스 (커스텀 용어집 조회용, 0이면 없음)
+	workspaceID int64
+
+	// 동시에 번역/TTS할 대상 언어 수 한도 (워크스페이스 quota, 0 = 무제한)
+	maxTargetLangs  int
+	langLimitLoaded bool
+
+	// 마지막 UsageRecord 기록 이후 구간 시작 시각
+	usageSince time.Time
+	usageMu    sync.Mutex
+
+	// 발언 대기열 (손들기): 활성화 시 발언권 있는 참가자의 오디오만 처리
+	speakerQueue *SpeakerQueue
+
+	// 회의 전 워밍업으로 만들어진 Room: 이 시각까지는 참가자가 없어도 정리하지 않음
+	warmUntil time.Time
+
+	// 첫 참가자 입장 웹훅 확인 여부 (실제 발송은 DB에서 웹훅당 한 번만)
+	firstJoinChecked bool
+
+	// 다른 서버 인스턴스와 Redis pub/sub으로 자막/TTS 공유 (nil = 단일 인스턴스)
+	fanout *roomFanout
+
+	// AWS 파이프라인을 실행할 인스턴스 선출 (Redis 임대, nil = 항상 직접 실행)
+	ownership *roomOwnership
+
+	// 이 Room을 구독 중인 엣지 릴레이 (릴레이 리스너 언어도 번역 대상에 포함)
+	relays map[*roomRelay]struct{}
+
+	// 웨비나: 지정 화자만 발화, 참석자 입장은 참가자 목록 갱신 생략, 브로드캐스트 워커/사전 직렬화
+	webinar roomWebinar
 }
 
 // Listener represents a user receiving translations
 type Listener struct {
 	ID         string
 	TargetLang string
+	Voice      *awsai.VoicePreference // nil = default voice for TargetLang
+	Caps       ClientCapabilities     // Negotiated at join; controls message formats
+	AudioMode  string                 // tts | original | both
 	Conn       *websocket.Conn
-	writeMu    sync.Mutex
+	Session    *session.Handle // Connection stats (nil for listeners not registered with the session manager)
+
+	// TTS output format negotiated at join (nil = mp3 24kHz)
+	AudioProfile *awsai.AudioProfile
+
+	// Skip queued TTS of transcripts superseded by a newer final of the same speaker
+	DropSuperseded bool
+
+	// Outbound messages, written to Conn by the listener's own goroutine
+	queue   *listenerQueue
+	writeMu sync.Mutex // Serializes the writer with handshake replies
 }
 
 // Speaker represents a user whose audio is being captured
@@ -69,11 +171,34 @@ type Speaker struct {
 
 // BroadcastMessage is sent to listeners
 type BroadcastMessage struct {
-	Type       string `json:"type"` // "transcript" | "audio"
+	Type       string `json:"type"`          // "transcript" | "audio"
+	Seq        uint64 `json:"seq,omitempty"` // Room sequence of replayable messages (final transcripts, TTS); resume cursor
 	SpeakerID  string `json:"speakerId"`
 	TargetLang string `json:"targetLang,omitempty"`
 	Data       any    `json:"data,omitempty"`
 	AudioData  []byte `json:"-"` // Binary audio data (not JSON serialized)
+	VoiceKey   string `json:"-"` // Voice preference key of the audio ("" = default voice)
+
+	// Transcript the TTS audio belongs to (for supersession); empty for other messages
+	TranscriptID string `json:"-"`
+
+	// Audio encoding for the binary envelope ("" = mp3 TTS)
+	AudioFormat     string `json:"-"`
+	AudioSampleRate int    `json:"-"`
+
+	// Deliver only to this listener (e.g. host notifications); empty = normal routing
+	TargetListenerID string `json:"-"`
+
+	// JSON serialized once before fan-out (webinar rooms); nil = marshal per listener
+	encoded []byte
+}
+
+// payload returns the message's JSON, reusing the pre-serialized form when there is one
+func (msg *BroadcastMessage) payload() ([]byte, error) {
+	if msg.encoded != nil {
+		return msg.encoded, nil
+	}
+	return json.Marshal(msg)
 }
 
 // AudioMessage is received from listeners (speaker's audio)
@@ -81,25 +206,47 @@ type AudioMessage struct {
 	SpeakerID  string
 	SourceLang string
 	AudioData  []byte
+
+	// Set for audio forwarded from another instance (the speaker is not in this Room)
+	SpeakerName string
+	ProfileImg  string
 }
 
 // TranscriptData represents transcript message
 type TranscriptData struct {
+	TranscriptID  string `json:"transcriptId,omitempty"` // TTS 클립/보관된 TTS 아티팩트와 매칭
 	ParticipantID string `json:"participantId"`
 	Original      string `json:"original"`
 	Translated    string `json:"translated,omitempty"`
 	IsFinal       bool   `json:"isFinal"`
 	Language      string `json:"language"`
+	TTSSkipped    string `json:"ttsSkipped,omitempty"` // Reason TTS audio was not generated (Polly budget, host turned TTS off)
+	Crosstalk     bool   `json:"crosstalk,omitempty"`  // Spoken over other speakers (lower STT quality)
+
+	// Moderation: flagged terms are already masked; clients may blur/hide flagged captions
+	Moderated        bool     `json:"moderated,omitempty"`
+	ModerationLabels []string `json:"moderationLabels,omitempty"`
+
+	// Translate unavailable (circuit breaker open): original text only
+	Degraded bool `json:"degraded,omitempty"`
 }
 
 // NewRoomHub creates a new RoomHub instance
 func NewRoomHub(aiClient *ai.GrpcClient, cfg *config.Config, useAWS bool, redisClient *cache.RedisClient) *RoomHub {
 	hub := &RoomHub{
-		rooms:       make(map[string]*Room),
-		aiClient:    aiClient,
-		cfg:         cfg,
-		useAWS:      useAWS,
-		redisClient: redisClient,
+		rooms:        make(map[string]*Room),
+		aiClient:     aiClient,
+		cfg:          cfg,
+		useAWS:       useAWS,
+		redisClient:  redisClient,
+		stopRecovery: make(chan struct{}),
+		breakouts:    newBreakoutRegistry(),
+		instanceID:   uuid.New().String(),
+		webhooks:     newWebhookDispatcher(),
+	}
+	if cfg != nil {
+		tunables := cfg.Tunables()
+		hub.tunables.Store(&tunables)
 	}
 
 	// Initialize shared AWS client pool if using AWS
@@ -116,6 +263,31 @@ func NewRoomHub(aiClient *ai.GrpcClient, cfg *config.Config, useAWS bool, redisC
 		}
 	}
 
+	// Meeting summaries via Bedrock reuse the pool's AWS credentials
+	if cfg != nil && cfg.AI.SummaryEnabled {
+		if hub.awsClientPool != nil {
+			hub.summarizer = summary.NewSummarizer(summary.NewBedrockClient(
+				hub.awsClientPool.GetAWSConfig(), cfg.AI.SummaryRegion, cfg.AI.SummaryModelID))
+			log.Printf("[RoomHub] ✅ Meeting summaries enabled (model=%s)", cfg.AI.SummaryModelID)
+		} else {
+			log.Printf("[RoomHub] ⚠️ Meeting summaries need the AWS client pool, disabled")
+		}
+	}
+
+	// Presence hooks (webhook from config; more can be added with AddPresenceHook)
+	hub.presence = newPresenceHooks(0)
+	if cfg != nil {
+		hub.presence = newPresenceHooks(cfg.Presence.QueueSize)
+		if cfg.Presence.WebhookURL != "" {
+			hub.AddPresenceHook(NewWebhookPresenceHook(cfg.Presence.WebhookURL, cfg.Presence.WebhookSecret))
+			log.Printf("[RoomHub] ✅ Presence webhook enabled")
+		}
+	}
+
+	go hub.runWebhooks()
+
+	metrics.Default.OnScrape(hub.collectMetrics)
+
 	return hub
 }
 
@@ -124,6 +296,11 @@ func (h *RoomHub) SetDB(db *gorm.DB) {
 	h.db = db
 }
 
+// SetSummarizer replaces the meeting summarizer (nil disables summaries)
+func (h *RoomHub) SetSummarizer(s *summary.Summarizer) {
+	h.summarizer = s
+}
+
 // GetTranscripts retrieves transcripts from Redis for a room
 func (h *RoomHub) GetTranscripts(roomID string) ([]cache.RoomTranscript, error) {
 	if h.redisClient == nil {
@@ -145,26 +322,82 @@ func (h *RoomHub) GetOrCreateRoom(roomID string) *Room {
 		return room
 	}
 
+	broadcastSize, audioInSize := h.roomBufferSizes()
 	ctx, cancel := context.WithCancel(context.Background())
 	room := &Room{
 		ID:               roomID,
-		Listeners:        make(map[string]*Listener),
-		Speakers:         make(map[string]*Speaker),
+		Listeners:        NewParticipantRegistry[*Listener](),
+		Speakers:         NewParticipantRegistry[*Speaker](),
 		SenderToSpeakers: make(map[string]map[string]bool), // FIX: Initialize sender-to-speakers tracking
-		broadcast:        make(chan *BroadcastMessage, 100),
-		audioIn:          make(chan *AudioMessage, 100),
+		broadcast:        make(chan *BroadcastMessage, broadcastSize),
+		relay:            make(chan *BroadcastMessage, relayBufferSize),
+		audioIn:          make(chan *AudioMessage, audioInSize),
 		ctx:              ctx,
 		cancel:           cancel,
 		hub:              h,
 		isRunning:        false,
+		speakerQueue:     NewSpeakerQueue(0),
+		usageSince:       time.Now(),
+	}
+	room.sequencer.SetShared(h.roomSequence(roomID))
+	if h.cfg != nil {
+		room.suppressPartials = h.cfg.AI.SuppressPartialsDuringTTS
+		room.incrementalPairs = h.cfg.AI.IncrementalPairs
+		room.speakerQueue = NewSpeakerQueue(h.cfg.AI.SpeakerSlots)
+	}
+
+	// 브레이크아웃: 부모 Room 설정 상속 (호스트가 만들지 않은 브레이크아웃도 목록에 등록)
+	if parentID, name, ok := ParseBreakoutRoomID(roomID); ok {
+		if parent, exists := h.rooms[parentID]; exists {
+			room.inheritSettings(parent)
+		}
+		if err := h.breakouts.add(parentID, name); err != nil {
+			log.Printf("[RoomHub] Breakout %s not registered: %v", roomID, err)
+		}
 	}
 
 	h.rooms[roomID] = room
+	if h.fanoutEnabled() {
+		room.startFanout()
+	}
+	if h.ownershipEnabled() {
+		room.startOwnership()
+	}
 	log.Printf("[RoomHub] Created room: %s", roomID)
 
 	return room
 }
 
+// roomSequence shares the room's final transcript order across instances through Redis
+// (nil without Redis: each process orders its own finals)
+func (h *RoomHub) roomSequence(roomID string) ai.SharedSequence {
+	if h.redisClient == nil {
+		return nil
+	}
+	return func(ctx context.Context, floor uint64) (uint64, error) {
+		return h.redisClient.NextRoomSeq(ctx, roomID, floor)
+	}
+}
+
+// Default room channel sizes (used without a buffer config)
+const (
+	defaultRoomBroadcastBuffer = 100
+	defaultRoomAudioInBuffer   = 100
+)
+
+// roomBufferSizes returns the broadcast and speaker audio channel sizes for new rooms
+// (BUFFER_* settings, defaults without a config)
+func (h *RoomHub) roomBufferSizes() (broadcast, audioIn int) {
+	broadcast, audioIn = defaultRoomBroadcastBuffer, defaultRoomAudioInBuffer
+	if h.cfg != nil && h.cfg.Buffers.RoomBroadcast > 0 {
+		broadcast = h.cfg.Buffers.RoomBroadcast
+	}
+	if h.cfg != nil && h.cfg.Buffers.RoomAudioIn > 0 {
+		audioIn = h.cfg.Buffers.RoomAudioIn
+	}
+	return broadcast, audioIn
+}
+
 // RemoveRoom removes an empty room
 func (h *RoomHub) RemoveRoom(roomID string) {
 	h.mu.Lock()
@@ -182,31 +415,49 @@ func (h *RoomHub) RemoveRoom(roomID string) {
 // =============================================================================
 
 // AddListener adds a listener to the room
-func (r *Room) AddListener(listenerID, targetLang string, conn *websocket.Conn) {
+// Returns non-nil if targetLang exceeded the room's language cap and the listener got another language.
+func (r *Room) AddListener(listenerID, targetLang string, caps ClientCapabilities, conn *websocket.Conn, sess *session.Handle) *LanguageFallback {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	r.Listeners[listenerID] = &Listener{
+	targetLang, fallback := r.capTargetLanguage(listenerID, targetLang, "")
+	listener := &Listener{
 		ID:         listenerID,
 		TargetLang: targetLang,
+		Caps:       caps,
+		AudioMode:  AudioModeTTS,
 		Conn:       conn,
+		Session:    sess,
+		queue:      newListenerQueue(r.hub.cfg),
+	}
+	// Same listener ID reconnecting: the old connection's writer is no longer reachable
+	if previous, ok := r.Listeners.Set(listenerID, listener); ok {
+		previous.detach()
 	}
+	go r.runListenerWriter(listener)
+	// Webinar attendees only listen: announcing each of them to every listener would be O(n²)
+	if !r.isWebinarAttendee(listenerID) {
+		r.broadcastRoster(RosterJoined, listenerID)
+	}
+	r.emitPresence(PresenceListenerJoined, listenerID, r.speakerNickname(listenerID), targetLang)
+	r.emitParticipantWebhook(model.WebhookEventParticipantJoined, listenerID, r.speakerNickname(listenerID), targetLang)
+
+	log.Printf("[Room %s] Added listener: %s (target: %s, caps: %v), total: %d",
+		r.ID, listenerID, targetLang, caps.List(), r.Listeners.Len())
 
-	log.Printf("[Room %s] Added listener: %s (target: %s), total: %d",
-		r.ID, listenerID, targetLang, len(r.Listeners))
+	if !r.firstJoinChecked {
+		r.firstJoinChecked = true
+		go r.hub.NotifyFirstJoin(r.ID, listenerID)
+		r.emitParticipantWebhook(model.WebhookEventMeetingStarted, listenerID, r.speakerNickname(listenerID), targetLang)
+	}
+	r.fanout.announceLanguages()
 
 	// Update target languages in AWS pipeline when new listener joins
 	if r.hub.useAWS && r.awsPipeline != nil {
-		targetLangs := make([]string, 0)
-		langSet := make(map[string]bool)
-		for _, l := range r.Listeners {
-			if !langSet[l.TargetLang] {
-				langSet[l.TargetLang] = true
-				targetLangs = append(targetLangs, l.TargetLang)
-			}
-		}
+		targetLangs := r.listenerTargetLanguages()
 		log.Printf("[Room %s] 🔄 Updating target languages: %v", r.ID, targetLangs)
 		r.awsPipeline.UpdateTargetLanguages(targetLangs)
+		r.awsPipeline.UpdateVoicePreferences(r.listenerVoicePreferences())
 	}
 
 	// Start room processing if not already running
@@ -215,6 +466,7 @@ func (r *Room) AddListener(listenerID, targetLang string, conn *websocket.Conn)
 		go r.runBroadcaster()
 		go r.runAudioProcessor()
 	}
+	return fallback
 }
 
 // RemoveListener removes a listener from the room
@@ -222,66 +474,123 @@ func (r *Room) RemoveListener(listenerID string) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	delete(r.Listeners, listenerID)
+	listener, ok := r.Listeners.Get(listenerID)
+	if ok {
+		listener.detach()
+	}
+	r.Listeners.Delete(listenerID)
+	if ok && !r.isWebinarAttendee(listenerID) {
+		r.broadcastRoster(RosterLeft, listenerID)
+	}
+	if ok {
+		r.emitPresence(PresenceListenerLeft, listenerID, r.speakerNickname(listenerID), listener.TargetLang)
+	}
+	r.resume.release(listenerID)
+	r.fanout.announceLanguages()
 	log.Printf("[Room %s] Removed listener: %s, remaining: %d",
-		r.ID, listenerID, len(r.Listeners))
+		r.ID, listenerID, r.Listeners.Len())
 
 	// Update target languages in AWS pipeline (deduplicated)
 	if r.hub.useAWS && r.awsPipeline != nil {
-		targetLangs := make([]string, 0)
-		langSet := make(map[string]bool)
-		for _, l := range r.Listeners {
-			if !langSet[l.TargetLang] {
-				langSet[l.TargetLang] = true
-				targetLangs = append(targetLangs, l.TargetLang)
-			}
-		}
+		targetLangs := r.listenerTargetLanguages()
 		r.awsPipeline.UpdateTargetLanguages(targetLangs)
+		r.awsPipeline.UpdateVoicePreferences(r.listenerVoicePreferences())
 	}
 }
 
 // UpdateListenerTargetLang updates a listener's target language
-func (r *Room) UpdateListenerTargetLang(listenerID, newTargetLang string) {
+// Returns non-nil (and keeps the current language) if the change would exceed the room's language cap.
+func (r *Room) UpdateListenerTargetLang(listenerID, newTargetLang string) *LanguageFallback {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	listener, exists := r.Listeners[listenerID]
+	listener, exists := r.Listeners.Get(listenerID)
 	if !exists {
-		return
+		return nil
 	}
 
 	oldLang := listener.TargetLang
+	if _, fallback := r.capTargetLanguage(listenerID, newTargetLang, oldLang); fallback != nil {
+		return fallback
+	}
 	listener.TargetLang = newTargetLang
+	r.fanout.announceLanguages()
+	r.broadcastRoster(RosterLanguageChanged, listenerID)
 
 	log.Printf("[Room %s] Listener %s changed target language: %s -> %s",
 		r.ID, listenerID, oldLang, newTargetLang)
 
 	// Update target languages in AWS pipeline
 	if r.hub.useAWS && r.awsPipeline != nil {
-		targetLangs := make([]string, 0)
-		langSet := make(map[string]bool)
-		for _, l := range r.Listeners {
-			if !langSet[l.TargetLang] {
-				langSet[l.TargetLang] = true
-				targetLangs = append(targetLangs, l.TargetLang)
-			}
-		}
+		targetLangs := r.listenerTargetLanguages()
 		log.Printf("[Room %s] 🔄 Updating target languages: %v", r.ID, targetLangs)
 		r.awsPipeline.UpdateTargetLanguages(targetLangs)
+		r.awsPipeline.UpdateVoicePreferences(r.listenerVoicePreferences())
 	}
 
 	// If no listeners and no speakers, cleanup room
-	if len(r.Listeners) == 0 && len(r.Speakers) == 0 {
+	if r.Listeners.Len() == 0 && r.Speakers.Len() == 0 {
 		go r.hub.RemoveRoom(r.ID)
 	}
+	return nil
+}
+
+// SetListenerVoice updates a listener's TTS voice preference (nil = default voice)
+func (r *Room) SetListenerVoice(listenerID string, voice *awsai.VoicePreference) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	listener, exists := r.Listeners.Get(listenerID)
+	if !exists {
+		return
+	}
+
+	if voice.IsDefault() {
+		voice = nil
+	}
+	listener.Voice = voice
+
+	log.Printf("[Room %s] Listener %s changed voice preference: %q", r.ID, listenerID, voice.Key())
+
+	if r.hub.useAWS && r.awsPipeline != nil {
+		r.awsPipeline.UpdateVoicePreferences(r.listenerVoicePreferences())
+	}
+}
+
+// listenerVoicePreferences returns the distinct listener voices per target language.
+// Caller must hold r.mu.
+func (r *Room) listenerVoicePreferences() map[string][]*awsai.VoicePreference {
+	prefs := make(map[string][]*awsai.VoicePreference)
+	seen := make(map[string]bool)
+	for _, l := range r.Listeners.Snapshot() {
+		voice := l.ttsVoice()
+		key := l.TargetLang + "#" + voice.Key()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		prefs[l.TargetLang] = append(prefs[l.TargetLang], voice)
+	}
+	// Relay listeners always get the default voice
+	for relay := range r.relays {
+		for _, lang := range relay.listeners {
+			if key := lang + "#"; !seen[key] {
+				seen[key] = true
+				prefs[lang] = append(prefs[lang], nil)
+			}
+		}
+	}
+	return prefs
 }
 
 // RemoveSpeaker removes a speaker from the room and closes their Transcribe stream
 func (r *Room) RemoveSpeaker(speakerID string) {
 	r.mu.Lock()
-	speaker, exists := r.Speakers[speakerID]
+	speaker, exists := r.Speakers.Get(speakerID)
 	if exists {
-		delete(r.Speakers, speakerID)
+		r.Speakers.Delete(speakerID)
+		r.broadcastRoster(RosterLeft, speakerID)
+		r.emitPresence(PresenceSpeakerLeft, speakerID, speaker.Nickname, speaker.SourceLang)
 	}
 	pipeline := r.awsPipeline
 	r.mu.Unlock()
@@ -289,6 +598,7 @@ func (r *Room) RemoveSpeaker(speakerID string) {
 	if !exists {
 		return
 	}
+	r.removeSpeakerDecoder(speakerID)
 
 	// Close the speaker's Transcribe stream (AWS mode)
 	if r.hub.useAWS && pipeline != nil {
@@ -300,7 +610,7 @@ func (r *Room) RemoveSpeaker(speakerID string) {
 
 	// If no listeners and no speakers, cleanup room
 	r.mu.RLock()
-	isEmpty := len(r.Listeners) == 0 && len(r.Speakers) == 0
+	isEmpty := r.Listeners.Len() == 0 && r.Speakers.Len() == 0 && r.relayListenerCount() == 0
 	r.mu.RUnlock()
 
 	if isEmpty {
@@ -349,10 +659,7 @@ func (r *Room) RemoveSpeakersForSender(senderID string) {
 
 // HasSpeaker checks if a speaker exists in the room
 func (r *Room) HasSpeaker(speakerID string) bool {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
-	_, exists := r.Speakers[speakerID]
-	return exists
+	return r.Speakers.Has(speakerID)
 }
 
 // AddOrUpdateSpeaker adds or updates a speaker
@@ -361,16 +668,17 @@ func (r *Room) AddOrUpdateSpeaker(speakerID, sourceLang, nickname, profileImg st
 
 	// Check if sourceLang changed - need to cleanup old Transcribe stream
 	oldSourceLang := ""
-	if existingSpeaker, exists := r.Speakers[speakerID]; exists {
+	existingSpeaker, speakerExists := r.Speakers.Get(speakerID)
+	if speakerExists {
 		oldSourceLang = existingSpeaker.SourceLang
 	}
 
-	r.Speakers[speakerID] = &Speaker{
+	r.Speakers.Set(speakerID, &Speaker{
 		ID:         speakerID,
 		SourceLang: sourceLang,
 		Nickname:   nickname,
 		ProfileImg: profileImg,
-	}
+	})
 
 	// FIX: Auto-update listener's targetLang to match sourceLang for bidirectional translation.
 	// When a Korean speaker (sourceLang=ko) connects, they should receive Korean translations
@@ -378,13 +686,20 @@ func (r *Room) AddOrUpdateSpeaker(speakerID, sourceLang, nickname, profileImg st
 	// would never receive Korean translations, breaking bidirectional translation.
 	listenerNeedsUpdate := false
 	var oldTargetLang string
-	if listener, exists := r.Listeners[speakerID]; exists {
+	if listener, exists := r.Listeners.Get(speakerID); exists {
 		if listener.TargetLang != sourceLang {
 			oldTargetLang = listener.TargetLang
 			listener.TargetLang = sourceLang
 			listenerNeedsUpdate = true
 		}
 	}
+	switch {
+	case !speakerExists:
+		r.broadcastRoster(RosterJoined, speakerID)
+		r.emitPresence(PresenceSpeakerJoined, speakerID, nickname, sourceLang)
+	case oldSourceLang != sourceLang || listenerNeedsUpdate:
+		r.broadcastRoster(RosterLanguageChanged, speakerID)
+	}
 	r.mu.Unlock()
 
 	// If sourceLang changed, clean up the old Transcribe stream
@@ -402,14 +717,7 @@ func (r *Room) AddOrUpdateSpeaker(speakerID, sourceLang, nickname, profileImg st
 			r.ID, speakerID, oldTargetLang, sourceLang)
 		if r.hub.useAWS && r.awsPipeline != nil {
 			r.mu.RLock()
-			targetLangs := make([]string, 0)
-			langSet := make(map[string]bool)
-			for _, l := range r.Listeners {
-				if !langSet[l.TargetLang] {
-					langSet[l.TargetLang] = true
-					targetLangs = append(targetLangs, l.TargetLang)
-				}
-			}
+			targetLangs := r.listenerTargetLanguages()
 			r.mu.RUnlock()
 			r.awsPipeline.UpdateTargetLanguages(targetLangs)
 		}
@@ -423,17 +731,123 @@ func (r *Room) AddOrUpdateSpeaker(speakerID, sourceLang, nickname, profileImg st
 func (r *Room) GetTargetLanguages() []string {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
+	return r.listenerTargetLanguages()
+}
 
-	langSet := make(map[string]bool)
-	for _, listener := range r.Listeners {
-		langSet[listener.TargetLang] = true
+// listenerTargetLanguages returns unique listener target languages, most listeners first
+// (the pipeline keeps the first ones when it has to limit fan-out). Caller must hold r.mu.
+func (r *Room) listenerTargetLanguages() []string {
+	counts := make(map[string]int)
+	for _, l := range r.Listeners.Snapshot() {
+		counts[l.TargetLang]++
+	}
+	// Listeners connected to other instances (multi-instance fan-out) and to edge relays
+	r.fanout.countRemoteLanguages(counts)
+	r.countRelayLanguages(counts)
+	return languagesByCount(counts)
+}
+
+// hasPassthroughAudience reports whether a listener other than the speaker uses lang
+// (the pipeline skips same-language TTS otherwise). Remote and relay listeners count as an audience.
+func (r *Room) hasPassthroughAudience(lang, speakerID string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for id, l := range r.Listeners.Snapshot() {
+		if id != speakerID && l.TargetLang == lang {
+			return true
+		}
+	}
+	counts := make(map[string]int)
+	r.fanout.countRemoteLanguages(counts)
+	r.countRelayLanguages(counts)
+	return counts[lang] > 0
+}
+
+// SetPartialSuppression enables or disables partial suppression during TTS playback for this room (moderators only)
+func (r *Room) SetPartialSuppression(hostID string, enabled bool) error {
+	if !r.isModerator(hostID) {
+		return ErrNotModerator
+	}
+
+	r.mu.Lock()
+	r.suppressPartials = enabled
+	pipeline := r.awsPipeline
+	r.mu.Unlock()
+
+	if pipeline != nil {
+		pipeline.SetPartialSuppression(enabled)
+	}
+	log.Printf("[Room %s] Partial suppression during TTS: %v (host: %s)", r.ID, enabled, hostID)
+	return nil
+}
+
+// SetPartialMinLengths sets room-level overrides for the per-language partial minimum lengths (moderators only).
+// Overrides are merged on top of the workspace settings; an empty map clears them.
+// Unknown languages or out-of-range lengths reject the whole update.
+func (r *Room) SetPartialMinLengths(hostID string, lengths map[string]int) error {
+	if !r.isModerator(hostID) {
+		return ErrNotModerator
+	}
+
+	overrides := make(map[string]int, len(lengths))
+	for lang, n := range lengths {
+		code := awsai.NormalizeLanguage(lang)
+		if code == "" {
+			return fmt.Errorf("%w: %q", awsai.ErrUnsupportedLanguage, lang)
+		}
+		if err := awsai.ValidatePartialMinLength(n); err != nil {
+			return fmt.Errorf("%s: %w", code, err)
+		}
+		overrides[code] = n
+	}
+
+	r.mu.Lock()
+	r.partialMinLengths = overrides
+	pipeline := r.awsPipeline
+	workspaceID := r.workspaceID
+	r.mu.Unlock()
+
+	if pipeline != nil {
+		_, _, defaults := r.hub.LoadWorkspaceNoiseFilter(workspaceID)
+		pipeline.SetPartialMinLengths(mergePartialMinLengths(defaults, overrides))
+	}
+	log.Printf("[Room %s] Partial min length overrides: %v (host: %s)", r.ID, overrides, hostID)
+	return nil
+}
+
+// mergePartialMinLengths applies room overrides on top of the workspace/default lengths
+func mergePartialMinLengths(base, overrides map[string]int) map[string]int {
+	merged := make(map[string]int, len(base)+len(overrides))
+	for lang, n := range base {
+		merged[lang] = n
+	}
+	for lang, n := range overrides {
+		merged[lang] = n
+	}
+	return merged
+}
+
+// SetIncrementalPairs sets the language pairs that get incremental (partial) translation+TTS (moderators only)
+func (r *Room) SetIncrementalPairs(hostID string, specs []string) error {
+	if !r.isModerator(hostID) {
+		return ErrNotModerator
 	}
+	pairs, err := awsai.ValidateLanguagePairs(specs)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.incrementalPairs = pairs
+	pipeline := r.awsPipeline
+	r.mu.Unlock()
 
-	langs := make([]string, 0, len(langSet))
-	for lang := range langSet {
-		langs = append(langs, lang)
+	if pipeline != nil {
+		pipeline.SetIncrementalPairs(awsai.ParseLanguagePairs(pairs))
 	}
-	return langs
+	log.Printf("[Room %s] Incremental translation pairs: %v (host: %s)", r.ID, pairs, hostID)
+	return nil
 }
 
 // SendAudio sends audio from a speaker to be processed
@@ -442,22 +856,62 @@ func (r *Room) SendAudio(speakerID, sourceLang string, audioData []byte) {
 	speakerID = strings.TrimSpace(speakerID)
 	sourceLang = strings.TrimSpace(sourceLang)
 
-	select {
-	case r.audioIn <- &AudioMessage{
+	// Muted or removed by the host
+	if r.isSpeakerMuted(speakerID) {
+		return
+	}
+
+	// Speaker queue: only the host and participants with the floor reach Transcribe
+	if !r.acceptsAudioFrom(speakerID) {
+		return
+	}
+
+	// Webinar: only designated speakers are transcribed
+	if !r.CanSendAudio(speakerID) {
+		return
+	}
+
+	// Relay the real voice to listeners who asked for it
+	r.relayOriginalAudio(speakerID, sourceLang, audioData)
+
+	if rec := r.activeRecorder(); rec != nil {
+		rec.WriteSpeaker(speakerID, audioData, time.Now())
+	}
+
+	r.enqueueAudio(&AudioMessage{
 		SpeakerID:  speakerID,
 		SourceLang: sourceLang,
 		AudioData:  audioData,
-	}:
+	})
+}
+
+// enqueueAudio queues audio for the audio processor (dropped if the buffer is full)
+func (r *Room) enqueueAudio(msg *AudioMessage) {
+	select {
+	case r.audioIn <- msg:
+		r.queues.audioIn.Observe(len(r.audioIn))
 	default:
-		log.Printf("[Room %s] Audio buffer full, dropping frame from %s", r.ID, speakerID)
+		log.Printf("[Room %s] Audio buffer full, dropping frame from %s", r.ID, msg.SpeakerID)
 	}
 }
 
-// Broadcast sends a message to all relevant listeners
+// Broadcast sends a message to all relevant listeners (and to other instances' listeners
+// for transcripts/TTS when fan-out is enabled)
 func (r *Room) Broadcast(msg *BroadcastMessage) {
+	r.publishFanout(msg)
+	r.broadcastLocal(msg)
+}
+
+// broadcastLocal queues a message for this instance's listeners only
+func (r *Room) broadcastLocal(msg *BroadcastMessage) {
+	r.preSerialize(msg)
+	r.resume.record(msg)
+
 	select {
 	case r.broadcast <- msg:
+		r.queues.broadcast.Observe(len(r.broadcast))
 	default:
+		metrics.DroppedMessages.Inc(metrics.DropRoomBroadcast)
 		log.Printf("[Room %s] Broadcast buffer full", r.ID)
 	}
 }
@@ -468,14 +922,36 @@ func (r *Room) Shutdown() {
 
 	// Close AWS pipeline if exists
 	r.mu.Lock()
-	if r.awsPipeline != nil {
-		r.awsPipeline.Close()
-		r.awsPipeline = nil
-	}
+	pipeline := r.awsPipeline
+	r.awsPipeline = nil
 	r.mu.Unlock()
 
-	// Save transcripts to database before shutdown
-	r.saveTranscriptsToDatabase()
+	if pipeline != nil {
+		// Translate remaining archive-only finals so the archive is complete
+		flushCtx, flushCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		r.saveArchiveTranslations(pipeline.FlushArchive(flushCtx))
+		flushCancel()
+
+		r.flushUsage(pipeline)
+		pipeline.Close()
+	}
+
+	r.closeSpeakerDecoders()
+
+	// Upload the last recording chunk before the meeting is finalized
+	if _, err := r.StopRecording(); err == nil {
+		log.Printf("[Room %s] Recording stopped with the room", r.ID)
+	}
+
+	// Flush transcripts, summarize, close attendance (idempotent, resumed after crashes)
+	if r.finalizesMeeting() {
+		r.hub.FinalizeMeeting(r.ID)
+	}
+
+	// No more deliveries from other instances once the queues are closed
+	r.stopFanout()
+	r.stopOwnership()
+	r.stopWebinar()
 
 	close(r.broadcast)
 	close(r.audioIn)
@@ -483,83 +959,75 @@ func (r *Room) Shutdown() {
 	log.Printf("[Room %s] Shutdown complete", r.ID)
 }
 
-// saveTranscriptsToDatabase flushes Redis transcripts to the database
-func (r *Room) saveTranscriptsToDatabase() {
-	if r.hub.redisClient == nil || r.hub.db == nil {
-		return
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-
-	// Get and delete transcripts from Redis
-	transcripts, err := r.hub.redisClient.FlushRoom(ctx, r.ID)
-	if err != nil {
-		log.Printf("[Room %s] Failed to flush transcripts from Redis: %v", r.ID, err)
-		return
+// FindMeetingByRoomID looks up the meeting for a room
+// roomID format: "meeting-{id}", otherwise the meeting code is used as fallback.
+// Breakout rooms ("{parent}~{name}") belong to the parent room's meeting.
+func FindMeetingByRoomID(db *gorm.DB, roomID string) (*model.Meeting, error) {
+	if parentID, _, ok := ParseBreakoutRoomID(roomID); ok {
+		roomID = parentID
 	}
 
-	if len(transcripts) == 0 {
-		log.Printf("[Room %s] No transcripts to save to database", r.ID)
-		return
-	}
-
-	// Parse meetingID from roomID (format: "meeting-{id}")
 	var meeting model.Meeting
-	if strings.HasPrefix(r.ID, "meeting-") {
-		meetingIDStr := strings.TrimPrefix(r.ID, "meeting-")
-		if err := r.hub.db.Where("id = ?", meetingIDStr).First(&meeting).Error; err != nil {
-			log.Printf("[Room %s] Meeting ID %s not found, skipping DB save: %v", r.ID, meetingIDStr, err)
-			return
+	if strings.HasPrefix(roomID, "meeting-") {
+		meetingIDStr := strings.TrimPrefix(roomID, "meeting-")
+		if err := db.Where("id = ?", meetingIDStr).First(&meeting).Error; err != nil {
+			return nil, err
 		}
 	} else {
-		// Try to find by code as fallback
-		if err := r.hub.db.Where("code = ?", r.ID).First(&meeting).Error; err != nil {
-			log.Printf("[Room %s] Meeting not found by code, skipping DB save: %v", r.ID, err)
-			return
+		if err := db.Where("code = ?", roomID).First(&meeting).Error; err != nil {
+			return nil, err
 		}
 	}
+	return &meeting, nil
+}
 
-	// Convert Redis transcripts to VoiceRecord models
-	voiceRecords := make([]model.VoiceRecord, 0, len(transcripts))
-	for _, t := range transcripts {
-		// Only save final transcripts to avoid duplicates
-		if !t.IsFinal {
-			continue
-		}
+// findMeeting looks up the meeting for this room
+func (r *Room) findMeeting() (*model.Meeting, error) {
+	return FindMeetingByRoomID(r.hub.db, r.ID)
+}
 
-		record := model.VoiceRecord{
-			MeetingID:   meeting.ID,
-			SpeakerName: t.SpeakerName,
-			Original:    t.Original,
-			CreatedAt:   t.Timestamp,
+// meetingRooms returns this instance's rooms of the given meetings, keyed by "meeting-{id}"
+// or meeting code the same way FindMeetingByRoomID resolves them (breakouts included)
+func (h *RoomHub) meetingRooms(meetings ...model.Meeting) []*Room {
+	keys := make(map[string]bool, len(meetings)*2)
+	for _, m := range meetings {
+		keys[fmt.Sprintf("meeting-%d", m.ID)] = true
+		if m.Code != "" {
+			keys[m.Code] = true
 		}
+	}
 
-		if t.SourceLang != "" {
-			record.SourceLang = &t.SourceLang
-		}
-		if t.Translated != "" {
-			record.Translated = &t.Translated
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	var rooms []*Room
+	for id, room := range h.rooms {
+		if parentID, _, ok := ParseBreakoutRoomID(id); ok {
+			id = parentID
 		}
-		if t.TargetLang != "" {
-			record.TargetLang = &t.TargetLang
+		if keys[id] {
+			rooms = append(rooms, room)
 		}
-
-		voiceRecords = append(voiceRecords, record)
 	}
+	return rooms
+}
 
-	if len(voiceRecords) == 0 {
-		log.Printf("[Room %s] No final transcripts to save", r.ID)
-		return
+// loadVocabulary loads the custom vocabulary of the meeting's workspace
+func (r *Room) loadVocabulary() *awsai.Vocabulary {
+	if r.hub.db == nil {
+		return nil
 	}
 
-	// Bulk insert to database
-	if err := r.hub.db.Create(&voiceRecords).Error; err != nil {
-		log.Printf("[Room %s] Failed to save transcripts to database: %v", r.ID, err)
-		return
+	meeting, err := r.findMeeting()
+	if err != nil || meeting.WorkspaceID == nil {
+		return nil
 	}
 
-	log.Printf("[Room %s] Saved %d transcripts to database (meeting_id: %d)", r.ID, len(voiceRecords), meeting.ID)
+	r.mu.Lock()
+	r.workspaceID = *meeting.WorkspaceID
+	r.mu.Unlock()
+
+	return r.hub.LoadWorkspaceVocabulary(*meeting.WorkspaceID)
 }
 
 // =============================================================================
@@ -580,63 +1048,139 @@ func (r *Room) runBroadcaster() {
 				return
 			}
 			r.broadcastMessage(msg)
+		case msg := <-r.relay:
+			r.broadcastMessage(msg)
 		}
 	}
 }
 
 func (r *Room) broadcastMessage(msg *BroadcastMessage) {
-	r.mu.RLock()
-	listeners := make([]*Listener, 0, len(r.Listeners))
-	for _, l := range r.Listeners {
-		listeners = append(listeners, l)
-	}
-	r.mu.RUnlock()
+	r.publishRelays(msg)
 
+	listeners := r.Listeners.Values()
+	if pool := r.webinarPool(); pool != nil && msg.TargetListenerID == "" {
+		pool.fanOut(msg, listeners)
+		return
+	}
 	for _, listener := range listeners {
-		// Skip sending to the speaker themselves (don't hear your own translation)
-		if listener.ID == msg.SpeakerID {
-			continue
-		}
+		r.routeToListener(listener, msg)
+	}
+}
 
-		shouldSend := false
-
-		if msg.Type == "transcript" {
-			// For transcripts with translation: only send to matching target language
-			// For original transcripts (no TargetLang): send to everyone except speaker
-			if msg.TargetLang == "" {
-				// Original transcript without translation - send to all (except speaker)
-				shouldSend = true
-			} else if msg.TargetLang == listener.TargetLang {
-				// Translated transcript - only send to listeners with matching target language
-				shouldSend = true
-			}
-		} else if msg.Type == "audio" {
-			// Audio messages go only to matching targetLang (and not the speaker)
-			shouldSend = msg.TargetLang == listener.TargetLang
-		}
+// routeToListener queues msg for the listener if it is meant for them
+func (r *Room) routeToListener(listener *Listener, msg *BroadcastMessage) {
+	// Skip sending to the speaker themselves (don't hear your own translation)
+	if listener.ID == msg.SpeakerID {
+		return
+	}
 
-		if shouldSend {
-			r.sendToListener(listener, msg)
+	shouldSend := false
+
+	if msg.TargetListenerID != "" {
+		shouldSend = listener.ID == msg.TargetListenerID
+	} else if msg.Type == "transcript" {
+		// For transcripts with translation: only send to matching target language
+		// For original transcripts (no TargetLang): send to everyone except speaker
+		if msg.TargetLang == "" {
+			// Original transcript without translation - send to all (except speaker)
+			shouldSend = true
+		} else if msg.TargetLang == listener.TargetLang {
+			// Translated transcript - only send to listeners with matching target language
+			shouldSend = true
 		}
+	} else if msg.Type == "audio" {
+		// Audio messages go only to matching targetLang and voice (and not the speaker)
+		shouldSend = msg.TargetLang == listener.TargetLang && msg.VoiceKey == listener.ttsVoice().Key() && listener.wantsTTS() &&
+			!(listener.DropSuperseded && r.isSuperseded(msg.TranscriptID))
+	} else if msg.Type == "audio_cancel" {
+		// Supersession notices go to everyone receiving TTS
+		shouldSend = listener.wantsTTS()
+	} else if msg.Type == "original_audio" {
+		// Relayed speaker audio goes to listeners who opted in (bilingual listeners)
+		shouldSend = listener.wantsOriginalAudio()
+	} else if msg.Type == "speaker_queue" || msg.Type == "quota_exceeded" || msg.Type == "moderation" || msg.Type == "roster" || msg.Type == "webinar" {
+		// Raise-hand queue state, quota notices, host controls, roster and webinar changes go to everyone
+		shouldSend = true
+	}
+
+	if shouldSend && listener.Caps.wantsMessage(msg) {
+		r.sendToListener(listener, msg)
 	}
 }
 
+// sendToListener queues msg for the listener's writer (never blocks on the socket)
 func (r *Room) sendToListener(listener *Listener, msg *BroadcastMessage) {
+	if listener.queue.push(msg) {
+		r.evictListener(listener)
+	}
+}
+
+// WriteToListener writes a text frame directly to the listener's socket (handshake replies)
+func (r *Room) WriteToListener(listenerID string, data []byte) error {
+	r.mu.RLock()
+	listener, ok := r.Listeners.Get(listenerID)
+	r.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("listener %s not in room", listenerID)
+	}
+
 	listener.writeMu.Lock()
 	defer listener.writeMu.Unlock()
+	if listener.queue.stopped() {
+		return fmt.Errorf("listener %s left the room", listenerID)
+	}
+	return listener.Session.Write(listener.Conn, websocket.TextMessage, "", data)
+}
+
+// writeToListener writes one message to the listener's socket (listener writer goroutine)
+func (r *Room) writeToListener(listener *Listener, msg *BroadcastMessage) {
+	listener.writeMu.Lock()
+	defer listener.writeMu.Unlock()
+	// The select in runListenerWriter may pick a queued message after the listener left
+	if listener.queue.stopped() {
+		return
+	}
 
 	var err error
 	if msg.AudioData != nil && len(msg.AudioData) > 0 {
-		// Send binary audio data
-		err = listener.Conn.WriteMessage(websocket.BinaryMessage, msg.AudioData)
+		// Send binary audio data (with metadata header if the client negotiated it)
+		frame := msg.AudioData
+		if listener.Caps.BinaryEnvelope {
+			envelope, envErr := encodeAudioEnvelope(msg)
+			if envErr != nil {
+				log.Printf("[Room %s] Failed to encode audio envelope: %v", r.ID, envErr)
+				return
+			}
+			frame = envelope
+		} else if listener.Caps.AudioHeader || msg.Type != "audio" {
+			// Describe the bare binary frame with a JSON header first: audio_header clients get
+			// seq/transcript/format metadata; legacy clients treat bare frames as TTS mp3, so
+			// other audio types are announced with the message itself
+			var header []byte
+			var jsonErr error
+			if listener.Caps.AudioHeader {
+				header, jsonErr = encodeAudioHeader(msg)
+			} else {
+				header, jsonErr = msg.payload()
+			}
+			if jsonErr != nil {
+				log.Printf("[Room %s] Failed to marshal message: %v", r.ID, jsonErr)
+				return
+			}
+			if err = listener.Session.Write(listener.Conn, websocket.TextMessage, msg.Type, header); err != nil {
+				log.Printf("[Room %s] Failed to send to listener %s: %v", r.ID, listener.ID, err)
+				return
+			}
+		}
+		err = listener.Session.Write(listener.Conn, websocket.BinaryMessage, msg.Type, frame)
 	} else {
 		// Send JSON message
-		jsonData, jsonErr := json.Marshal(msg)
+		jsonData, jsonErr := msg.payload()
 		if jsonErr != nil {
 			log.Printf("[Room %s] Failed to marshal message: %v", r.ID, jsonErr)
 			return
 		}
-		err = listener.Conn.WriteMessage(websocket.TextMessage, jsonData)
+		err = listener.Session.Write(listener.Conn, websocket.TextMessage, msg.Type, jsonData)
 	}
 
 	if err != nil {
@@ -649,20 +1193,29 @@ func (r *Room) runAudioProcessor() {
 	log.Printf("[Room %s] Audio processor started (useAWS: %v)", r.ID, r.hub.useAWS)
 	defer log.Printf("[Room %s] Audio processor stopped", r.ID)
 
-	// Start AI stream (AWS or gRPC)
-	if err := r.startStream(); err != nil {
-		log.Printf("[Room %s] Failed to start stream: %v", r.ID, err)
-		return
+	// Start AI stream (AWS or gRPC), unless another instance owns the room's pipeline
+	running := false
+	if r.ownership.isOwner() {
+		if err := r.startStream(); err != nil {
+			log.Printf("[Room %s] Failed to start stream: %v", r.ID, err)
+			return
+		}
+		running = true
 	}
 
 	for {
 		select {
 		case <-r.ctx.Done():
 			return
+		case <-r.ownership.changes():
+			running = r.syncPipelineOwnership(running)
 		case audioMsg, ok := <-r.audioIn:
 			if !ok {
 				return
 			}
+			if r.forwardAudio(audioMsg) {
+				continue
+			}
 			r.processAudio(audioMsg)
 		}
 	}
@@ -691,7 +1244,7 @@ func (r *Room) startGrpcStream() error {
 	// Build participants from listeners
 	participants := make([]ai.ParticipantConfig, 0)
 	r.mu.RLock()
-	for _, listener := range r.Listeners {
+	for _, listener := range r.Listeners.Snapshot() {
 		participants = append(participants, ai.ParticipantConfig{
 			ParticipantID:      listener.ID,
 			Nickname:           listener.ID,
@@ -743,11 +1296,73 @@ func (r *Room) startAWSPipeline() error {
 		targetLangs = []string{"en"} // Default
 	}
 
+	r.mu.RLock()
+	suppressPartials := r.suppressPartials
+	incrementalPairs := awsai.ParseLanguagePairs(r.incrementalPairs)
+	ttsDisabled := r.ttsDisabledLanguagesLocked()
+	slowMode := r.slowMode
+	r.mu.RUnlock()
+
+	vocabulary := r.loadVocabulary()
+
+	r.mu.RLock()
+	workspaceID := r.workspaceID
+	r.mu.RUnlock()
+	noiseRules, noiseThresholds, partialMinLengths := r.hub.LoadWorkspaceNoiseFilter(workspaceID)
+	r.mu.RLock()
+	partialMinLengths = mergePartialMinLengths(partialMinLengths, r.partialMinLengths)
+	r.mu.RUnlock()
+	noiseFilter := awsai.DefaultNoiseFilterConfig()
+	noiseFilter.Rules = noiseRules
+	noiseFilter.LanguageThresholds = noiseThresholds
+	noiseFilter.PartialMinLengths = partialMinLengths
+	noiseFilter.MinTextLength = r.hub.tunables.Load().NoiseMinTextLength
+
 	pipelineCfg := &awsai.PipelineConfig{
 		TargetLanguages:  targetLangs,
 		SampleRate:       16000,
 		UseStreamManager: true, // Enable language-based stream pooling
 		UseWorkerPools:   true, // Enable worker pools for translation/TTS
+
+		SuppressPartialsDuringTTS: suppressPartials,
+		Vocabulary:                vocabulary,
+		ArchiveLanguages:          r.hub.cfg.AI.ArchiveLanguages,
+		ArchiveBatchInterval:      r.hub.cfg.AI.ArchiveBatchInterval,
+		PollyCharBudget:           r.hub.cfg.AI.PollyCharBudget,
+		TTSDisabledLanguages:      ttsDisabled,
+		SlowMode:                  slowMode,
+		SlowModePartialInterval:   r.hub.cfg.AI.SlowModePartialInterval,
+		Prosody: &awsai.Prosody{
+			Rate:   r.hub.cfg.AI.TTSRate,
+			Pitch:  r.hub.cfg.AI.TTSPitch,
+			Volume: r.hub.cfg.AI.TTSVolumeDB,
+		},
+		AutoProsody:      r.hub.cfg.AI.TTSAutoRate,
+		IncrementalPairs: incrementalPairs,
+		DowngradeAfter:   r.hub.cfg.AI.DowngradeAfter,
+		RecoverAfter:     r.hub.cfg.AI.RecoverAfter,
+		HibernateAfter:   r.hub.cfg.AI.HibernateAfter,
+		NoiseFilter:      noiseFilter,
+		RedactPII:        r.hub.WorkspaceRedactsPII(workspaceID),
+		Quota:            r.hub.newUsageQuota(workspaceID),
+		TTSInterrupt:     r.hub.cfg.AI.TTSInterrupt,
+		Cache:            r.hub.pipelineCacheConfig(),
+		Buffers: &awsai.BufferSizes{
+			TranscriptChan:    r.hub.cfg.Buffers.PipelineTranscripts,
+			AudioChan:         r.hub.cfg.Buffers.PipelineAudio,
+			StreamTranscripts: r.hub.cfg.Buffers.StreamTranscripts,
+			StreamAudioIn:     r.hub.cfg.Buffers.StreamAudioIn,
+		},
+
+		PassthroughAudience: r.hasPassthroughAudience,
+		Sequence:            r.hub.roomSequence(r.ID),
+	}
+	if r.hub.cfg.AI.ModerationEnabled {
+		pipelineCfg.Moderation = &awsai.ModerationConfig{
+			WordLists:         awsai.ParseModerationWords(r.hub.cfg.AI.ModerationWords),
+			Toxicity:          r.hub.cfg.AI.ModerationToxicity,
+			ToxicityThreshold: float32(r.hub.cfg.AI.ModerationToxicityThreshold),
+		}
 	}
 
 	var pipeline *awsai.Pipeline
@@ -777,19 +1392,14 @@ func (r *Room) startAWSPipeline() error {
 	r.awsPipeline = pipeline
 	// After pipeline is set, immediately update target languages with ALL current listeners
 	// This fixes race condition where listeners joined while pipeline was being created
-	currentTargetLangs := make([]string, 0)
-	langSet := make(map[string]bool)
-	for _, l := range r.Listeners {
-		if !langSet[l.TargetLang] {
-			langSet[l.TargetLang] = true
-			currentTargetLangs = append(currentTargetLangs, l.TargetLang)
-		}
-	}
+	currentTargetLangs := r.listenerTargetLanguages()
+	voicePrefs := r.listenerVoicePreferences()
 	r.mu.Unlock()
 
 	// Update with all current listeners' target languages (outside lock to avoid deadlock)
 	if len(currentTargetLangs) > 0 {
 		pipeline.UpdateTargetLanguages(currentTargetLangs)
+		pipeline.UpdateVoicePreferences(voicePrefs)
 		log.Printf("[Room %s] 🔄 Updated target languages after pipeline creation: %v", r.ID, currentTargetLangs)
 	}
 
@@ -835,6 +1445,40 @@ func (r *Room) receiveAWSResponses() {
 			if err != nil {
 				log.Printf("[Room %s] AWS pipeline error: %v", r.ID, err)
 			}
+
+		case translations, ok := <-pipeline.ArchiveChan:
+			if !ok {
+				return
+			}
+			go r.saveArchiveTranslations(translations)
+
+		case change, ok := <-pipeline.ModeChan:
+			if !ok {
+				return
+			}
+			go r.notifyHostModeChange(change)
+
+		case event, ok := <-pipeline.CrosstalkChan:
+			if !ok {
+				return
+			}
+			go r.notifyHostCrosstalk(event)
+
+		case event, ok := <-pipeline.QuotaChan:
+			if !ok {
+				return
+			}
+			r.notifyQuotaExceeded(event)
+
+		case event, ok := <-pipeline.SupersededChan:
+			if !ok {
+				return
+			}
+			r.Broadcast(&BroadcastMessage{
+				Type:      "audio_cancel",
+				SpeakerID: event.SpeakerID,
+				Data:      event,
+			})
 		}
 	}
 }
@@ -887,6 +1531,17 @@ func (r *Room) handleTranscript(t *ai.TranscriptMessage) {
 		speakerName = t.Speaker.ParticipantId // 또는 Speaker.Nickname이 있으면 사용
 	}
 
+	// AWS 파이프라인은 순번을 매겨 보냄, gRPC 경로는 수신 순서로 매김
+	if t.IsFinal && t.Sequence == 0 {
+		t.Sequence = r.sequencer.Next()
+	}
+
+	// 호스트 키워드 감시 (DB 조회/저장이 있으므로 수신 루프 밖에서)
+	if t.IsFinal {
+		go r.scanKeywords(t, speakerID)
+		r.emitTranscriptWebhook(t, speakerID)
+	}
+
 	// 번역이 있는 경우: 번역된 메시지만 전송 (원본 포함됨)
 	// 번역이 없는 경우: 원본만 전송
 	if len(t.Translations) > 0 {
@@ -897,11 +1552,18 @@ func (r *Room) handleTranscript(t *ai.TranscriptMessage) {
 				SpeakerID:  speakerID,
 				TargetLang: trans.TargetLanguage,
 				Data: TranscriptData{
+					TranscriptID:  t.ID,
 					ParticipantID: speakerID,
 					Original:      t.OriginalText,
 					Translated:    trans.TranslatedText,
 					IsFinal:       t.IsFinal,
 					Language:      t.OriginalLanguage,
+					TTSSkipped:    t.TTSSkipped[trans.TargetLanguage],
+					Crosstalk:     t.Crosstalk,
+
+					Moderated:        t.Moderated,
+					ModerationLabels: t.ModerationLabels,
+					Degraded:         t.Degraded,
 				},
 			})
 		}
@@ -914,14 +1576,18 @@ func (r *Room) handleTranscript(t *ai.TranscriptMessage) {
 					defer cancel()
 
 					transcript := &cache.RoomTranscript{
-						RoomID:      r.ID,
-						SpeakerID:   speakerID,
-						SpeakerName: speakerName,
-						Original:    t.OriginalText,
-						Translated:  translatedText,
-						SourceLang:  t.OriginalLanguage,
-						TargetLang:  targetLang,
-						IsFinal:     t.IsFinal,
+						RoomID:       r.ID,
+						TranscriptID: t.ID,
+						SpeakerID:    speakerID,
+						SpeakerName:  speakerName,
+						Original:     t.OriginalText,
+						Translated:   translatedText,
+						SourceLang:   t.OriginalLanguage,
+						TargetLang:   targetLang,
+						IsFinal:      t.IsFinal,
+						Crosstalk:    t.Crosstalk,
+						Moderated:    t.Moderated,
+						Sequence:     t.Sequence,
 					}
 
 					if err := r.hub.redisClient.AddTranscript(ctx, r.ID, transcript); err != nil {
@@ -936,10 +1602,15 @@ func (r *Room) handleTranscript(t *ai.TranscriptMessage) {
 			Type:      "transcript",
 			SpeakerID: speakerID,
 			Data: TranscriptData{
+				TranscriptID:  t.ID,
 				ParticipantID: speakerID,
 				Original:      t.OriginalText,
 				IsFinal:       t.IsFinal,
 				Language:      t.OriginalLanguage,
+				Crosstalk:     t.Crosstalk,
+
+				Moderated:        t.Moderated,
+				ModerationLabels: t.ModerationLabels,
 			},
 		})
 
@@ -950,12 +1621,16 @@ func (r *Room) handleTranscript(t *ai.TranscriptMessage) {
 				defer cancel()
 
 				transcript := &cache.RoomTranscript{
-					RoomID:      r.ID,
-					SpeakerID:   speakerID,
-					SpeakerName: speakerName,
-					Original:    t.OriginalText,
-					SourceLang:  t.OriginalLanguage,
-					IsFinal:     t.IsFinal,
+					RoomID:       r.ID,
+					TranscriptID: t.ID,
+					SpeakerID:    speakerID,
+					SpeakerName:  speakerName,
+					Original:     t.OriginalText,
+					SourceLang:   t.OriginalLanguage,
+					IsFinal:      t.IsFinal,
+					Crosstalk:    t.Crosstalk,
+					Moderated:    t.Moderated,
+					Sequence:     t.Sequence,
 				}
 
 				if err := r.hub.redisClient.AddTranscript(ctx, r.ID, transcript); err != nil {
@@ -966,6 +1641,85 @@ func (r *Room) handleTranscript(t *ai.TranscriptMessage) {
 	}
 }
 
+// notifyHostModeChange tells the meeting host that optional features were disabled or restored
+func (r *Room) notifyHostModeChange(change *awsai.PipelineModeChange) {
+	log.Printf("[Room %s] Pipeline mode change: downgraded=%v (%s)", r.ID, change.Downgraded, change.Reason)
+	if r.hub.db == nil {
+		return
+	}
+
+	meeting, err := r.findMeeting()
+	if err != nil {
+		log.Printf("[Room %s] Cannot notify host of pipeline mode change: %v", r.ID, err)
+		return
+	}
+
+	r.Broadcast(&BroadcastMessage{
+		Type:             "pipeline_mode",
+		Data:             change,
+		TargetListenerID: fmt.Sprintf("%d", meeting.HostID),
+	})
+}
+
+// notifyHostCrosstalk warns the meeting host that several participants are talking over each other
+func (r *Room) notifyHostCrosstalk(event *awsai.CrosstalkEvent) {
+	log.Printf("[Room %s] Crosstalk active=%v speakers=%v", r.ID, event.Active, event.SpeakerIDs)
+	if r.hub.db == nil {
+		return
+	}
+
+	meeting, err := r.findMeeting()
+	if err != nil {
+		log.Printf("[Room %s] Cannot notify host of crosstalk: %v", r.ID, err)
+		return
+	}
+
+	r.Broadcast(&BroadcastMessage{
+		Type:             "crosstalk_warning",
+		Data:             event,
+		TargetListenerID: fmt.Sprintf("%d", meeting.HostID),
+	})
+}
+
+// saveArchiveTranslations stores batch-translated finals for archive-only languages in Redis
+// (they have no live listeners, so nothing is broadcast)
+func (r *Room) saveArchiveTranslations(translations []*awsai.ArchiveTranslation) {
+	if len(translations) == 0 || r.hub.redisClient == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	saved := 0
+	for _, t := range translations {
+		speakerName := t.Entry.SpeakerName
+		if speakerName == "" {
+			speakerName = t.Entry.SpeakerID
+		}
+
+		transcript := &cache.RoomTranscript{
+			RoomID:      r.ID,
+			SpeakerID:   t.Entry.SpeakerID,
+			SpeakerName: speakerName,
+			Original:    t.Entry.Text,
+			Translated:  t.TranslatedText,
+			SourceLang:  t.Entry.SourceLang,
+			TargetLang:  t.TargetLang,
+			IsFinal:     true,
+			Timestamp:   t.Entry.CapturedAt,
+		}
+
+		if err := r.hub.redisClient.AddTranscript(ctx, r.ID, transcript); err != nil {
+			log.Printf("[Room %s] Failed to save archive transcript to Redis: %v", r.ID, err)
+			continue
+		}
+		saved++
+	}
+
+	log.Printf("[Room %s] 📚 Saved %d archive translations", r.ID, saved)
+}
+
 func (r *Room) handleAudio(audio *ai.AudioMessage) {
 	log.Printf("[Room %s] 🔊 Broadcasting TTS audio: speaker=%s, targetLang=%s, size=%d bytes",
 		r.ID, audio.SpeakerParticipantID, audio.TargetLanguage, len(audio.AudioData))
@@ -974,7 +1728,18 @@ func (r *Room) handleAudio(audio *ai.AudioMessage) {
 		SpeakerID:  audio.SpeakerParticipantID,
 		TargetLang: audio.TargetLanguage,
 		AudioData:  audio.AudioData,
+		VoiceKey:   audio.VoiceKey,
+
+		TranscriptID: audio.TranscriptID,
+
+		AudioFormat:     audio.Format,
+		AudioSampleRate: int(audio.SampleRate),
 	})
+
+	if rec := r.activeRecorder(); rec != nil {
+		rec.WriteTTS(audio.TargetLanguage, audio.VoiceKey, audio.Format, audio.AudioData, time.Now())
+		rec.WriteTTSArtifact(audio)
+	}
 }
 
 func (r *Room) processAudio(msg *AudioMessage) {
@@ -989,7 +1754,7 @@ func (r *Room) processAudio(msg *AudioMessage) {
 func (r *Room) processAudioAWS(msg *AudioMessage) {
 	r.mu.RLock()
 	pipeline := r.awsPipeline
-	speaker := r.Speakers[msg.SpeakerID]
+	speaker, _ := r.Speakers.Get(msg.SpeakerID)
 	r.mu.RUnlock()
 
 	if pipeline == nil {
@@ -997,9 +1762,12 @@ func (r *Room) processAudioAWS(msg *AudioMessage) {
 		return
 	}
 
-	// Speaker 정보 결정
+	// Speaker 정보 결정 (다른 인스턴스에서 전달된 오디오는 전달된 정보 사용)
 	speakerName := msg.SpeakerID
-	profileImg := ""
+	if msg.SpeakerName != "" {
+		speakerName = msg.SpeakerName
+	}
+	profileImg := msg.ProfileImg
 	if speaker != nil {
 		if speaker.Nickname != "" {
 			speakerName = speaker.Nickname
@@ -1021,7 +1789,7 @@ func (r *Room) processAudioGRPC(msg *AudioMessage) {
 	r.mu.RLock()
 	stream := r.grpcStream
 	// Speaker 정보 가져오기
-	speaker := r.Speakers[msg.SpeakerID]
+	speaker, _ := r.Speakers.Get(msg.SpeakerID)
 	r.mu.RUnlock()
 
 	if stream == nil {
@@ -1068,7 +1836,7 @@ func (h *RoomHub) CleanupInactiveRooms(maxAge time.Duration) {
 
 	for roomID, room := range h.rooms {
 		room.mu.RLock()
-		isEmpty := len(room.Listeners) == 0 && len(room.Speakers) == 0
+		isEmpty := room.Listeners.Len() == 0 && room.Speakers.Len() == 0 && room.relayListenerCount() == 0 && !room.isWarm(time.Now())
 		room.mu.RUnlock()
 
 		if isEmpty {
@@ -1084,6 +1852,10 @@ func (h *RoomHub) Close() {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
+	close(h.stopRecovery)
+	h.stopPresenceHooks()
+	h.stopWebhooks()
+
 	// Shutdown all rooms
 	for roomID, room := range h.rooms {
 		room.Shutdown()
@@ -1100,6 +1872,266 @@ func (h *RoomHub) Close() {
 	log.Printf("[RoomHub] Shutdown complete")
 }
 
+// WorkspaceTerminologyName returns the AWS Translate terminology name of a workspace
+func WorkspaceTerminologyName(workspaceID int64) string {
+	return fmt.Sprintf("eum-workspace-%d", workspaceID)
+}
+
+// LoadWorkspaceVocabulary loads a workspace's custom vocabulary/terminology settings
+func (h *RoomHub) LoadWorkspaceVocabulary(workspaceID int64) *awsai.Vocabulary {
+	if h.db == nil {
+		return nil
+	}
+
+	var vocabularies []model.WorkspaceVocabulary
+	if err := h.db.Where("workspace_id = ?", workspaceID).Find(&vocabularies).Error; err != nil {
+		log.Printf("[RoomHub] Failed to load vocabularies for workspace %d: %v", workspaceID, err)
+		return nil
+	}
+	if len(vocabularies) == 0 {
+		return nil
+	}
+
+	vocabulary := &awsai.Vocabulary{
+		TranscribeVocabularies: make(map[string]string),
+	}
+	hasPhrases := false
+	for _, v := range vocabularies {
+		if v.TranscribeVocabularyName != nil && *v.TranscribeVocabularyName != "" {
+			vocabulary.TranscribeVocabularies[v.LanguageCode] = *v.TranscribeVocabularyName
+		}
+		if len(v.PhraseList()) > 0 {
+			hasPhrases = true
+		}
+	}
+	if hasPhrases {
+		vocabulary.TerminologyName = WorkspaceTerminologyName(workspaceID)
+	}
+
+	return vocabulary
+}
+
+// RefreshWorkspaceVocabulary applies updated vocabulary to active rooms of a workspace
+func (h *RoomHub) RefreshWorkspaceVocabulary(workspaceID int64) {
+	vocabulary := h.LoadWorkspaceVocabulary(workspaceID)
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, room := range h.rooms {
+		room.mu.RLock()
+		pipeline := room.awsPipeline
+		matches := room.workspaceID == workspaceID
+		room.mu.RUnlock()
+
+		if matches && pipeline != nil {
+			pipeline.SetVocabulary(vocabulary)
+			log.Printf("[Room %s] 📖 Vocabulary refreshed", room.ID)
+		}
+	}
+}
+
+// LoadWorkspaceNoiseFilter loads a workspace's custom noise rules, per-language thresholds and
+// partial minimum lengths. Workspace values override the AI_NOISE_THRESHOLDS / AI_PARTIAL_MIN_LENGTHS
+// defaults; workspaceID 0 returns the defaults only.
+func (h *RoomHub) LoadWorkspaceNoiseFilter(workspaceID int64) ([]awsai.NoiseRule, map[string]float32, map[string]int) {
+	thresholds := make(map[string]float32)
+	partialMinLengths := make(map[string]int)
+	if tunables := h.tunables.Load(); tunables != nil {
+		thresholds = awsai.ParseLanguageThresholds(tunables.NoiseThresholds)
+		partialMinLengths = awsai.ParsePartialMinLengths(tunables.PartialMinLengths)
+	}
+	if h.db == nil || workspaceID == 0 {
+		return nil, thresholds, partialMinLengths
+	}
+
+	var rules []model.NoiseFilterRule
+	if err := h.db.Where("workspace_id = ?", workspaceID).Order("id ASC").Find(&rules).Error; err != nil {
+		log.Printf("[RoomHub] Failed to load noise filter rules for workspace %d: %v", workspaceID, err)
+	}
+	noiseRules := make([]awsai.NoiseRule, 0, len(rules))
+	for _, rule := range rules {
+		noiseRules = append(noiseRules, awsai.NoiseRule{
+			ID:       fmt.Sprintf("%d", rule.ID),
+			Language: rule.LanguageCode,
+			Pattern:  rule.Pattern,
+			Regex:    rule.IsRegex,
+		})
+	}
+
+	var overrides []model.NoiseFilterThreshold
+	if err := h.db.Where("workspace_id = ?", workspaceID).Find(&overrides).Error; err != nil {
+		log.Printf("[RoomHub] Failed to load noise filter thresholds for workspace %d: %v", workspaceID, err)
+	}
+	for _, t := range overrides {
+		if t.MinConfidence > 0 {
+			thresholds[t.LanguageCode] = t.MinConfidence
+		}
+		if t.PartialMinLength > 0 {
+			partialMinLengths[t.LanguageCode] = t.PartialMinLength
+		}
+	}
+
+	return noiseRules, thresholds, partialMinLengths
+}
+
+// RefreshWorkspaceNoiseFilter applies updated noise filter settings to active rooms of a workspace
+func (h *RoomHub) RefreshWorkspaceNoiseFilter(workspaceID int64) {
+	rules, thresholds, partialMinLengths := h.LoadWorkspaceNoiseFilter(workspaceID)
+	minTextLength := 0
+	if tunables := h.tunables.Load(); tunables != nil {
+		minTextLength = tunables.NoiseMinTextLength
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, room := range h.rooms {
+		room.mu.RLock()
+		pipeline := room.awsPipeline
+		matches := room.workspaceID == workspaceID
+		roomLengths := mergePartialMinLengths(partialMinLengths, room.partialMinLengths)
+		room.mu.RUnlock()
+
+		if matches && pipeline != nil {
+			pipeline.SetNoiseFilter(rules, thresholds)
+			pipeline.SetPartialMinLengths(roomLengths)
+			pipeline.SetMinTextLength(minTextLength)
+			log.Printf("[Room %s] 🔇 Noise filter refreshed", room.ID)
+		}
+	}
+}
+
+// ApplyTunables applies reloaded settings without restarting rooms: running pipelines get the
+// new noise filter defaults right away, the webinar worker count applies to rooms that switch
+// to webinar mode afterwards
+func (h *RoomHub) ApplyTunables(tunables config.Tunables) {
+	h.tunables.Store(&tunables)
+
+	workspaces := make(map[int64]struct{})
+	h.mu.RLock()
+	for _, room := range h.rooms {
+		room.mu.RLock()
+		if room.awsPipeline != nil {
+			workspaces[room.workspaceID] = struct{}{}
+		}
+		room.mu.RUnlock()
+	}
+	h.mu.RUnlock()
+
+	for workspaceID := range workspaces {
+		h.RefreshWorkspaceNoiseFilter(workspaceID)
+	}
+}
+
+// WorkspaceRedactsPII reports whether a workspace's compliance setting requires PII redaction
+func (h *RoomHub) WorkspaceRedactsPII(workspaceID int64) bool {
+	if h.db == nil || workspaceID == 0 {
+		return false
+	}
+
+	var compliance model.WorkspaceCompliance
+	if err := h.db.Where("workspace_id = ?", workspaceID).Limit(1).Find(&compliance).Error; err != nil {
+		log.Printf("[RoomHub] Failed to load compliance settings for workspace %d: %v", workspaceID, err)
+		return false
+	}
+	return compliance.RedactPII
+}
+
+// RefreshWorkspaceCompliance applies updated compliance settings to active rooms of a workspace
+func (h *RoomHub) RefreshWorkspaceCompliance(workspaceID int64) {
+	redactPII := h.WorkspaceRedactsPII(workspaceID)
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, room := range h.rooms {
+		room.mu.RLock()
+		pipeline := room.awsPipeline
+		matches := room.workspaceID == workspaceID
+		room.mu.RUnlock()
+
+		if matches && pipeline != nil {
+			pipeline.SetPIIRedaction(redactPII)
+			log.Printf("[Room %s] 🔒 Compliance settings refreshed (redactPII=%v)", room.ID, redactPII)
+		}
+	}
+}
+
+// pipelineCacheConfig returns the translation/TTS cache settings, with Redis as the shared tier when enabled
+func (h *RoomHub) pipelineCacheConfig() *awsai.CacheConfig {
+	cacheCfg := awsai.DefaultCacheConfig()
+	cacheCfg.MaxTranslationEntries = h.cfg.AI.CacheMaxTranslations
+	cacheCfg.MaxTTSBytes = h.cfg.AI.CacheMaxTTSBytes
+	if h.redisClient != nil && h.cfg.AI.SharedCacheEnabled {
+		cacheCfg.Shared = h.redisClient
+		cacheCfg.SharedTTL = h.cfg.AI.SharedCacheTTL
+		cacheCfg.SharedMaxBytes = h.cfg.AI.SharedCacheMaxBytes
+	}
+	return cacheCfg
+}
+
+// NewTranslationCache returns a translation cache with the pipeline's settings (for translating outside rooms, e.g. chat)
+func (h *RoomHub) NewTranslationCache() *awsai.PipelineCache {
+	return awsai.NewPipelineCache(h.pipelineCacheConfig())
+}
+
+// GetTranslateClient returns the shared Translate client (nil if AWS is not used)
+func (h *RoomHub) GetTranslateClient() *awsai.TranslateClient {
+	if h.awsClientPool == nil {
+		return nil
+	}
+	return h.awsClientPool.Translate
+}
+
+// GetCacheStats returns translation/TTS cache metrics per active room and aggregated since startup
+func (h *RoomHub) GetCacheStats() map[string]interface{} {
+	h.mu.RLock()
+	rooms := make(map[string]*awsai.CacheMetrics, len(h.rooms))
+	sizes := make(map[string]awsai.CacheStats, len(h.rooms))
+	for id, room := range h.rooms {
+		room.mu.RLock()
+		pipeline := room.awsPipeline
+		room.mu.RUnlock()
+		if pipeline != nil {
+			rooms[id] = pipeline.CacheMetrics()
+			sizes[id] = pipeline.CacheStats()
+		}
+	}
+	h.mu.RUnlock()
+
+	return map[string]interface{}{
+		"global": awsai.GlobalCacheMetrics(),
+		"rooms":  rooms,
+		"sizes":  sizes,
+	}
+}
+
+// collectMetrics refreshes per-room gauges before a /api/admin/metrics scrape
+func (h *RoomHub) collectMetrics() {
+	metrics.RoomListeners.Reset()
+	metrics.ActiveStreams.Reset()
+	metrics.BackpressureLevel.Reset()
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	metrics.ActiveRooms.Set(float64(len(h.rooms)))
+	for id, room := range h.rooms {
+		room.mu.RLock()
+		listeners := room.Listeners.Len()
+		pipeline := room.awsPipeline
+		room.mu.RUnlock()
+
+		metrics.RoomListeners.Set(float64(listeners), id)
+		if pipeline != nil {
+			health := pipeline.GetHealth()
+			metrics.ActiveStreams.Set(float64(health.ActiveStreams), id)
+			metrics.BackpressureLevel.Set(health.BackpressureLevel, id)
+		}
+	}
+}
+
 // GetClientPoolStats returns statistics about the shared AWS client pool
 func (h *RoomHub) GetClientPoolStats() map[string]interface{} {
 	if h.awsClientPool == nil {
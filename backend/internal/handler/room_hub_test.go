@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	fastws "github.com/fasthttp/websocket"
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Run with -race: listeners join and leave over real sockets while the room keeps broadcasting
+func TestRoomConcurrentListenersAndBroadcast(t *testing.T) {
+	const clients, rounds = 8, 10
+
+	hub := NewRoomHub(nil, nil, false, nil)
+	room := hub.GetOrCreateRoom("race-test")
+
+	var handlers sync.WaitGroup
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	app.Get("/ws", websocket.New(func(c *websocket.Conn) {
+		defer handlers.Done()
+		listenerID := c.Query("listenerId")
+		room.AddListener(listenerID, "en", ClientCapabilities{}, c, nil)
+		for {
+			if _, _, err := c.ReadMessage(); err != nil {
+				break
+			}
+		}
+		room.RemoveListener(listenerID)
+	}))
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	go func() { _ = app.Listener(ln) }()
+	defer func() { _ = app.Shutdown() }()
+
+	stop := make(chan struct{})
+	var broadcasters sync.WaitGroup
+	broadcasters.Add(1)
+	go func() {
+		defer broadcasters.Done()
+		for i := 0; ; i++ {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			room.Broadcast(&BroadcastMessage{Type: "transcript", Data: map[string]int{"seq": i}})
+			_ = room.Roster()
+			time.Sleep(time.Millisecond)
+		}
+	}()
+
+	url := fmt.Sprintf("ws://%s/ws", ln.Addr())
+	var clientWG sync.WaitGroup
+	for i := 0; i < clients; i++ {
+		clientWG.Add(1)
+		go func(i int) {
+			defer clientWG.Done()
+			for round := 0; round < rounds; round++ {
+				handlers.Add(1)
+				conn, _, err := fastws.DefaultDialer.Dial(fmt.Sprintf("%s?listenerId=listener-%d-%d", url, i, round), nil)
+				if err != nil {
+					handlers.Done()
+					t.Errorf("dial: %v", err)
+					return
+				}
+				// Read a few broadcasts, then leave
+				_ = conn.SetReadDeadline(time.Now().Add(20 * time.Millisecond))
+				for {
+					if _, _, err := conn.ReadMessage(); err != nil {
+						break
+					}
+				}
+				_ = conn.Close()
+			}
+		}(i)
+	}
+	clientWG.Wait()
+	handlers.Wait()
+	close(stop)
+	broadcasters.Wait()
+
+	if n := room.Listeners.Len(); n != 0 {
+		t.Fatalf("room still has %d listeners after every client left: %v", n, room.Listeners.IDs())
+	}
+}
@@ -0,0 +1,314 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/config"
+	"realtime-backend/internal/model"
+)
+
+// Room 웹훅 발송 설정
+const (
+	roomWebhookTimeout  = 10 * time.Second
+	roomWebhookAttempts = 3
+	roomWebhookBackoff  = 2 * time.Second // 재시도마다 두 배
+)
+
+var roomWebhookClient = &http.Client{Timeout: roomWebhookTimeout}
+
+// RoomWebhookPayload 웹훅 요청 본문
+type RoomWebhookPayload struct {
+	Event         string    `json:"event"`
+	MeetingID     int64     `json:"meeting_id"`
+	RoomID        string    `json:"room_id"`
+	Code          string    `json:"code"`
+	ParticipantID string    `json:"participant_id"`
+	OccurredAt    time.Time `json:"occurred_at"`
+}
+
+// =============================================================================
+// RoomHub - first-join webhook
+// =============================================================================
+
+// NotifyFirstJoin 미리 만든 Room에 첫 참가자가 들어오면 등록된 웹훅 발송 (웹훅당 한 번)
+// 여러 서버나 Room 재생성으로 중복 호출되어도 fired_at을 먼저 선점한 쪽만 발송
+func (h *RoomHub) NotifyFirstJoin(roomID, participantID string) {
+	if h.db == nil {
+		return
+	}
+	if _, _, ok := ParseBreakoutRoomID(roomID); ok {
+		return
+	}
+
+	meeting, err := FindMeetingByRoomID(h.db, roomID)
+	if err != nil {
+		return
+	}
+
+	var webhooks []model.RoomWebhook
+	if err := h.db.Where("meeting_id = ? AND event = ? AND fired_at IS NULL", meeting.ID, model.RoomWebhookEventFirstJoin).
+		Find(&webhooks).Error; err != nil {
+		log.Printf("[Room %s] Failed to load webhooks: %v", roomID, err)
+		return
+	}
+
+	now := time.Now()
+	for _, webhook := range webhooks {
+		result := h.db.Model(&model.RoomWebhook{}).
+			Where("id = ? AND fired_at IS NULL", webhook.ID).
+			Update("fired_at", now)
+		if result.Error != nil || result.RowsAffected == 0 {
+			continue
+		}
+
+		payload := RoomWebhookPayload{
+			Event:         webhook.Event,
+			MeetingID:     meeting.ID,
+			RoomID:        roomID,
+			Code:          meeting.Code,
+			ParticipantID: participantID,
+			OccurredAt:    now,
+		}
+		h.deliverRoomWebhook(webhook, payload)
+	}
+}
+
+// deliverRoomWebhook 웹훅 POST (5xx/네트워크 오류는 재시도) 후 결과 기록
+func (h *RoomHub) deliverRoomWebhook(webhook model.RoomWebhook, payload RoomWebhookPayload) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+
+	var status int
+	backoff := roomWebhookBackoff
+	for attempt := 1; attempt <= roomWebhookAttempts; attempt++ {
+		status, err = postRoomWebhook(webhook, body)
+		h.db.Model(&model.RoomWebhook{}).Where("id = ?", webhook.ID).Updates(map[string]any{
+			"attempts":    attempt,
+			"last_status": status,
+			"last_error":  errorString(err),
+		})
+		if err == nil {
+			log.Printf("[Room %s] 🔔 Webhook %d delivered (%s, status %d)", payload.RoomID, webhook.ID, webhook.Event, status)
+			return
+		}
+		if status >= 400 && status < 500 {
+			break
+		}
+		if attempt < roomWebhookAttempts {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+	}
+	log.Printf("[Room %s] ❌ Webhook %d failed (%s): %v", payload.RoomID, webhook.ID, webhook.Event, err)
+}
+
+// postRoomWebhook 서명한 요청 한 번 전송 (2xx가 아니면 에러)
+func postRoomWebhook(webhook model.RoomWebhook, body []byte) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), roomWebhookTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Eum-Event", webhook.Event)
+	if webhook.Secret != "" {
+		req.Header.Set("X-Eum-Signature", "sha256="+signRoomWebhook(webhook.Secret, body))
+	}
+
+	resp, err := roomWebhookClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// signRoomWebhook 본문 HMAC-SHA256 (hex)
+func signRoomWebhook(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// =============================================================================
+// Room provisioning API
+// =============================================================================
+
+// RoomProvisionHandler 외부 시스템(캘린더/LMS)용 Room 사전 생성 핸들러
+type RoomProvisionHandler struct {
+	db  *gorm.DB
+	cfg *config.Config
+}
+
+// NewRoomProvisionHandler RoomProvisionHandler 생성
+func NewRoomProvisionHandler(db *gorm.DB, cfg *config.Config) *RoomProvisionHandler {
+	return &RoomProvisionHandler{db: db, cfg: cfg}
+}
+
+// ProvisionRoomRequest Room 사전 생성 요청
+type ProvisionRoomRequest struct {
+	Title         string `json:"title"`
+	Type          string `json:"type"`                     // VIDEO, VOICE_ONLY, WEBINAR
+	ChatModerated bool   `json:"chat_moderated,omitempty"` // 웨비나 채팅 검토 (WEBINAR에서만 적용)
+	WebhookURL    string `json:"webhook_url,omitempty"`    // 첫 참가자 입장 시 호출 (http/https)
+	WebhookSecret string `json:"webhook_secret,omitempty"` // 있으면 X-Eum-Signature로 서명
+}
+
+// ProvisionRoomResponse 생성된 Room과 참가 링크
+type ProvisionRoomResponse struct {
+	MeetingID int64              `json:"meeting_id"`
+	Code      string             `json:"code"`
+	RoomID    string             `json:"room_id"`
+	JoinURL   string             `json:"join_url"`    // 브라우저 참가 링크
+	RoomWSURL string             `json:"room_ws_url"` // 번역 WebSocket (listenerId, targetLang 쿼리는 클라이언트가 추가)
+	Webhook   *model.RoomWebhook `json:"webhook,omitempty"`
+}
+
+// ProvisionRoom 회의 Room을 미리 만들고 참가 링크 반환 (워크스페이스 멤버 전용)
+// webhook_url을 주면 첫 참가자가 들어올 때 한 번 호출되어 외부 시스템이 폴링하지 않아도 됨
+func (h *RoomProvisionHandler) ProvisionRoom(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	var count int64
+	h.db.Model(&model.WorkspaceMember{}).
+		Where("workspace_id = ? AND user_id = ? AND status = ?", workspaceID, claims.UserID, model.MemberStatusActive.String()).
+		Count(&count)
+	if count == 0 {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you are not a member of this workspace",
+		})
+	}
+
+	var req ProvisionRoomRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	req.Title = sanitizeString(req.Title)
+	if req.Title == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "title is required",
+		})
+	}
+	if len(req.Title) > 200 {
+		req.Title = req.Title[:200]
+	}
+
+	switch req.Type {
+	case "":
+		req.Type = "VIDEO"
+	case "VIDEO", "VOICE_ONLY", model.MeetingTypeWebinar.String():
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "type must be VIDEO, VOICE_ONLY or WEBINAR",
+		})
+	}
+
+	if req.WebhookURL != "" {
+		u, err := url.Parse(req.WebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || len(req.WebhookURL) > 500 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "webhook_url must be an absolute http(s) URL",
+			})
+		}
+	}
+	if len(req.WebhookSecret) > 100 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "webhook_secret must be at most 100 characters",
+		})
+	}
+
+	code, err := generateSecureMeetingCode()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to generate meeting code",
+		})
+	}
+
+	wsID := int64(workspaceID)
+	meeting := model.Meeting{
+		WorkspaceID: &wsID,
+		HostID:      claims.UserID,
+		Title:       req.Title,
+		Code:        code,
+		Type:        req.Type,
+		Status:      "SCHEDULED",
+
+		ChatModerated: req.ChatModerated && req.Type == model.MeetingTypeWebinar.String(),
+	}
+	var webhook *model.RoomWebhook
+
+	err = h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&meeting).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&model.Participant{
+			MeetingID: meeting.ID,
+			UserID:    &claims.UserID,
+			Role:      "HOST",
+		}).Error; err != nil {
+			return err
+		}
+		if req.WebhookURL == "" {
+			return nil
+		}
+		webhook = &model.RoomWebhook{
+			MeetingID: meeting.ID,
+			Event:     model.RoomWebhookEventFirstJoin,
+			URL:       req.WebhookURL,
+			Secret:    req.WebhookSecret,
+		}
+		return tx.Create(webhook).Error
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to create room",
+		})
+	}
+
+	roomID := fmt.Sprintf("meeting-%d", meeting.ID)
+	return c.Status(fiber.StatusCreated).JSON(ProvisionRoomResponse{
+		MeetingID: meeting.ID,
+		Code:      meeting.Code,
+		RoomID:    roomID,
+		JoinURL:   fmt.Sprintf("%s/workspace/%d?meeting=%s", h.cfg.Server.AppURL, workspaceID, url.QueryEscape(meeting.Code)),
+		RoomWSURL: fmt.Sprintf("%s/ws/room?roomId=%s", h.cfg.Server.PublicWSURL, url.QueryEscape(roomID)),
+		Webhook:   webhook,
+	})
+}
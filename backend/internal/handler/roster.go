@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"sort"
+)
+
+// 참가자 목록 이벤트 종류 (roster 메시지의 event)
+const (
+	RosterJoined          = "joined"           // 리스너 입장 또는 화자 등록
+	RosterLeft            = "left"             // 리스너 퇴장 또는 화자 해제
+	RosterLanguageChanged = "language_changed" // 리스너 번역 언어 또는 화자 발화 언어 변경
+)
+
+// RosterParticipant 참가자 목록 항목 (Listeners/Speakers 맵과 화자 정보로 구성)
+// 리스너이면서 화자인 참가자는 한 항목으로 합쳐짐
+type RosterParticipant struct {
+	ID         string `json:"id"`
+	Nickname   string `json:"nickname,omitempty"`
+	ProfileImg string `json:"profileImg,omitempty"`
+	Listening  bool   `json:"listening"`            // 이 Room의 WebSocket에 접속 중
+	TargetLang string `json:"targetLang,omitempty"` // 리스너 번역 언어
+	Speaking   bool   `json:"speaking"`             // 오디오가 들어와 화자로 등록됨
+	SourceLang string `json:"sourceLang,omitempty"` // 화자 발화 언어
+	Muted      bool   `json:"muted"`                // 호스트가 음소거함
+}
+
+// RosterEvent 참가자 입장/퇴장/언어 변경 시 브로드캐스트되는 이벤트
+type RosterEvent struct {
+	Event       string            `json:"event"`
+	Participant RosterParticipant `json:"participant"`
+}
+
+// Roster 현재 참가자 목록
+type Roster struct {
+	RoomID         string              `json:"roomId"`
+	Participants   []RosterParticipant `json:"participants"`
+	RelayListeners int                 `json:"relayListeners"`      // 엣지 릴레이로 접속한 리스너 수 (개별 정보 없음)
+	Attendees      int                 `json:"attendees,omitempty"` // 웨비나 청취 전용 참석자 수 (개별 정보 없음)
+}
+
+// =============================================================================
+// RoomHub - roster lookup
+// =============================================================================
+
+// GetRoom 이 인스턴스에서 활성 중인 Room (없으면 nil, 새로 만들지 않음)
+func (h *RoomHub) GetRoom(roomID string) *Room {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.rooms[roomID]
+}
+
+// =============================================================================
+// Room Methods - Roster
+// =============================================================================
+
+// Roster 현재 참가자 목록 (닉네임, ID 순, 웨비나 참석자는 수만)
+func (r *Room) Roster() Roster {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	roster := Roster{
+		RoomID:         r.ID,
+		RelayListeners: r.relayListenerCount(),
+	}
+	ids := make(map[string]bool, r.Speakers.Len())
+	for id := range r.Listeners.Snapshot() {
+		if r.isWebinarAttendee(id) {
+			roster.Attendees++
+			continue
+		}
+		ids[id] = true
+	}
+	for id := range r.Speakers.Snapshot() {
+		ids[id] = true
+	}
+
+	roster.Participants = make([]RosterParticipant, 0, len(ids))
+	for id := range ids {
+		roster.Participants = append(roster.Participants, r.rosterParticipant(id))
+	}
+	sort.Slice(roster.Participants, func(i, j int) bool {
+		a, b := roster.Participants[i], roster.Participants[j]
+		if a.Nickname != b.Nickname {
+			return a.Nickname < b.Nickname
+		}
+		return a.ID < b.ID
+	})
+	return roster
+}
+
+// rosterParticipant 참가자 한 명의 목록 항목 (r.mu 보유 상태에서 호출)
+func (r *Room) rosterParticipant(id string) RosterParticipant {
+	p := RosterParticipant{ID: id, Muted: r.moderation.muted[id]}
+	if listener, ok := r.Listeners.Get(id); ok {
+		p.Listening = true
+		p.TargetLang = listener.TargetLang
+	}
+	if speaker, ok := r.Speakers.Get(id); ok {
+		p.Speaking = true
+		p.SourceLang = speaker.SourceLang
+		p.Nickname = speaker.Nickname
+		p.ProfileImg = speaker.ProfileImg
+	}
+	return p
+}
+
+// broadcastRoster 참가자 목록 변경 이벤트 전송 (r.mu 보유 상태에서 호출, Broadcast는 대기하지 않음)
+func (r *Room) broadcastRoster(event, participantID string) {
+	r.Broadcast(&BroadcastMessage{
+		Type: "roster",
+		Data: RosterEvent{Event: event, Participant: r.rosterParticipant(participantID)},
+	})
+}
+
+// SendRoster 새로 접속한 참가자에게 현재 참가자 목록 전송
+func (r *Room) SendRoster(listenerID string) {
+	r.Broadcast(&BroadcastMessage{
+		Type:             "roster_state",
+		Data:             r.Roster(),
+		TargetListenerID: listenerID,
+	})
+}
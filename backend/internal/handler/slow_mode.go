@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	awsai "realtime-backend/internal/aws"
+)
+
+// ErrNotSlowModeHost 호스트가 아닌 참가자가 슬로 모드 변경 시도
+var ErrNotSlowModeHost = errors.New("only the host can change slow mode")
+
+// SlowModeState 모든 참가자에게 브로드캐스트되는 슬로 모드 상태
+type SlowModeState struct {
+	Enabled           bool  `json:"enabled"`
+	PartialIntervalMs int64 `json:"partialIntervalMs"` // 화자별 partial 자막 최소 간격
+}
+
+// =============================================================================
+// Room Methods - Slow mode
+// =============================================================================
+
+// SetSlowMode 슬로 모드 켜기/끄기 (호스트 전용)
+// 켜면 partial 자막을 화자별 간격마다 하나로 합치고, TTS는 발화가 끝났을 때 한 번만 재생
+func (r *Room) SetSlowMode(hostID string, enabled bool) error {
+	if !r.isModerator(hostID) {
+		return ErrNotSlowModeHost
+	}
+
+	r.mu.Lock()
+	changed := r.slowMode != enabled
+	r.slowMode = enabled
+	pipeline := r.awsPipeline
+	r.mu.Unlock()
+
+	if !changed {
+		return nil
+	}
+	if pipeline != nil {
+		pipeline.SetSlowMode(enabled, r.slowModeInterval())
+	}
+	log.Printf("[Room %s] 🐢 Slow mode enabled=%v (host: %s)", r.ID, enabled, hostID)
+
+	r.Broadcast(&BroadcastMessage{
+		Type: "slow_mode",
+		Data: r.slowModeState(),
+	})
+	return nil
+}
+
+// SlowModeEnabled 슬로 모드 여부
+func (r *Room) SlowModeEnabled() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.slowMode
+}
+
+// slowModeInterval 슬로 모드 partial 간격 (설정값, 없으면 기본값)
+func (r *Room) slowModeInterval() time.Duration {
+	if r.hub.cfg != nil && r.hub.cfg.AI.SlowModePartialInterval > 0 {
+		return r.hub.cfg.AI.SlowModePartialInterval
+	}
+	return awsai.DefaultSlowModePartialInterval
+}
+
+func (r *Room) slowModeState() SlowModeState {
+	return SlowModeState{
+		Enabled:           r.SlowModeEnabled(),
+		PartialIntervalMs: r.slowModeInterval().Milliseconds(),
+	}
+}
+
+// SendSlowModeState 새로 접속한 참가자에게 슬로 모드 상태 전송 (꺼져 있으면 생략)
+func (r *Room) SendSlowModeState(listenerID string) {
+	if !r.SlowModeEnabled() {
+		return
+	}
+	r.Broadcast(&BroadcastMessage{
+		Type:             "slow_mode",
+		Data:             r.slowModeState(),
+		TargetListenerID: listenerID,
+	})
+}
+
+// sendSlowModeError 요청한 참가자에게 슬로 모드 설정 오류 전송
+func (r *Room) sendSlowModeError(listenerID string, err error) {
+	r.Broadcast(&BroadcastMessage{
+		Type:             "slow_mode_error",
+		Data:             map[string]string{"message": err.Error()},
+		TargetListenerID: listenerID,
+	})
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"log"
+	"sync"
+
+	"realtime-backend/internal/codec"
+)
+
+// speakerSampleRate Transcribe로 보내는 화자 PCM 샘플레이트
+const speakerSampleRate = 16000
+
+// speakerDecoder 화자 업링크 오디오 디코더 (같은 화자 프레임은 순서대로 디코딩)
+type speakerDecoder struct {
+	codec  string
+	dec    codec.Decoder
+	errors int
+	mu     sync.Mutex
+}
+
+// =============================================================================
+// Room Methods - Inbound speaker codec
+// =============================================================================
+
+// SetSpeakerCodec 화자가 보내는 오디오 코덱 설정 ("pcm" 기본, "opus"는 서버가 libopus로 빌드된 경우)
+// Opus 프레임은 서버에서 PCM으로 디코딩한 뒤 Transcribe와 원음 릴레이로 전달
+func (r *Room) SetSpeakerCodec(speakerID, name string) error {
+	name = codec.Normalize(name)
+	dec, err := codec.NewDecoder(name, speakerSampleRate)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	old := r.speakerDecoders[speakerID]
+	if dec == nil {
+		delete(r.speakerDecoders, speakerID)
+	} else {
+		if r.speakerDecoders == nil {
+			r.speakerDecoders = make(map[string]*speakerDecoder)
+		}
+		r.speakerDecoders[speakerID] = &speakerDecoder{codec: name, dec: dec}
+	}
+	r.mu.Unlock()
+
+	if old != nil {
+		old.close()
+	}
+	log.Printf("[Room %s] 🎙️ Speaker %s audio codec: %s", r.ID, speakerID, name)
+	return nil
+}
+
+// decodeSpeakerAudio 화자 코덱에 맞게 프레임을 PCM으로 변환 (PCM 화자는 그대로, 디코딩 실패 시 false)
+func (r *Room) decodeSpeakerAudio(speakerID string, frame []byte) ([]byte, bool) {
+	r.mu.RLock()
+	sd := r.speakerDecoders[speakerID]
+	r.mu.RUnlock()
+	if sd == nil {
+		return frame, true
+	}
+
+	sd.mu.Lock()
+	defer sd.mu.Unlock()
+	if sd.dec == nil {
+		return nil, false
+	}
+
+	pcm, err := sd.dec.Decode(frame)
+	if err != nil {
+		// 손상된 패킷이 이어질 때 로그 폭주 방지
+		if sd.errors++; sd.errors == 1 || sd.errors%100 == 0 {
+			log.Printf("[Room %s] Failed to decode %s audio from %s (%d errors): %v", r.ID, sd.codec, speakerID, sd.errors, err)
+		}
+		return nil, false
+	}
+	return pcm, len(pcm) > 0
+}
+
+// removeSpeakerDecoder 화자 디코더 해제
+func (r *Room) removeSpeakerDecoder(speakerID string) {
+	r.mu.Lock()
+	sd := r.speakerDecoders[speakerID]
+	delete(r.speakerDecoders, speakerID)
+	r.mu.Unlock()
+
+	if sd != nil {
+		sd.close()
+	}
+}
+
+// closeSpeakerDecoders Room 종료 시 모든 디코더 해제
+func (r *Room) closeSpeakerDecoders() {
+	r.mu.Lock()
+	decoders := r.speakerDecoders
+	r.speakerDecoders = nil
+	r.mu.Unlock()
+
+	for _, sd := range decoders {
+		sd.close()
+	}
+}
+
+// sendSpeakerCodecError 요청한 참가자에게 코덱 설정 오류 전송 (클라이언트는 PCM으로 폴백)
+func (r *Room) sendSpeakerCodecError(listenerID, name string, err error) {
+	r.Broadcast(&BroadcastMessage{
+		Type:             "codec_error",
+		Data:             map[string]any{"codec": name, "message": err.Error(), "supported": codec.SupportedList()},
+		TargetListenerID: listenerID,
+	})
+}
+
+func (sd *speakerDecoder) close() {
+	sd.mu.Lock()
+	defer sd.mu.Unlock()
+	if sd.dec != nil {
+		sd.dec.Close()
+		sd.dec = nil
+	}
+}
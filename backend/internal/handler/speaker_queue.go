@@ -0,0 +1,326 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 발언 대기열 설정
+const (
+	defaultSpeakerSlots      = 3                // 동시에 발언권을 가질 수 있는 참가자 수 (호스트/공동 호스트 제외)
+	speakerQueueModeratorTTL = 30 * time.Second // 호스트/공동 호스트 여부 캐시 유지 시간 (오디오 프레임마다 DB 조회 방지)
+)
+
+var (
+	ErrNotRoomHost        = errors.New("only the host or a co-host can manage the speaker queue")
+	ErrSpeakerQueueOff    = errors.New("speaker queue is not enabled")
+	ErrSpeakerSlotsFull   = errors.New("all speaker slots are in use")
+	ErrNotInSpeakerQueue  = errors.New("participant has not raised a hand")
+	ErrSpeakerNotGranted  = errors.New("participant does not have the floor")
+	ErrAlreadyHandRaised  = errors.New("hand already raised")
+	ErrAlreadyHasTheFloor = errors.New("participant already has the floor")
+)
+
+// HandRaise 발언 요청
+type HandRaise struct {
+	ParticipantID string    `json:"participantId"`
+	Nickname      string    `json:"nickname,omitempty"`
+	RaisedAt      time.Time `json:"raisedAt"`
+}
+
+// SpeakerQueueState 모든 참가자에게 브로드캐스트되는 대기열 상태
+type SpeakerQueueState struct {
+	Enabled bool        `json:"enabled"`
+	Slots   int         `json:"slots"`
+	Queue   []HandRaise `json:"queue"`
+	Granted []string    `json:"granted"`
+}
+
+// SpeakerQueue Room 발언 대기열 (손들기)
+// 활성화되면 호스트/공동 호스트와 발언권을 받은 참가자의 오디오만 Transcribe 스트림으로 전달됨.
+// 발언권 수는 Transcribe 슬롯 수(slots)로 제한
+type SpeakerQueue struct {
+	enabled    bool
+	slots      int
+	queue      []HandRaise
+	granted    map[string]time.Time
+	moderators map[string]moderatorCheck
+
+	droppedFrames int64
+	mu            sync.Mutex
+}
+
+// NewSpeakerQueue SpeakerQueue 생성 (slots <= 0이면 기본값)
+func NewSpeakerQueue(slots int) *SpeakerQueue {
+	if slots <= 0 {
+		slots = defaultSpeakerSlots
+	}
+	return &SpeakerQueue{
+		slots:      slots,
+		granted:    make(map[string]time.Time),
+		moderators: make(map[string]moderatorCheck),
+	}
+}
+
+// moderatorCheck 참가자의 호스트/공동 호스트 여부 캐시 항목
+type moderatorCheck struct {
+	ok        bool
+	checkedAt time.Time
+}
+
+// CanSpeak 해당 참가자의 오디오를 받을지 여부 (대기열 비활성 시 모두 허용, 호스트/공동 호스트는 항상 허용)
+func (q *SpeakerQueue) CanSpeak(participantID string, isModerator func(string) bool) bool {
+	q.mu.Lock()
+	if !q.enabled {
+		q.mu.Unlock()
+		return true
+	}
+	if _, ok := q.granted[participantID]; ok {
+		q.mu.Unlock()
+		return true
+	}
+	if c, ok := q.moderators[participantID]; ok && time.Since(c.checkedAt) < speakerQueueModeratorTTL {
+		q.mu.Unlock()
+		return c.ok
+	}
+	q.mu.Unlock()
+
+	// DB 조회는 잠금 밖에서 수행
+	ok := isModerator(participantID)
+	q.mu.Lock()
+	q.moderators[participantID] = moderatorCheck{ok: ok, checkedAt: time.Now()}
+	q.mu.Unlock()
+	return ok
+}
+
+// State 현재 대기열 상태
+func (q *SpeakerQueue) State() SpeakerQueueState {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	state := SpeakerQueueState{
+		Enabled: q.enabled,
+		Slots:   q.slots,
+		Queue:   append([]HandRaise{}, q.queue...),
+		Granted: make([]string, 0, len(q.granted)),
+	}
+	for id := range q.granted {
+		state.Granted = append(state.Granted, id)
+	}
+	sort.Slice(state.Granted, func(i, j int) bool {
+		return q.granted[state.Granted[i]].Before(q.granted[state.Granted[j]])
+	})
+	return state
+}
+
+func (q *SpeakerQueue) queueIndex(participantID string) int {
+	for i, h := range q.queue {
+		if h.ParticipantID == participantID {
+			return i
+		}
+	}
+	return -1
+}
+
+// =============================================================================
+// Room Methods - Speaker Queue
+// =============================================================================
+
+// SetSpeakerQueueMode 발언 대기열 활성화/비활성화 (호스트 전용, 비활성화 시 대기열/발언권 초기화)
+func (r *Room) SetSpeakerQueueMode(listenerID string, enabled bool) error {
+	if !r.isModerator(listenerID) {
+		return ErrNotRoomHost
+	}
+
+	q := r.speakerQueue
+	q.mu.Lock()
+	q.enabled = enabled
+	q.queue = nil
+	q.granted = make(map[string]time.Time)
+	q.moderators = make(map[string]moderatorCheck)
+	q.mu.Unlock()
+
+	log.Printf("[Room %s] ✋ Speaker queue enabled=%v (host: %s)", r.ID, enabled, listenerID)
+	r.broadcastSpeakerQueue()
+	return nil
+}
+
+// RaiseHand 발언 요청 (대기열 끝에 추가)
+func (r *Room) RaiseHand(participantID, nickname string) error {
+	if nickname == "" {
+		r.mu.RLock()
+		if speaker, _ := r.Speakers.Get(participantID); speaker != nil {
+			nickname = speaker.Nickname
+		}
+		r.mu.RUnlock()
+	}
+
+	if r.isModerator(participantID) {
+		return ErrAlreadyHasTheFloor
+	}
+
+	q := r.speakerQueue
+	q.mu.Lock()
+	if !q.enabled {
+		q.mu.Unlock()
+		return ErrSpeakerQueueOff
+	}
+	if _, ok := q.granted[participantID]; ok {
+		q.mu.Unlock()
+		return ErrAlreadyHasTheFloor
+	}
+	if q.queueIndex(participantID) >= 0 {
+		q.mu.Unlock()
+		return ErrAlreadyHandRaised
+	}
+	q.queue = append(q.queue, HandRaise{
+		ParticipantID: participantID,
+		Nickname:      nickname,
+		RaisedAt:      time.Now(),
+	})
+	q.mu.Unlock()
+
+	log.Printf("[Room %s] ✋ Hand raised: %s", r.ID, participantID)
+	r.broadcastSpeakerQueue()
+	return nil
+}
+
+// LowerHand 발언 요청 취소
+func (r *Room) LowerHand(participantID string) error {
+	q := r.speakerQueue
+	q.mu.Lock()
+	i := q.queueIndex(participantID)
+	if i < 0 {
+		q.mu.Unlock()
+		return ErrNotInSpeakerQueue
+	}
+	q.queue = append(q.queue[:i], q.queue[i+1:]...)
+	q.mu.Unlock()
+
+	r.broadcastSpeakerQueue()
+	return nil
+}
+
+// GrantSpeaker 발언권 부여 (호스트 전용, 빈 Transcribe 슬롯이 있어야 함)
+func (r *Room) GrantSpeaker(hostID, participantID string) error {
+	if !r.isModerator(hostID) {
+		return ErrNotRoomHost
+	}
+
+	q := r.speakerQueue
+	q.mu.Lock()
+	if !q.enabled {
+		q.mu.Unlock()
+		return ErrSpeakerQueueOff
+	}
+	if _, ok := q.granted[participantID]; ok {
+		q.mu.Unlock()
+		return ErrAlreadyHasTheFloor
+	}
+	i := q.queueIndex(participantID)
+	if i < 0 {
+		q.mu.Unlock()
+		return ErrNotInSpeakerQueue
+	}
+	if len(q.granted) >= q.slots {
+		q.mu.Unlock()
+		return ErrSpeakerSlotsFull
+	}
+	q.queue = append(q.queue[:i], q.queue[i+1:]...)
+	q.granted[participantID] = time.Now()
+	q.mu.Unlock()
+
+	log.Printf("[Room %s] 🎙️ Floor granted to %s", r.ID, participantID)
+	r.broadcastSpeakerQueue()
+	return nil
+}
+
+// RevokeSpeaker 발언권 회수 (호스트 또는 본인), Transcribe 스트림을 닫아 슬롯 반환
+func (r *Room) RevokeSpeaker(requesterID, participantID string) error {
+	if requesterID != participantID && !r.isModerator(requesterID) {
+		return ErrNotRoomHost
+	}
+
+	q := r.speakerQueue
+	q.mu.Lock()
+	if _, ok := q.granted[participantID]; !ok {
+		q.mu.Unlock()
+		return ErrSpeakerNotGranted
+	}
+	delete(q.granted, participantID)
+	q.mu.Unlock()
+
+	r.RemoveSpeaker(participantID)
+	log.Printf("[Room %s] 🔇 Floor revoked from %s", r.ID, participantID)
+	r.broadcastSpeakerQueue()
+	return nil
+}
+
+// leaveSpeakerQueue 연결이 끊긴 참가자를 대기열/발언권에서 제거
+func (r *Room) leaveSpeakerQueue(participantID string) {
+	q := r.speakerQueue
+	q.mu.Lock()
+	if !q.enabled {
+		q.mu.Unlock()
+		return
+	}
+	changed := false
+	if i := q.queueIndex(participantID); i >= 0 {
+		q.queue = append(q.queue[:i], q.queue[i+1:]...)
+		changed = true
+	}
+	if _, ok := q.granted[participantID]; ok {
+		delete(q.granted, participantID)
+		changed = true
+	}
+	q.mu.Unlock()
+
+	if changed {
+		r.broadcastSpeakerQueue()
+	}
+}
+
+// acceptsAudioFrom 대기열이 활성화된 경우 발언권 없는 참가자의 오디오 차단
+func (r *Room) acceptsAudioFrom(speakerID string) bool {
+	if r.speakerQueue.CanSpeak(speakerID, r.isModerator) {
+		return true
+	}
+	if n := atomic.AddInt64(&r.speakerQueue.droppedFrames, 1); n%500 == 1 {
+		log.Printf("[Room %s] Dropping audio from %s (no floor, %d frames dropped)", r.ID, speakerID, n)
+	}
+	return false
+}
+
+// broadcastSpeakerQueue 대기열 상태를 모든 참가자에게 전송
+func (r *Room) broadcastSpeakerQueue() {
+	r.Broadcast(&BroadcastMessage{
+		Type: "speaker_queue",
+		Data: r.speakerQueue.State(),
+	})
+}
+
+// SendSpeakerQueueState 새로 접속한 참가자에게 현재 대기열 상태 전송
+func (r *Room) SendSpeakerQueueState(listenerID string) {
+	state := r.speakerQueue.State()
+	if !state.Enabled {
+		return
+	}
+	r.Broadcast(&BroadcastMessage{
+		Type:             "speaker_queue",
+		Data:             state,
+		TargetListenerID: listenerID,
+	})
+}
+
+// sendSpeakerQueueError 요청한 참가자에게 대기열 오류 전송
+func (r *Room) sendSpeakerQueueError(listenerID string, err error) {
+	r.Broadcast(&BroadcastMessage{
+		Type:             "speaker_queue_error",
+		Data:             map[string]string{"message": err.Error()},
+		TargetListenerID: listenerID,
+	})
+}
@@ -1,24 +1,63 @@
 package handler
 
 import (
+	"errors"
+	"fmt"
+	"log"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
 
 	"realtime-backend/internal/auth"
+	"realtime-backend/internal/config"
 	"realtime-backend/internal/model"
+	"realtime-backend/internal/preview"
+	"realtime-backend/internal/scan"
 	"realtime-backend/internal/storage"
 )
 
 type StorageHandler struct {
-	db *gorm.DB
-	s3 *storage.S3Service
+	db       *gorm.DB
+	s3       *storage.S3Service
+	previews *preview.Worker // 썸네일/미리보기 생성 (S3가 없으면 nil)
+	scans    *scan.Worker    // 바이러스 검사 (ClamAV가 설정되지 않으면 nil)
+
+	trashRetention time.Duration // 휴지통 보관 기간
+	maxUploadSize  int64         // 업로드 파일 최대 크기 기본값 (워크스페이스 정책이 없을 때)
+	storageQuota   int64         // 워크스페이스 저장 용량 한도 기본값 (0 = 무제한)
+	stopPurge      chan struct{}
+	closeOnce      sync.Once
 }
 
 // NewStorageHandler StorageHandler 생성
-func NewStorageHandler(db *gorm.DB, s3 *storage.S3Service) *StorageHandler {
-	return &StorageHandler{db: db, s3: s3}
+func NewStorageHandler(db *gorm.DB, s3 *storage.S3Service, cfg *config.S3Config) *StorageHandler {
+	trashRetention := cfg.TrashRetention
+	if trashRetention <= 0 {
+		trashRetention = defaultTrashRetention
+	}
+	maxUploadSize := cfg.MaxUploadSize
+	if maxUploadSize <= 0 {
+		maxUploadSize = storage.MaxPutSize
+	}
+	h := &StorageHandler{
+		db:             db,
+		s3:             s3,
+		trashRetention: trashRetention,
+		maxUploadSize:  maxUploadSize,
+		storageQuota:   cfg.StorageQuota,
+		stopPurge:      make(chan struct{}),
+	}
+	if s3 != nil {
+		h.previews = preview.NewWorker(db, s3)
+		if cfg.ClamAVAddr != "" {
+			h.scans = scan.NewWorker(db, s3, scan.NewClamAV(cfg.ClamAVAddr), cfg.ScanTimeout)
+			h.scans.OnInfected(h.notifyQuarantine)
+		}
+	}
+	return h
 }
 
 // FileResponse 파일/폴더 응답
@@ -35,6 +74,9 @@ type FileResponse struct {
 	S3Key            *string        `json:"s3_key,omitempty"`
 	RelatedMeetingID *int64         `json:"related_meeting_id,omitempty"`
 	CreatedAt        string         `json:"created_at"`
+	ThumbnailURL     *string        `json:"thumbnail_url,omitempty"` // 이미지/PDF 썸네일 (생성 전이면 없음)
+	PreviewURL       *string        `json:"preview_url,omitempty"`
+	ScanStatus       *string        `json:"scan_status,omitempty"` // PENDING, CLEAN, INFECTED(격리), FAILED
 	Uploader         *UserResponse  `json:"uploader,omitempty"`
 	Children         []FileResponse `json:"children,omitempty"`
 }
@@ -49,6 +91,7 @@ type CreateFolderRequest struct {
 type GetPresignedURLRequest struct {
 	FileName       string `json:"file_name"`
 	ContentType    string `json:"content_type"`
+	FileSize       int64  `json:"file_size"` // 서명에 포함 (다른 크기의 본문은 S3가 거부)
 	ParentFolderID *int64 `json:"parent_folder_id,omitempty"`
 }
 
@@ -82,14 +125,38 @@ func (h *StorageHandler) GetPresignedURL(c *fiber.Ctx) error {
 		})
 	}
 
-	if req.FileName == "" || req.ContentType == "" {
+	// 크기를 서명에 고정해야 저장 용량 한도를 넘는 본문을 S3가 거부
+	if req.FileName == "" || req.ContentType == "" || req.FileSize <= 0 {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "file_name and content_type are required",
+			"error": "file_name, content_type and file_size are required",
 		})
 	}
 
+	// 워크스페이스 파일 정책 확인
+	policy, err := h.loadUploadPolicy(int64(workspaceID))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get file policy",
+		})
+	}
+	if err := policy.validate(req.FileName, req.ContentType, req.FileSize); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	// 저장 용량 한도 확인
+	if ok, err := h.checkStorageQuota(c, int64(workspaceID), req.FileSize); !ok {
+		return err
+	}
+
 	// Presigned URL 생성
-	presigned, err := h.s3.GenerateUploadURL(int64(workspaceID), req.FileName, req.ContentType)
+	presigned, err := h.s3.GenerateUploadURL(int64(workspaceID), req.FileName, req.ContentType, req.FileSize)
+	if errors.Is(err, storage.ErrTooLarge) {
+		return c.Status(fiber.StatusRequestEntityTooLarge).JSON(fiber.Map{
+			"error": "file is too large for a single upload, use multipart upload",
+		})
+	}
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "failed to generate presigned URL",
@@ -100,6 +167,7 @@ func (h *StorageHandler) GetPresignedURL(c *fiber.Ctx) error {
 		"upload_url":       presigned.URL,
 		"key":              presigned.Key,
 		"expires_at":       presigned.ExpiresAt,
+		"headers":          presigned.Headers, // PUT 요청에 그대로 포함 (암호화 헤더 등)
 		"parent_folder_id": req.ParentFolderID,
 	})
 }
@@ -140,13 +208,33 @@ func (h *StorageHandler) ConfirmUpload(c *fiber.Ctx) error {
 			"error": "name and key are required",
 		})
 	}
+	if !strings.HasPrefix(req.Key, fmt.Sprintf("workspaces/%d/", workspaceID)) || strings.Contains(req.Key, "..") {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid key",
+		})
+	}
 
-	req.Name = sanitizeString(req.Name)
+	if ok, err := h.checkKeyNotRegistered(c, req.Key); !ok {
+		return err
+	}
+
+	// 업로드된 내용을 정책으로 다시 검사 (크기/타입은 S3 객체 기준)
+	info, err := h.verifyUploadedObject(c, int64(workspaceID), req.Key, req.Name, req.MimeType)
+	if info == nil {
+		return err
+	}
+
+	return h.saveUploadedFile(c, int64(workspaceID), claims.UserID, req.Name, req.Key, info.Size, info.ContentType, req.ParentFolderID)
+}
+
+// saveUploadedFile S3에 올라간 파일을 워크스페이스 파일로 등록하고 응답
+func (h *StorageHandler) saveUploadedFile(c *fiber.Ctx, workspaceID, uploaderID int64, name, key string, size int64, mimeType string, parentFolderID *int64) error {
+	name = sanitizeString(name)
 
 	// 부모 폴더 확인
-	if req.ParentFolderID != nil {
+	if parentFolderID != nil {
 		var parent model.WorkspaceFile
-		err := h.db.Where("id = ? AND workspace_id = ? AND type = ?", *req.ParentFolderID, workspaceID, "FOLDER").First(&parent).Error
+		err := h.db.Where("id = ? AND workspace_id = ? AND type = ?", *parentFolderID, workspaceID, "FOLDER").First(&parent).Error
 		if err != nil {
 			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 				"error": "parent folder not found",
@@ -154,19 +242,33 @@ func (h *StorageHandler) ConfirmUpload(c *fiber.Ctx) error {
 		}
 	}
 
+	// 사용량 행이 없으면 이 파일을 넣기 전 합계로 생성
+	if _, err := h.ensureStorageUsage(workspaceID); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get storage usage",
+		})
+	}
+	// 실제 객체 크기로 한도 재확인 (요청 때 선언한 크기와 다를 수 있음), 넘으면 객체 삭제
+	if ok, err := h.checkStorageQuota(c, workspaceID, size); !ok {
+		if delErr := h.s3.DeleteFile(key); delErr != nil {
+			log.Printf("[Storage] Failed to delete over-quota upload %s: %v", key, delErr)
+		}
+		return err
+	}
+
 	// S3 URL 생성
-	fileURL := h.s3.GetPublicURL(req.Key)
+	fileURL := h.s3.GetPublicURL(key)
 
 	file := model.WorkspaceFile{
-		WorkspaceID:    int64(workspaceID),
-		UploaderID:     &claims.UserID,
-		ParentFolderID: req.ParentFolderID,
-		Name:           req.Name,
+		WorkspaceID:    workspaceID,
+		UploaderID:     &uploaderID,
+		ParentFolderID: parentFolderID,
+		Name:           name,
 		Type:           "FILE",
 		FileURL:        &fileURL,
-		FileSize:       &req.FileSize,
-		MimeType:       &req.MimeType,
-		S3Key:          &req.Key,
+		FileSize:       &size,
+		MimeType:       &mimeType,
+		S3Key:          &key,
 	}
 
 	if err := h.db.Create(&file).Error; err != nil {
@@ -174,12 +276,32 @@ func (h *StorageHandler) ConfirmUpload(c *fiber.Ctx) error {
 			"error": "failed to save file metadata",
 		})
 	}
+	h.addStorageUsage(workspaceID, size)
+	h.scans.Enqueue(&file)
+	h.previews.Enqueue(&file)
 
 	h.db.Preload("Uploader").First(&file, file.ID)
 
 	return c.Status(fiber.StatusCreated).JSON(h.toFileResponse(&file))
 }
 
+// checkKeyNotRegistered S3 객체가 아직 파일로 등록되지 않았는지 확인 (휴지통 포함)
+// 같은 키를 두 번 확인하면 행이 둘 생기고 사용량이 두 번 더해지므로 거부 (실패 시 오류 응답을 보내고 false 반환)
+func (h *StorageHandler) checkKeyNotRegistered(c *fiber.Ctx, key string) (bool, error) {
+	var count int64
+	if err := h.db.Unscoped().Model(&model.WorkspaceFile{}).Where("s3_key = ?", key).Count(&count).Error; err != nil {
+		return false, c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to check file",
+		})
+	}
+	if count > 0 {
+		return false, c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": "file is already registered",
+		})
+	}
+	return true, nil
+}
+
 // GetWorkspaceFiles 워크스페이스 파일 목록
 func (h *StorageHandler) GetWorkspaceFiles(c *fiber.Ctx) error {
 	claims := c.Locals("claims").(*auth.Claims)
@@ -317,7 +439,7 @@ func (h *StorageHandler) CreateFolder(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusCreated).JSON(h.toFileResponse(&folder))
 }
 
-// UploadFile 파일 업로드 (메타데이터만 저장 - 레거시 지원)
+// UploadFile 파일 업로드 (메타데이터만 저장 - 레거시 지원, 이 워크스페이스에 올린 S3 객체의 URL만 허용)
 func (h *StorageHandler) UploadFile(c *fiber.Ctx) error {
 	claims := c.Locals("claims").(*auth.Claims)
 	workspaceID, err := c.ParamsInt("workspaceId")
@@ -354,28 +476,32 @@ func (h *StorageHandler) UploadFile(c *fiber.Ctx) error {
 		})
 	}
 
-	req.Name = sanitizeString(req.Name)
-
-	file := model.WorkspaceFile{
-		WorkspaceID:    int64(workspaceID),
-		UploaderID:     &claims.UserID,
-		ParentFolderID: req.ParentFolderID,
-		Name:           req.Name,
-		Type:           "FILE",
-		FileURL:        &req.FileURL,
-		FileSize:       &req.FileSize,
-		MimeType:       &req.MimeType,
+	if h.s3 == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "S3 service is not configured",
+		})
 	}
 
-	if err := h.db.Create(&file).Error; err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "failed to save file metadata",
+	// 이 워크스페이스에 올라간 S3 객체만 등록 (외부 URL은 정책/바이러스 검사를 할 수 없음)
+	key, ok := h.s3.KeyFromPublicURL(req.FileURL)
+	if !ok || !strings.HasPrefix(key, fmt.Sprintf("workspaces/%d/", workspaceID)) || strings.Contains(key, "..") {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "file_url must point to a file uploaded to this workspace",
 		})
 	}
 
-	h.db.Preload("Uploader").First(&file, file.ID)
+	if ok, err := h.checkKeyNotRegistered(c, key); !ok {
+		return err
+	}
 
-	return c.Status(fiber.StatusCreated).JSON(h.toFileResponse(&file))
+	// 업로드 확인과 같은 검사: 파일 정책 (크기/타입은 S3 객체 기준)
+	info, err := h.verifyUploadedObject(c, int64(workspaceID), key, req.Name, req.MimeType)
+	if info == nil {
+		return err
+	}
+
+	// 저장 용량 한도 확인, 사용량 반영, 바이러스 검사/미리보기 예약
+	return h.saveUploadedFile(c, int64(workspaceID), claims.UserID, req.Name, key, info.Size, info.ContentType, req.ParentFolderID)
 }
 
 // DeleteFile 파일/폴더 삭제
@@ -419,38 +545,22 @@ func (h *StorageHandler) DeleteFile(c *fiber.Ctx) error {
 		})
 	}
 
-	// 트랜잭션으로 DB 삭제 (S3 삭제는 별도 처리)
-	var s3KeysToDelete []string
-
-	err = h.db.Transaction(func(tx *gorm.DB) error {
-		// 폴더인 경우 하위 항목도 삭제
-		if file.Type == "FOLDER" {
-			h.deleteRecursiveWithTx(tx, file.ID, &s3KeysToDelete)
-		}
-
-		// S3 키 수집
-		if file.S3Key != nil && *file.S3Key != "" {
-			s3KeysToDelete = append(s3KeysToDelete, *file.S3Key)
-		}
-
-		return tx.Delete(&file).Error
-	})
-
+	// 휴지통으로 이동 (폴더면 하위 항목 포함, S3 객체는 보관 기간 동안 유지)
+	trashedAt, s3Keys, err := h.moveToTrash(&file, claims.UserID)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "failed to delete file",
 		})
 	}
 
-	// DB 삭제 성공 후 S3 파일 삭제 (실패해도 무시)
-	if h.s3 != nil {
-		for _, key := range s3KeysToDelete {
-			h.s3.DeleteFile(key)
-		}
+	// DB 처리 후 S3 객체에 휴지통 태그 (실패해도 영구 삭제 작업이 정리)
+	if h.s3 != nil && len(s3Keys) > 0 {
+		go h.tagTrashed(s3Keys, trashedAt)
 	}
 
 	return c.JSON(fiber.Map{
-		"message": "file deleted",
+		"message":  "file moved to trash",
+		"purge_at": trashedAt.Add(h.trashRetention).Format(time.RFC3339),
 	})
 }
 
@@ -500,12 +610,37 @@ func (h *StorageHandler) RenameFile(c *fiber.Ctx) error {
 		})
 	}
 
-	file.Name = sanitizeString(req.Name)
+	name := sanitizeString(req.Name)
+
+	// 파일이면 S3 키의 파일명도 변경 (새 키로 복사 → DB 변경 → 이전 키 삭제)
+	newKey, err := h.renameObject(c.UserContext(), &file, name)
+	if err != nil {
+		log.Printf("[Storage] Failed to copy object for rename %d: %v", file.ID, err)
+		return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{
+			"error": "failed to rename file",
+		})
+	}
+	oldKey := file.S3Key
+	if newKey != "" {
+		fileURL := h.s3.GetPublicURL(newKey)
+		file.S3Key = &newKey
+		file.FileURL = &fileURL
+	}
+
+	file.Name = name
 	if err := h.db.Save(&file).Error; err != nil {
+		if newKey != "" {
+			h.discardCopies([]string{newKey})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": "failed to rename file",
 		})
 	}
+	if newKey != "" {
+		if err := h.s3.DeleteFile(*oldKey); err != nil {
+			log.Printf("[Storage] Failed to delete renamed object %s: %v", *oldKey, err)
+		}
+	}
 	h.db.Preload("Uploader").First(&file, file.ID)
 
 	return c.JSON(h.toFileResponse(&file))
@@ -548,6 +683,14 @@ func (h *StorageHandler) GetDownloadURL(c *fiber.Ctx) error {
 		})
 	}
 
+	// 바이러스 검사에서 격리된 파일
+	if quarantined(&file) {
+		return c.Status(fiber.StatusLocked).JSON(fiber.Map{
+			"error":     "file is quarantined",
+			"signature": file.ScanSignature,
+		})
+	}
+
 	if file.S3Key == nil || *file.S3Key == "" {
 		// S3 키가 없으면 기존 URL 반환
 		if file.FileURL != nil {
@@ -582,20 +725,20 @@ func (h *StorageHandler) isWorkspaceMember(workspaceID, userID int64) bool {
 	return count > 0
 }
 
-func (h *StorageHandler) deleteRecursiveWithTx(tx *gorm.DB, folderID int64, s3Keys *[]string) {
+// collectSubtreeWithTx 폴더 하위 항목(휴지통에 없는 것만)의 ID와 S3 키 수집
+func (h *StorageHandler) collectSubtreeWithTx(tx *gorm.DB, folderID int64, ids *[]int64, s3Keys *[]string) {
 	var children []model.WorkspaceFile
 	tx.Where("parent_folder_id = ?", folderID).Find(&children)
 
 	for _, child := range children {
-		// S3 키 수집 (삭제는 트랜잭션 완료 후)
+		*ids = append(*ids, child.ID)
 		if child.S3Key != nil && *child.S3Key != "" {
 			*s3Keys = append(*s3Keys, *child.S3Key)
 		}
 
 		if child.Type == "FOLDER" {
-			h.deleteRecursiveWithTx(tx, child.ID, s3Keys)
+			h.collectSubtreeWithTx(tx, child.ID, ids, s3Keys)
 		}
-		tx.Delete(&child)
 	}
 }
 
@@ -634,6 +777,14 @@ func (h *StorageHandler) toFileResponse(f *model.WorkspaceFile) FileResponse {
 		RelatedMeetingID: f.RelatedMeetingID,
 		CreatedAt:        f.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
+	resp.ScanStatus = f.ScanStatus
+	if quarantined(f) {
+		// 격리된 파일은 내려받을 수 있는 주소를 내보내지 않음
+		resp.FileURL = nil
+	} else {
+		resp.ThumbnailURL = h.derivedURL(f.ThumbnailKey)
+		resp.PreviewURL = h.derivedURL(f.PreviewKey)
+	}
 
 	if f.Uploader != nil && f.Uploader.ID != 0 {
 		resp.Uploader = &UserResponse{
@@ -0,0 +1,304 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/storage"
+)
+
+// 폴더 작업 설정
+const (
+	copyTimeout       = 10 * time.Minute // 재귀 복사 전체 제한 시간
+	maxCopyItems      = 1000             // 한 번에 복사할 수 있는 최대 항목 수
+	renameCopyTimeout = 2 * time.Minute  // 이름 변경 시 S3 키 복사 제한 시간
+)
+
+// MoveFileRequest 파일/폴더 이동 요청 (parent_folder_id가 없으면 최상위로)
+type MoveFileRequest struct {
+	ParentFolderID *int64 `json:"parent_folder_id"`
+}
+
+// CopyFileRequest 파일/폴더 복사 요청 (name이 없으면 "원래 이름 (copy)")
+type CopyFileRequest struct {
+	ParentFolderID *int64 `json:"parent_folder_id"`
+	Name           string `json:"name,omitempty"`
+}
+
+// MoveFile 파일/폴더를 다른 폴더로 이동 (POST /api/workspaces/:workspaceId/files/:fileId/move)
+// S3 키는 폴더 구조와 무관하므로 DB의 상위 폴더만 변경
+func (h *StorageHandler) MoveFile(c *fiber.Ctx) error {
+	file, err := h.folderTarget(c)
+	if file == nil {
+		return err
+	}
+
+	var req MoveFileRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	if req.ParentFolderID != nil {
+		ok, err := h.validDestination(c, file, *req.ParentFolderID)
+		if !ok {
+			return err
+		}
+	}
+
+	if err := h.db.Model(&model.WorkspaceFile{}).Where("id = ?", file.ID).Update("parent_folder_id", req.ParentFolderID).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to move file",
+		})
+	}
+
+	h.db.Preload("Uploader").First(file, file.ID)
+	return c.JSON(h.toFileResponse(file))
+}
+
+// CopyFile 파일/폴더 복사 (POST /api/workspaces/:workspaceId/files/:fileId/copy)
+// 폴더는 하위 항목까지 재귀 복사: S3 객체를 먼저 새 키로 복사하고 DB 행을 한 트랜잭션으로 생성,
+// 중간에 실패하면 이미 복사한 객체를 삭제 (보상)
+func (h *StorageHandler) CopyFile(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	file, err := h.folderTarget(c)
+	if file == nil {
+		return err
+	}
+
+	var req CopyFileRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+	if req.ParentFolderID != nil {
+		ok, err := h.validDestination(c, file, *req.ParentFolderID)
+		if !ok {
+			return err
+		}
+	}
+
+	// 복사할 항목 (상위 항목이 항상 하위 항목보다 앞에 오도록 너비 우선)
+	items := []model.WorkspaceFile{*file}
+	var totalSize int64
+	for i := 0; i < len(items); i++ {
+		if quarantined(&items[i]) {
+			return c.Status(fiber.StatusLocked).JSON(fiber.Map{
+				"error": "cannot copy quarantined files",
+			})
+		}
+		if items[i].Type == "FILE" && items[i].FileSize != nil && items[i].S3Key != nil {
+			totalSize += *items[i].FileSize
+		}
+		if items[i].Type != "FOLDER" {
+			continue
+		}
+		var children []model.WorkspaceFile
+		if err := h.db.Where("parent_folder_id = ?", items[i].ID).Order("id").Find(&children).Error; err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": "failed to load folder contents",
+			})
+		}
+		items = append(items, children...)
+		if len(items) > maxCopyItems {
+			return c.Status(fiber.StatusRequestEntityTooLarge).JSON(fiber.Map{
+				"error": "too many items to copy",
+			})
+		}
+	}
+
+	// 저장 용량 한도 확인
+	if ok, err := h.checkStorageQuota(c, file.WorkspaceID, totalSize); !ok {
+		return err
+	}
+
+	// 1단계: S3 객체 복사
+	ctx, cancel := context.WithTimeout(c.UserContext(), copyTimeout)
+	defer cancel()
+	newKeys := make(map[int64]string)
+	var copied []string
+	for _, item := range items {
+		if item.S3Key == nil || *item.S3Key == "" {
+			continue
+		}
+		if h.s3 == nil {
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+				"error": "S3 service is not configured",
+			})
+		}
+		newKey := storage.NewWorkspaceKey(item.WorkspaceID, item.Name)
+		if err := h.s3.CopyObject(ctx, item.WorkspaceID, *item.S3Key, newKey); err != nil {
+			log.Printf("[Storage] Failed to copy object %s: %v", *item.S3Key, err)
+			h.discardCopies(copied)
+			return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{
+				"error": "failed to copy file",
+			})
+		}
+		newKeys[item.ID] = newKey
+		copied = append(copied, newKey)
+	}
+
+	// 2단계: DB 행 생성 (실패하면 복사한 객체 삭제)
+	rootName := strings.TrimSpace(sanitizeString(req.Name))
+	if rootName == "" {
+		rootName = file.Name + " (copy)"
+	}
+	var root model.WorkspaceFile
+	var created []model.WorkspaceFile
+	err = h.db.Transaction(func(tx *gorm.DB) error {
+		newIDs := make(map[int64]int64)
+		for i, item := range items {
+			dup := model.WorkspaceFile{
+				WorkspaceID:      item.WorkspaceID,
+				UploaderID:       &claims.UserID,
+				ParentFolderID:   req.ParentFolderID,
+				Name:             item.Name,
+				Type:             item.Type,
+				FileURL:          item.FileURL,
+				FileSize:         item.FileSize,
+				MimeType:         item.MimeType,
+				RelatedMeetingID: item.RelatedMeetingID,
+				ScanStatus:       item.ScanStatus, // 내용이 같으므로 검사 결과 유지
+				ScanSignature:    item.ScanSignature,
+				ScannedAt:        item.ScannedAt,
+			}
+			if i == 0 {
+				dup.Name = rootName
+			} else {
+				parentID := newIDs[*item.ParentFolderID]
+				dup.ParentFolderID = &parentID
+			}
+			if key, ok := newKeys[item.ID]; ok {
+				fileURL := h.s3.GetPublicURL(key)
+				dup.S3Key = &key
+				dup.FileURL = &fileURL
+			}
+			if err := tx.Create(&dup).Error; err != nil {
+				return err
+			}
+			newIDs[item.ID] = dup.ID
+			created = append(created, dup)
+			if i == 0 {
+				root = dup
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		h.discardCopies(copied)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to copy file",
+		})
+	}
+	h.addStorageUsage(file.WorkspaceID, totalSize)
+	// 썸네일은 새 키 기준으로 다시 생성, 검사가 끝나지 않은 원본의 복사본은 따로 검사
+	for i := range created {
+		if created[i].ScanStatus != nil && *created[i].ScanStatus == model.ScanStatusPending {
+			h.scans.Enqueue(&created[i])
+		}
+		h.previews.Enqueue(&created[i])
+	}
+
+	h.db.Preload("Uploader").First(&root, root.ID)
+	return c.Status(fiber.StatusCreated).JSON(h.toFileResponse(&root))
+}
+
+// renameObject 파일 S3 키의 파일명을 새 이름으로 복사 (키가 바뀌지 않으면 "" 반환)
+// 호출한 쪽은 DB 변경에 성공하면 이전 키를, 실패하면 새 키를 삭제
+func (h *StorageHandler) renameObject(ctx context.Context, file *model.WorkspaceFile, name string) (string, error) {
+	if h.s3 == nil || file.Type != "FILE" || file.S3Key == nil || !strings.HasPrefix(*file.S3Key, "workspaces/") {
+		return "", nil
+	}
+	newKey := storage.RenamedKey(*file.S3Key, name)
+	if newKey == *file.S3Key {
+		return "", nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, renameCopyTimeout)
+	defer cancel()
+	if err := h.s3.CopyObject(ctx, file.WorkspaceID, *file.S3Key, newKey); err != nil {
+		return "", err
+	}
+	return newKey, nil
+}
+
+// discardCopies 작업이 실패했을 때 이미 복사한 객체 삭제 (보상)
+func (h *StorageHandler) discardCopies(keys []string) {
+	if len(keys) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), trashPurgeTimeout)
+	defer cancel()
+	if err := h.s3.DeleteFiles(ctx, keys); err != nil {
+		log.Printf("[Storage] Failed to discard copied objects: %v", err)
+	}
+}
+
+// folderTarget 이동/복사할 항목 조회 (실패 시 오류 응답을 보내고 nil 반환)
+func (h *StorageHandler) folderTarget(c *fiber.Ctx) (*model.WorkspaceFile, error) {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return nil, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+	fileID, err := c.ParamsInt("fileId")
+	if err != nil {
+		return nil, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid file id",
+		})
+	}
+
+	// 멤버 확인
+	if !h.isWorkspaceMember(int64(workspaceID), claims.UserID) {
+		return nil, c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you are not a member of this workspace",
+		})
+	}
+
+	var file model.WorkspaceFile
+	if err := h.db.Where("id = ? AND workspace_id = ?", fileID, workspaceID).First(&file).Error; err != nil {
+		return nil, c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "file not found",
+		})
+	}
+	return &file, nil
+}
+
+// validDestination 대상 폴더 확인: 같은 워크스페이스의 폴더이고, 자기 자신이나 하위 폴더가 아니어야 함
+// (실패 시 오류 응답을 보내고 false 반환)
+func (h *StorageHandler) validDestination(c *fiber.Ctx, file *model.WorkspaceFile, folderID int64) (bool, error) {
+	var folder model.WorkspaceFile
+	if err := h.db.Where("id = ? AND workspace_id = ? AND type = ?", folderID, file.WorkspaceID, "FOLDER").First(&folder).Error; err != nil {
+		return false, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "parent folder not found",
+		})
+	}
+
+	// 대상 폴더에서 위로 올라가며 자기 자신을 만나면 순환
+	for current := &folder; ; {
+		if current.ID == file.ID {
+			return false, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "cannot move or copy a folder into itself",
+			})
+		}
+		if current.ParentFolderID == nil {
+			return true, nil
+		}
+		var parent model.WorkspaceFile
+		if err := h.db.First(&parent, *current.ParentFolderID).Error; err != nil {
+			return true, nil
+		}
+		current = &parent
+	}
+}
@@ -0,0 +1,269 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/storage"
+)
+
+// maxPresignPartsPerRequest 한 번에 발급하는 조각 URL 수
+const maxPresignPartsPerRequest = 100
+
+// CreateMultipartUploadRequest 멀티파트 업로드 시작 요청
+type CreateMultipartUploadRequest struct {
+	FileName       string `json:"file_name"`
+	ContentType    string `json:"content_type"`
+	FileSize       int64  `json:"file_size"`
+	ParentFolderID *int64 `json:"parent_folder_id,omitempty"`
+}
+
+// MultipartPartsRequest 조각 업로드 URL 요청 (part_numbers가 비어 있으면 아직 올라가지 않은 조각부터)
+type MultipartPartsRequest struct {
+	Key         string  `json:"key"`
+	UploadID    string  `json:"upload_id"`
+	PartNumbers []int32 `json:"part_numbers"`
+}
+
+// MultipartPartURL 조각 업로드 URL
+type MultipartPartURL struct {
+	PartNumber int32  `json:"part_number"`
+	URL        string `json:"url"`
+	ExpiresAt  string `json:"expires_at"`
+}
+
+// CompleteMultipartUploadRequest 멀티파트 업로드 완료 요청 (완료 후 워크스페이스 파일로 등록)
+type CompleteMultipartUploadRequest struct {
+	Key            string                  `json:"key"`
+	UploadID       string                  `json:"upload_id"`
+	Parts          []storage.CompletedPart `json:"parts"`
+	Name           string                  `json:"name"`
+	MimeType       string                  `json:"mime_type"`
+	ParentFolderID *int64                  `json:"parent_folder_id,omitempty"`
+}
+
+// CreateMultipartUpload 큰 파일 멀티파트 업로드 시작 (조각 크기/개수 반환)
+func (h *StorageHandler) CreateMultipartUpload(c *fiber.Ctx) error {
+	workspaceID, claims, err := h.multipartWorkspace(c)
+	if claims == nil {
+		return err
+	}
+
+	var req CreateMultipartUploadRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+	if req.FileName == "" || req.ContentType == "" || req.FileSize <= 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "file_name, content_type and file_size are required",
+		})
+	}
+
+	// 워크스페이스 파일 정책 확인
+	policy, err := h.loadUploadPolicy(workspaceID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get file policy",
+		})
+	}
+	if err := policy.validate(req.FileName, req.ContentType, req.FileSize); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	// 저장 용량 한도 확인
+	if ok, err := h.checkStorageQuota(c, workspaceID, req.FileSize); !ok {
+		return err
+	}
+
+	upload, err := h.s3.CreateMultipartUpload(c.UserContext(), workspaceID, req.FileName, req.ContentType, req.FileSize)
+	if errors.Is(err, storage.ErrTooLarge) {
+		return c.Status(fiber.StatusRequestEntityTooLarge).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to start multipart upload",
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"upload_id":        upload.UploadID,
+		"key":              upload.Key,
+		"part_size":        upload.PartSize,
+		"part_count":       upload.PartCount,
+		"parent_folder_id": req.ParentFolderID,
+	})
+}
+
+// PresignMultipartParts 조각 업로드용 Presigned URL 발급
+func (h *StorageHandler) PresignMultipartParts(c *fiber.Ctx) error {
+	workspaceID, claims, err := h.multipartWorkspace(c)
+	if claims == nil {
+		return err
+	}
+
+	var req MultipartPartsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+	if !validMultipartTarget(workspaceID, req.Key, req.UploadID) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid key or upload_id",
+		})
+	}
+	if len(req.PartNumbers) == 0 || len(req.PartNumbers) > maxPresignPartsPerRequest {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fmt.Sprintf("part_numbers must contain 1 to %d parts", maxPresignPartsPerRequest),
+		})
+	}
+
+	urls := make([]MultipartPartURL, 0, len(req.PartNumbers))
+	for _, partNumber := range req.PartNumbers {
+		presigned, err := h.s3.PresignUploadPart(c.UserContext(), req.Key, req.UploadID, partNumber)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": fmt.Sprintf("failed to presign part %d", partNumber),
+			})
+		}
+		urls = append(urls, MultipartPartURL{PartNumber: partNumber, URL: presigned.URL, ExpiresAt: presigned.ExpiresAt})
+	}
+
+	return c.JSON(fiber.Map{
+		"key":       req.Key,
+		"upload_id": req.UploadID,
+		"parts":     urls,
+	})
+}
+
+// GetMultipartParts 이미 올라간 조각 목록 (이어 올리기: 목록에 없는 조각만 다시 업로드)
+func (h *StorageHandler) GetMultipartParts(c *fiber.Ctx) error {
+	workspaceID, claims, err := h.multipartWorkspace(c)
+	if claims == nil {
+		return err
+	}
+
+	key, uploadID := c.Query("key"), c.Query("upload_id")
+	if !validMultipartTarget(workspaceID, key, uploadID) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid key or upload_id",
+		})
+	}
+
+	parts, err := h.s3.ListUploadedParts(c.UserContext(), key, uploadID)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "multipart upload not found",
+		})
+	}
+	if parts == nil {
+		parts = []storage.UploadedPart{}
+	}
+
+	return c.JSON(fiber.Map{
+		"key":       key,
+		"upload_id": uploadID,
+		"parts":     parts,
+	})
+}
+
+// CompleteMultipartUpload 조각을 합치고 워크스페이스 파일로 등록
+func (h *StorageHandler) CompleteMultipartUpload(c *fiber.Ctx) error {
+	workspaceID, claims, err := h.multipartWorkspace(c)
+	if claims == nil {
+		return err
+	}
+
+	var req CompleteMultipartUploadRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+	if !validMultipartTarget(workspaceID, req.Key, req.UploadID) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid key or upload_id",
+		})
+	}
+	if req.Name == "" || len(req.Parts) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "name and parts are required",
+		})
+	}
+
+	if err := h.s3.CompleteMultipartUpload(c.UserContext(), req.Key, req.UploadID, req.Parts); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "failed to complete multipart upload",
+		})
+	}
+
+	// 합쳐진 객체를 정책으로 다시 검사 (크기/타입은 클라이언트 값 대신 객체 기준)
+	info, err := h.verifyUploadedObject(c, workspaceID, req.Key, req.Name, req.MimeType)
+	if info == nil {
+		return err
+	}
+
+	return h.saveUploadedFile(c, workspaceID, claims.UserID, req.Name, req.Key, info.Size, info.ContentType, req.ParentFolderID)
+}
+
+// AbortMultipartUpload 멀티파트 업로드 취소 (올라간 조각 삭제)
+func (h *StorageHandler) AbortMultipartUpload(c *fiber.Ctx) error {
+	workspaceID, claims, err := h.multipartWorkspace(c)
+	if claims == nil {
+		return err
+	}
+
+	key, uploadID := c.Query("key"), c.Query("upload_id")
+	if !validMultipartTarget(workspaceID, key, uploadID) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid key or upload_id",
+		})
+	}
+
+	if err := h.s3.AbortMultipartUpload(c.UserContext(), key, uploadID); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to abort multipart upload",
+		})
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// multipartWorkspace S3 설정과 워크스페이스 멤버 확인 (실패 시 오류 응답을 보내고 claims nil 반환)
+func (h *StorageHandler) multipartWorkspace(c *fiber.Ctx) (int64, *auth.Claims, error) {
+	if h.s3 == nil {
+		return 0, nil, c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "S3 service is not configured",
+		})
+	}
+
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return 0, nil, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	// 멤버 확인
+	if !h.isWorkspaceMember(int64(workspaceID), claims.UserID) {
+		return 0, nil, c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you are not a member of this workspace",
+		})
+	}
+	return int64(workspaceID), claims, nil
+}
+
+// validMultipartTarget 다른 워크스페이스의 키로 조각을 올리거나 합치지 못하도록 키 접두어 확인
+func validMultipartTarget(workspaceID int64, key, uploadID string) bool {
+	return uploadID != "" && strings.HasPrefix(key, fmt.Sprintf("workspaces/%d/", workspaceID)) && !strings.Contains(key, "..")
+}
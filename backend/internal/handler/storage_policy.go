@@ -0,0 +1,353 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm/clause"
+
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/storage"
+)
+
+// 업로드 검사 설정
+const (
+	sniffLength        = 512 // http.DetectContentType이 보는 길이
+	uploadCheckTimeout = 15 * time.Second
+)
+
+// executableExtensions 정책에서 허용하지 않으면 거부하는 실행 파일 확장자
+var executableExtensions = map[string]bool{
+	".exe": true, ".dll": true, ".com": true, ".bat": true, ".cmd": true,
+	".msi": true, ".scr": true, ".pif": true, ".cpl": true, ".ps1": true,
+	".vbs": true, ".vbe": true, ".wsf": true, ".jar": true, ".sh": true,
+	".apk": true, ".dmg": true, ".app": true, ".elf": true,
+}
+
+// executableTypes 정책에서 허용하지 않으면 거부하는 실행 파일 MIME 타입
+var executableTypes = map[string]bool{
+	"application/x-msdownload":                      true,
+	"application/x-msdos-program":                   true,
+	"application/vnd.microsoft.portable-executable": true,
+	"application/x-executable":                      true,
+	"application/x-elf":                             true,
+	"application/x-mach-binary":                     true,
+	"application/x-sh":                              true,
+	"application/x-bat":                             true,
+	"application/x-msi":                             true,
+	"application/java-archive":                      true,
+	"application/vnd.android.package-archive":       true,
+	"application/x-apple-diskimage":                 true,
+}
+
+// executableMagic 실행 파일 시그니처 (PE, ELF, Mach-O, 유니버설 바이너리, 스크립트)
+var executableMagic = [][]byte{
+	[]byte("MZ"),
+	[]byte("\x7fELF"),
+	{0xfe, 0xed, 0xfa, 0xce}, {0xfe, 0xed, 0xfa, 0xcf},
+	{0xce, 0xfa, 0xed, 0xfe}, {0xcf, 0xfa, 0xed, 0xfe},
+	{0xca, 0xfe, 0xba, 0xbe},
+	[]byte("#!"),
+}
+
+// UpdateFilePolicyRequest 파일 업로드 정책 변경 요청 (보낸 항목만 변경)
+type UpdateFilePolicyRequest struct {
+	AllowedTypes     *[]string `json:"allowed_types"`
+	DeniedTypes      *[]string `json:"denied_types"`
+	MaxFileSize      *int64    `json:"max_file_size"`
+	AllowExecutables *bool     `json:"allow_executables"`
+}
+
+// FilePolicyResponse 파일 업로드 정책 (max_file_size는 서버 기본값을 반영한 실제 한도)
+type FilePolicyResponse struct {
+	WorkspaceID      int64    `json:"workspace_id"`
+	AllowedTypes     []string `json:"allowed_types"`
+	DeniedTypes      []string `json:"denied_types"`
+	MaxFileSize      int64    `json:"max_file_size"`
+	AllowExecutables bool     `json:"allow_executables"`
+}
+
+// uploadPolicy 업로드 검사에 쓰는 정책
+type uploadPolicy struct {
+	allowed          []string
+	denied           []string
+	maxSize          int64
+	allowExecutables bool
+}
+
+// GetFilePolicy 워크스페이스 파일 업로드 정책 조회
+func (h *StorageHandler) GetFilePolicy(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	// 멤버 확인
+	if !h.isWorkspaceMember(int64(workspaceID), claims.UserID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you are not a member of this workspace",
+		})
+	}
+
+	policy, err := h.loadUploadPolicy(int64(workspaceID))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get file policy",
+		})
+	}
+	return c.JSON(policy.response(int64(workspaceID)))
+}
+
+// UpdateFilePolicy 워크스페이스 파일 업로드 정책 변경 (ADMIN)
+func (h *StorageHandler) UpdateFilePolicy(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	// 권한 확인 (ADMIN)
+	hasPermission, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "ADMIN")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to check permission",
+		})
+	}
+	if !hasPermission {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you do not have permission to update the file policy",
+		})
+	}
+
+	var req UpdateFilePolicyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	row := model.WorkspaceFilePolicy{WorkspaceID: int64(workspaceID)}
+	if err := h.db.Where("workspace_id = ?", workspaceID).Limit(1).Find(&row).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get file policy",
+		})
+	}
+	if req.AllowedTypes != nil {
+		types, err := normalizeTypePatterns(*req.AllowedTypes)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		row.AllowedTypes = types
+	}
+	if req.DeniedTypes != nil {
+		types, err := normalizeTypePatterns(*req.DeniedTypes)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+		}
+		row.DeniedTypes = types
+	}
+	if req.MaxFileSize != nil {
+		if *req.MaxFileSize < 0 || *req.MaxFileSize > storage.MaxMultipartSize {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": fmt.Sprintf("max_file_size must be between 0 and %d bytes", int64(storage.MaxMultipartSize)),
+			})
+		}
+		row.MaxFileSize = *req.MaxFileSize
+	}
+	if req.AllowExecutables != nil {
+		row.AllowExecutables = *req.AllowExecutables
+	}
+	row.UpdatedBy = claims.UserID
+
+	if err := h.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "workspace_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"allowed_types", "denied_types", "max_file_size", "allow_executables", "updated_by", "updated_at"}),
+	}).Create(&row).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to update file policy",
+		})
+	}
+
+	return c.JSON(h.policyFromRow(row).response(int64(workspaceID)))
+}
+
+// loadUploadPolicy 워크스페이스 업로드 정책 (행이 없으면 서버 기본값)
+func (h *StorageHandler) loadUploadPolicy(workspaceID int64) (uploadPolicy, error) {
+	row := model.WorkspaceFilePolicy{WorkspaceID: workspaceID}
+	if err := h.db.Where("workspace_id = ?", workspaceID).Limit(1).Find(&row).Error; err != nil {
+		return uploadPolicy{}, err
+	}
+	return h.policyFromRow(row), nil
+}
+
+func (h *StorageHandler) policyFromRow(row model.WorkspaceFilePolicy) uploadPolicy {
+	maxSize := h.maxUploadSize
+	if row.MaxFileSize > 0 {
+		maxSize = row.MaxFileSize
+	}
+	return uploadPolicy{
+		allowed:          splitTypePatterns(row.AllowedTypes),
+		denied:           splitTypePatterns(row.DeniedTypes),
+		maxSize:          maxSize,
+		allowExecutables: row.AllowExecutables,
+	}
+}
+
+func (p uploadPolicy) response(workspaceID int64) FilePolicyResponse {
+	return FilePolicyResponse{
+		WorkspaceID:      workspaceID,
+		AllowedTypes:     append([]string{}, p.allowed...),
+		DeniedTypes:      append([]string{}, p.denied...),
+		MaxFileSize:      p.maxSize,
+		AllowExecutables: p.allowExecutables,
+	}
+}
+
+// validate 업로드 전 검사: 크기, 실행 파일, 거부/허용 목록, 확장자와 MIME 타입 일치 여부
+func (p uploadPolicy) validate(fileName, contentType string, size int64) error {
+	if size < 0 || size > p.maxSize {
+		return fmt.Errorf("file_size must be between 0 and %d bytes", p.maxSize)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return errors.New("invalid content_type")
+	}
+	ext := strings.ToLower(filepath.Ext(fileName))
+	if !p.allowExecutables && (executableExtensions[ext] || executableTypes[mediaType]) {
+		return errors.New("executable files are not allowed")
+	}
+	if matchTypePattern(p.denied, mediaType) {
+		return fmt.Errorf("content_type %s is not allowed", mediaType)
+	}
+	if len(p.allowed) > 0 && !matchTypePattern(p.allowed, mediaType) {
+		return fmt.Errorf("content_type %s is not allowed", mediaType)
+	}
+
+	// 확장자가 알려진 타입이면 선언한 타입과 같아야 함 (이미지 확장자로 다른 형식을 올리는 경우 등)
+	if byExt := mime.TypeByExtension(ext); byExt != "" {
+		if extType, _, err := mime.ParseMediaType(byExt); err == nil && extType != mediaType {
+			return errors.New("file extension does not match content_type")
+		}
+	}
+	return nil
+}
+
+// checkContent 업로드된 내용 검사 (선언한 타입과 다른 실행 파일/이미지가 아닌 본문 거부)
+func (p uploadPolicy) checkContent(declaredType string, head []byte) error {
+	if !p.allowExecutables {
+		for _, magic := range executableMagic {
+			if bytes.HasPrefix(head, magic) {
+				return errors.New("executable files are not allowed")
+			}
+		}
+	}
+
+	declared, _, _ := mime.ParseMediaType(declaredType)
+	sniffed, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	if strings.HasPrefix(declared, "image/") && declared != "image/svg+xml" && !strings.HasPrefix(sniffed, "image/") {
+		return errors.New("file content does not match content_type")
+	}
+	if matchTypePattern(p.denied, sniffed) {
+		return fmt.Errorf("content_type %s is not allowed", sniffed)
+	}
+	return nil
+}
+
+// verifyUploadedObject 업로드된 객체를 정책으로 다시 검사하고, 통과하지 못하면 객체를 삭제
+// 크기/타입은 클라이언트 값 대신 S3 객체 기준 (실패 시 오류 응답을 보내고 nil 반환)
+func (h *StorageHandler) verifyUploadedObject(c *fiber.Ctx, workspaceID int64, key, name, declaredType string) (*storage.ObjectInfo, error) {
+	ctx, cancel := context.WithTimeout(c.UserContext(), uploadCheckTimeout)
+	defer cancel()
+
+	info, err := h.s3.HeadObject(ctx, key)
+	if err != nil {
+		return nil, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "uploaded file not found",
+		})
+	}
+	if info.ContentType == "" {
+		info.ContentType = declaredType
+	}
+
+	policy, err := h.loadUploadPolicy(workspaceID)
+	if err != nil {
+		return nil, c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get file policy",
+		})
+	}
+	err = policy.validate(name, info.ContentType, info.Size)
+	if err == nil {
+		head, readErr := h.s3.ReadObjectPrefix(ctx, key, sniffLength)
+		if readErr != nil {
+			return nil, c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": "failed to read uploaded file",
+			})
+		}
+		err = policy.checkContent(info.ContentType, head)
+	}
+	if err != nil {
+		if delErr := h.s3.DeleteFile(key); delErr != nil {
+			log.Printf("[Storage] Failed to delete rejected upload %s: %v", key, delErr)
+		}
+		return nil, c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return info, nil
+}
+
+// normalizeTypePatterns MIME 타입 목록 정리 후 저장 형식(쉼표 구분)으로 변환
+func normalizeTypePatterns(patterns []string) (string, error) {
+	normalized := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		if pattern == "" {
+			continue
+		}
+		major, minor, ok := strings.Cut(pattern, "/")
+		if !ok || major == "" || minor == "" || major == "*" || strings.Contains(pattern, ",") {
+			return "", fmt.Errorf("invalid content type pattern %q", pattern)
+		}
+		normalized = append(normalized, pattern)
+	}
+	return strings.Join(normalized, ","), nil
+}
+
+func splitTypePatterns(value string) []string {
+	var patterns []string
+	for _, pattern := range strings.Split(value, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
+
+// matchTypePattern "type/subtype" 또는 "type/*" 일치 여부
+func matchTypePattern(patterns []string, mediaType string) bool {
+	for _, pattern := range patterns {
+		if pattern == mediaType {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(pattern, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"log"
+)
+
+// StartPreviewWorker 업로드한 이미지/PDF의 썸네일·미리보기 생성 워커 시작 (S3가 없으면 아무것도 하지 않음)
+func (h *StorageHandler) StartPreviewWorker() {
+	if h.previews != nil {
+		h.previews.Start()
+	}
+}
+
+// derivedURL 썸네일/미리보기 다운로드 URL (없거나 서명에 실패하면 nil)
+func (h *StorageHandler) derivedURL(key *string) *string {
+	if key == nil || *key == "" || h.s3 == nil {
+		return nil
+	}
+	url, err := h.s3.GetFileURL(*key)
+	if err != nil {
+		log.Printf("[Storage] Failed to sign preview URL %s: %v", *key, err)
+		return nil
+	}
+	return &url
+}
@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/model"
+)
+
+// UpdateStorageQuotaRequest 워크스페이스 저장 용량 한도 변경 요청 (0 = 무제한)
+type UpdateStorageQuotaRequest struct {
+	QuotaBytes *int64 `json:"quota_bytes"`
+}
+
+// StorageUsageResponse 워크스페이스 저장 용량 사용량
+type StorageUsageResponse struct {
+	WorkspaceID    int64  `json:"workspace_id"`
+	UsedBytes      int64  `json:"used_bytes"`
+	QuotaBytes     int64  `json:"quota_bytes"`               // 0 = 무제한
+	RemainingBytes *int64 `json:"remaining_bytes,omitempty"` // 한도가 있을 때만
+}
+
+// GetStorageUsage 워크스페이스 저장 용량 사용량과 한도 조회
+func (h *StorageHandler) GetStorageUsage(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	// 멤버 확인
+	if !h.isWorkspaceMember(int64(workspaceID), claims.UserID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you are not a member of this workspace",
+		})
+	}
+
+	usage, err := h.ensureStorageUsage(int64(workspaceID))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get storage usage",
+		})
+	}
+	return c.JSON(h.storageUsageResponse(usage))
+}
+
+// UpdateStorageQuota 워크스페이스 저장 용량 한도 변경 (ADMIN, 이미 올라간 파일은 그대로 두고 새 업로드만 거부)
+func (h *StorageHandler) UpdateStorageQuota(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	// 권한 확인 (ADMIN)
+	hasPermission, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "ADMIN")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to check permission",
+		})
+	}
+	if !hasPermission {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you do not have permission to update the storage quota",
+		})
+	}
+
+	var req UpdateStorageQuotaRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+	if req.QuotaBytes == nil || *req.QuotaBytes < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "quota_bytes must be 0 (unlimited) or greater",
+		})
+	}
+
+	if _, err := h.ensureStorageUsage(int64(workspaceID)); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get storage usage",
+		})
+	}
+	var usage model.WorkspaceStorageUsage
+	if err := h.db.Model(&usage).Where("workspace_id = ?", workspaceID).Update("quota_bytes", *req.QuotaBytes).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to update storage quota",
+		})
+	}
+	if err := h.db.Where("workspace_id = ?", workspaceID).First(&usage).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get storage usage",
+		})
+	}
+	return c.JSON(h.storageUsageResponse(usage))
+}
+
+// ensureStorageUsage 사용량 행 조회 (없으면 기존 파일 크기 합계로 생성)
+// 업로드/삭제로 사용량을 바꾸기 전에 호출해야 합계에 변경분이 두 번 반영되지 않음
+func (h *StorageHandler) ensureStorageUsage(workspaceID int64) (model.WorkspaceStorageUsage, error) {
+	var usage model.WorkspaceStorageUsage
+	err := h.db.Where("workspace_id = ?", workspaceID).First(&usage).Error
+	if err == nil || err != gorm.ErrRecordNotFound {
+		return usage, err
+	}
+
+	var used int64
+	if err := h.db.Unscoped().Model(&model.WorkspaceFile{}).
+		Where("workspace_id = ? AND type = ?", workspaceID, "FILE").
+		Select("COALESCE(SUM(file_size), 0)").
+		Scan(&used).Error; err != nil {
+		return usage, err
+	}
+	usage = model.WorkspaceStorageUsage{WorkspaceID: workspaceID, UsedBytes: used}
+	if err := h.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&usage).Error; err != nil {
+		return usage, err
+	}
+	return usage, h.db.Where("workspace_id = ?", workspaceID).First(&usage).Error
+}
+
+// addStorageUsage 사용량 증감 (ensureStorageUsage 이후에 호출)
+func (h *StorageHandler) addStorageUsage(workspaceID, delta int64) {
+	if delta == 0 {
+		return
+	}
+	if err := h.db.Model(&model.WorkspaceStorageUsage{}).
+		Where("workspace_id = ?", workspaceID).
+		Update("used_bytes", gorm.Expr("GREATEST(used_bytes + ?, 0)", delta)).Error; err != nil {
+		log.Printf("[Storage] Failed to update storage usage for workspace %d: %v", workspaceID, err)
+	}
+}
+
+// quotaBytes 워크스페이스 저장 용량 한도 (0 = 무제한)
+func (h *StorageHandler) quotaBytes(usage model.WorkspaceStorageUsage) int64 {
+	if usage.QuotaBytes != nil {
+		return *usage.QuotaBytes
+	}
+	return h.storageQuota
+}
+
+func (h *StorageHandler) storageUsageResponse(usage model.WorkspaceStorageUsage) StorageUsageResponse {
+	resp := StorageUsageResponse{
+		WorkspaceID: usage.WorkspaceID,
+		UsedBytes:   usage.UsedBytes,
+		QuotaBytes:  h.quotaBytes(usage),
+	}
+	if resp.QuotaBytes > 0 {
+		remaining := max(resp.QuotaBytes-resp.UsedBytes, 0)
+		resp.RemainingBytes = &remaining
+	}
+	return resp
+}
+
+// checkStorageQuota size바이트를 더 올려도 한도를 넘지 않는지 확인 (실패 시 오류 응답을 보내고 false 반환)
+func (h *StorageHandler) checkStorageQuota(c *fiber.Ctx, workspaceID, size int64) (bool, error) {
+	usage, err := h.ensureStorageUsage(workspaceID)
+	if err != nil {
+		return false, c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get storage usage",
+		})
+	}
+	quota := h.quotaBytes(usage)
+	if quota > 0 && usage.UsedBytes+size > quota {
+		return false, c.Status(fiber.StatusInsufficientStorage).JSON(fiber.Map{
+			"error":           "workspace storage quota exceeded",
+			"used_bytes":      usage.UsedBytes,
+			"quota_bytes":     quota,
+			"remaining_bytes": max(quota-usage.UsedBytes, 0),
+		})
+	}
+	return true, nil
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"fmt"
+	"log"
+
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/scan"
+)
+
+// StartScanWorker 업로드한 파일의 바이러스 검사 워커 시작 (ClamAV가 설정되지 않으면 아무것도 하지 않음)
+func (h *StorageHandler) StartScanWorker() {
+	if h.scans != nil {
+		h.scans.Start()
+	}
+}
+
+// Scanner 바이러스 검사 엔진 (헬스체크용, 설정되지 않았으면 nil)
+func (h *StorageHandler) Scanner() scan.Scanner {
+	if h.scans == nil {
+		return nil
+	}
+	return h.scans.Scanner()
+}
+
+// quarantined 바이러스 검사에서 악성 코드가 발견되어 격리된 파일인지
+func quarantined(f *model.WorkspaceFile) bool {
+	return f.ScanStatus != nil && *f.ScanStatus == model.ScanStatusInfected
+}
+
+// notifyQuarantine 격리된 파일을 워크스페이스 소유자/관리자와 업로더에게 알림
+func (h *StorageHandler) notifyQuarantine(file *model.WorkspaceFile, signature string) {
+	var workspace model.Workspace
+	if err := h.db.Select("id", "name", "owner_id").First(&workspace, file.WorkspaceID).Error; err != nil {
+		log.Printf("[Storage] Failed to load workspace %d for quarantine notice: %v", file.WorkspaceID, err)
+		return
+	}
+
+	var admins []int64
+	h.db.Table("workspace_members").
+		Joins("JOIN role_permissions ON role_permissions.role_id = workspace_members.role_id").
+		Where("workspace_members.workspace_id = ? AND workspace_members.status = ? AND role_permissions.permission_code = 'ADMIN'",
+			file.WorkspaceID, model.MemberStatusActive.String()).
+		Distinct().
+		Pluck("workspace_members.user_id", &admins)
+
+	recipients := append([]int64{workspace.OwnerID}, admins...)
+	if file.UploaderID != nil {
+		recipients = append(recipients, *file.UploaderID)
+	}
+
+	content := fmt.Sprintf("%s 워크스페이스의 파일 '%s'에서 악성 코드(%s)가 발견되어 격리했습니다.", workspace.Name, file.Name, signature)
+	relatedType := "WORKSPACE"
+	notified := make(map[int64]bool)
+	for _, userID := range recipients {
+		if notified[userID] {
+			continue
+		}
+		notified[userID] = true
+		if err := CreateNotification(h.db, userID, nil, model.NotificationTypeFileQuarantined.String(), content, &relatedType, &file.WorkspaceID); err != nil {
+			log.Printf("[Storage] Failed to notify user %d of quarantined file %d: %v", userID, file.ID, err)
+		}
+	}
+}
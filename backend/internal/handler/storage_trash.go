@@ -0,0 +1,334 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/storage"
+)
+
+// 휴지통 설정
+const (
+	defaultTrashRetention = 30 * 24 * time.Hour // 휴지통 보관 기간 기본값
+	trashPurgeInterval    = time.Hour           // 영구 삭제 작업 기본 주기
+	trashPurgeBatchSize   = 500                 // 한 번에 영구 삭제할 최대 항목 수
+	trashTagTimeout       = 2 * time.Minute     // S3 태그 변경 전체 제한 시간
+	trashPurgeTimeout     = 5 * time.Minute     // S3 일괄 삭제 전체 제한 시간
+	lifecycleTimeout      = 30 * time.Second    // 버킷 수명 주기 규칙 설정 제한 시간
+)
+
+// TrashItemResponse 휴지통 항목 (함께 삭제된 하위 항목은 최상위 항목 하나로 표시)
+type TrashItemResponse struct {
+	FileResponse
+	DeletedAt string `json:"deleted_at"`
+	DeletedBy *int64 `json:"deleted_by,omitempty"`
+	PurgeAt   string `json:"purge_at"` // 이 시각 이후 영구 삭제
+}
+
+// moveToTrash 파일/폴더(하위 항목 포함)를 휴지통으로 이동, 삭제 시각과 S3 키 반환
+func (h *StorageHandler) moveToTrash(file *model.WorkspaceFile, userID int64) (time.Time, []string, error) {
+	trashedAt := time.Now()
+	ids := []int64{file.ID}
+	var s3Keys []string
+	if file.S3Key != nil && *file.S3Key != "" {
+		s3Keys = append(s3Keys, *file.S3Key)
+	}
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		if file.Type == "FOLDER" {
+			h.collectSubtreeWithTx(tx, file.ID, &ids, &s3Keys)
+		}
+		return tx.Model(&model.WorkspaceFile{}).Where("id IN ?", ids).Updates(map[string]any{
+			"deleted_at":    trashedAt,
+			"deleted_by":    userID,
+			"trash_root_id": file.ID,
+		}).Error
+	})
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+	return trashedAt, s3Keys, nil
+}
+
+// tagTrashed 휴지통으로 옮긴 S3 객체에 태그 지정 (요청과 분리해 백그라운드 실행)
+func (h *StorageHandler) tagTrashed(keys []string, trashedAt time.Time) {
+	ctx, cancel := context.WithTimeout(context.Background(), trashTagTimeout)
+	defer cancel()
+	for _, key := range keys {
+		if err := h.s3.MarkTrashed(ctx, key, trashedAt); err != nil {
+			log.Printf("[Storage] Failed to tag trashed object %s: %v", key, err)
+		}
+	}
+}
+
+// untagRestored 복원한 S3 객체의 휴지통 태그 제거 (백그라운드 실행)
+func (h *StorageHandler) untagRestored(keys []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), trashTagTimeout)
+	defer cancel()
+	for _, key := range keys {
+		if err := h.s3.UnmarkTrashed(ctx, key); err != nil {
+			log.Printf("[Storage] Failed to untag restored object %s: %v", key, err)
+		}
+	}
+}
+
+// GetTrash 워크스페이스 휴지통 목록 (GET /api/workspaces/:workspaceId/files/trash)
+func (h *StorageHandler) GetTrash(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	// 멤버 확인
+	if !h.isWorkspaceMember(int64(workspaceID), claims.UserID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you are not a member of this workspace",
+		})
+	}
+
+	var files []model.WorkspaceFile
+	if err := h.db.Unscoped().Preload("Uploader").
+		Where("workspace_id = ? AND deleted_at IS NOT NULL AND id = trash_root_id", workspaceID).
+		Order("deleted_at DESC").
+		Find(&files).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get trash",
+		})
+	}
+
+	items := make([]TrashItemResponse, 0, len(files))
+	for i := range files {
+		deletedAt := files[i].DeletedAt.Time
+		items = append(items, TrashItemResponse{
+			FileResponse: h.toFileResponse(&files[i]),
+			DeletedAt:    deletedAt.Format(time.RFC3339),
+			DeletedBy:    files[i].DeletedBy,
+			PurgeAt:      deletedAt.Add(h.trashRetention).Format(time.RFC3339),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"items":           items,
+		"retention_hours": int(h.trashRetention.Hours()),
+	})
+}
+
+// RestoreFile 휴지통 항목 복원 (POST /api/workspaces/:workspaceId/files/:fileId/restore)
+// 함께 삭제된 하위 항목도 복원하고, 원래 상위 폴더가 없으면 최상위로 복원
+func (h *StorageHandler) RestoreFile(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+	fileID, err := c.ParamsInt("fileId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid file id",
+		})
+	}
+
+	// 멤버 확인
+	if !h.isWorkspaceMember(int64(workspaceID), claims.UserID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you are not a member of this workspace",
+		})
+	}
+
+	var file model.WorkspaceFile
+	err = h.db.Unscoped().
+		Where("id = ? AND workspace_id = ? AND deleted_at IS NOT NULL AND trash_root_id = id", fileID, workspaceID).
+		First(&file).Error
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "file not found in trash",
+		})
+	}
+
+	// 업로더, 삭제한 사람 또는 워크스페이스 소유자만 복원 가능
+	var workspace model.Workspace
+	h.db.First(&workspace, workspaceID)
+
+	canRestore := workspace.OwnerID == claims.UserID ||
+		(file.UploaderID != nil && *file.UploaderID == claims.UserID) ||
+		(file.DeletedBy != nil && *file.DeletedBy == claims.UserID)
+	if !canRestore {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you don't have permission to restore this file",
+		})
+	}
+
+	var s3Keys []string
+	err = h.db.Transaction(func(tx *gorm.DB) error {
+		var items []model.WorkspaceFile
+		if err := tx.Unscoped().Where("trash_root_id = ?", file.ID).Find(&items).Error; err != nil {
+			return err
+		}
+		for _, item := range items {
+			if item.S3Key != nil && *item.S3Key != "" {
+				s3Keys = append(s3Keys, *item.S3Key)
+			}
+		}
+
+		if err := tx.Unscoped().Model(&model.WorkspaceFile{}).Where("trash_root_id = ?", file.ID).Updates(map[string]any{
+			"deleted_at":    nil,
+			"deleted_by":    nil,
+			"trash_root_id": nil,
+		}).Error; err != nil {
+			return err
+		}
+
+		// 상위 폴더가 휴지통에 있거나 영구 삭제되었으면 최상위로
+		if file.ParentFolderID != nil {
+			var parents int64
+			tx.Model(&model.WorkspaceFile{}).Where("id = ?", *file.ParentFolderID).Count(&parents)
+			if parents == 0 {
+				file.ParentFolderID = nil
+				return tx.Model(&model.WorkspaceFile{}).Where("id = ?", file.ID).Update("parent_folder_id", nil).Error
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to restore file",
+		})
+	}
+
+	if h.s3 != nil && len(s3Keys) > 0 {
+		go h.untagRestored(s3Keys)
+	}
+
+	h.db.Preload("Uploader").First(&file, file.ID)
+	return c.JSON(h.toFileResponse(&file))
+}
+
+// StartTrashPurge 보관 기간이 지난 휴지통 항목을 주기적으로 영구 삭제
+func (h *StorageHandler) StartTrashPurge(interval time.Duration) {
+	if interval <= 0 {
+		interval = trashPurgeInterval
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				h.PurgeExpiredTrash()
+			case <-h.stopPurge:
+				return
+			}
+		}
+	}()
+}
+
+// EnsureTrashLifecycle 휴지통 보관 기간에 맞춰 버킷 수명 주기 규칙 설정 (영구 삭제 작업의 안전망)
+func (h *StorageHandler) EnsureTrashLifecycle() {
+	if h.s3 == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), lifecycleTimeout)
+	defer cancel()
+	if err := h.s3.EnsureLifecycleRules(ctx, h.trashRetention); err != nil {
+		log.Printf("[Storage] ⚠️ Failed to configure bucket lifecycle: %v", err)
+		return
+	}
+	log.Printf("[Storage] ♻️ Bucket lifecycle configured (trash retention: %s)", h.trashRetention)
+}
+
+// PurgeExpiredTrash 보관 기간이 지난 휴지통 항목의 S3 객체와 DB 행 영구 삭제
+// S3 삭제에 실패한 항목은 행을 남겨 다음 주기에 다시 시도
+func (h *StorageHandler) PurgeExpiredTrash() {
+	var expired []model.WorkspaceFile
+	cutoff := time.Now().Add(-h.trashRetention)
+	if err := h.db.Unscoped().
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+		Order("deleted_at").
+		Limit(trashPurgeBatchSize).
+		Find(&expired).Error; err != nil {
+		log.Printf("[Storage] Failed to load expired trash: %v", err)
+		return
+	}
+
+	// S3 객체는 DeleteObjects로 한 번에 삭제 (썸네일/미리보기 포함, 파생 객체는 실패해도 행 삭제)
+	var keys []string
+	for _, file := range expired {
+		for _, key := range []*string{file.S3Key, file.ThumbnailKey, file.PreviewKey} {
+			if key != nil && *key != "" {
+				keys = append(keys, *key)
+			}
+		}
+	}
+	var failed map[string]bool
+	if len(keys) > 0 && h.s3 != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), trashPurgeTimeout)
+		err := h.s3.DeleteFiles(ctx, keys)
+		cancel()
+		if err != nil {
+			log.Printf("[Storage] Failed to purge objects: %v", err)
+			if failed = storage.FailedDeleteKeys(err); failed == nil {
+				return
+			}
+		}
+	}
+
+	// 사용량 행이 없으면 삭제 전 합계로 생성해 두고 삭제한 만큼 차감
+	freed := make(map[int64]int64)
+	for _, file := range expired {
+		if _, seen := freed[file.WorkspaceID]; seen {
+			continue
+		}
+		if _, err := h.ensureStorageUsage(file.WorkspaceID); err != nil {
+			log.Printf("[Storage] Failed to load storage usage for workspace %d: %v", file.WorkspaceID, err)
+			return
+		}
+		freed[file.WorkspaceID] = 0
+	}
+
+	purged := 0
+	for _, file := range expired {
+		if file.S3Key != nil && *file.S3Key != "" && (h.s3 == nil || failed[*file.S3Key]) {
+			continue
+		}
+		// 아직 남아 있는 하위 행이 이 행을 참조하지 않도록 (같은 배치나 다음 주기에 삭제됨)
+		h.db.Unscoped().Model(&model.WorkspaceFile{}).Where("parent_folder_id = ?", file.ID).Update("parent_folder_id", nil)
+		if err := h.db.Unscoped().Delete(&model.WorkspaceFile{}, file.ID).Error; err != nil {
+			log.Printf("[Storage] Failed to purge file row %d: %v", file.ID, err)
+			continue
+		}
+		if file.Type == "FILE" && file.FileSize != nil {
+			freed[file.WorkspaceID] += *file.FileSize
+		}
+		purged++
+	}
+	for workspaceID, bytes := range freed {
+		h.addStorageUsage(workspaceID, -bytes)
+	}
+	if purged > 0 {
+		log.Printf("[Storage] 🗑️ Purged %d expired trash item(s)", purged)
+	}
+}
+
+// Close 휴지통 영구 삭제 작업과 썸네일 생성/바이러스 검사 워커 중지
+func (h *StorageHandler) Close() {
+	h.closeOnce.Do(func() {
+		close(h.stopPurge)
+		if h.previews != nil {
+			h.previews.Stop()
+		}
+		if h.scans != nil {
+			h.scans.Stop()
+		}
+	})
+}
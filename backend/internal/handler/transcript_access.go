@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/model"
+)
+
+// =============================================================================
+// 회의 접근 범위 (채팅 기록, 화이트보드, Room 입장 역할 판정에 공통 사용)
+// - 회의 호스트, 워크스페이스 활성 멤버/소유자, 회의 참가자(게스트 포함)
+// =============================================================================
+
+// MeetingAccess 사용자와 회의의 관계
+type MeetingAccess struct {
+	Host            bool
+	WorkspaceMember bool               // 회의가 속한 워크스페이스의 활성 멤버 또는 소유자
+	Participant     *model.Participant // 회의 참가자 (없으면 nil)
+}
+
+// CanAccess 회의에 들어가거나 회의 채팅/화이트보드를 볼 수 있는지
+func (a MeetingAccess) CanAccess() bool {
+	return a.Host || a.WorkspaceMember || a.Participant != nil
+}
+
+// Guest 게스트로 초대된 참가자인지
+func (a MeetingAccess) Guest() bool {
+	return a.Participant != nil && a.Participant.Role == participantRoleGuest
+}
+
+// GetMeetingAccess 사용자와 회의의 관계 조회 (호스트면 다른 조회 생략)
+func GetMeetingAccess(db *gorm.DB, meeting *model.Meeting, userID int64) (MeetingAccess, error) {
+	if meeting.HostID == userID {
+		return MeetingAccess{Host: true}, nil
+	}
+
+	var access MeetingAccess
+	var participants []model.Participant
+	if err := db.Where("meeting_id = ? AND user_id = ?", meeting.ID, userID).Limit(1).Find(&participants).Error; err != nil {
+		return MeetingAccess{}, err
+	}
+	if len(participants) > 0 {
+		access.Participant = &participants[0]
+	}
+
+	if meeting.WorkspaceID != nil {
+		member, err := isWorkspaceMemberOrOwner(db, *meeting.WorkspaceID, userID)
+		if err != nil {
+			return MeetingAccess{}, err
+		}
+		access.WorkspaceMember = member
+	}
+	return access, nil
+}
+
+// isWorkspaceMemberOrOwner 워크스페이스의 활성 멤버 또는 소유자인지
+func isWorkspaceMemberOrOwner(db *gorm.DB, workspaceID, userID int64) (bool, error) {
+	var members int64
+	err := db.Model(&model.WorkspaceMember{}).
+		Where("workspace_id = ? AND user_id = ? AND status = ?", workspaceID, userID, model.MemberStatusActive.String()).
+		Count(&members).Error
+	if err != nil {
+		return false, err
+	}
+	if members == 0 {
+		err = db.Model(&model.Workspace{}).Where("id = ? AND owner_id = ?", workspaceID, userID).Count(&members).Error
+		if err != nil {
+			return false, err
+		}
+	}
+	return members > 0, nil
+}
+
+// =============================================================================
+// 회의록 접근 제어
+// - 워크스페이스 소유자/ADMIN: 조회 + 내보내기
+// - 회의 호스트 및 참가자(GUEST 제외): 조회
+// - 게스트, 비참가자: 접근 불가
+// =============================================================================
+
+// TranscriptAccess 회의록 접근 권한
+type TranscriptAccess struct {
+	CanRead   bool
+	CanExport bool
+}
+
+// participantRoleGuest 게스트 참가자 역할
+const participantRoleGuest = "GUEST"
+
+// GetTranscriptAccess 사용자의 회의록 접근 권한 조회
+func GetTranscriptAccess(db *gorm.DB, meeting *model.Meeting, userID int64) (TranscriptAccess, error) {
+	// 워크스페이스 관리자는 모든 회의록 조회/내보내기 가능
+	if meeting.WorkspaceID != nil {
+		isAdmin, err := auth.CheckPermission(db, *meeting.WorkspaceID, userID, "EXPORT_TRANSCRIPTS")
+		if err != nil {
+			return TranscriptAccess{}, err
+		}
+		if isAdmin {
+			return TranscriptAccess{CanRead: true, CanExport: true}, nil
+		}
+	}
+
+	if meeting.HostID == userID {
+		return TranscriptAccess{CanRead: true}, nil
+	}
+
+	var participant model.Participant
+	err := db.Where("meeting_id = ? AND user_id = ?", meeting.ID, userID).First(&participant).Error
+	if err == gorm.ErrRecordNotFound {
+		return TranscriptAccess{}, nil
+	}
+	if err != nil {
+		return TranscriptAccess{}, err
+	}
+
+	return TranscriptAccess{CanRead: participant.Role != participantRoleGuest}, nil
+}
+
+// RecordTranscriptAccess 회의록 접근 감사 로그 기록
+func RecordTranscriptAccess(db *gorm.DB, c *fiber.Ctx, meeting *model.Meeting, userID int64, action model.TranscriptAccessAction) {
+	userAgent := c.Get(fiber.HeaderUserAgent)
+	if len(userAgent) > 255 {
+		userAgent = userAgent[:255]
+	}
+
+	entry := model.TranscriptAccessLog{
+		WorkspaceID: meeting.WorkspaceID,
+		MeetingID:   meeting.ID,
+		UserID:      userID,
+		Action:      action.String(),
+		IPAddress:   c.IP(),
+		UserAgent:   userAgent,
+	}
+	if err := db.Create(&entry).Error; err != nil {
+		log.Printf("⚠️ Failed to record transcript access (meeting=%d, user=%d, action=%s): %v", meeting.ID, userID, action, err)
+	}
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"realtime-backend/internal/cache"
+	"realtime-backend/internal/model"
+)
+
+// Redis 자막 → voice_records 이동 설정
+const (
+	transcriptClaimBatch  = 500 // claim 한 번에 꺼내는 자막 수
+	voiceRecordInsertSize = 100 // INSERT 한 번에 저장하는 voice_records 수
+)
+
+// transcriptSink claim한 자막 묶음에서 저장할 voice_records와, 같은 트랜잭션에서 함께 처리할 갱신(nil 가능)을 만듦
+type transcriptSink func(entries []cache.RoomTranscript) ([]model.VoiceRecord, func(tx *gorm.DB) error)
+
+// persistTranscripts Room의 Redis 자막을 정확히 한 번 voice_records로 이동하고 저장한 행 수 반환
+//  1. 자막을 claim 토큰과 함께 Redis의 별도 목록으로 옮기고 (원자적)
+//  2. voice_records와 TranscriptClaim 행을 한 트랜잭션으로 저장한 뒤
+//  3. 커밋이 확인된 claim만 Redis에서 삭제
+//
+// 중간에 중단되어 남은 claim은 다음 실행 때 먼저 처리하며, TranscriptClaim 행이 있으면 이미
+// 저장된 것이므로 Redis에서 지우기만 함
+func (h *RoomHub) persistTranscripts(ctx context.Context, roomID string, meetingID int64, sink transcriptSink) (int, error) {
+	saved := 0
+
+	tokens, err := h.redisClient.TranscriptClaims(ctx, roomID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read transcript claims: %w", err)
+	}
+	for _, token := range tokens {
+		entries, err := h.redisClient.ClaimedTranscripts(ctx, roomID, token)
+		if err != nil {
+			return saved, fmt.Errorf("failed to read transcript claim %s: %w", token, err)
+		}
+		n, err := h.commitTranscriptClaim(ctx, roomID, meetingID, token, entries, sink)
+		if err != nil {
+			return saved, err
+		}
+		log.Printf("[Room %s] ♻️ Recovered transcript claim %s (%d saved)", roomID, token, n)
+		saved += n
+	}
+
+	for {
+		token := uuid.New().String()
+		entries, err := h.redisClient.ClaimTranscripts(ctx, roomID, token, transcriptClaimBatch)
+		if err != nil {
+			return saved, fmt.Errorf("failed to claim transcripts: %w", err)
+		}
+		if len(entries) == 0 {
+			return saved, nil
+		}
+		n, err := h.commitTranscriptClaim(ctx, roomID, meetingID, token, entries, sink)
+		if err != nil {
+			return saved, err
+		}
+		saved += n
+		if len(entries) < transcriptClaimBatch {
+			return saved, nil
+		}
+	}
+}
+
+// commitTranscriptClaim claim 하나를 DB에 저장하고 (이미 저장됐으면 건너뜀) Redis에서 claim 삭제
+func (h *RoomHub) commitTranscriptClaim(ctx context.Context, roomID string, meetingID int64, token string, entries []cache.RoomTranscript, sink transcriptSink) (int, error) {
+	saved := 0
+	err := h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		records, commit := sink(entries)
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.TranscriptClaim{
+			Token:     token,
+			MeetingID: meetingID,
+			RoomID:    roomID,
+			Entries:   len(entries),
+			Records:   len(records),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			// 이전 실행에서 커밋된 뒤 Redis 삭제 전에 중단됨
+			return nil
+		}
+
+		if len(records) > 0 {
+			if err := tx.CreateInBatches(&records, voiceRecordInsertSize).Error; err != nil {
+				return err
+			}
+		}
+		if commit != nil {
+			if err := commit(tx); err != nil {
+				return err
+			}
+		}
+		saved = len(records)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to save transcripts: %w", err)
+	}
+
+	// 커밋된 뒤에만 삭제 (실패해도 다음 실행에서 TranscriptClaim 행을 보고 삭제만 함)
+	if err := h.redisClient.ReleaseTranscriptClaim(ctx, roomID, token); err != nil {
+		log.Printf("[Room %s] Failed to release transcript claim %s: %v", roomID, token, err)
+	}
+	return saved, nil
+}
+
+// releaseCommittedClaims DB에 이미 저장된 claim만 Redis에서 삭제 (저장되지 않은 claim은 다음 flush에서 처리)
+func (h *RoomHub) releaseCommittedClaims(ctx context.Context, roomID string) {
+	tokens, err := h.redisClient.TranscriptClaims(ctx, roomID)
+	if err != nil || len(tokens) == 0 {
+		return
+	}
+
+	var committed []string
+	if err := h.db.Model(&model.TranscriptClaim{}).Where("token IN ?", tokens).Pluck("token", &committed).Error; err != nil {
+		log.Printf("[Room %s] Failed to check transcript claims: %v", roomID, err)
+		return
+	}
+	for _, token := range committed {
+		if err := h.redisClient.ReleaseTranscriptClaim(ctx, roomID, token); err != nil {
+			log.Printf("[Room %s] Failed to release transcript claim %s: %v", roomID, token, err)
+		}
+	}
+}
@@ -0,0 +1,429 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/auth"
+	awsai "realtime-backend/internal/aws"
+	"realtime-backend/internal/export"
+	"realtime-backend/internal/model"
+)
+
+// ExportVoiceRecordsDOCX 회의록을 DOCX로 내보내 S3에 업로드하고 미팅 관련 파일로 등록
+func (h *VoiceRecordHandler) ExportVoiceRecordsDOCX(c *fiber.Ctx) error {
+	if h.s3 == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "S3 service is not configured",
+		})
+	}
+
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	meetingID, err := c.ParamsInt("meetingId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid meeting id",
+		})
+	}
+
+	// 미팅 확인
+	var meeting model.Meeting
+	if err := h.db.Where("id = ? AND workspace_id = ?", meetingID, workspaceID).First(&meeting).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "meeting not found",
+		})
+	}
+
+	// 내보내기 권한 확인
+	access, err := GetTranscriptAccess(h.db, &meeting, claims.UserID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !access.CanExport {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you do not have permission to export this transcript",
+		})
+	}
+
+	var records []model.VoiceRecord
+	if err := h.db.Where("meeting_id = ?", meetingID).Preload("Speaker").Order(voiceRecordOrder).Find(&records).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get voice records",
+		})
+	}
+	if len(records) == 0 {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "no transcript to export",
+		})
+	}
+
+	data, err := export.RenderDOCX(buildTranscriptDocument(&meeting, records, loadSessionBreaks(h.db, meeting.ID)))
+	if err != nil {
+		log.Printf("❌ Failed to render DOCX for meeting %d: %v", meetingID, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to render document",
+		})
+	}
+
+	fileName := fmt.Sprintf("meeting-%d-transcript-%s.docx", meetingID, time.Now().Format("20060102-150405"))
+	upload, err := h.s3.UploadFile(int64(workspaceID), fileName, export.DOCXContentType, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		log.Printf("❌ Failed to upload DOCX for meeting %d: %v", meetingID, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to upload document",
+		})
+	}
+
+	// 미팅 관련 파일로 등록 (워크스페이스 파일 목록/미팅에서 링크)
+	mimeType := export.DOCXContentType
+	file := model.WorkspaceFile{
+		WorkspaceID:      int64(workspaceID),
+		UploaderID:       &claims.UserID,
+		Name:             fileName,
+		Type:             "FILE",
+		FileURL:          &upload.URL,
+		FileSize:         &upload.FileSize,
+		MimeType:         &mimeType,
+		S3Key:            &upload.Key,
+		RelatedMeetingID: &meeting.ID,
+	}
+	if err := h.db.Create(&file).Error; err != nil {
+		_ = h.s3.DeleteFile(upload.Key)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to save file metadata",
+		})
+	}
+	RecordTranscriptAccess(h.db, c, &meeting, claims.UserID, model.TranscriptAccessExport)
+
+	downloadURL, err := h.s3.GetFileURL(upload.Key)
+	if err != nil {
+		downloadURL = upload.URL
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"file": FileResponse{
+			ID:               file.ID,
+			WorkspaceID:      file.WorkspaceID,
+			UploaderID:       file.UploaderID,
+			Name:             file.Name,
+			Type:             file.Type,
+			FileURL:          file.FileURL,
+			FileSize:         file.FileSize,
+			MimeType:         file.MimeType,
+			S3Key:            file.S3Key,
+			RelatedMeetingID: file.RelatedMeetingID,
+			CreatedAt:        file.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		},
+		"download_url": downloadURL,
+	})
+}
+
+// ExportTranscript 회의록을 SRT/WebVTT/JSON/TXT로 내보내기
+// 진행 중인 회의는 아직 DB에 저장되지 않은 Redis 자막도 포함
+// ?format=srt|vtt|json|txt, ?lang=원문은 "original" (SRT/VTT 기본값), ?upload=true면 S3에 올리고 presigned URL 반환
+func (h *VoiceRecordHandler) ExportTranscript(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	meetingID, err := c.ParamsInt("meetingId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid meeting id",
+		})
+	}
+
+	format := strings.ToLower(c.Query("format", export.FormatTXT))
+	switch format {
+	case export.FormatSRT, export.FormatVTT, export.FormatJSON, export.FormatTXT:
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "format must be one of srt, vtt, json, txt",
+		})
+	}
+
+	upload := c.QueryBool("upload")
+	if upload && h.s3 == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "S3 service is not configured",
+		})
+	}
+
+	// 미팅 확인
+	var meeting model.Meeting
+	if err := h.db.Where("id = ? AND workspace_id = ?", meetingID, workspaceID).First(&meeting).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "meeting not found",
+		})
+	}
+
+	// 내보내기 권한 확인
+	access, err := GetTranscriptAccess(h.db, &meeting, claims.UserID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !access.CanExport {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you do not have permission to export this transcript",
+		})
+	}
+
+	var records []model.VoiceRecord
+	if err := h.db.Where("meeting_id = ?", meetingID).Preload("Speaker").Order(voiceRecordOrder).Find(&records).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get voice records",
+		})
+	}
+	records = append(records, h.liveVoiceRecords(&meeting, records)...)
+	sort.SliceStable(records, func(i, j int) bool { return records[i].Seq < records[j].Seq })
+	if len(records) == 0 {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "no transcript to export",
+		})
+	}
+
+	doc := buildTranscriptDocument(&meeting, records, loadSessionBreaks(h.db, meeting.ID))
+	lang := c.Query("lang")
+	if lang != "" || format == export.FormatSRT || format == export.FormatVTT {
+		if !selectTranscriptSection(doc, lang) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "no transcript in the requested language",
+			})
+		}
+	}
+
+	data, err := export.Render(doc, format)
+	if err != nil {
+		log.Printf("❌ Failed to render %s transcript for meeting %d: %v", format, meetingID, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to render transcript",
+		})
+	}
+	RecordTranscriptAccess(h.db, c, &meeting, claims.UserID, model.TranscriptAccessExport)
+
+	suffix := ""
+	if lang != "" {
+		suffix = "-" + lang
+	}
+	fileName := fmt.Sprintf("meeting-%d-transcript%s.%s", meetingID, suffix, format)
+
+	if !upload {
+		c.Set(fiber.HeaderContentType, export.ContentType(format))
+		c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, fileName))
+		return c.Send(data)
+	}
+
+	key := fmt.Sprintf("exports/meetings/%d/%s/%s", meetingID, time.Now().Format("20060102-150405"), fileName)
+	ctx, cancel := context.WithTimeout(c.Context(), 30*time.Second)
+	defer cancel()
+	if err := h.s3.PutObject(ctx, meetingWorkspaceID(&meeting), key, export.ContentType(format), data); err != nil {
+		log.Printf("❌ Failed to upload %s transcript for meeting %d: %v", format, meetingID, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to upload transcript",
+		})
+	}
+	downloadURL, err := h.s3.GetFileURL(key)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to create download url",
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"file_name":    fileName,
+		"format":       format,
+		"size":         len(data),
+		"download_url": downloadURL,
+	})
+}
+
+// liveVoiceRecords 진행 중인 회의의 Redis 자막 중 아직 DB에 없는 final 발화
+func (h *VoiceRecordHandler) liveVoiceRecords(meeting *model.Meeting, stored []model.VoiceRecord) []model.VoiceRecord {
+	if h.roomHub == nil {
+		return nil
+	}
+
+	recordKey := func(speaker, original, targetLang string, at time.Time) string {
+		return fmt.Sprintf("%s|%s|%s|%d", speaker, original, targetLang, at.Unix())
+	}
+	seen := make(map[string]bool, len(stored))
+	for _, record := range stored {
+		target := ""
+		if record.TargetLang != nil {
+			target = *record.TargetLang
+		}
+		seen[recordKey(record.SpeakerName, record.Original, target, record.CreatedAt)] = true
+	}
+
+	var live []model.VoiceRecord
+	for _, roomID := range []string{fmt.Sprintf("meeting-%d", meeting.ID), meeting.Code} {
+		transcripts, err := h.roomHub.GetTranscripts(roomID)
+		if err != nil {
+			log.Printf("[Room %s] Failed to read live transcripts for export: %v", roomID, err)
+			continue
+		}
+		for _, t := range transcripts {
+			key := recordKey(t.SpeakerName, t.Original, t.TargetLang, t.Timestamp)
+			if !t.IsFinal || seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			record := model.VoiceRecord{
+				MeetingID:   meeting.ID,
+				SpeakerName: t.SpeakerName,
+				Original:    t.Original,
+				Crosstalk:   t.Crosstalk,
+				Seq:         int64(t.OrderKey()),
+				CreatedAt:   t.Timestamp,
+			}
+			if t.SourceLang != "" {
+				record.SourceLang = &t.SourceLang
+			}
+			if t.TargetLang != "" && t.Translated != "" {
+				record.TargetLang = &t.TargetLang
+				record.Translated = &t.Translated
+			}
+			live = append(live, record)
+		}
+	}
+	return live
+}
+
+// selectTranscriptSection 문서를 한 언어 섹션만 남김 ("" 또는 "original" = 원문)
+func selectTranscriptSection(doc *export.TranscriptDocument, lang string) bool {
+	if lang == "original" {
+		lang = ""
+	} else if lang != "" {
+		if lang = awsai.NormalizeLanguage(lang); lang == "" {
+			return false
+		}
+	}
+	for _, section := range doc.Sections {
+		if section.Language == lang || (lang != "" && awsai.NormalizeLanguage(section.Language) == lang) {
+			doc.Sections = []export.TranscriptSection{section}
+			return len(section.Entries) > 0
+		}
+	}
+	return false
+}
+
+// buildTranscriptDocument 음성 기록을 원문 섹션 + 번역 언어별 섹션으로 구성
+// 재개된 회의는 각 섹션의 재개 시점에 세션 구분 표시를 넣어 한 회의록으로 이어 붙임
+func buildTranscriptDocument(meeting *model.Meeting, records []model.VoiceRecord, breaks []model.MeetingSessionBreak) *export.TranscriptDocument {
+	doc := &export.TranscriptDocument{
+		Title:     meeting.Title,
+		StartedAt: meeting.StartedAt,
+	}
+
+	// 번역 언어마다 같은 원문이 저장되므로 원문은 (화자, 원문, 초 단위 시각)으로 중복 제거
+	original := export.TranscriptSection{Heading: "Original"}
+	seen := make(map[string]bool)
+	translated := make(map[string]*export.TranscriptSection)
+
+	for _, record := range records {
+		speaker := record.SpeakerName
+		if record.Speaker != nil && record.Speaker.Nickname != "" {
+			speaker = record.Speaker.Nickname
+		}
+
+		key := fmt.Sprintf("%s|%s|%d", speaker, record.Original, record.CreatedAt.Unix())
+		if !seen[key] {
+			seen[key] = true
+			original.Entries = append(original.Entries, export.TranscriptEntry{
+				Speaker:   speaker,
+				Timestamp: record.CreatedAt,
+				Text:      record.Original,
+			})
+		}
+
+		if record.TargetLang == nil || record.Translated == nil || *record.Translated == "" {
+			continue
+		}
+		lang := *record.TargetLang
+		section, ok := translated[lang]
+		if !ok {
+			heading := lang
+			if info, found := awsai.LookupLanguage(lang); found {
+				heading = fmt.Sprintf("%s (%s)", info.Name, info.Code)
+			}
+			section = &export.TranscriptSection{Language: lang, Heading: heading}
+			translated[lang] = section
+		}
+		section.Entries = append(section.Entries, export.TranscriptEntry{
+			Speaker:   speaker,
+			Timestamp: record.CreatedAt,
+			Text:      *record.Translated,
+		})
+	}
+
+	doc.Sections = append(doc.Sections, original)
+	langs := make([]string, 0, len(translated))
+	for lang := range translated {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	for _, lang := range langs {
+		doc.Sections = append(doc.Sections, *translated[lang])
+	}
+	for i := range doc.Sections {
+		insertSessionBreaks(&doc.Sections[i], breaks)
+	}
+	return doc
+}
+
+// loadSessionBreaks 회의 재개 지점 (세션 순)
+func loadSessionBreaks(db *gorm.DB, meetingID int64) []model.MeetingSessionBreak {
+	var breaks []model.MeetingSessionBreak
+	if err := db.Where("meeting_id = ?", meetingID).Order("session ASC").Find(&breaks).Error; err != nil {
+		log.Printf("[Meeting %d] Failed to load session breaks: %v", meetingID, err)
+	}
+	return breaks
+}
+
+// insertSessionBreaks 재개 시각 순서에 맞춰 섹션에 세션 구분 항목 삽입
+func insertSessionBreaks(section *export.TranscriptSection, breaks []model.MeetingSessionBreak) {
+	if len(breaks) == 0 {
+		return
+	}
+	entries := make([]export.TranscriptEntry, 0, len(section.Entries)+len(breaks))
+	next := 0
+	for _, entry := range section.Entries {
+		for next < len(breaks) && !entry.Timestamp.Before(breaks[next].ResumedAt) {
+			entries = append(entries, sessionBreakEntry(breaks[next]))
+			next++
+		}
+		entries = append(entries, entry)
+	}
+	for ; next < len(breaks); next++ {
+		entries = append(entries, sessionBreakEntry(breaks[next]))
+	}
+	section.Entries = entries
+}
+
+func sessionBreakEntry(b model.MeetingSessionBreak) export.TranscriptEntry {
+	return export.TranscriptEntry{
+		Timestamp: b.ResumedAt,
+		Text:      fmt.Sprintf("Session %d (resumed %s)", b.Session, b.ResumedAt.Format("2006-01-02 15:04")),
+		Break:     true,
+	}
+}
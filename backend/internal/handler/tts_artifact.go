@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"realtime-backend/internal/ai"
+	"realtime-backend/internal/auth"
+	awsai "realtime-backend/internal/aws"
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/storage"
+)
+
+// ttsArtifactFile TTS 오디오 형식별 파일 확장자와 MIME 타입
+func ttsArtifactFile(format string) (ext, contentType string) {
+	switch format {
+	case awsai.AudioFormatOgg:
+		return "ogg", "audio/ogg"
+	case awsai.AudioFormatPCM:
+		return "pcm", "audio/L16"
+	default:
+		return "mp3", "audio/mpeg"
+	}
+}
+
+// WriteTTSArtifact 리스너에게 보낸 TTS 클립을 transcriptID + 언어 + 음성 단위로 S3에 보관하고 매핑 저장
+// 같은 클립이 다시 오면(재전송) 처음 저장한 것을 유지
+func (rec *roomRecorder) WriteTTSArtifact(audio *ai.AudioMessage) {
+	if audio.TranscriptID == "" || len(audio.AudioData) == 0 {
+		return
+	}
+
+	rec.mu.Lock()
+	if rec.stopped {
+		rec.mu.Unlock()
+		return
+	}
+	rec.uploads.Add(1)
+	rec.mu.Unlock()
+
+	format := audio.Format
+	if format == "" {
+		format = awsai.AudioFormatMP3
+	}
+	ext, contentType := ttsArtifactFile(format)
+	name := audio.TargetLanguage
+	if audio.VoiceKey != "" {
+		name += "-" + strings.NewReplacer("/", "_", ":", "_").Replace(audio.VoiceKey)
+	}
+
+	artifact := model.TTSArtifact{
+		MeetingID:    rec.meetingID,
+		TranscriptID: audio.TranscriptID,
+		Language:     audio.TargetLanguage,
+		VoiceKey:     audio.VoiceKey,
+		SpeakerID:    audio.SpeakerParticipantID,
+		S3Key:        fmt.Sprintf("recordings/meetings/%d/tts/%s/%s.%s", rec.meetingID, audio.TranscriptID, name, ext),
+		Format:       format,
+		SampleRate:   int(audio.SampleRate),
+		SizeBytes:    int64(len(audio.AudioData)),
+	}
+	data := audio.AudioData
+
+	go func() {
+		defer rec.uploads.Done()
+
+		ctx, cancel := context.WithTimeout(context.Background(), recordingUploadTimeout)
+		defer cancel()
+		if err := rec.storage.PutObject(ctx, rec.workspaceID, artifact.S3Key, contentType, data); err != nil {
+			log.Printf("[Room %s] ❌ Failed to upload TTS artifact %s: %v", rec.room.ID, artifact.S3Key, err)
+			return
+		}
+		if rec.room.hub.db == nil {
+			return
+		}
+		if err := rec.room.hub.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&artifact).Error; err != nil {
+			log.Printf("[Room %s] Failed to save TTS artifact %s: %v", rec.room.ID, artifact.S3Key, err)
+		}
+	}()
+}
+
+// =============================================================================
+// TTS artifact API
+// =============================================================================
+
+// TTSArtifactHandler 보관된 TTS 클립 조회 핸들러
+type TTSArtifactHandler struct {
+	db *gorm.DB
+	s3 *storage.S3Service
+}
+
+// NewTTSArtifactHandler TTSArtifactHandler 생성
+func NewTTSArtifactHandler(db *gorm.DB, s3 *storage.S3Service) *TTSArtifactHandler {
+	return &TTSArtifactHandler{db: db, s3: s3}
+}
+
+// TTSArtifactResponse TTS 클립 응답 (재생용 presigned URL 포함)
+type TTSArtifactResponse struct {
+	model.TTSArtifact
+	URL string `json:"url,omitempty"`
+}
+
+// GetTTSArtifacts 회의에서 재생된 TTS 클립 목록 (회의록 읽기 권한 필요)
+// ?transcriptId=, ?lang=으로 필터링
+func (h *TTSArtifactHandler) GetTTSArtifacts(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+	meetingID, err := c.ParamsInt("meetingId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid meeting id",
+		})
+	}
+
+	var meeting model.Meeting
+	if err := h.db.Where("id = ? AND workspace_id = ?", meetingID, workspaceID).First(&meeting).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "meeting not found",
+		})
+	}
+
+	access, err := GetTranscriptAccess(h.db, &meeting, claims.UserID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !access.CanRead {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you do not have permission to access this meeting's recordings",
+		})
+	}
+
+	query := h.db.Where("meeting_id = ?", meeting.ID)
+	if transcriptID := c.Query("transcriptId"); transcriptID != "" {
+		query = query.Where("transcript_id = ?", transcriptID)
+	}
+	if lang := c.Query("lang"); lang != "" {
+		query = query.Where("language = ?", awsai.NormalizeLanguage(lang))
+	}
+
+	var artifacts []model.TTSArtifact
+	if err := query.Order("created_at ASC, id ASC").Find(&artifacts).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get tts artifacts",
+		})
+	}
+
+	responses := make([]TTSArtifactResponse, len(artifacts))
+	for i, artifact := range artifacts {
+		responses[i] = TTSArtifactResponse{TTSArtifact: artifact}
+		if h.s3 != nil {
+			if url, err := h.s3.GetFileURL(artifact.S3Key); err == nil {
+				responses[i].URL = url
+			}
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"meeting_id": meeting.ID,
+		"artifacts":  responses,
+		"total":      len(responses),
+	})
+}
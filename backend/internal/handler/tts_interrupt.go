@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"strings"
+
+	awsai "realtime-backend/internal/aws"
+)
+
+// 리스너별 TTS 중단 방식 (같은 화자의 새 final이 이전 final을 대체했을 때)
+const (
+	TTSInterruptKeep = "keep" // 이미 대기 중인 이전 TTS도 모두 수신 (기본값, audio_cancel 이벤트로 클라이언트가 판단)
+	TTSInterruptDrop = "drop" // 아직 전송되지 않은 이전 TTS는 서버에서 버림
+)
+
+// ErrInvalidTTSInterruptPolicy Room TTS 중단 정책이 none | signal | drop 이 아님
+var ErrInvalidTTSInterruptPolicy = errors.New("tts interrupt policy must be none, signal or drop")
+
+// ParseTTSInterrupt 리스너 TTS 중단 방식 검증 (빈 값이면 기본값)
+func ParseTTSInterrupt(mode string) (string, bool) {
+	switch mode = strings.ToLower(strings.TrimSpace(mode)); mode {
+	case "":
+		return TTSInterruptKeep, true
+	case TTSInterruptKeep, TTSInterruptDrop:
+		return mode, true
+	}
+	return "", false
+}
+
+// SetListenerTTSInterrupt 리스너의 TTS 중단 방식 변경
+func (r *Room) SetListenerTTSInterrupt(listenerID, mode string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	listener, exists := r.Listeners.Get(listenerID)
+	if !exists {
+		return
+	}
+	listener.DropSuperseded = mode == TTSInterruptDrop
+
+	log.Printf("[Room %s] Listener %s changed TTS interrupt: %s", r.ID, listenerID, mode)
+}
+
+// SetTTSInterruptPolicy Room의 TTS 중단 정책 변경 (none | signal | drop, 호스트 전용)
+func (r *Room) SetTTSInterruptPolicy(hostID, policy string) error {
+	if !r.isModerator(hostID) {
+		return ErrNotModerator
+	}
+	parsed, ok := awsai.ParseTTSInterruptPolicy(policy)
+	if !ok {
+		return ErrInvalidTTSInterruptPolicy
+	}
+
+	r.mu.RLock()
+	pipeline := r.awsPipeline
+	r.mu.RUnlock()
+
+	if pipeline != nil {
+		pipeline.SetTTSInterruptPolicy(parsed)
+	}
+	log.Printf("[Room %s] TTS interrupt policy: %s (host: %s)", r.ID, parsed, hostID)
+	return nil
+}
+
+// isSuperseded 같은 화자의 새 final이 해당 자막을 대체했는지 확인
+func (r *Room) isSuperseded(transcriptID string) bool {
+	if transcriptID == "" {
+		return false
+	}
+	r.mu.RLock()
+	pipeline := r.awsPipeline
+	r.mu.RUnlock()
+
+	return pipeline != nil && pipeline.IsSuperseded(transcriptID)
+}
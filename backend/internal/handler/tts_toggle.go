@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"sort"
+
+	awsai "realtime-backend/internal/aws"
+)
+
+var (
+	ErrNotTTSHost         = errors.New("only the host can turn TTS on or off")
+	ErrUnsupportedTTSLang = errors.New("unsupported target language")
+)
+
+// TTSLanguageState 모든 참가자에게 브로드캐스트되는 언어별 TTS 상태
+type TTSLanguageState struct {
+	Disabled []string `json:"disabled"` // TTS가 꺼진 대상 언어 (자막은 계속 전송)
+}
+
+// =============================================================================
+// Room Methods - Per-language TTS toggle
+// =============================================================================
+
+// SetLanguageTTS 대상 언어의 TTS 켜기/끄기 (호스트 전용, 꺼도 자막은 계속 전송)
+func (r *Room) SetLanguageTTS(hostID, targetLang string, enabled bool) error {
+	if !r.isModerator(hostID) {
+		return ErrNotTTSHost
+	}
+	lang := awsai.NormalizeLanguage(targetLang)
+	if !awsai.IsSupportedLanguage(lang) {
+		return ErrUnsupportedTTSLang
+	}
+
+	r.mu.Lock()
+	if r.ttsDisabled == nil {
+		r.ttsDisabled = make(map[string]bool)
+	}
+	if enabled {
+		delete(r.ttsDisabled, lang)
+	} else {
+		r.ttsDisabled[lang] = true
+	}
+	disabled := r.ttsDisabledLanguagesLocked()
+	pipeline := r.awsPipeline
+	r.mu.Unlock()
+
+	if pipeline != nil {
+		pipeline.SetTTSDisabledLanguages(disabled)
+	}
+	log.Printf("[Room %s] 🔇 TTS for %s enabled=%v (host: %s)", r.ID, lang, enabled, hostID)
+
+	r.Broadcast(&BroadcastMessage{
+		Type: "tts_languages",
+		Data: TTSLanguageState{Disabled: disabled},
+	})
+	return nil
+}
+
+// TTSDisabledLanguages TTS가 꺼진 대상 언어 목록
+func (r *Room) TTSDisabledLanguages() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.ttsDisabledLanguagesLocked()
+}
+
+// ttsDisabledLanguagesLocked (r.mu를 잡은 상태에서 호출)
+func (r *Room) ttsDisabledLanguagesLocked() []string {
+	langs := make([]string, 0, len(r.ttsDisabled))
+	for lang := range r.ttsDisabled {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// SendTTSLanguageState 새로 접속한 참가자에게 꺼진 TTS 언어 전송 (모두 켜져 있으면 생략)
+func (r *Room) SendTTSLanguageState(listenerID string) {
+	disabled := r.TTSDisabledLanguages()
+	if len(disabled) == 0 {
+		return
+	}
+	r.Broadcast(&BroadcastMessage{
+		Type:             "tts_languages",
+		Data:             TTSLanguageState{Disabled: disabled},
+		TargetListenerID: listenerID,
+	})
+}
+
+// sendTTSLanguageError 요청한 참가자에게 TTS 설정 오류 전송
+func (r *Room) sendTTSLanguageError(listenerID string, err error) {
+	r.Broadcast(&BroadcastMessage{
+		Type:             "tts_languages_error",
+		Data:             map[string]string{"message": err.Error()},
+		TargetListenerID: listenerID,
+	})
+}
@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"log"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/auth"
+	awsai "realtime-backend/internal/aws"
+	"realtime-backend/internal/model"
+)
+
+// 사용량 기록 주기
+const usageFlushInterval = time.Minute
+
+// =============================================================================
+// RoomHub - usage accounting
+// =============================================================================
+
+// StartUsageFlush 활성 Room의 AWS 사용량을 주기적으로 UsageRecord로 저장
+func (h *RoomHub) StartUsageFlush(interval time.Duration) {
+	if interval <= 0 {
+		interval = usageFlushInterval
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				h.FlushUsage()
+			case <-h.stopRecovery:
+				return
+			}
+		}
+	}()
+}
+
+// FlushUsage 모든 활성 Room의 누적 사용량 저장
+func (h *RoomHub) FlushUsage() {
+	h.mu.RLock()
+	rooms := make([]*Room, 0, len(h.rooms))
+	for _, room := range h.rooms {
+		rooms = append(rooms, room)
+	}
+	h.mu.RUnlock()
+
+	for _, room := range rooms {
+		room.mu.RLock()
+		pipeline := room.awsPipeline
+		room.mu.RUnlock()
+		if pipeline != nil {
+			room.flushUsage(pipeline)
+		}
+	}
+
+	// 방금 저장한 사용량을 기준으로 워크스페이스 한도 확인값 갱신
+	h.refreshQuotas()
+}
+
+// WorkspaceLiveUsage 아직 저장되지 않은 분을 포함한 워크스페이스 활성 Room의 누적 사용량
+func (h *RoomHub) WorkspaceLiveUsage(workspaceID int64) map[string]awsai.UsageSnapshot {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	usage := make(map[string]awsai.UsageSnapshot)
+	for id, room := range h.rooms {
+		room.mu.RLock()
+		matches := room.workspaceID == workspaceID
+		pipeline := room.awsPipeline
+		room.mu.RUnlock()
+
+		if matches && pipeline != nil {
+			usage[id] = pipeline.Usage()
+		}
+	}
+	return usage
+}
+
+// flushUsage 마지막 기록 이후 사용량을 UsageRecord로 저장
+func (r *Room) flushUsage(pipeline *awsai.Pipeline) {
+	r.usageMu.Lock()
+	defer r.usageMu.Unlock()
+
+	now := time.Now()
+	delta := pipeline.DrainUsage()
+	quality := pipeline.DrainQuality()
+	periodStart := r.usageSince
+	r.usageSince = now
+	if (delta.IsZero() && len(quality) == 0) || r.hub.db == nil {
+		return
+	}
+
+	var meetingID, workspaceID *int64
+	if meeting, err := r.findMeeting(); err == nil {
+		meetingID = &meeting.ID
+		workspaceID = meeting.WorkspaceID
+	}
+
+	if !delta.IsZero() {
+		r.saveUsageRecord(delta, periodStart, now, meetingID, workspaceID)
+	}
+	if len(quality) > 0 {
+		r.saveQualityRecords(quality, periodStart, now, meetingID, workspaceID)
+	}
+}
+
+// saveUsageRecord 사용량 누적분을 UsageRecord로 저장
+func (r *Room) saveUsageRecord(delta awsai.UsageSnapshot, periodStart, periodEnd time.Time, meetingID, workspaceID *int64) {
+	record := model.UsageRecord{
+		WorkspaceID:       workspaceID,
+		MeetingID:         meetingID,
+		RoomID:            r.ID,
+		PeriodStart:       periodStart,
+		PeriodEnd:         periodEnd,
+		TranscribeSeconds: delta.TranscribeSeconds,
+		TranslateChars:    delta.TranslateChars,
+		PollyChars:        delta.PollyChars,
+		EstimatedCostUSD:  delta.EstimatedCostUSD,
+	}
+
+	if err := r.hub.db.Create(&record).Error; err != nil {
+		log.Printf("[Room %s] Failed to save usage record: %v", r.ID, err)
+	}
+}
+
+// =============================================================================
+// REST - usage report
+// =============================================================================
+
+// UsageHandler 워크스페이스 AWS 사용량/비용 조회 핸들러
+type UsageHandler struct {
+	db      *gorm.DB
+	roomHub *RoomHub
+}
+
+// NewUsageHandler UsageHandler 생성
+func NewUsageHandler(db *gorm.DB, roomHub *RoomHub) *UsageHandler {
+	return &UsageHandler{db: db, roomHub: roomHub}
+}
+
+// UsageTotals 사용량 합계
+type UsageTotals struct {
+	TranscribeSeconds float64 `json:"transcribe_seconds"`
+	TranslateChars    int64   `json:"translate_chars"`
+	PollyChars        int64   `json:"polly_chars"`
+	EstimatedCostUSD  float64 `json:"estimated_cost_usd"`
+}
+
+// RoomUsage Room(회의)별 사용량 합계
+type RoomUsage struct {
+	RoomID    string `json:"room_id"`
+	MeetingID *int64 `json:"meeting_id"`
+	UsageTotals
+}
+
+// GetWorkspaceUsage 워크스페이스 사용량 조회 (?from=&to= RFC3339, 기본: 최근 30일)
+func (h *UsageHandler) GetWorkspaceUsage(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("id")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid workspace id"})
+	}
+
+	// 권한 확인 (ADMIN)
+	hasPermission, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "ADMIN")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !hasPermission {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "you do not have permission to view usage"})
+	}
+
+	to := time.Now()
+	from := to.AddDate(0, 0, -30)
+	if v := c.Query("from"); v != "" {
+		if from, err = time.Parse(time.RFC3339, v); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid from (RFC3339)"})
+		}
+	}
+	if v := c.Query("to"); v != "" {
+		if to, err = time.Parse(time.RFC3339, v); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid to (RFC3339)"})
+		}
+	}
+
+	var rooms []RoomUsage
+	if err := h.db.Model(&model.UsageRecord{}).
+		Select("room_id, meeting_id, SUM(transcribe_seconds) AS transcribe_seconds, SUM(translate_chars) AS translate_chars, "+
+			"SUM(polly_chars) AS polly_chars, SUM(estimated_cost_usd) AS estimated_cost_usd").
+		Where("workspace_id = ? AND period_start >= ? AND period_start < ?", workspaceID, from, to).
+		Group("room_id, meeting_id").
+		Order("estimated_cost_usd DESC").
+		Scan(&rooms).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to get usage"})
+	}
+
+	var totals UsageTotals
+	for _, room := range rooms {
+		totals.TranscribeSeconds += room.TranscribeSeconds
+		totals.TranslateChars += room.TranslateChars
+		totals.PollyChars += room.PollyChars
+		totals.EstimatedCostUSD += room.EstimatedCostUSD
+	}
+
+	response := fiber.Map{
+		"workspace_id": workspaceID,
+		"from":         from,
+		"to":           to,
+		"totals":       totals,
+		"rooms":        rooms,
+	}
+	if h.roomHub != nil {
+		response["live"] = h.roomHub.WorkspaceLiveUsage(int64(workspaceID))
+	}
+	return c.JSON(response)
+}
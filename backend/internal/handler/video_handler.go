@@ -17,12 +17,16 @@ import (
 )
 
 type VideoHandler struct {
-	cfg *config.Config
-	db  *gorm.DB
+	cfg        *config.Config
+	db         *gorm.DB
+	jwtManager *internalAuth.JWTManager
+	roomRole   func(roomID string, userID int64) (string, int64, error) // MeetingHandler.RoomRole
 }
 
-func NewVideoHandler(cfg *config.Config, db *gorm.DB) *VideoHandler {
-	return &VideoHandler{cfg: cfg, db: db}
+// NewVideoHandler roomRole은 토큰을 발급할 Room의 입장 가능 여부와 역할 확인용 (MeetingHandler.RoomRole)
+func NewVideoHandler(cfg *config.Config, db *gorm.DB, jwtManager *internalAuth.JWTManager,
+	roomRole func(roomID string, userID int64) (string, int64, error)) *VideoHandler {
+	return &VideoHandler{cfg: cfg, db: db, jwtManager: jwtManager, roomRole: roomRole}
 }
 
 type TokenRequest struct {
@@ -31,7 +35,8 @@ type TokenRequest struct {
 }
 
 type TokenResponse struct {
-	Token string `json:"token"`
+	Token     string `json:"token"`
+	RoomToken string `json:"roomToken,omitempty"` // /ws/room, /ws/audio 접속용 (?token=)
 }
 
 // ParticipantMetadata is stored in LiveKit participant metadata
@@ -94,6 +99,23 @@ func (h *VideoHandler) GenerateToken(c *fiber.Ctx) error {
 		}
 	}
 
+	// 회의/채널 멤버만 토큰 발급 (/ws/room 입장 토큰, 쿠키 접속과 같은 규칙)
+	claims, err := internalAuth.GetClaimsFromContext(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "unauthorized",
+		})
+	}
+	role, _, err := h.roomRole(req.RoomName, claims.UserID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if role == "" {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you are not a member of this room",
+		})
+	}
+
 	// Get user profile image from database
 	var metadata ParticipantMetadata
 	if userID, ok := c.Locals("userId").(int64); ok {
@@ -139,7 +161,16 @@ func (h *VideoHandler) GenerateToken(c *fiber.Ctx) error {
 		})
 	}
 
-	return c.JSON(TokenResponse{Token: token})
+	// 번역 WebSocket용 Room 토큰 (같은 Room, 사용자 ID를 listenerId로 고정)
+	participantID := fmt.Sprintf("%d", claims.UserID)
+	roomToken, err := h.jwtManager.GenerateRoomToken(claims.UserID, participantID, req.RoomName, role, h.cfg.WebSocket.RoomTokenTTL)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to generate room token",
+		})
+	}
+
+	return c.JSON(TokenResponse{Token: token, RoomToken: roomToken})
 }
 
 // RoomParticipant represents a participant in a room
@@ -0,0 +1,270 @@
+package handler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/auth"
+	awsai "realtime-backend/internal/aws"
+	"realtime-backend/internal/model"
+)
+
+// 용어집 설정
+const (
+	maxVocabularyPhrases      = 500 // 언어별 최대 용어 수
+	maxVocabularyPhraseLength = 100 // 용어 최대 길이
+)
+
+// VocabularyHandler 워크스페이스 커스텀 용어집 핸들러
+type VocabularyHandler struct {
+	db      *gorm.DB
+	roomHub *RoomHub
+}
+
+// NewVocabularyHandler VocabularyHandler 생성
+func NewVocabularyHandler(db *gorm.DB, roomHub *RoomHub) *VocabularyHandler {
+	return &VocabularyHandler{db: db, roomHub: roomHub}
+}
+
+// VocabularyResponse 용어집 응답
+type VocabularyResponse struct {
+	ID                       int64    `json:"id"`
+	LanguageCode             string   `json:"language_code"`
+	TranscribeVocabularyName *string  `json:"transcribe_vocabulary_name,omitempty"`
+	Phrases                  []string `json:"phrases"`
+	UpdatedAt                string   `json:"updated_at"`
+}
+
+// UpsertVocabularyRequest 용어집 생성/수정 요청
+type UpsertVocabularyRequest struct {
+	TranscribeVocabularyName *string  `json:"transcribe_vocabulary_name"` // AWS에 미리 생성된 Transcribe 커스텀 어휘 이름
+	Phrases                  []string `json:"phrases"`                    // 번역 시 그대로 유지할 회사/제품명
+}
+
+// GetVocabularies 워크스페이스 용어집 목록 조회
+func (h *VocabularyHandler) GetVocabularies(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	if !h.isWorkspaceMember(int64(workspaceID), claims.UserID) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you are not a member of this workspace",
+		})
+	}
+
+	var vocabularies []model.WorkspaceVocabulary
+	if err := h.db.Where("workspace_id = ?", workspaceID).Order("language_code ASC").Find(&vocabularies).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get vocabularies",
+		})
+	}
+
+	responses := make([]VocabularyResponse, len(vocabularies))
+	for i, v := range vocabularies {
+		responses[i] = h.toVocabularyResponse(&v)
+	}
+
+	return c.JSON(fiber.Map{
+		"vocabularies": responses,
+		"total":        len(responses),
+	})
+}
+
+// UpsertVocabulary 언어별 용어집 생성/수정
+func (h *VocabularyHandler) UpsertVocabulary(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	lang := c.Params("lang")
+	if !isSupportedVocabularyLanguage(lang) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "unsupported language",
+		})
+	}
+
+	// 권한 확인
+	hasPermission, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "MANAGE_VOCABULARY")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !hasPermission {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "you do not have permission to manage vocabularies"})
+	}
+
+	var req UpsertVocabularyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	if len(req.Phrases) > maxVocabularyPhrases {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "too many phrases",
+		})
+	}
+	for i, phrase := range req.Phrases {
+		req.Phrases[i] = sanitizeString(phrase)
+		if len([]rune(req.Phrases[i])) > maxVocabularyPhraseLength {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "phrase is too long",
+			})
+		}
+	}
+
+	if req.TranscribeVocabularyName != nil {
+		name := sanitizeString(*req.TranscribeVocabularyName)
+		if name == "" {
+			req.TranscribeVocabularyName = nil
+		} else {
+			req.TranscribeVocabularyName = &name
+		}
+	}
+
+	var vocabulary model.WorkspaceVocabulary
+	err = h.db.Where("workspace_id = ? AND language_code = ?", workspaceID, lang).First(&vocabulary).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get vocabulary",
+		})
+	}
+	if err == gorm.ErrRecordNotFound {
+		vocabulary = model.WorkspaceVocabulary{
+			WorkspaceID:  int64(workspaceID),
+			LanguageCode: lang,
+			CreatedBy:    claims.UserID,
+		}
+	}
+
+	vocabulary.TranscribeVocabularyName = req.TranscribeVocabularyName
+	vocabulary.SetPhraseList(req.Phrases)
+
+	if err := h.db.Save(&vocabulary).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to save vocabulary",
+		})
+	}
+
+	if err := h.syncTerminology(int64(workspaceID)); err != nil {
+		return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{
+			"error": "vocabulary saved but failed to sync translation terminology",
+		})
+	}
+
+	return c.JSON(h.toVocabularyResponse(&vocabulary))
+}
+
+// DeleteVocabulary 언어별 용어집 삭제
+func (h *VocabularyHandler) DeleteVocabulary(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	lang := c.Params("lang")
+
+	// 권한 확인
+	hasPermission, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "MANAGE_VOCABULARY")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !hasPermission {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "you do not have permission to manage vocabularies"})
+	}
+
+	result := h.db.Where("workspace_id = ? AND language_code = ?", workspaceID, lang).Delete(&model.WorkspaceVocabulary{})
+	if result.Error != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to delete vocabulary",
+		})
+	}
+	if result.RowsAffected == 0 {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "vocabulary not found",
+		})
+	}
+
+	if err := h.syncTerminology(int64(workspaceID)); err != nil {
+		return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{
+			"error": "vocabulary deleted but failed to sync translation terminology",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "vocabulary deleted successfully",
+	})
+}
+
+// syncTerminology 워크스페이스 전체 용어를 AWS Translate 용어집에 반영하고 활성 Room에 적용
+func (h *VocabularyHandler) syncTerminology(workspaceID int64) error {
+	if h.roomHub == nil {
+		return nil
+	}
+
+	if translateClient := h.roomHub.GetTranslateClient(); translateClient != nil {
+		var vocabularies []model.WorkspaceVocabulary
+		if err := h.db.Where("workspace_id = ?", workspaceID).Find(&vocabularies).Error; err != nil {
+			return err
+		}
+
+		phrases := make([]string, 0)
+		for _, v := range vocabularies {
+			phrases = append(phrases, v.PhraseList()...)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		name := WorkspaceTerminologyName(workspaceID)
+		if len(phrases) == 0 {
+			if err := translateClient.DeleteTerminology(ctx, name); err != nil {
+				log.Printf("[Vocabulary] Failed to delete terminology %s: %v", name, err)
+			}
+		} else if err := translateClient.ImportTerminology(ctx, name, phrases); err != nil {
+			return err
+		}
+	}
+
+	h.roomHub.RefreshWorkspaceVocabulary(workspaceID)
+	return nil
+}
+
+func (h *VocabularyHandler) toVocabularyResponse(v *model.WorkspaceVocabulary) VocabularyResponse {
+	return VocabularyResponse{
+		ID:                       v.ID,
+		LanguageCode:             v.LanguageCode,
+		TranscribeVocabularyName: v.TranscribeVocabularyName,
+		Phrases:                  v.PhraseList(),
+		UpdatedAt:                v.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+func (h *VocabularyHandler) isWorkspaceMember(workspaceID, userID int64) bool {
+	var count int64
+	h.db.Model(&model.WorkspaceMember{}).
+		Where("workspace_id = ? AND user_id = ? AND status = ?", workspaceID, userID, model.MemberStatusActive.String()).
+		Count(&count)
+	return count > 0
+}
+
+// isSupportedVocabularyLanguage 용어집 지원 언어 확인 (언어 레지스트리 기준)
+func isSupportedVocabularyLanguage(lang string) bool {
+	return awsai.NormalizeLanguage(lang) == lang && awsai.IsSupportedLanguage(lang)
+}
@@ -1,21 +1,39 @@
 package handler
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
 
 	"realtime-backend/internal/auth"
 	"realtime-backend/internal/model"
+	"realtime-backend/internal/repository"
+	"realtime-backend/internal/storage"
 )
 
+// voiceRecordOrder 음성 기록 발화 순서 (여러 goroutine에서 저장되어 created_at/id 순서는 다를 수 있음)
+const voiceRecordOrder = "seq ASC, id ASC"
+
 // VoiceRecordHandler 음성 기록 핸들러
 type VoiceRecordHandler struct {
-	db *gorm.DB
+	db       *gorm.DB
+	s3       *storage.S3Service // 문서 내보내기 업로드용 (nil이면 비활성화)
+	roomHub  *RoomHub           // 회의록 수정 시 요약 캐시 무효화 (nil 가능)
+	meetings repository.MeetingRepo
+	records  repository.VoiceRecordRepo
 }
 
 // NewVoiceRecordHandler VoiceRecordHandler 생성
-func NewVoiceRecordHandler(db *gorm.DB) *VoiceRecordHandler {
-	return &VoiceRecordHandler{db: db}
+func NewVoiceRecordHandler(db *gorm.DB, s3 *storage.S3Service, roomHub *RoomHub) *VoiceRecordHandler {
+	return &VoiceRecordHandler{
+		db:       db,
+		s3:       s3,
+		roomHub:  roomHub,
+		meetings: repository.NewMeetingRepo(db),
+		records:  repository.NewVoiceRecordRepo(db),
+	}
 }
 
 // VoiceRecordResponse 음성 기록 응답
@@ -27,6 +45,7 @@ type VoiceRecordResponse struct {
 	Original    string        `json:"original"`
 	Translated  *string       `json:"translated,omitempty"`
 	TargetLang  *string       `json:"target_lang,omitempty"`
+	Seq         int64         `json:"seq"`
 	CreatedAt   string        `json:"created_at"`
 	Speaker     *UserResponse `json:"speaker,omitempty"`
 }
@@ -45,6 +64,7 @@ type CreateVoiceRecordBulkRequest struct {
 }
 
 // GetVoiceRecords 미팅의 음성 기록 조회
+// ?speaker_id=&lang=&q= 로 화자/번역 언어/내용 필터, ?cursor= 로 이어서 조회 (응답의 next_cursor)
 func (h *VoiceRecordHandler) GetVoiceRecords(c *fiber.Ctx) error {
 	claims := c.Locals("claims").(*auth.Claims)
 	workspaceID, err := c.ParamsInt("workspaceId")
@@ -69,48 +89,58 @@ func (h *VoiceRecordHandler) GetVoiceRecords(c *fiber.Ctx) error {
 	}
 
 	// 미팅이 워크스페이스에 속하는지 확인
-	var meeting model.Meeting
-	if err := h.db.Where("id = ? AND workspace_id = ?", meetingID, workspaceID).First(&meeting).Error; err != nil {
+	meeting, err := h.meetings.GetInWorkspace(c.UserContext(), int64(workspaceID), int64(meetingID))
+	if err != nil {
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 			"error": "meeting not found",
 		})
 	}
 
-	// 음성 기록 조회
-	var records []model.VoiceRecord
-	limit := c.QueryInt("limit", 100)
-	offset := c.QueryInt("offset", 0)
-
-	err = h.db.
-		Where("meeting_id = ?", meetingID).
-		Preload("Speaker").
-		Order("created_at ASC").
-		Limit(limit).
-		Offset(offset).
-		Find(&records).Error
-
+	// 회의록 접근 권한 확인 (참가자만 조회 가능, 게스트 불가)
+	access, err := GetTranscriptAccess(h.db, meeting, claims.UserID)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "failed to get voice records",
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !access.CanRead {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you do not have permission to read this transcript",
 		})
 	}
+	RecordTranscriptAccess(h.db, c, meeting, claims.UserID, model.TranscriptAccessRead)
+
+	// 음성 기록 조회
+	filter := repository.VoiceRecordFilter{
+		MeetingID:  meeting.ID,
+		TargetLang: c.Query("lang"),
+		Query:      c.Query("q"),
+	}
+	if speakerID := c.QueryInt("speaker_id", 0); speakerID > 0 {
+		id := int64(speakerID)
+		filter.SpeakerID = &id
+	}
+	pageReq := pageRequest(c, 100)
+	page, err := h.records.List(c.UserContext(), filter, pageReq, repository.WithPreload("Speaker"))
+	if err != nil {
+		return pageError(c, err, "failed to get voice records")
+	}
 
 	// 응답 변환
-	responses := make([]VoiceRecordResponse, len(records))
-	for i, record := range records {
+	responses := make([]VoiceRecordResponse, len(page.Items))
+	for i, record := range page.Items {
 		responses[i] = h.toVoiceRecordResponse(&record)
 	}
 
 	// 전체 개수 조회
-	var total int64
-	h.db.Model(&model.VoiceRecord{}).Where("meeting_id = ?", meetingID).Count(&total)
+	total, _ := h.records.Count(c.UserContext(), filter)
 
 	return c.JSON(fiber.Map{
-		"meeting_id": meetingID,
-		"records":    responses,
-		"total":      total,
-		"limit":      limit,
-		"offset":     offset,
+		"meeting_id":  meetingID,
+		"records":     responses,
+		"total":       total,
+		"limit":       pageReq.LimitOr(100),
+		"offset":      pageReq.Offset,
+		"next_cursor": page.NextCursor,
+		"has_more":    page.HasMore,
 	})
 }
 
@@ -173,7 +203,7 @@ func (h *VoiceRecordHandler) CreateVoiceRecord(c *fiber.Ctx) error {
 		req.SpeakerName = req.SpeakerName[:100]
 	}
 
-	// 음성 기록 생성
+	// 음성 기록 생성 (일괄 생성과 같은 방식으로 발화 순서 부여)
 	record := model.VoiceRecord{
 		MeetingID:   int64(meetingID),
 		SpeakerID:   &claims.UserID,
@@ -181,6 +211,7 @@ func (h *VoiceRecordHandler) CreateVoiceRecord(c *fiber.Ctx) error {
 		Original:    req.Original,
 		Translated:  req.Translated,
 		TargetLang:  req.TargetLang,
+		Seq:         time.Now().UnixMicro(),
 	}
 
 	if err := h.db.Create(&record).Error; err != nil {
@@ -189,6 +220,8 @@ func (h *VoiceRecordHandler) CreateVoiceRecord(c *fiber.Ctx) error {
 		})
 	}
 
+	h.invalidateSummary(int64(meetingID))
+
 	// Speaker 정보 로드
 	h.db.Preload("Speaker").First(&record, record.ID)
 
@@ -246,7 +279,8 @@ func (h *VoiceRecordHandler) CreateVoiceRecordBulk(c *fiber.Ctx) error {
 		})
 	}
 
-	// 음성 기록 생성
+	// 음성 기록 생성 (요청 순서대로 발화 순서 부여)
+	seq := time.Now().UnixMicro()
 	records := make([]model.VoiceRecord, len(req.Records))
 	for i, r := range req.Records {
 		original := sanitizeString(r.Original)
@@ -269,6 +303,7 @@ func (h *VoiceRecordHandler) CreateVoiceRecordBulk(c *fiber.Ctx) error {
 			Original:    original,
 			Translated:  r.Translated,
 			TargetLang:  r.TargetLang,
+			Seq:         seq + int64(i),
 		}
 	}
 
@@ -277,6 +312,7 @@ func (h *VoiceRecordHandler) CreateVoiceRecordBulk(c *fiber.Ctx) error {
 			"error": "failed to create voice records",
 		})
 	}
+	h.invalidateSummary(int64(meetingID))
 
 	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
 		"message": "voice records created successfully",
@@ -323,6 +359,8 @@ func (h *VoiceRecordHandler) DeleteVoiceRecords(c *fiber.Ctx) error {
 			"error": "failed to delete voice records",
 		})
 	}
+	RecordTranscriptAccess(h.db, c, &meeting, claims.UserID, model.TranscriptAccessDelete)
+	h.invalidateSummary(int64(meetingID))
 
 	return c.JSON(fiber.Map{
 		"message": "voice records deleted successfully",
@@ -330,6 +368,115 @@ func (h *VoiceRecordHandler) DeleteVoiceRecords(c *fiber.Ctx) error {
 	})
 }
 
+// ExportVoiceRecords 미팅의 음성 기록 전체 내보내기 (관리자 전용)
+func (h *VoiceRecordHandler) ExportVoiceRecords(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	meetingID, err := c.ParamsInt("meetingId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid meeting id",
+		})
+	}
+
+	// 미팅 확인
+	var meeting model.Meeting
+	if err := h.db.Where("id = ? AND workspace_id = ?", meetingID, workspaceID).First(&meeting).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "meeting not found",
+		})
+	}
+
+	// 내보내기 권한 확인
+	access, err := GetTranscriptAccess(h.db, &meeting, claims.UserID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !access.CanExport {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you do not have permission to export this transcript",
+		})
+	}
+
+	var records []model.VoiceRecord
+	if err := h.db.Where("meeting_id = ?", meetingID).Preload("Speaker").Order(voiceRecordOrder).Find(&records).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get voice records",
+		})
+	}
+	RecordTranscriptAccess(h.db, c, &meeting, claims.UserID, model.TranscriptAccessExport)
+
+	responses := make([]VoiceRecordResponse, len(records))
+	for i, record := range records {
+		responses[i] = h.toVoiceRecordResponse(&record)
+	}
+
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="meeting-%d-transcript.json"`, meetingID))
+	return c.JSON(fiber.Map{
+		"meeting_id": meetingID,
+		"title":      meeting.Title,
+		"records":    responses,
+		"total":      len(responses),
+	})
+}
+
+// GetTranscriptAccessLogs 회의록 접근 감사 로그 조회 (관리자 전용)
+func (h *VoiceRecordHandler) GetTranscriptAccessLogs(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	meetingID, err := c.ParamsInt("meetingId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid meeting id",
+		})
+	}
+
+	// 권한 확인
+	hasPermission, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "ADMIN")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !hasPermission {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "you do not have permission to view access logs"})
+	}
+
+	var logs []model.TranscriptAccessLog
+	limit := c.QueryInt("limit", 100)
+	offset := c.QueryInt("offset", 0)
+
+	err = h.db.
+		Where("meeting_id = ? AND workspace_id = ?", meetingID, workspaceID).
+		Preload("User").
+		Order("created_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&logs).Error
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get access logs",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"meeting_id": meetingID,
+		"logs":       logs,
+		"limit":      limit,
+		"offset":     offset,
+	})
+}
+
 // 헬퍼 함수
 func (h *VoiceRecordHandler) isWorkspaceMember(workspaceID, userID int64) bool {
 	var count int64
@@ -339,6 +486,13 @@ func (h *VoiceRecordHandler) isWorkspaceMember(workspaceID, userID int64) bool {
 	return count > 0
 }
 
+// invalidateSummary 회의록이 바뀌었으니 캐시된 요약 응답 삭제
+func (h *VoiceRecordHandler) invalidateSummary(meetingID int64) {
+	if h.roomHub != nil {
+		h.roomHub.InvalidateMeetingSummary(meetingID)
+	}
+}
+
 func (h *VoiceRecordHandler) toVoiceRecordResponse(record *model.VoiceRecord) VoiceRecordResponse {
 	resp := VoiceRecordResponse{
 		ID:          record.ID,
@@ -348,6 +502,7 @@ func (h *VoiceRecordHandler) toVoiceRecordResponse(record *model.VoiceRecord) Vo
 		Original:    record.Original,
 		Translated:  record.Translated,
 		TargetLang:  record.TargetLang,
+		Seq:         record.Seq,
 		CreatedAt:   record.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 
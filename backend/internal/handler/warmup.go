@@ -0,0 +1,238 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/auth"
+	awsai "realtime-backend/internal/aws"
+	"realtime-backend/internal/model"
+)
+
+// 워밍업 설정
+const (
+	maxWarmupLanguages   = 10               // 원본/대상 언어 각각 최대 개수
+	warmupTimeout        = 60 * time.Second // 워밍업 요청 전체 제한 시간
+	warmupPipelinePoll   = 100 * time.Millisecond
+	defaultWarmupRoomTTL = 15 * time.Minute
+)
+
+// errWarmupUnavailable AWS 파이프라인을 쓰지 않는 서버에서는 워밍업 불가
+var errWarmupUnavailable = errors.New("warm-up requires the AWS pipeline")
+
+// =============================================================================
+// Room - pre-meeting warm-up
+// =============================================================================
+
+// isWarm 워밍업 유지 시간 안인지 (r.mu를 잡은 상태에서 호출)
+func (r *Room) isWarm(now time.Time) bool {
+	return now.Before(r.warmUntil)
+}
+
+// Warm 참가자가 들어오기 전에 파이프라인을 만들고 (용어집 로드 포함) 스트림/번역/TTS 캐시를 미리 준비
+func (r *Room) Warm(ctx context.Context, req awsai.WarmupRequest, ttl time.Duration) (*awsai.WarmupResult, error) {
+	if !r.hub.useAWS {
+		return nil, errWarmupUnavailable
+	}
+
+	r.mu.Lock()
+	if until := time.Now().Add(ttl); until.After(r.warmUntil) {
+		r.warmUntil = until
+	}
+	if !r.isRunning {
+		r.isRunning = true
+		go r.runBroadcaster()
+		go r.runAudioProcessor()
+	}
+	r.mu.Unlock()
+
+	pipeline, err := r.waitForPipeline(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[Room %s] 🔥 Warming up (sources: %v, targets: %v, tts: %v)",
+		r.ID, req.SourceLanguages, req.TargetLanguages, req.SynthesizeTTS)
+	return pipeline.Warm(ctx, req), nil
+}
+
+// waitForPipeline 오디오 처리기가 AWS 파이프라인을 만들 때까지 대기
+func (r *Room) waitForPipeline(ctx context.Context) (*awsai.Pipeline, error) {
+	ticker := time.NewTicker(warmupPipelinePoll)
+	defer ticker.Stop()
+
+	for {
+		r.mu.RLock()
+		pipeline := r.awsPipeline
+		r.mu.RUnlock()
+		if pipeline != nil {
+			return pipeline, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("pipeline not ready: %w", ctx.Err())
+		case <-r.ctx.Done():
+			return nil, errors.New("room closed")
+		case <-ticker.C:
+		}
+	}
+}
+
+// =============================================================================
+// Warm-up API
+// =============================================================================
+
+// WarmupHandler 예정된 회의 워밍업 핸들러
+type WarmupHandler struct {
+	db      *gorm.DB
+	roomHub *RoomHub
+}
+
+// NewWarmupHandler WarmupHandler 생성
+func NewWarmupHandler(db *gorm.DB, roomHub *RoomHub) *WarmupHandler {
+	return &WarmupHandler{db: db, roomHub: roomHub}
+}
+
+// WarmupMeetingRequest 워밍업 요청
+type WarmupMeetingRequest struct {
+	SourceLanguages []string            `json:"source_languages"`
+	TargetLanguages []string            `json:"target_languages"`
+	Phrases         map[string][]string `json:"phrases,omitempty"` // 원본 언어별 자주 쓰는 문장 (없으면 기본 문장)
+	TTS             bool                `json:"tts"`
+}
+
+// WarmMeeting 회의 시작 몇 분 전에 Room과 AI 리소스를 미리 준비 (호스트 전용)
+func (h *WarmupHandler) WarmMeeting(c *fiber.Ctx) error {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+	meetingID, err := c.ParamsInt("meetingId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid meeting id",
+		})
+	}
+
+	if h.roomHub == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "room hub not available",
+		})
+	}
+
+	var meeting model.Meeting
+	if err := h.db.Where("id = ? AND workspace_id = ?", meetingID, workspaceID).First(&meeting).Error; err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "meeting not found",
+		})
+	}
+
+	// 호스트만 워밍업 가능
+	if meeting.HostID != claims.UserID {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "only host can warm up the meeting",
+		})
+	}
+	if meeting.Status == "ENDED" {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": "meeting already ended",
+		})
+	}
+
+	var req WarmupMeetingRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	sources, err := normalizeWarmupLanguages(req.SourceLanguages, awsai.SupportsTranscribe)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "source_languages: " + err.Error(),
+		})
+	}
+	targets, err := normalizeWarmupLanguages(req.TargetLanguages, awsai.IsSupportedLanguage)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "target_languages: " + err.Error(),
+		})
+	}
+
+	var phrases map[string][]string
+	if req.Phrases != nil {
+		phrases = make(map[string][]string, len(req.Phrases))
+		for lang, texts := range req.Phrases {
+			phrases[awsai.NormalizeLanguage(lang)] = texts
+		}
+	}
+
+	ttl := defaultWarmupRoomTTL
+	if h.roomHub.cfg != nil && h.roomHub.cfg.AI.WarmupTTL > 0 {
+		ttl = h.roomHub.cfg.AI.WarmupTTL
+	}
+
+	roomID := fmt.Sprintf("meeting-%d", meeting.ID)
+	room := h.roomHub.GetOrCreateRoom(roomID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), warmupTimeout)
+	defer cancel()
+
+	result, err := room.Warm(ctx, awsai.WarmupRequest{
+		SourceLanguages: sources,
+		TargetLanguages: targets,
+		Phrases:         phrases,
+		SynthesizeTTS:   req.TTS,
+	}, ttl)
+	if errors.Is(err, errWarmupUnavailable) {
+		return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	if err != nil {
+		log.Printf("[Warmup] Meeting %d warm-up failed: %v", meeting.ID, err)
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "failed to warm up meeting",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"room_id":    roomID,
+		"warm_until": time.Now().Add(ttl),
+		"result":     result,
+	})
+}
+
+// normalizeWarmupLanguages 언어 코드 정규화, 중복 제거 및 지원 여부 확인
+func normalizeWarmupLanguages(langs []string, supported func(string) bool) ([]string, error) {
+	if len(langs) == 0 {
+		return nil, errors.New("at least one language is required")
+	}
+	if len(langs) > maxWarmupLanguages {
+		return nil, fmt.Errorf("at most %d languages", maxWarmupLanguages)
+	}
+
+	seen := make(map[string]bool, len(langs))
+	normalized := make([]string, 0, len(langs))
+	for _, lang := range langs {
+		code := awsai.NormalizeLanguage(lang)
+		if !supported(code) {
+			return nil, fmt.Errorf("unsupported language %q", lang)
+		}
+		if !seen[code] {
+			seen[code] = true
+			normalized = append(normalized, code)
+		}
+	}
+	return normalized, nil
+}
@@ -0,0 +1,600 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/ai"
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/metrics"
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/retry"
+)
+
+// 워크스페이스 웹훅 설정
+const (
+	webhookQueueSize       = 512
+	webhookRequestTimeout  = 10 * time.Second // 요청 한 번
+	webhookDeliveryTimeout = 2 * time.Minute  // 발송 하나 (재시도 포함)
+	webhookMaxConcurrent   = 8                // 동시에 진행하는 발송 수
+	webhookCacheTTL        = 30 * time.Second // 워크스페이스별 웹훅 목록 캐시
+	maxWorkspaceWebhooks   = 10
+	maxWebhookDeliveries   = 100 // 발송 기록 조회 최대 개수
+)
+
+// webhookPolicy 워크스페이스 웹훅 재시도 (4xx는 재시도하지 않음)
+var webhookPolicy = retry.Policy{
+	MaxAttempts:    5,
+	InitialBackoff: 2 * time.Second,
+	MaxBackoff:     30 * time.Second,
+	Jitter:         0.2,
+}
+
+// WebhookEvent 워크스페이스 웹훅 요청 본문
+type WebhookEvent struct {
+	DeliveryID  int64       `json:"delivery_id"` // 수신 측 중복 제거용 (재시도해도 같음)
+	Event       string      `json:"event"`
+	WorkspaceID int64       `json:"workspace_id"`
+	MeetingID   *int64      `json:"meeting_id,omitempty"`
+	RoomID      string      `json:"room_id,omitempty"`
+	Data        interface{} `json:"data,omitempty"`
+	OccurredAt  time.Time   `json:"occurred_at"`
+}
+
+// WebhookParticipantData participant.joined / meeting.started 데이터
+type WebhookParticipantData struct {
+	ParticipantID string `json:"participant_id"`
+	Nickname      string `json:"nickname,omitempty"`
+	Language      string `json:"language,omitempty"`
+}
+
+// WebhookTranscriptData transcript.finalized 데이터
+type WebhookTranscriptData struct {
+	TranscriptID string            `json:"transcript_id"`
+	SpeakerID    string            `json:"speaker_id"`
+	Language     string            `json:"language"`
+	Text         string            `json:"text"`
+	Translations map[string]string `json:"translations,omitempty"` // 언어 -> 번역문
+}
+
+// WebhookSummaryData summary.ready 데이터
+type WebhookSummaryData struct {
+	UtteranceCount int    `json:"utterance_count"`
+	Provider       string `json:"provider"`
+}
+
+// webhookDispatcher 이벤트 큐와 워크스페이스별 웹훅 캐시
+// (Room 잠금 중에도 막히지 않도록 큐에 넣고 반환, DB 조회와 발송은 전용 goroutine에서)
+type webhookDispatcher struct {
+	queue  chan WebhookEvent
+	slots  chan struct{}
+	client *http.Client
+	stopCh chan struct{}
+
+	mu    sync.Mutex
+	cache map[int64]cachedWebhooks
+}
+
+type cachedWebhooks struct {
+	hooks    []model.WorkspaceWebhook
+	loadedAt time.Time
+}
+
+func newWebhookDispatcher() *webhookDispatcher {
+	return &webhookDispatcher{
+		queue:  make(chan WebhookEvent, webhookQueueSize),
+		slots:  make(chan struct{}, webhookMaxConcurrent),
+		client: newWebhookClient(),
+		stopCh: make(chan struct{}),
+		cache:  make(map[int64]cachedWebhooks),
+	}
+}
+
+// errWebhookAddressBlocked 웹훅 주소가 내부망(루프백/사설/링크로컬 등)으로 향함
+var errWebhookAddressBlocked = errors.New("webhook url must resolve to a public address")
+
+// cgnatRange 통신사 공유 주소 대역 (100.64.0.0/10, net.IP.IsPrivate에 포함되지 않음)
+var cgnatRange = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// isBlockedWebhookIP 웹훅으로 요청을 보내면 안 되는 주소 (루프백, 사설, 링크로컬(169.254 메타데이터 포함), 미지정, 멀티캐스트)
+func isBlockedWebhookIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || cgnatRange.Contains(ip)
+}
+
+// newWebhookClient 웹훅 발송 클라이언트
+// 연결 직전(DNS 해석 후) 주소를 다시 확인하므로 DNS 재바인딩으로도 내부망에 닿지 않고, 리다이렉트는 따라가지 않음
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookRequestTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isBlockedWebhookIP(ip) {
+				return errWebhookAddressBlocked
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: webhookRequestTimeout,
+		Transport: &http.Transport{
+			Proxy:               nil, // 프록시를 거치면 Control이 프록시 주소만 확인함
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: webhookRequestTimeout,
+			MaxIdleConnsPerHost: 2,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// validateWebhookURL 등록할 웹훅 URL 확인 (절대 http(s) URL이고 호스트의 모든 주소가 공인 주소)
+func validateWebhookURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" || len(rawURL) > 500 {
+		return errors.New("url must be an absolute http(s) URL")
+	}
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil || len(addrs) == 0 {
+		return errors.New("url host could not be resolved")
+	}
+	for _, addr := range addrs {
+		if isBlockedWebhookIP(addr.IP) {
+			return errWebhookAddressBlocked
+		}
+	}
+	return nil
+}
+
+// =============================================================================
+// RoomHub - workspace webhooks
+// =============================================================================
+
+// emitWebhook 회의 이벤트를 큐에 넣음 (큐가 가득 차면 버림)
+// roomID나 meetingID 중 하나로 워크스페이스를 찾으며, 브레이크아웃 Room 이벤트는 보내지 않음
+func (h *RoomHub) emitWebhook(event, roomID string, meetingID *int64, data interface{}) {
+	if h.db == nil || h.webhooks == nil {
+		return
+	}
+	if _, _, ok := ParseBreakoutRoomID(roomID); ok {
+		return
+	}
+
+	select {
+	case h.webhooks.queue <- WebhookEvent{Event: event, RoomID: roomID, MeetingID: meetingID, Data: data, OccurredAt: time.Now()}:
+	default:
+		metrics.DroppedMessages.Inc(metrics.DropWebhook)
+		log.Printf("[Webhook] Queue full, dropping %s (room %s)", event, roomID)
+	}
+}
+
+// runWebhooks 큐의 이벤트를 구독 중인 워크스페이스 웹훅마다 기록하고 발송
+func (h *RoomHub) runWebhooks() {
+	for {
+		select {
+		case event := <-h.webhooks.queue:
+			h.dispatchWebhook(event)
+		case <-h.webhooks.stopCh:
+			return
+		}
+	}
+}
+
+// dispatchWebhook 이벤트의 회의/워크스페이스를 찾아 발송 기록을 만들고 발송 시작
+func (h *RoomHub) dispatchWebhook(event WebhookEvent) {
+	var meeting model.Meeting
+	var err error
+	if event.MeetingID != nil {
+		err = h.db.First(&meeting, *event.MeetingID).Error
+	} else {
+		var found *model.Meeting
+		if found, err = FindMeetingByRoomID(h.db, event.RoomID); err == nil {
+			meeting = *found
+		}
+	}
+	if err != nil || meeting.WorkspaceID == nil {
+		return
+	}
+	event.MeetingID = &meeting.ID
+	event.WorkspaceID = *meeting.WorkspaceID
+	if event.RoomID == "" {
+		event.RoomID = meeting.Code
+	}
+
+	for _, hook := range h.workspaceWebhooks(event.WorkspaceID) {
+		if !webhookSubscribes(hook, event.Event) {
+			continue
+		}
+
+		delivery := model.WebhookDelivery{
+			WebhookID:   hook.ID,
+			WorkspaceID: event.WorkspaceID,
+			MeetingID:   event.MeetingID,
+			Event:       event.Event,
+			Payload:     "{}",
+			Status:      model.WebhookDeliveryPending,
+		}
+		if err := h.db.Create(&delivery).Error; err != nil {
+			log.Printf("[Webhook] Failed to record %s delivery for webhook %d: %v", event.Event, hook.ID, err)
+			continue
+		}
+
+		event.DeliveryID = delivery.ID
+		body, err := json.Marshal(event)
+		if err != nil {
+			continue
+		}
+		h.db.Model(&model.WebhookDelivery{}).Where("id = ?", delivery.ID).Update("payload", string(body))
+
+		// 동시 발송 수 제한 (모두 재시도 중이면 큐 처리도 잠시 멈춤)
+		select {
+		case h.webhooks.slots <- struct{}{}:
+		case <-h.webhooks.stopCh:
+			return
+		}
+		go func(hook model.WorkspaceWebhook, deliveryID int64, name string, body []byte) {
+			defer func() { <-h.webhooks.slots }()
+			h.deliverWebhook(hook, deliveryID, name, body)
+		}(hook, delivery.ID, event.Event, body)
+	}
+}
+
+// deliverWebhook 서명한 요청 전송 (5xx/네트워크 오류는 재시도) 후 시도마다 결과 기록
+func (h *RoomHub) deliverWebhook(hook model.WorkspaceWebhook, deliveryID int64, event string, body []byte) {
+	ctx, cancel := context.WithTimeout(context.Background(), webhookDeliveryTimeout)
+	defer cancel()
+
+	attempts := 0
+	err := retry.Do(ctx, webhookPolicy, func(ctx context.Context) error {
+		attempts++
+		status, err := h.postWebhook(ctx, hook, event, body)
+		h.db.Model(&model.WebhookDelivery{}).Where("id = ?", deliveryID).Updates(map[string]any{
+			"attempts":    attempts,
+			"last_status": status,
+			"last_error":  errorString(err),
+		})
+		return err
+	})
+
+	updates := map[string]any{"status": model.WebhookDeliveryDelivered, "delivered_at": time.Now()}
+	if err != nil {
+		updates = map[string]any{"status": model.WebhookDeliveryFailed}
+		log.Printf("[Webhook] ❌ Delivery %d to webhook %d failed (%s): %v", deliveryID, hook.ID, event, err)
+	}
+	h.db.Model(&model.WebhookDelivery{}).Where("id = ?", deliveryID).Updates(updates)
+}
+
+// postWebhook 요청 한 번 전송 (2xx가 아니면 에러, 4xx는 재시도하지 않도록 표시)
+func (h *RoomHub) postWebhook(ctx context.Context, hook model.WorkspaceWebhook, event string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, retry.Stop(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Eum-Event", event)
+	if hook.Secret != "" {
+		req.Header.Set("X-Eum-Signature", "sha256="+signRoomWebhook(hook.Secret, body))
+	}
+
+	resp, err := h.webhooks.client.Do(req)
+	if errors.Is(err, errWebhookAddressBlocked) {
+		return 0, retry.Stop(errWebhookAddressBlocked)
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		err := fmt.Errorf("unexpected status %d", resp.StatusCode)
+		if resp.StatusCode < 500 {
+			return resp.StatusCode, retry.Stop(err)
+		}
+		return resp.StatusCode, err
+	}
+	return resp.StatusCode, nil
+}
+
+// workspaceWebhooks 워크스페이스의 활성 웹훅 (짧게 캐시, 변경 시 InvalidateWorkspaceWebhooks)
+func (h *RoomHub) workspaceWebhooks(workspaceID int64) []model.WorkspaceWebhook {
+	h.webhooks.mu.Lock()
+	cached, ok := h.webhooks.cache[workspaceID]
+	h.webhooks.mu.Unlock()
+	if ok && time.Since(cached.loadedAt) < webhookCacheTTL {
+		return cached.hooks
+	}
+
+	var hooks []model.WorkspaceWebhook
+	if err := h.db.Where("workspace_id = ? AND active = ?", workspaceID, true).Find(&hooks).Error; err != nil {
+		log.Printf("[Webhook] Failed to load webhooks of workspace %d: %v", workspaceID, err)
+		return nil
+	}
+
+	h.webhooks.mu.Lock()
+	h.webhooks.cache[workspaceID] = cachedWebhooks{hooks: hooks, loadedAt: time.Now()}
+	h.webhooks.mu.Unlock()
+	return hooks
+}
+
+// InvalidateWorkspaceWebhooks 워크스페이스 웹훅 캐시 삭제 (등록/삭제 후)
+func (h *RoomHub) InvalidateWorkspaceWebhooks(workspaceID int64) {
+	h.webhooks.mu.Lock()
+	delete(h.webhooks.cache, workspaceID)
+	h.webhooks.mu.Unlock()
+}
+
+// stopWebhooks 웹훅 goroutine 중지 (큐에 남은 이벤트는 버림)
+func (h *RoomHub) stopWebhooks() {
+	select {
+	case <-h.webhooks.stopCh:
+	default:
+		close(h.webhooks.stopCh)
+	}
+}
+
+// webhookSubscribes 웹훅이 이벤트를 구독하는지
+func webhookSubscribes(hook model.WorkspaceWebhook, event string) bool {
+	return slices.Contains(strings.Split(hook.Events, ","), event)
+}
+
+// =============================================================================
+// Room Methods - webhook events
+// =============================================================================
+
+// emitParticipantWebhook 참가자 이벤트 (meeting.started, participant.joined, r.mu 보유 상태에서 호출 가능)
+func (r *Room) emitParticipantWebhook(event, participantID, nickname, language string) {
+	r.hub.emitWebhook(event, r.ID, nil, WebhookParticipantData{
+		ParticipantID: participantID,
+		Nickname:      nickname,
+		Language:      language,
+	})
+}
+
+// emitTranscriptWebhook transcript.finalized 이벤트
+func (r *Room) emitTranscriptWebhook(t *ai.TranscriptMessage, speakerID string) {
+	data := WebhookTranscriptData{
+		TranscriptID: t.ID,
+		SpeakerID:    speakerID,
+		Language:     t.OriginalLanguage,
+		Text:         t.OriginalText,
+	}
+	if len(t.Translations) > 0 {
+		data.Translations = make(map[string]string, len(t.Translations))
+		for _, trans := range t.Translations {
+			data.Translations[trans.TargetLanguage] = trans.TranslatedText
+		}
+	}
+	r.hub.emitWebhook(model.WebhookEventTranscriptFinalized, r.ID, nil, data)
+}
+
+// =============================================================================
+// WebhookHandler - REST API
+// =============================================================================
+
+// WebhookHandler 워크스페이스 웹훅 등록/조회/삭제 핸들러 (MANAGE_WEBHOOKS 권한)
+type WebhookHandler struct {
+	db      *gorm.DB
+	roomHub *RoomHub
+}
+
+// NewWebhookHandler WebhookHandler 생성
+func NewWebhookHandler(db *gorm.DB, roomHub *RoomHub) *WebhookHandler {
+	return &WebhookHandler{db: db, roomHub: roomHub}
+}
+
+// CreateWebhookRequest 웹훅 등록 요청
+type CreateWebhookRequest struct {
+	URL    string   `json:"url"`
+	Secret string   `json:"secret,omitempty"` // 있으면 X-Eum-Signature로 서명
+	Events []string `json:"events"`           // 빈 값 = 모든 이벤트
+}
+
+// GetWebhooks 워크스페이스 웹훅 목록
+func (h *WebhookHandler) GetWebhooks(c *fiber.Ctx) error {
+	workspaceID, ok, err := h.authorize(c)
+	if !ok {
+		return err
+	}
+
+	var hooks []model.WorkspaceWebhook
+	if err := h.db.Where("workspace_id = ?", workspaceID).Order("id ASC").Find(&hooks).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get webhooks",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"webhooks": hooks,
+		"events":   model.WebhookEvents,
+	})
+}
+
+// CreateWebhook 웹훅 등록
+func (h *WebhookHandler) CreateWebhook(c *fiber.Ctx) error {
+	workspaceID, ok, err := h.authorize(c)
+	if !ok {
+		return err
+	}
+	claims := c.Locals("claims").(*auth.Claims)
+
+	var req CreateWebhookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid request body",
+		})
+	}
+
+	lookupCtx, cancel := context.WithTimeout(c.UserContext(), webhookRequestTimeout)
+	err = validateWebhookURL(lookupCtx, req.URL)
+	cancel()
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	if len(req.Secret) > 100 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "secret must be at most 100 characters",
+		})
+	}
+
+	events := req.Events
+	if len(events) == 0 {
+		events = model.WebhookEvents
+	}
+	var subscribed []string
+	for _, event := range events {
+		if !slices.Contains(model.WebhookEvents, event) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": fmt.Sprintf("unknown event %q", event),
+			})
+		}
+		if !slices.Contains(subscribed, event) {
+			subscribed = append(subscribed, event)
+		}
+	}
+
+	var count int64
+	h.db.Model(&model.WorkspaceWebhook{}).Where("workspace_id = ?", workspaceID).Count(&count)
+	if count >= maxWorkspaceWebhooks {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fmt.Sprintf("at most %d webhooks can be registered", maxWorkspaceWebhooks),
+		})
+	}
+
+	hook := model.WorkspaceWebhook{
+		WorkspaceID: workspaceID,
+		URL:         req.URL,
+		Secret:      req.Secret,
+		Events:      strings.Join(subscribed, ","),
+		Active:      true,
+		CreatedBy:   claims.UserID,
+	}
+	if err := h.db.Create(&hook).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to create webhook",
+		})
+	}
+	if h.roomHub != nil {
+		h.roomHub.InvalidateWorkspaceWebhooks(workspaceID)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(hook)
+}
+
+// DeleteWebhook 웹훅 삭제 (발송 기록도 함께 삭제)
+func (h *WebhookHandler) DeleteWebhook(c *fiber.Ctx) error {
+	workspaceID, ok, err := h.authorize(c)
+	if !ok {
+		return err
+	}
+	webhookID, err := c.ParamsInt("webhookId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid webhook id",
+		})
+	}
+
+	err = h.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND workspace_id = ?", webhookID, workspaceID).Delete(&model.WorkspaceWebhook{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Where("webhook_id = ?", webhookID).Delete(&model.WebhookDelivery{}).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "webhook not found",
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to delete webhook",
+		})
+	}
+	if h.roomHub != nil {
+		h.roomHub.InvalidateWorkspaceWebhooks(workspaceID)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "webhook deleted",
+	})
+}
+
+// GetWebhookDeliveries 웹훅 발송 기록 (최신순, ?status=FAILED 로 필터)
+func (h *WebhookHandler) GetWebhookDeliveries(c *fiber.Ctx) error {
+	workspaceID, ok, err := h.authorize(c)
+	if !ok {
+		return err
+	}
+	webhookID, err := c.ParamsInt("webhookId")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid webhook id",
+		})
+	}
+
+	limit := c.QueryInt("limit", 50)
+	if limit <= 0 || limit > maxWebhookDeliveries {
+		limit = maxWebhookDeliveries
+	}
+
+	query := h.db.Where("webhook_id = ? AND workspace_id = ?", webhookID, workspaceID)
+	if status := strings.ToUpper(c.Query("status")); status != "" {
+		query = query.Where("status = ?", status)
+	}
+	var deliveries []model.WebhookDelivery
+	if err := query.Order("id DESC").Limit(limit).Find(&deliveries).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to get webhook deliveries",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"deliveries": deliveries,
+		"total":      len(deliveries),
+	})
+}
+
+// authorize 워크스페이스 ID 확인과 웹훅 관리 권한 확인 (실패하면 오류 응답을 쓰고 false)
+func (h *WebhookHandler) authorize(c *fiber.Ctx) (int64, bool, error) {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return 0, false, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "invalid workspace id",
+		})
+	}
+
+	hasPermission, err := auth.CheckPermission(h.db, int64(workspaceID), claims.UserID, "MANAGE_WEBHOOKS")
+	if err != nil {
+		return 0, false, c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !hasPermission {
+		return 0, false, c.Status(fiber.StatusForbidden).JSON(fiber.Map{"error": "you do not have permission to manage webhooks"})
+	}
+	return int64(workspaceID), true, nil
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Webhooks must not reach loopback, private or link-local (cloud metadata) addresses
+func TestValidateWebhookURLRejectsInternalAddresses(t *testing.T) {
+	for _, rawURL := range []string{
+		"http://127.0.0.1/hook",
+		"http://localhost:8080/hook",
+		"http://10.0.0.5/hook",
+		"http://192.168.1.10/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[::1]/hook",
+		"http://0.0.0.0/hook",
+	} {
+		if err := validateWebhookURL(context.Background(), rawURL); !errors.Is(err, errWebhookAddressBlocked) {
+			t.Errorf("%s: expected errWebhookAddressBlocked, got %v", rawURL, err)
+		}
+	}
+	if err := validateWebhookURL(context.Background(), "ftp://example.com/hook"); err == nil {
+		t.Error("expected non-http(s) url to be rejected")
+	}
+}
+
+// The dial-time check catches hosts that passed registration but now resolve internally
+func TestWebhookClientRefusesInternalDial(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	_, err := newWebhookClient().Post(server.URL, "application/json", nil)
+	if !errors.Is(err, errWebhookAddressBlocked) {
+		t.Fatalf("expected errWebhookAddressBlocked, got %v", err)
+	}
+}
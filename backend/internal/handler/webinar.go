@@ -0,0 +1,307 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"realtime-backend/internal/model"
+)
+
+// 웨비나 브로드캐스트 설정
+const (
+	defaultWebinarFanoutWorkers = 8
+	webinarFanoutChunk          = 256 // 리스너가 이보다 많을 때만 워커에 나눠서 큐에 넣음
+)
+
+// 지정 화자가 될 수 있는 참가자 역할
+var webinarSpeakerRoles = []string{participantRoleHost, "PRESENTER"}
+
+var (
+	ErrNotWebinar        = errors.New("room is not a webinar")
+	ErrNotWebinarSpeaker = errors.New("only designated webinar speakers can send audio")
+)
+
+// WebinarState 모든 참가자에게 보내는 웨비나 상태
+type WebinarState struct {
+	Speakers      []string `json:"speakers"`      // 오디오를 보낼 수 있는 지정 화자
+	ChatModerated bool     `json:"chatModerated"` // 참석자 채팅은 호스트 승인 후 공개
+}
+
+// roomWebinar 웨비나 Room 상태 (speakers는 Room.mu로 보호)
+// enabled는 Room.mu를 잡은 채 호출되는 Broadcast 경로에서도 읽으므로 atomic
+type roomWebinar struct {
+	enabled       atomic.Bool
+	loaded        bool
+	chatModerated bool
+	speakers      map[string]bool // 호스트, HOST/PRESENTER 참가자, 호스트가 지정한 참가자
+	pool          *broadcastPool
+}
+
+// =============================================================================
+// Room Methods - Webinar
+// =============================================================================
+
+// LoadWebinar 미팅이 웨비나이면 지정 화자 목록을 읽고 브로드캐스트 워커 시작 (Room당 한 번)
+// AddListener 전에 호출 (참석자 입장은 참가자 목록/화자 등록을 건너뜀)
+func (r *Room) LoadWebinar() {
+	r.mu.RLock()
+	loaded := r.webinar.loaded
+	r.mu.RUnlock()
+	if loaded || r.hub.db == nil {
+		return
+	}
+
+	meeting, err := r.findMeeting()
+	if err != nil {
+		return
+	}
+	speakers := make(map[string]bool)
+	if meeting.Type == model.MeetingTypeWebinar.String() {
+		speakers[fmt.Sprintf("%d", meeting.HostID)] = true
+		var userIDs []int64
+		r.hub.db.Model(&model.Participant{}).
+			Where("meeting_id = ? AND role IN ? AND user_id IS NOT NULL", meeting.ID, webinarSpeakerRoles).
+			Pluck("user_id", &userIDs)
+		for _, id := range userIDs {
+			speakers[fmt.Sprintf("%d", id)] = true
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.webinar.loaded {
+		return
+	}
+	r.webinar.loaded = true
+	if meeting.Type != model.MeetingTypeWebinar.String() {
+		return
+	}
+	r.webinar.speakers = speakers
+	r.webinar.chatModerated = meeting.ChatModerated
+	r.webinar.pool = newBroadcastPool(r, r.webinarFanoutWorkers())
+	r.webinar.enabled.Store(true)
+	log.Printf("[Room %s] 🎙️ Webinar room (%d designated speakers, chat moderated: %v)", r.ID, len(speakers), meeting.ChatModerated)
+}
+
+// IsWebinar 웨비나 Room 여부
+func (r *Room) IsWebinar() bool {
+	return r.webinar.enabled.Load()
+}
+
+// CanSendAudio 참가자의 오디오를 처리하는지 확인 (일반 Room은 항상 true, 웨비나는 지정 화자만)
+func (r *Room) CanSendAudio(participantID string) bool {
+	if !r.IsWebinar() {
+		return true
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.webinar.speakers[participantID]
+}
+
+// isWebinarAttendee 웨비나의 청취 전용 참석자인지 확인 (r.mu 보유 상태에서 호출)
+func (r *Room) isWebinarAttendee(participantID string) bool {
+	return r.IsWebinar() && !r.webinar.speakers[participantID]
+}
+
+// SetWebinarSpeaker 참가자를 지정 화자로 추가/해제 (호스트 전용)
+// 해제하면 그 참가자의 Transcribe 스트림도 닫음
+func (r *Room) SetWebinarSpeaker(hostID, participantID string, allowed bool) error {
+	if !r.IsWebinar() {
+		return ErrNotWebinar
+	}
+	participantID = strings.TrimSpace(participantID)
+	if participantID == "" {
+		return ErrMissingParticipant
+	}
+	if !r.isModerator(hostID) {
+		return ErrNotModerator
+	}
+
+	r.mu.Lock()
+	changed := r.webinar.speakers[participantID] != allowed
+	if allowed {
+		r.webinar.speakers[participantID] = true
+	} else {
+		delete(r.webinar.speakers, participantID)
+	}
+	r.mu.Unlock()
+
+	if !changed {
+		return nil
+	}
+	if !allowed {
+		r.RemoveSpeaker(participantID)
+	}
+	log.Printf("[Room %s] 🎙️ Webinar speaker %s allowed=%v (host: %s)", r.ID, participantID, allowed, hostID)
+
+	r.Broadcast(&BroadcastMessage{
+		Type: "webinar",
+		Data: r.webinarState(),
+	})
+	return nil
+}
+
+func (r *Room) webinarState() WebinarState {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	state := WebinarState{
+		Speakers:      make([]string, 0, len(r.webinar.speakers)),
+		ChatModerated: r.webinar.chatModerated,
+	}
+	for id := range r.webinar.speakers {
+		state.Speakers = append(state.Speakers, id)
+	}
+	sort.Strings(state.Speakers)
+	return state
+}
+
+// SendWebinarState 새로 접속한 참가자에게 웨비나 상태 전송 (일반 Room이면 생략)
+func (r *Room) SendWebinarState(listenerID string) {
+	if !r.IsWebinar() {
+		return
+	}
+	r.Broadcast(&BroadcastMessage{
+		Type:             "webinar",
+		Data:             r.webinarState(),
+		TargetListenerID: listenerID,
+	})
+}
+
+// sendWebinarError 요청한 참가자에게 웨비나 제어 오류 전송
+func (r *Room) sendWebinarError(listenerID string, err error) {
+	r.Broadcast(&BroadcastMessage{
+		Type:             "webinar_error",
+		Data:             map[string]string{"message": err.Error()},
+		TargetListenerID: listenerID,
+	})
+}
+
+// webinarFanoutWorkers 브로드캐스트 워커 수 (설정값, 없으면 기본값)
+func (r *Room) webinarFanoutWorkers() int {
+	if tunables := r.hub.tunables.Load(); tunables != nil && tunables.WebinarFanoutWorkers > 0 {
+		return tunables.WebinarFanoutWorkers
+	}
+	return defaultWebinarFanoutWorkers
+}
+
+// preSerialize 웨비나 Room은 리스너마다 직렬화하지 않도록 JSON을 한 번만 만들어 둠
+// (큐에 넣기 전, 재전송 버퍼에 기록하기 전에 호출)
+func (r *Room) preSerialize(msg *BroadcastMessage) {
+	if !r.IsWebinar() || msg.TargetListenerID != "" || msg.encoded != nil {
+		return
+	}
+	if data, err := json.Marshal(msg); err == nil {
+		msg.encoded = data
+	}
+}
+
+// stopWebinar 브로드캐스트 워커 중지
+func (r *Room) stopWebinar() {
+	r.mu.RLock()
+	pool := r.webinar.pool
+	r.mu.RUnlock()
+	if pool != nil {
+		pool.stop()
+	}
+}
+
+// webinarPool 웨비나 브로드캐스트 워커 (일반 Room이면 nil)
+func (r *Room) webinarPool() *broadcastPool {
+	if !r.IsWebinar() {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.webinar.pool
+}
+
+// =============================================================================
+// Broadcast pool
+// =============================================================================
+
+// broadcastPool 리스너가 많은 Room의 메시지를 리스너 그룹별로 나눠 동시에 큐에 넣는 워커
+// 메시지 하나를 모든 그룹에 넣을 때까지 기다리므로 리스너별 메시지 순서는 그대로 유지
+type broadcastPool struct {
+	room     *Room
+	workers  int
+	jobs     chan broadcastJob // 버퍼 없음: 워커가 받은 작업은 중지 전에 끝까지 처리
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+type broadcastJob struct {
+	msg       *BroadcastMessage
+	listeners []*Listener
+	wg        *sync.WaitGroup
+}
+
+func newBroadcastPool(r *Room, workers int) *broadcastPool {
+	p := &broadcastPool{
+		room:    r,
+		workers: workers,
+		jobs:    make(chan broadcastJob),
+		done:    make(chan struct{}),
+	}
+	for i := 0; i < workers; i++ {
+		go p.run()
+	}
+	return p
+}
+
+func (p *broadcastPool) run() {
+	for {
+		select {
+		case <-p.done:
+			return
+		case job := <-p.jobs:
+			for _, listener := range job.listeners {
+				p.room.routeToListener(listener, job.msg)
+			}
+			job.wg.Done()
+		}
+	}
+}
+
+// fanOut 리스너를 워커 수만큼 나눠 큐에 넣고 모두 끝날 때까지 대기 (적으면 바로 처리)
+func (p *broadcastPool) fanOut(msg *BroadcastMessage, listeners []*Listener) {
+	chunk := (len(listeners) + p.workers - 1) / p.workers
+	if chunk < webinarFanoutChunk {
+		chunk = webinarFanoutChunk
+	}
+
+	var wg sync.WaitGroup
+	for start := 0; start < len(listeners); start += chunk {
+		end := min(start+chunk, len(listeners))
+		group := listeners[start:end]
+		if end == len(listeners) {
+			// 마지막 그룹은 브로드캐스터가 직접 처리
+			for _, listener := range group {
+				p.room.routeToListener(listener, msg)
+			}
+			break
+		}
+
+		wg.Add(1)
+		select {
+		case p.jobs <- broadcastJob{msg: msg, listeners: group, wg: &wg}:
+		case <-p.done:
+			wg.Done()
+			for _, listener := range group {
+				p.room.routeToListener(listener, msg)
+			}
+		}
+	}
+	wg.Wait()
+}
+
+func (p *broadcastPool) stop() {
+	p.stopOnce.Do(func() {
+		close(p.done)
+	})
+}
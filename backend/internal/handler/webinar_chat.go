@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"encoding/json"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+
+	"realtime-backend/internal/model"
+)
+
+// 웨비나 채팅 검토 설정
+const (
+	maxPendingChats = 200 // 채팅방당 승인 대기 메시지 수
+)
+
+// pendingChat 승인을 기다리는 참석자 메시지 (저장 전, 메모리에만 보관)
+type pendingChat struct {
+	ID             int64
+	Sender         *ChatClient
+	Message        string
+	IdempotencyKey *string
+	ReceivedAt     time.Time
+}
+
+// pendingChats 채팅방의 승인 대기 메시지 (ChatRoom.mu로 보호)
+type pendingChats struct {
+	nextID int64
+	items  map[int64]*pendingChat
+}
+
+// PendingChatPayload 호스트에게 보내는 승인 대기 메시지
+type PendingChatPayload struct {
+	PendingID  int64  `json:"pending_id"`
+	Message    string `json:"message"`
+	SenderID   int64  `json:"sender_id"`
+	Nickname   string `json:"nickname"`
+	ReceivedAt string `json:"received_at"`
+}
+
+// ChatReviewPayload approve_message / reject_message 요청
+type ChatReviewPayload struct {
+	PendingID int64 `json:"pending_id"`
+}
+
+// ChatReviewResultPayload 검토 결과 (호스트들과 보낸 사람에게 전송)
+type ChatReviewResultPayload struct {
+	PendingID int64 `json:"pending_id"`
+	Approved  bool  `json:"approved"`
+	MessageID int64 `json:"message_id,omitempty"` // 승인되어 저장된 메시지 ID
+}
+
+// chatModeration 채팅방이 검토 중인 웨비나 채팅인지와 사용자가 검토할 수 있는지 확인
+// (워크스페이스 소유자, 회의 호스트, HOST/PRESENTER 참가자가 검토)
+func (h *ChatWSHandler) chatModeration(meetingID, userID int64, isOwner bool) (moderated, moderator bool) {
+	var meeting model.Meeting
+	if err := h.db.Select("id", "host_id", "type", "chat_moderated").First(&meeting, meetingID).Error; err != nil {
+		return false, isOwner
+	}
+	if meeting.Type != model.MeetingTypeWebinar.String() || !meeting.ChatModerated {
+		return false, isOwner
+	}
+	if isOwner || meeting.HostID == userID {
+		return true, true
+	}
+
+	var count int64
+	h.db.Model(&model.Participant{}).
+		Where("meeting_id = ? AND user_id = ? AND role IN ?", meetingID, userID, webinarSpeakerRoles).
+		Count(&count)
+	return true, count > 0
+}
+
+// holdMessage 참석자 메시지를 승인 대기열에 넣고 호스트들에게 알림
+func (h *ChatWSHandler) holdMessage(room *ChatRoom, client *ChatClient, message string, idempotencyKey *string) {
+	room.mu.Lock()
+	if room.pending.items == nil {
+		room.pending.items = make(map[int64]*pendingChat)
+	}
+	if len(room.pending.items) >= maxPendingChats {
+		room.mu.Unlock()
+		client.Session.Write(client.Conn, websocket.TextMessage, "error", []byte(`{"type":"error","message":"moderation queue is full, please try again later"}`))
+		return
+	}
+	room.pending.nextID++
+	item := &pendingChat{
+		ID:             room.pending.nextID,
+		Sender:         client,
+		Message:        message,
+		IdempotencyKey: idempotencyKey,
+		ReceivedAt:     time.Now(),
+	}
+	room.pending.items[item.ID] = item
+	room.mu.Unlock()
+
+	h.sendToModerators(room, WSMessage{Type: "message_pending", Payload: item.payload()})
+	h.sendTo(client, WSMessage{Type: "message_held", Payload: ChatReviewPayload{PendingID: item.ID}})
+}
+
+// handleReview 호스트의 승인/거절 처리 (승인하면 저장 후 모두에게 전송)
+func (h *ChatWSHandler) handleReview(room *ChatRoom, client *ChatClient, roomID int64, approve bool, payload interface{}) {
+	if !client.Moderator {
+		client.Session.Write(client.Conn, websocket.TextMessage, "error", []byte(`{"type":"error","message":"only the host can review messages"}`))
+		return
+	}
+
+	payloadBytes, _ := json.Marshal(payload)
+	var review ChatReviewPayload
+	if err := json.Unmarshal(payloadBytes, &review); err != nil {
+		return
+	}
+
+	room.mu.Lock()
+	item, ok := room.pending.items[review.PendingID]
+	delete(room.pending.items, review.PendingID)
+	room.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	result := ChatReviewResultPayload{PendingID: item.ID, Approved: approve}
+	if approve {
+		messageID, ok := h.publishMessage(room, item.Sender, roomID, item.Message, item.IdempotencyKey)
+		if !ok {
+			return
+		}
+		result.MessageID = messageID
+	}
+	log.Printf("채팅 검토: room=%d, pending=%d, approved=%v, by=%d", roomID, item.ID, approve, client.UserID)
+
+	msg := WSMessage{Type: "message_reviewed", Payload: result}
+	h.sendToModerators(room, msg)
+	if !item.Sender.Moderator {
+		h.sendTo(item.Sender, msg)
+	}
+}
+
+// sendPendingMessages 새로 접속한 호스트에게 승인 대기 메시지 전송
+func (h *ChatWSHandler) sendPendingMessages(room *ChatRoom, client *ChatClient) {
+	room.mu.RLock()
+	items := make([]PendingChatPayload, 0, len(room.pending.items))
+	for _, item := range room.pending.items {
+		items = append(items, item.payload())
+	}
+	room.mu.RUnlock()
+
+	if len(items) == 0 {
+		return
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].PendingID < items[j].PendingID })
+	h.sendTo(client, WSMessage{Type: "pending_messages", Payload: items})
+}
+
+// sendToModerators 채팅방의 검토 권한이 있는 클라이언트에게만 전송
+func (h *ChatWSHandler) sendToModerators(room *ChatRoom, msg WSMessage) {
+	room.mu.RLock()
+	defer room.mu.RUnlock()
+
+	msgBytes, _ := json.Marshal(msg)
+	for conn, c := range room.clients {
+		if c.Moderator {
+			c.Session.Write(conn, websocket.TextMessage, msg.Type, msgBytes)
+		}
+	}
+}
+
+// sendTo 클라이언트 한 명에게 전송 (보낸 사람이 이미 나갔으면 쓰기 실패는 로그만 남김)
+func (h *ChatWSHandler) sendTo(client *ChatClient, msg WSMessage) {
+	msgBytes, _ := json.Marshal(msg)
+	if err := client.Session.Write(client.Conn, websocket.TextMessage, msg.Type, msgBytes); err != nil {
+		log.Printf("메시지 전송 실패: user=%d: %v", client.UserID, err)
+	}
+}
+
+func (p *pendingChat) payload() PendingChatPayload {
+	return PendingChatPayload{
+		PendingID:  p.ID,
+		Message:    p.Message,
+		SenderID:   p.Sender.UserID,
+		Nickname:   p.Sender.Nickname,
+		ReceivedAt: p.ReceivedAt.Format(time.RFC3339),
+	}
+}
@@ -1,10 +1,13 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log"
+	"realtime-backend/internal/cache"
 	"realtime-backend/internal/model"
+	"realtime-backend/internal/storage"
 	"strconv"
 	"strings"
 	"time"
@@ -14,13 +17,20 @@ import (
 )
 
 type WhiteboardHandler struct {
-	db *gorm.DB
+	db    *gorm.DB
+	redis *cache.RedisClient // /ws/whiteboard 획 버퍼 (nil = 실시간 경로 비활성화)
+	s3    *storage.S3Service // PNG/PDF 내보내기 업로드 (nil = 비활성화)
 }
 
 func NewWhiteboardHandler(db *gorm.DB) *WhiteboardHandler {
 	return &WhiteboardHandler{db: db}
 }
 
+// SetBuffer sets the Redis stroke buffer used by the live whiteboard WebSocket
+func (h *WhiteboardHandler) SetBuffer(redis *cache.RedisClient) {
+	h.redis = redis
+}
+
 type WhiteboardRequest struct {
 	Room   string `json:"room"`
 	Stroke any    `json:"stroke,omitempty"` // Can be single object or array
@@ -79,6 +89,9 @@ func (h *WhiteboardHandler) GetWhiteboard(c *fiber.Ctx) error {
 		}
 	}
 
+	// Add live strokes that have not been compacted into a snapshot yet
+	history = append(history, h.bufferedStrokes(c.UserContext(), meetingID, snapshots)...)
+
 	// Undo/Redo is only for available strokes in 'whiteboard_strokes'
 	// Users cannot undo archived snapshot content easily.
 	var deletedCount int64
@@ -92,6 +105,35 @@ func (h *WhiteboardHandler) GetWhiteboard(c *fiber.Ctx) error {
 	})
 }
 
+// bufferedStrokes returns live strokes newer than the last compacted snapshot
+func (h *WhiteboardHandler) bufferedStrokes(ctx context.Context, meetingID int64, snapshots []model.WhiteboardSnapshot) []any {
+	if h.redis == nil {
+		return nil
+	}
+	buffered, err := h.redis.WhiteboardBuffer(ctx, meetingID)
+	if err != nil {
+		log.Printf("[Whiteboard] Failed to read live strokes for meeting %d: %v", meetingID, err)
+		return nil
+	}
+
+	var lastCompacted int64
+	for _, snap := range snapshots {
+		lastCompacted = max(lastCompacted, snap.EndID)
+	}
+
+	strokes := make([]any, 0, len(buffered))
+	for _, s := range buffered {
+		if s.Seq <= lastCompacted {
+			continue
+		}
+		var strokeData any
+		if err := json.Unmarshal(s.Data, &strokeData); err == nil {
+			strokes = append(strokes, strokeData)
+		}
+	}
+	return strokes
+}
+
 // Helper to chunk strokes into a snapshot
 func (h *WhiteboardHandler) snapshotStrokes(meetingID int64) {
 	const triggerCount = 1100
@@ -201,6 +243,11 @@ func (h *WhiteboardHandler) HandleWhiteboard(c *fiber.Ctx) error {
 		if err := h.db.Where("meeting_id = ?", meetingID).Delete(&model.WhiteboardSnapshot{}).Error; err != nil {
 			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to clear snapshots"})
 		}
+		if h.redis != nil {
+			if err := h.redis.ClearWhiteboardBuffer(c.UserContext(), meetingID); err != nil {
+				return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "Failed to clear live strokes"})
+			}
+		}
 
 	case "undo":
 		// Undo only affects 'WhiteboardStroke' (Active). We cannot easily undo a snapshot stroke.
@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"realtime-backend/internal/auth"
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/storage"
+	"realtime-backend/internal/whiteboard"
+)
+
+// whiteboardExportTimeout 렌더링 + 업로드 한도
+const whiteboardExportTimeout = time.Minute
+
+// WhiteboardExportResponse 내보낸 파일 응답 (다운로드용 presigned URL 포함)
+type WhiteboardExportResponse struct {
+	model.WhiteboardExport
+	URL string `json:"url,omitempty"`
+}
+
+// SetStorage 내보내기 파일을 올릴 S3 설정 (nil = 내보내기 비활성화)
+func (h *WhiteboardHandler) SetStorage(s3 *storage.S3Service) {
+	h.s3 = s3
+}
+
+// ExportWhiteboard 회의 화이트보드를 PNG/PDF로 렌더링해 S3에 저장 (?format=png|pdf, 회의록 읽기 권한 필요)
+func (h *WhiteboardHandler) ExportWhiteboard(c *fiber.Ctx) error {
+	if h.s3 == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": "storage not available"})
+	}
+
+	meeting, claims, err := h.exportMeeting(c)
+	if meeting == nil {
+		return err
+	}
+
+	format := strings.ToUpper(c.Query("format", "png"))
+	if format != model.WhiteboardExportPNG && format != model.WhiteboardExportPDF {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "format must be png or pdf"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.UserContext(), whiteboardExportTimeout)
+	defer cancel()
+
+	strokes, err := h.historyStrokes(ctx, meeting.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to load whiteboard"})
+	}
+	segments := whiteboard.ParseStrokes(strokes)
+
+	export := model.WhiteboardExport{
+		MeetingID:   meeting.ID,
+		Format:      format,
+		StrokeCount: len(strokes),
+		CreatedBy:   claims.UserID,
+	}
+	var data []byte
+	switch format {
+	case model.WhiteboardExportPNG:
+		data, export.Width, export.Height, err = whiteboard.RenderPNG(segments)
+		export.ContentType = "image/png"
+	case model.WhiteboardExportPDF:
+		data, err = whiteboard.RenderPDF(segments)
+		export.ContentType = "application/pdf"
+	}
+	if err != nil {
+		log.Printf("[Whiteboard] Failed to render %s for meeting %d: %v", format, meeting.ID, err)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to render whiteboard"})
+	}
+
+	export.SizeBytes = int64(len(data))
+	export.S3Key = fmt.Sprintf("whiteboards/meetings/%d/%d.%s", meeting.ID, time.Now().UnixMilli(), strings.ToLower(format))
+	if err := h.s3.PutObject(ctx, meetingWorkspaceID(meeting), export.S3Key, export.ContentType, data); err != nil {
+		log.Printf("[Whiteboard] Failed to upload export %s: %v", export.S3Key, err)
+		return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{"error": "failed to upload whiteboard"})
+	}
+	if err := h.db.Create(&export).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to save whiteboard export"})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(h.exportResponse(export))
+}
+
+// GetWhiteboardExports 회의 화이트보드 내보내기 목록 (최근 순)
+func (h *WhiteboardHandler) GetWhiteboardExports(c *fiber.Ctx) error {
+	meeting, _, err := h.exportMeeting(c)
+	if meeting == nil {
+		return err
+	}
+
+	var exports []model.WhiteboardExport
+	if err := h.db.Where("meeting_id = ?", meeting.ID).Order("created_at DESC").Find(&exports).Error; err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to get whiteboard exports"})
+	}
+
+	responses := make([]WhiteboardExportResponse, len(exports))
+	for i, export := range exports {
+		responses[i] = h.exportResponse(export)
+	}
+	return c.JSON(fiber.Map{
+		"meeting_id": meeting.ID,
+		"exports":    responses,
+	})
+}
+
+func (h *WhiteboardHandler) exportResponse(export model.WhiteboardExport) WhiteboardExportResponse {
+	resp := WhiteboardExportResponse{WhiteboardExport: export}
+	if h.s3 != nil {
+		if url, err := h.s3.GetFileURL(export.S3Key); err == nil {
+			resp.URL = url
+		}
+	}
+	return resp
+}
+
+// exportMeeting 워크스페이스 회의와 회의록 읽기 권한 확인 (실패 시 오류 응답을 보내고 nil 반환)
+func (h *WhiteboardHandler) exportMeeting(c *fiber.Ctx) (*model.Meeting, *auth.Claims, error) {
+	claims := c.Locals("claims").(*auth.Claims)
+	workspaceID, err := c.ParamsInt("workspaceId")
+	if err != nil {
+		return nil, nil, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid workspace id"})
+	}
+	meetingID, err := c.ParamsInt("meetingId")
+	if err != nil {
+		return nil, nil, c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "invalid meeting id"})
+	}
+
+	var meeting model.Meeting
+	if err := h.db.Where("id = ? AND workspace_id = ?", meetingID, workspaceID).First(&meeting).Error; err != nil {
+		return nil, nil, c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "meeting not found"})
+	}
+
+	access, err := GetTranscriptAccess(h.db, &meeting, claims.UserID)
+	if err != nil {
+		return nil, nil, c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to check permission"})
+	}
+	if !access.CanRead {
+		return nil, nil, c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you do not have permission to access this meeting's whiteboard",
+		})
+	}
+	return &meeting, claims, nil
+}
+
+// historyStrokes 스냅샷 → 저장된 획 → 아직 압축되지 않은 실시간 획 순서의 전체 기록
+func (h *WhiteboardHandler) historyStrokes(ctx context.Context, meetingID int64) ([]json.RawMessage, error) {
+	var snapshots []model.WhiteboardSnapshot
+	if err := h.db.WithContext(ctx).Where("meeting_id = ?", meetingID).Order("id ASC").Find(&snapshots).Error; err != nil {
+		return nil, err
+	}
+	var rows []model.WhiteboardStroke
+	if err := h.db.WithContext(ctx).Where("meeting_id = ? AND is_deleted = ?", meetingID, false).Order("id ASC").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	var strokes []json.RawMessage
+	for _, snap := range snapshots {
+		var chunk []json.RawMessage
+		if err := json.Unmarshal([]byte(snap.Data), &chunk); err != nil {
+			log.Printf("[Whiteboard] Failed to parse snapshot %d: %v", snap.ID, err)
+			continue
+		}
+		strokes = append(strokes, chunk...)
+	}
+	for _, row := range rows {
+		strokes = append(strokes, json.RawMessage(row.StrokeData))
+	}
+	for _, s := range h.bufferedStrokes(ctx, meetingID, snapshots) {
+		if raw, err := json.Marshal(s); err == nil {
+			strokes = append(strokes, raw)
+		}
+	}
+	return strokes, nil
+}
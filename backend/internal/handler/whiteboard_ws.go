@@ -0,0 +1,495 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/cache"
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/session"
+)
+
+// 화이트보드 실시간 동기화 설정
+const (
+	maxWhiteboardStrokeSize    = 64 * 1024        // 획 하나의 최대 크기
+	whiteboardCompactInterval  = 30 * time.Second // 버퍼를 스냅샷으로 옮기는 주기
+	whiteboardCompactThreshold = 500              // 버퍼가 이만큼 쌓이면 주기를 기다리지 않고 압축
+	whiteboardLockTTL          = 30 * time.Second
+	whiteboardRedisTimeout     = 3 * time.Second
+)
+
+// WhiteboardWSHandler 화이트보드 실시간 동기화 WebSocket 핸들러
+// 획은 같은 회의의 참가자에게 바로 전달하고 Redis 버퍼에 쌓았다가 주기적으로 WhiteboardSnapshot 청크로 압축
+// Redis가 없으면 획마다 WhiteboardStroke로 저장
+type WhiteboardWSHandler struct {
+	db    *gorm.DB
+	redis *cache.RedisClient // 획 버퍼 (nil = DB 직접 저장)
+	rooms map[int64]*WhiteboardRoom
+	mu    sync.RWMutex
+
+	instanceID string // 압축 잠금 소유자
+	stop       chan struct{}
+	closeOnce  sync.Once
+}
+
+// WhiteboardRoom 회의별 화이트보드 접속자
+type WhiteboardRoom struct {
+	clients map[*websocket.Conn]*WhiteboardClient
+	mu      sync.RWMutex
+
+	compacting bool // 압축 진행 중 (mu 보호)
+}
+
+// WhiteboardClient 화이트보드 클라이언트
+type WhiteboardClient struct {
+	UserID   int64
+	Nickname string
+	Conn     *websocket.Conn
+	Session  *session.Handle
+
+	writeMu sync.Mutex // 브로드캐스트와 기록 전송이 동시에 쓰지 않도록
+}
+
+// WhiteboardWSMessage 화이트보드 WebSocket 메시지
+type WhiteboardWSMessage struct {
+	Type    string          `json:"type"` // stroke, cursor, clear (서버 → 클라이언트: history, error 추가)
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// WhiteboardStrokePayload 획 브로드캐스트
+type WhiteboardStrokePayload struct {
+	Seq      int64           `json:"seq,omitempty"` // 회의 내 순번 (기록의 last_seq 이하는 이미 받은 획)
+	UserID   int64           `json:"user_id"`
+	Nickname string          `json:"nickname"`
+	Stroke   json.RawMessage `json:"stroke"`
+}
+
+// WhiteboardCursorPayload 커서 위치 (저장하지 않음)
+type WhiteboardCursorPayload struct {
+	UserID   int64   `json:"user_id"`
+	Nickname string  `json:"nickname"`
+	X        float64 `json:"x"`
+	Y        float64 `json:"y"`
+}
+
+// WhiteboardHistoryPayload 입장 시 전체 획 (스냅샷 → 저장된 획 → 버퍼 순)
+type WhiteboardHistoryPayload struct {
+	Strokes []json.RawMessage `json:"strokes"`
+	LastSeq int64             `json:"last_seq"`
+}
+
+// NewWhiteboardWSHandler WhiteboardWSHandler 생성 (redis가 있으면 버퍼 압축 루프 시작)
+func NewWhiteboardWSHandler(db *gorm.DB, redis *cache.RedisClient) *WhiteboardWSHandler {
+	h := &WhiteboardWSHandler{
+		db:         db,
+		redis:      redis,
+		rooms:      make(map[int64]*WhiteboardRoom),
+		instanceID: uuid.New().String(),
+		stop:       make(chan struct{}),
+	}
+	if redis != nil {
+		go h.runCompaction()
+	}
+	return h
+}
+
+// Close 압축 루프 중지 후 남은 버퍼를 스냅샷으로 저장
+func (h *WhiteboardWSHandler) Close() {
+	h.closeOnce.Do(func() {
+		close(h.stop)
+		if h.redis == nil {
+			return
+		}
+		for _, meetingID := range h.activeMeetings() {
+			h.compact(meetingID)
+		}
+	})
+}
+
+// HandleWebSocket WebSocket 연결 처리
+func (h *WhiteboardWSHandler) HandleWebSocket(c *websocket.Conn) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("화이트보드 WebSocket 패닉 복구: %v", r)
+		}
+	}()
+
+	meetingID, ok1 := c.Locals("meetingId").(int64)
+	userID, ok2 := c.Locals("userId").(int64)
+	nickname, ok3 := c.Locals("nickname").(string)
+	if !ok1 || !ok2 || !ok3 {
+		c.WriteMessage(websocket.TextMessage, []byte(`{"type":"error","message":"invalid session"}`))
+		c.Close()
+		return
+	}
+
+	sess := session.Default.Open(session.KindWhiteboard, "")
+	sess.SetRoomID(strconv.FormatInt(meetingID, 10))
+	sess.SetUserID(strconv.FormatInt(userID, 10))
+	defer sess.Close()
+
+	client := &WhiteboardClient{UserID: userID, Nickname: nickname, Conn: c, Session: sess}
+	room := h.joinRoom(meetingID, client)
+	log.Printf("화이트보드 클라이언트 연결: meeting=%d, user=%d", meetingID, userID)
+
+	defer func() {
+		if h.leaveRoom(meetingID, room, c) && h.redis != nil {
+			// 마지막 참가자가 나가면 남은 버퍼 정리
+			go h.compact(meetingID)
+		}
+		c.Close()
+		log.Printf("화이트보드 클라이언트 연결 해제: meeting=%d, user=%d", meetingID, userID)
+	}()
+
+	h.sendHistory(client, meetingID)
+
+	for {
+		_, msgBytes, err := sess.Read(c)
+		if err != nil {
+			break
+		}
+
+		var msg WhiteboardWSMessage
+		if err := json.Unmarshal(msgBytes, &msg); err != nil {
+			continue
+		}
+
+		switch msg.Type {
+		case "stroke":
+			h.handleStroke(room, client, meetingID, msg.Payload)
+		case "cursor":
+			var cursor WhiteboardCursorPayload
+			if json.Unmarshal(msg.Payload, &cursor) != nil {
+				continue
+			}
+			cursor.UserID = userID
+			cursor.Nickname = nickname
+			h.broadcast(room, "cursor", cursor, client)
+		case "clear":
+			h.handleClear(room, client, meetingID)
+		}
+	}
+}
+
+// handleStroke 획을 버퍼(또는 DB)에 저장하고 다른 참가자에게 전달
+func (h *WhiteboardWSHandler) handleStroke(room *WhiteboardRoom, client *WhiteboardClient, meetingID int64, stroke json.RawMessage) {
+	if len(stroke) == 0 || len(stroke) > maxWhiteboardStrokeSize || !json.Valid(stroke) {
+		client.send("error", []byte(`{"type":"error","message":"invalid stroke"}`))
+		return
+	}
+
+	payload := WhiteboardStrokePayload{UserID: client.UserID, Nickname: client.Nickname, Stroke: stroke}
+	if h.redis != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), whiteboardRedisTimeout)
+		buffered, err := h.redis.AppendWhiteboardStroke(ctx, meetingID, client.UserID, stroke)
+		cancel()
+		if err != nil {
+			log.Printf("화이트보드 획 버퍼 저장 실패: meeting=%d: %v", meetingID, err)
+			client.send("error", []byte(`{"type":"error","message":"failed to save stroke"}`))
+			return
+		}
+		payload.Seq = buffered.Seq
+	} else {
+		row := model.WhiteboardStroke{MeetingID: meetingID, UserID: client.UserID, StrokeData: string(stroke)}
+		if err := h.db.Create(&row).Error; err != nil {
+			log.Printf("화이트보드 획 저장 실패: meeting=%d: %v", meetingID, err)
+			client.send("error", []byte(`{"type":"error","message":"failed to save stroke"}`))
+			return
+		}
+	}
+
+	h.broadcast(room, "stroke", payload, client)
+
+	if h.redis != nil && payload.Seq%whiteboardCompactThreshold == 0 {
+		go h.compact(meetingID)
+	}
+}
+
+// handleClear 화이트보드 전체 삭제 (압축과 겹치지 않도록 잠금을 잡고 진행)
+func (h *WhiteboardWSHandler) handleClear(room *WhiteboardRoom, client *WhiteboardClient, meetingID int64) {
+	if h.redis != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), whiteboardRedisTimeout)
+		defer cancel()
+		if !h.lock(ctx, meetingID) {
+			client.send("error", []byte(`{"type":"error","message":"whiteboard is busy, try again"}`))
+			return
+		}
+		defer h.redis.UnlockWhiteboard(context.Background(), meetingID, h.instanceID)
+
+		if err := h.redis.ClearWhiteboardBuffer(ctx, meetingID); err != nil {
+			log.Printf("화이트보드 버퍼 삭제 실패: meeting=%d: %v", meetingID, err)
+			client.send("error", []byte(`{"type":"error","message":"failed to clear whiteboard"}`))
+			return
+		}
+	}
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("meeting_id = ?", meetingID).Delete(&model.WhiteboardStroke{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("meeting_id = ?", meetingID).Delete(&model.WhiteboardSnapshot{}).Error
+	})
+	if err != nil {
+		log.Printf("화이트보드 삭제 실패: meeting=%d: %v", meetingID, err)
+		client.send("error", []byte(`{"type":"error","message":"failed to clear whiteboard"}`))
+		return
+	}
+
+	log.Printf("[Whiteboard] User %d cleared meeting %d", client.UserID, meetingID)
+	h.broadcast(room, "clear", map[string]any{"user_id": client.UserID, "nickname": client.Nickname}, nil)
+}
+
+// lock 압축 잠금 획득 (다른 인스턴스가 압축 중이면 잠시 재시도)
+func (h *WhiteboardWSHandler) lock(ctx context.Context, meetingID int64) bool {
+	for attempt := 0; attempt < 5; attempt++ {
+		ok, err := h.redis.LockWhiteboard(ctx, meetingID, h.instanceID, whiteboardLockTTL)
+		if err != nil {
+			log.Printf("화이트보드 잠금 실패: meeting=%d: %v", meetingID, err)
+			return false
+		}
+		if ok {
+			return true
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(200 * time.Millisecond):
+		}
+	}
+	return false
+}
+
+// sendHistory 입장한 클라이언트에게 지금까지의 획 전송
+func (h *WhiteboardWSHandler) sendHistory(client *WhiteboardClient, meetingID int64) {
+	history := WhiteboardHistoryPayload{Strokes: make([]json.RawMessage, 0)}
+
+	var snapshots []model.WhiteboardSnapshot
+	if err := h.db.Where("meeting_id = ?", meetingID).Order("id ASC").Find(&snapshots).Error; err != nil {
+		log.Printf("화이트보드 스냅샷 조회 실패: meeting=%d: %v", meetingID, err)
+	}
+	for _, snap := range snapshots {
+		var chunk []json.RawMessage
+		if err := json.Unmarshal([]byte(snap.Data), &chunk); err != nil {
+			log.Printf("[Whiteboard] Failed to parse snapshot %d: %v", snap.ID, err)
+			continue
+		}
+		history.Strokes = append(history.Strokes, chunk...)
+		history.LastSeq = max(history.LastSeq, snap.EndID)
+	}
+
+	var strokes []model.WhiteboardStroke
+	h.db.Where("meeting_id = ? AND is_deleted = ?", meetingID, false).Order("id ASC").Find(&strokes)
+	for _, s := range strokes {
+		history.Strokes = append(history.Strokes, json.RawMessage(s.StrokeData))
+	}
+
+	if h.redis != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), whiteboardRedisTimeout)
+		defer cancel()
+		// 순번이 끊기지 않도록 마지막 스냅샷 이후부터 시작
+		h.redis.SeedWhiteboardSeq(ctx, meetingID, h.lastCompactedSeq(meetingID))
+		buffered, err := h.redis.WhiteboardBuffer(ctx, meetingID)
+		if err != nil {
+			log.Printf("화이트보드 버퍼 조회 실패: meeting=%d: %v", meetingID, err)
+		}
+		lastCompacted := history.LastSeq
+		for _, s := range buffered {
+			if s.Seq <= lastCompacted {
+				continue
+			}
+			history.Strokes = append(history.Strokes, s.Data)
+			history.LastSeq = max(history.LastSeq, s.Seq)
+		}
+	}
+
+	msgBytes, _ := json.Marshal(struct {
+		Type    string                   `json:"type"`
+		Payload WhiteboardHistoryPayload `json:"payload"`
+	}{"history", history})
+	client.send("history", msgBytes)
+}
+
+// runCompaction 접속 중인 회의의 버퍼를 주기적으로 스냅샷으로 압축
+func (h *WhiteboardWSHandler) runCompaction() {
+	ticker := time.NewTicker(whiteboardCompactInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.stop:
+			return
+		case <-ticker.C:
+			for _, meetingID := range h.activeMeetings() {
+				h.compact(meetingID)
+			}
+		}
+	}
+}
+
+// compact 버퍼의 획을 WhiteboardSnapshot 청크 하나로 옮김 (StartID/EndID = 획 순번 범위)
+// 스냅샷 저장 후 버퍼를 자르기 전에 실패하면 다음 압축에서 EndID 이하 획을 건너뛰어 중복 저장하지 않음
+func (h *WhiteboardWSHandler) compact(meetingID int64) {
+	h.mu.RLock()
+	room := h.rooms[meetingID]
+	h.mu.RUnlock()
+	if room != nil {
+		room.mu.Lock()
+		if room.compacting {
+			room.mu.Unlock()
+			return
+		}
+		room.compacting = true
+		room.mu.Unlock()
+		defer func() {
+			room.mu.Lock()
+			room.compacting = false
+			room.mu.Unlock()
+		}()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), whiteboardLockTTL)
+	defer cancel()
+
+	ok, err := h.redis.LockWhiteboard(ctx, meetingID, h.instanceID, whiteboardLockTTL)
+	if err != nil || !ok {
+		return
+	}
+	defer h.redis.UnlockWhiteboard(context.Background(), meetingID, h.instanceID)
+
+	buffered, err := h.redis.WhiteboardBuffer(ctx, meetingID)
+	if err != nil {
+		log.Printf("[Snapshot] Failed to read whiteboard buffer for meeting %d: %v", meetingID, err)
+		return
+	}
+	if len(buffered) == 0 {
+		return
+	}
+
+	lastCompacted := h.lastCompactedSeq(meetingID)
+	chunk := make([]json.RawMessage, 0, len(buffered))
+	snapshot := model.WhiteboardSnapshot{MeetingID: meetingID}
+	for _, s := range buffered {
+		if s.Seq <= lastCompacted {
+			continue
+		}
+		chunk = append(chunk, s.Data)
+		if snapshot.StartID == 0 || s.Seq < snapshot.StartID {
+			snapshot.StartID = s.Seq
+		}
+		snapshot.EndID = max(snapshot.EndID, s.Seq)
+	}
+
+	if len(chunk) > 0 {
+		data, err := json.Marshal(chunk)
+		if err != nil {
+			log.Printf("[Snapshot] Failed to marshal whiteboard buffer: %v", err)
+			return
+		}
+		snapshot.Data = string(data)
+		if err := h.db.Create(&snapshot).Error; err != nil {
+			log.Printf("[Snapshot] Failed to create snapshot for meeting %d: %v", meetingID, err)
+			return
+		}
+	}
+
+	if err := h.redis.TrimWhiteboardBuffer(ctx, meetingID, int64(len(buffered))); err != nil {
+		log.Printf("[Snapshot] Failed to trim whiteboard buffer for meeting %d: %v", meetingID, err)
+		return
+	}
+	if len(chunk) > 0 {
+		log.Printf("[Snapshot] Compacted %d live strokes of meeting %d into snapshot %d (Seq %d-%d)",
+			len(chunk), meetingID, snapshot.ID, snapshot.StartID, snapshot.EndID)
+	}
+}
+
+// lastCompactedSeq 마지막 스냅샷의 EndID (없으면 0)
+func (h *WhiteboardWSHandler) lastCompactedSeq(meetingID int64) int64 {
+	var endID int64
+	h.db.Model(&model.WhiteboardSnapshot{}).Where("meeting_id = ?", meetingID).
+		Select("COALESCE(MAX(end_id), 0)").Scan(&endID)
+	return endID
+}
+
+// joinRoom 회의 화이트보드에 클라이언트 등록 (없으면 생성)
+func (h *WhiteboardWSHandler) joinRoom(meetingID int64, client *WhiteboardClient) *WhiteboardRoom {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	room, ok := h.rooms[meetingID]
+	if !ok {
+		room = &WhiteboardRoom{clients: make(map[*websocket.Conn]*WhiteboardClient)}
+		h.rooms[meetingID] = room
+	}
+	room.mu.Lock()
+	room.clients[client.Conn] = client
+	room.mu.Unlock()
+	return room
+}
+
+// leaveRoom 클라이언트 제거, 마지막 클라이언트였으면 방을 지우고 true 반환
+func (h *WhiteboardWSHandler) leaveRoom(meetingID int64, room *WhiteboardRoom, conn *websocket.Conn) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	room.mu.Lock()
+	delete(room.clients, conn)
+	empty := len(room.clients) == 0
+	room.mu.Unlock()
+
+	if empty && h.rooms[meetingID] == room {
+		delete(h.rooms, meetingID)
+		return true
+	}
+	return false
+}
+
+func (h *WhiteboardWSHandler) activeMeetings() []int64 {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	meetingIDs := make([]int64, 0, len(h.rooms))
+	for meetingID := range h.rooms {
+		meetingIDs = append(meetingIDs, meetingID)
+	}
+	return meetingIDs
+}
+
+// broadcast 같은 회의의 클라이언트에게 전송 (except는 보낸 사람, nil이면 모두)
+func (h *WhiteboardWSHandler) broadcast(room *WhiteboardRoom, msgType string, payload any, except *WhiteboardClient) {
+	msgBytes, err := json.Marshal(struct {
+		Type    string `json:"type"`
+		Payload any    `json:"payload"`
+	}{msgType, payload})
+	if err != nil {
+		return
+	}
+
+	room.mu.RLock()
+	clients := make([]*WhiteboardClient, 0, len(room.clients))
+	for _, c := range room.clients {
+		if c != except {
+			clients = append(clients, c)
+		}
+	}
+	room.mu.RUnlock()
+
+	for _, c := range clients {
+		c.send(msgType, msgBytes)
+	}
+}
+
+func (c *WhiteboardClient) send(msgType string, data []byte) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := c.Session.Write(c.Conn, websocket.TextMessage, msgType, data); err != nil {
+		log.Printf("화이트보드 메시지 전송 실패: user=%d: %v", c.UserID, err)
+	}
+}
@@ -0,0 +1,124 @@
+package metrics
+
+import "io"
+
+// Default 서버 전역 Registry (/api/admin/metrics 엔드포인트에서 출력)
+var Default = NewRegistry()
+
+// 파이프라인 메트릭
+var (
+	// TranscriptsTotal 전송된 자막 수 (rate()로 초당 자막 수 계산)
+	TranscriptsTotal = Default.NewCounterVec("eum_transcripts_total",
+		"Transcripts delivered to rooms.", "type")
+
+	// TranslationLatency Amazon Translate 호출 지연시간
+	TranslationLatency = Default.NewHistogramVec("eum_translation_latency_seconds",
+		"Amazon Translate call latency.", DefaultLatencyBuckets, "mode")
+
+	// TTSLatency Amazon Polly 호출 지연시간
+	TTSLatency = Default.NewHistogramVec("eum_tts_latency_seconds",
+		"Amazon Polly synthesis latency.", DefaultLatencyBuckets)
+
+	// DroppedMessages 버퍼 초과로 버려진 메시지 수
+	DroppedMessages = Default.NewCounterVec("eum_dropped_messages_total",
+		"Messages dropped because a buffer was full.", "reason")
+)
+
+// Room 메트릭 (스크랩 시 RoomHub가 갱신)
+var (
+	ActiveRooms = Default.NewGaugeVec("eum_active_rooms",
+		"Rooms currently open.")
+
+	ActiveStreams = Default.NewGaugeVec("eum_active_streams",
+		"Transcribe streams currently open per room.", "room")
+
+	BackpressureLevel = Default.NewGaugeVec("eum_backpressure_level",
+		"Pipeline output buffer usage per room (0..1).", "room")
+
+	RoomListeners = Default.NewGaugeVec("eum_room_listeners",
+		"Connected listeners per room.", "room")
+
+	// SlowListenersEvicted 송신 큐가 계속 가득 차서 연결을 끊은 리스너 수
+	SlowListenersEvicted = Default.NewCounterVec("eum_slow_listeners_evicted_total",
+		"Room listeners disconnected because their send queue stayed full.")
+)
+
+// 채팅 메트릭
+var (
+	ChatRooms = Default.NewGaugeVec("eum_chat_rooms",
+		"Chat rooms currently open.")
+
+	ChatClients = Default.NewGaugeVec("eum_chat_clients",
+		"Chat WebSocket clients currently connected.")
+
+	ChatMessagesTotal = Default.NewCounterVec("eum_chat_messages_total",
+		"Chat messages persisted and broadcast.")
+)
+
+// WebSocket 세션 메트릭 (kind: audio | room | chat)
+var (
+	WebSocketSessions = Default.NewGaugeVec("eum_websocket_sessions",
+		"WebSocket sessions currently connected.", "kind")
+
+	WebSocketSessionsTotal = Default.NewCounterVec("eum_websocket_sessions_total",
+		"WebSocket sessions opened.", "kind")
+
+	// WebSocketMessages 메시지 수 (direction: in | out, type: JSON type 필드 또는 binary)
+	WebSocketMessages = Default.NewCounterVec("eum_websocket_messages_total",
+		"WebSocket messages received and sent.", "kind", "direction", "type")
+
+	WebSocketMessageBytes = Default.NewCounterVec("eum_websocket_message_bytes_total",
+		"WebSocket message payload bytes received and sent.", "kind", "direction", "type")
+
+	// WebSocketWriteLatency 메시지 한 번 쓰는 데 걸린 시간 (느린 클라이언트/네트워크 감지)
+	WebSocketWriteLatency = Default.NewHistogramVec("eum_websocket_write_latency_seconds",
+		"WebSocket message write latency.", WebSocketWriteBuckets, "kind")
+
+	WebSocketWriteErrors = Default.NewCounterVec("eum_websocket_write_errors_total",
+		"WebSocket message writes that failed.", "kind")
+)
+
+// WebSocketWriteBuckets WebSocket 쓰기 지연 히스토그램 버킷 (초)
+var WebSocketWriteBuckets = []float64{0.0005, 0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1}
+
+// WebSocket 메시지 방향 라벨
+const (
+	DirectionIn  = "in"
+	DirectionOut = "out"
+)
+
+// RateLimited 요청 제한으로 거부된 요청/메시지 수 (scope: ws_upgrade | chat_message | audio_frame)
+var RateLimited = Default.NewCounterVec("eum_rate_limited_total",
+	"Requests and messages rejected by rate limits.", "scope")
+
+// 요청 제한 범위 라벨
+const (
+	RateLimitWSUpgrade   = "ws_upgrade"
+	RateLimitChatMessage = "chat_message"
+	RateLimitAudioFrame  = "audio_frame"
+)
+
+// JanitorCleaned 정리 작업이 회수한 리소스 수
+var JanitorCleaned = Default.NewCounterVec("eum_janitor_cleaned_total",
+	"Leaked resources cleaned up by the janitor.", "resource")
+
+// 드롭 사유 라벨
+const (
+	DropTranscriptChannel = "transcript_channel"
+	DropAudioChannel      = "audio_channel"
+	DropRoomBroadcast     = "room_broadcast"
+	DropOriginalAudio     = "original_audio"
+	DropSuperseded        = "superseded_tts"
+	DropRoomFanout        = "room_fanout"
+	DropListenerQueue     = "listener_queue"
+	DropListenerAudio     = "listener_audio"
+	DropEdgeRelay         = "edge_relay"
+	DropPresenceHook      = "presence_hook"
+	DropPassthroughTTS    = "passthrough_tts"
+	DropWebhook           = "webhook"
+)
+
+// WriteText Default Registry 출력
+func WriteText(w io.Writer) {
+	Default.WriteText(w)
+}
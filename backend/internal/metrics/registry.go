@@ -0,0 +1,281 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ContentType Prometheus text exposition format
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefaultLatencyBuckets 지연시간 히스토그램 기본 버킷 (초)
+var DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// collector /metrics 출력 단위
+type collector interface {
+	write(w io.Writer)
+}
+
+// Registry 메트릭 등록소
+type Registry struct {
+	collectors  []collector
+	scrapeHooks []func()
+	mu          sync.Mutex
+}
+
+// NewRegistry 빈 Registry 생성
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// OnScrape 스크랩 직전에 호출할 함수 등록 (Room 목록처럼 매번 계산해야 하는 게이지 갱신용)
+func (r *Registry) OnScrape(fn func()) {
+	r.mu.Lock()
+	r.scrapeHooks = append(r.scrapeHooks, fn)
+	r.mu.Unlock()
+}
+
+func (r *Registry) register(c collector) {
+	r.mu.Lock()
+	r.collectors = append(r.collectors, c)
+	r.mu.Unlock()
+}
+
+// WriteText 모든 메트릭을 text exposition format으로 출력
+func (r *Registry) WriteText(w io.Writer) {
+	r.mu.Lock()
+	hooks := append([]func(){}, r.scrapeHooks...)
+	collectors := append([]collector{}, r.collectors...)
+	r.mu.Unlock()
+
+	for _, hook := range hooks {
+		hook()
+	}
+	for _, c := range collectors {
+		c.write(w)
+	}
+}
+
+// =============================================================================
+// Label handling
+// =============================================================================
+
+type series struct {
+	labels []string
+	value  float64
+}
+
+type labeledValues struct {
+	name       string
+	help       string
+	kind       string
+	labelNames []string
+	values     map[string]*series
+	mu         sync.Mutex
+}
+
+func newLabeledValues(name, help, kind string, labelNames []string) labeledValues {
+	return labeledValues{
+		name:       name,
+		help:       help,
+		kind:       kind,
+		labelNames: labelNames,
+		values:     make(map[string]*series),
+	}
+}
+
+// get 라벨 값에 해당하는 시계열 (mu 보유 상태에서 호출)
+func (v *labeledValues) get(labels []string) *series {
+	if len(labels) != len(v.labelNames) {
+		panic(fmt.Sprintf("metrics: %s expects %d labels, got %d", v.name, len(v.labelNames), len(labels)))
+	}
+	key := strings.Join(labels, "\xff")
+	s, ok := v.values[key]
+	if !ok {
+		s = &series{labels: append([]string{}, labels...)}
+		v.values[key] = s
+	}
+	return s
+}
+
+func (v *labeledValues) write(w io.Writer) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", v.name, v.help, v.name, v.kind)
+	for _, key := range sortedKeys(v.values) {
+		s := v.values[key]
+		fmt.Fprintf(w, "%s%s %s\n", v.name, formatLabels(v.labelNames, s.labels, "", ""), formatValue(s.value))
+	}
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// formatLabels {a="x",b="y"} 형식 (extraName이 있으면 le 같은 추가 라벨 포함)
+func formatLabels(names, values []string, extraName, extraValue string) string {
+	if len(names) == 0 && extraName == "" {
+		return ""
+	}
+	parts := make([]string, 0, len(names)+1)
+	for i, name := range names {
+		parts = append(parts, fmt.Sprintf(`%s="%s"`, name, labelEscaper.Replace(values[i])))
+	}
+	if extraName != "" {
+		parts = append(parts, fmt.Sprintf(`%s="%s"`, extraName, extraValue))
+	}
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// =============================================================================
+// Counter / Gauge / Histogram
+// =============================================================================
+
+// CounterVec 단조 증가 카운터
+type CounterVec struct {
+	labeledValues
+}
+
+// NewCounterVec 카운터 생성 및 등록
+func (r *Registry) NewCounterVec(name, help string, labelNames ...string) *CounterVec {
+	c := &CounterVec{newLabeledValues(name, help, "counter", labelNames)}
+	r.register(c)
+	return c
+}
+
+// Inc 1 증가
+func (c *CounterVec) Inc(labels ...string) {
+	c.Add(1, labels...)
+}
+
+// Add delta 증가 (음수는 무시)
+func (c *CounterVec) Add(delta float64, labels ...string) {
+	if delta < 0 {
+		return
+	}
+	c.mu.Lock()
+	c.get(labels).value += delta
+	c.mu.Unlock()
+}
+
+// GaugeVec 임의로 변하는 값
+type GaugeVec struct {
+	labeledValues
+}
+
+// NewGaugeVec 게이지 생성 및 등록
+func (r *Registry) NewGaugeVec(name, help string, labelNames ...string) *GaugeVec {
+	g := &GaugeVec{newLabeledValues(name, help, "gauge", labelNames)}
+	r.register(g)
+	return g
+}
+
+// Set 값 설정
+func (g *GaugeVec) Set(value float64, labels ...string) {
+	g.mu.Lock()
+	g.get(labels).value = value
+	g.mu.Unlock()
+}
+
+// Reset 모든 시계열 제거 (사라진 Room 라벨 정리용)
+func (g *GaugeVec) Reset() {
+	g.mu.Lock()
+	g.values = make(map[string]*series)
+	g.mu.Unlock()
+}
+
+// HistogramVec 관측값 분포
+type HistogramVec struct {
+	name       string
+	help       string
+	labelNames []string
+	buckets    []float64
+	values     map[string]*histogramSeries
+	mu         sync.Mutex
+}
+
+type histogramSeries struct {
+	labels []string
+	counts []uint64 // 버킷별 누적 전 카운트
+	count  uint64
+	sum    float64
+}
+
+// NewHistogramVec 히스토그램 생성 및 등록 (buckets는 오름차순)
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	h := &HistogramVec{
+		name:       name,
+		help:       help,
+		labelNames: labelNames,
+		buckets:    buckets,
+		values:     make(map[string]*histogramSeries),
+	}
+	r.register(h)
+	return h
+}
+
+// Observe 값 기록
+func (h *HistogramVec) Observe(value float64, labels ...string) {
+	if len(labels) != len(h.labelNames) {
+		panic(fmt.Sprintf("metrics: %s expects %d labels, got %d", h.name, len(h.labelNames), len(labels)))
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	key := strings.Join(labels, "\xff")
+	s, ok := h.values[key]
+	if !ok {
+		s = &histogramSeries{labels: append([]string{}, labels...), counts: make([]uint64, len(h.buckets))}
+		h.values[key] = s
+	}
+	for i, upper := range h.buckets {
+		if value <= upper {
+			s.counts[i]++
+			break
+		}
+	}
+	s.count++
+	s.sum += value
+}
+
+func (h *HistogramVec) write(w io.Writer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s histogram\n", h.name, h.help, h.name)
+	for _, key := range sortedKeys(h.values) {
+		s := h.values[key]
+		var cumulative uint64
+		for i, upper := range h.buckets {
+			cumulative += s.counts[i]
+			fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, formatLabels(h.labelNames, s.labels, "le", formatValue(upper)), cumulative)
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", h.name, formatLabels(h.labelNames, s.labels, "le", "+Inf"), s.count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", h.name, formatLabels(h.labelNames, s.labels, "", ""), formatValue(s.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", h.name, formatLabels(h.labelNames, s.labels, "", ""), s.count)
+	}
+}
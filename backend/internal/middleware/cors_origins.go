@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"realtime-backend/internal/config"
+)
+
+// OriginAllowlist CORS 허용 origin 목록 (cors.Config.AllowOriginsFunc용, 설정을 다시 읽으면 재시작 없이 교체)
+type OriginAllowlist struct {
+	set atomic.Pointer[originSet]
+}
+
+type originSet struct {
+	exact    map[string]bool
+	wildcard []wildcardOrigin
+}
+
+// wildcardOrigin "https://*.example.com" → prefix "https://", suffix ".example.com"
+type wildcardOrigin struct {
+	prefix string
+	suffix string
+}
+
+// NewOriginAllowlist OriginAllowlist 생성 (spec 형식은 config.ParseOrigins)
+func NewOriginAllowlist(spec string) (*OriginAllowlist, error) {
+	l := &OriginAllowlist{}
+	if err := l.Set(spec); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Set 허용 목록 교체 (형식이 잘못되면 기존 목록 유지)
+func (l *OriginAllowlist) Set(spec string) error {
+	origins, err := config.ParseOrigins(spec)
+	if err != nil {
+		return err
+	}
+
+	set := &originSet{exact: make(map[string]bool, len(origins))}
+	for _, origin := range origins {
+		if i := strings.Index(origin, "://*."); i != -1 {
+			set.wildcard = append(set.wildcard, wildcardOrigin{prefix: origin[:i+3], suffix: origin[i+4:]})
+			continue
+		}
+		set.exact[origin] = true
+	}
+	l.set.Store(set)
+	return nil
+}
+
+// Allow origin 허용 여부 (cors 미들웨어가 소문자로 바꿔서 전달)
+func (l *OriginAllowlist) Allow(origin string) bool {
+	set := l.set.Load()
+	if set.exact[origin] {
+		return true
+	}
+	for _, w := range set.wildcard {
+		if len(origin) > len(w.prefix)+len(w.suffix) &&
+			strings.HasPrefix(origin, w.prefix) && strings.HasSuffix(origin, w.suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"strconv"
+	"strings"
+
+	"realtime-backend/internal/auth"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// RoomRoleResolver 로그인 사용자가 Room(회의)에 들어갈 수 있는 최대 역할과 회의 ID
+// 회의가 없거나 멤버가 아니면 빈 역할, 조회 실패는 err
+type RoomRoleResolver func(roomID string, userID int64) (role string, meetingID int64, err error)
+
+// BreakoutParser 브레이크아웃 Room ID를 부모 Room ID와 이름으로 분리 (브레이크아웃이 아니면 ok=false)
+type BreakoutParser func(roomID string) (parentRoomID, name string, ok bool)
+
+// RoomWSMiddleware Room/오디오 WebSocket 업그레이드 인증 미들웨어
+type RoomWSMiddleware struct {
+	jwtManager    *auth.JWTManager
+	requireAuth   bool
+	resolveRole   RoomRoleResolver
+	parseBreakout BreakoutParser
+}
+
+// NewRoomWSMiddleware RoomWSMiddleware 생성 (requireAuth=false면 토큰 없는 연결도 통과)
+// resolveRole은 Room 토큰 없이 로그인 쿠키로 접속한 경우의 역할 확인용,
+// parseBreakout은 토큰의 Room에 딸린 브레이크아웃 Room 접속 허용용
+func NewRoomWSMiddleware(jwtManager *auth.JWTManager, requireAuth bool, resolveRole RoomRoleResolver, parseBreakout BreakoutParser) *RoomWSMiddleware {
+	return &RoomWSMiddleware{jwtManager: jwtManager, requireAuth: requireAuth, resolveRole: resolveRole, parseBreakout: parseBreakout}
+}
+
+// Authenticate 업그레이드 요청의 Room 토큰을 검증하고 연결을 참가자/Room에 고정
+//
+// 토큰 우선순위: ?token= (브라우저 WebSocket은 헤더를 못 붙임) > Authorization: Bearer > access_token 쿠키.
+// Room 토큰이면 roomId/listenerId가 토큰과 같아야 하고 (roomId는 토큰 Room의 브레이크아웃도 허용),
+// 쿠키 로그인이면 listenerId가 사용자 ID여야 함
+// (쿠키 로그인은 speaker 역할). requireSpeaker면 listener 역할 토큰을 거부.
+// 통과하면 Locals에 roomClaims, roomRole 저장
+func (m *RoomWSMiddleware) Authenticate(requireSpeaker bool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		// WebSocket은 JSON 응답 대신 상태 코드로 업그레이드 거부
+		claims, status := m.roomClaims(c)
+		if status == fiber.StatusUnauthorized && !m.requireAuth && roomToken(c) == "" {
+			return c.Next()
+		}
+		if status != fiber.StatusOK {
+			return c.SendStatus(status)
+		}
+
+		roomID := c.Query("roomId", "")
+		if roomID != "" && roomID != claims.RoomID {
+			if !m.isBreakoutOf(roomID, claims.RoomID) {
+				return c.SendStatus(fiber.StatusForbidden)
+			}
+			// breakout_move로 이동한 브레이크아웃: 같은 권한으로 그 Room에 고정
+			scoped := *claims
+			scoped.RoomID = roomID
+			claims = &scoped
+		}
+		listenerID := c.Query("listenerId", "")
+		if listenerID != "" && listenerID != claims.ParticipantID {
+			return c.SendStatus(fiber.StatusForbidden)
+		}
+		if requireSpeaker && !claims.CanSpeak() {
+			return c.SendStatus(fiber.StatusForbidden)
+		}
+
+		c.Locals("roomClaims", claims)
+		c.Locals("roomRole", claims.Role)
+		return c.Next()
+	}
+}
+
+// isBreakoutOf roomID가 parentRoomID의 브레이크아웃 Room인지 확인
+func (m *RoomWSMiddleware) isBreakoutOf(roomID, parentRoomID string) bool {
+	if m.parseBreakout == nil {
+		return false
+	}
+	parent, _, ok := m.parseBreakout(roomID)
+	return ok && parent == parentRoomID
+}
+
+// roomClaims 요청의 토큰으로 Room 클레임 구성 (실패 시 거부 상태 코드)
+func (m *RoomWSMiddleware) roomClaims(c *fiber.Ctx) (*auth.RoomClaims, int) {
+	if token := roomToken(c); token != "" {
+		claims, err := m.jwtManager.ValidateRoomToken(token)
+		if err != nil {
+			return nil, fiber.StatusUnauthorized
+		}
+		return claims, fiber.StatusOK
+	}
+
+	// 기존 클라이언트 호환: 로그인 쿠키 (입장 토큰 발급과 같은 규칙으로 회의 멤버 여부와 역할 확인)
+	accessToken := c.Cookies("access_token")
+	if accessToken == "" {
+		return nil, fiber.StatusUnauthorized
+	}
+	user, err := m.jwtManager.ValidateAccessToken(accessToken)
+	if err != nil {
+		return nil, fiber.StatusUnauthorized
+	}
+	roomID := c.Query("roomId", "")
+	if roomID == "" {
+		return nil, fiber.StatusBadRequest
+	}
+	role, meetingID, err := m.resolveRole(roomID, user.UserID)
+	if err != nil {
+		return nil, fiber.StatusInternalServerError
+	}
+	if role == "" {
+		return nil, fiber.StatusForbidden
+	}
+	return &auth.RoomClaims{
+		UserID:        user.UserID,
+		ParticipantID: strconv.FormatInt(user.UserID, 10),
+		RoomID:        roomID,
+		Role:          role,
+		MeetingID:     meetingID,
+	}, fiber.StatusOK
+}
+
+// roomToken ?token= 또는 Authorization: Bearer 토큰
+func roomToken(c *fiber.Ctx) string {
+	if token := c.Query("token", ""); token != "" {
+		return token
+	}
+	if parts := strings.Fields(c.Get("Authorization")); len(parts) == 2 && strings.EqualFold(parts[0], "bearer") {
+		return parts[1]
+	}
+	return ""
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+)
+
+// WorkspaceCompliance 워크스페이스 컴플라이언스 설정
+// RedactPII: 자막/번역/회의록(VoiceRecord)에서 이메일, 전화번호, 카드번호를 마스킹
+// KMSKeyARN: 워크스페이스 파일/녹음/내보내기를 이 KMS 키로 암호화 (SSE-KMS, nil = 서버 기본 설정)
+type WorkspaceCompliance struct {
+	WorkspaceID int64     `gorm:"primaryKey" json:"workspace_id"`
+	RedactPII   bool      `gorm:"not null;default:false" json:"redact_pii"`
+	KMSKeyARN   *string   `gorm:"type:varchar(2048)" json:"kms_key_arn,omitempty"`
+	UpdatedBy   int64     `gorm:"not null" json:"updated_by"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (WorkspaceCompliance) TableName() string {
+	return "workspace_compliance"
+}
@@ -15,6 +15,7 @@ const (
 	NotificationTypeWorkspaceInvite NotificationType = "WORKSPACE_INVITE"
 	NotificationTypeMeetingAlert    NotificationType = "MEETING_ALERT"
 	NotificationTypeCommentMention  NotificationType = "COMMENT_MENTION"
+	NotificationTypeFileQuarantined NotificationType = "FILE_QUARANTINED" // 업로드한 파일에서 악성 코드 발견
 )
 
 // String 메서드
@@ -33,8 +34,22 @@ const (
 	MeetingTypeChatRoom MeetingType = "CHAT_ROOM"
 	MeetingTypeDM       MeetingType = "DM"
 	MeetingTypeGeneral  MeetingType = "MEETING" // 일반 화상 회의
+	MeetingTypeWebinar  MeetingType = "WEBINAR" // 리슨 전용 웨비나 (지정 화자만 발화, 나머지는 청취만)
 )
 
 func (m MeetingType) String() string {
 	return string(m)
 }
+
+// TranscriptAccessAction 회의록 접근 유형 (감사 로그용)
+type TranscriptAccessAction string
+
+const (
+	TranscriptAccessRead   TranscriptAccessAction = "READ"
+	TranscriptAccessExport TranscriptAccessAction = "EXPORT"
+	TranscriptAccessDelete TranscriptAccessAction = "DELETE"
+)
+
+func (a TranscriptAccessAction) String() string {
+	return string(a)
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"time"
+)
+
+// 이메일 알림 종류
+const (
+	EmailKindWorkspaceInvite = "WORKSPACE_INVITE" // 워크스페이스 초대
+	EmailKindMeetingReminder = "MEETING_REMINDER" // 예정 회의 시작 전 알림
+	EmailKindMeetingSummary  = "MEETING_SUMMARY"  // 회의 종료 후 요약/회의록 링크
+)
+
+// 이메일 발송 상태
+const (
+	EmailStatusPending = "PENDING"
+	EmailStatusSent    = "SENT"
+	EmailStatusFailed  = "FAILED" // 최대 시도 횟수 초과
+)
+
+// EmailNotification 발송 대기열의 이메일 한 통 (발송 시점에 템플릿으로 렌더링, 실패하면 스케줄러가 재시도)
+type EmailNotification struct {
+	ID        int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	UserID    int64      `gorm:"not null;index" json:"user_id"`
+	Kind      string     `gorm:"type:varchar(30);not null" json:"kind"`
+	DedupKey  string     `gorm:"type:varchar(100);uniqueIndex;not null" json:"-"` // 같은 알림 중복 방지 (예: reminder:{meetingID}:{userID})
+	Payload   string     `gorm:"type:jsonb;not null" json:"payload"`              // 템플릿 데이터
+	Status    string     `gorm:"type:varchar(20);not null;default:'PENDING';index" json:"status"`
+	Attempts  int        `gorm:"not null;default:0" json:"attempts"`
+	LastError string     `gorm:"type:text" json:"last_error,omitempty"`
+	SendAfter time.Time  `gorm:"not null" json:"send_after"` // 다음 시도 시각 (실패 시 뒤로 미룸)
+	SentAt    *time.Time `json:"sent_at,omitempty"`
+	CreatedAt time.Time  `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (EmailNotification) TableName() string {
+	return "email_notifications"
+}
+
+// EmailPreference 사용자별 이메일 수신 설정 (행이 없으면 모두 수신)
+type EmailPreference struct {
+	UserID           int64     `gorm:"primaryKey" json:"user_id"`
+	WorkspaceInvites bool      `gorm:"not null" json:"workspace_invites"`
+	MeetingReminders bool      `gorm:"not null" json:"meeting_reminders"`
+	MeetingSummaries bool      `gorm:"not null" json:"meeting_summaries"`
+	UpdatedAt        time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (EmailPreference) TableName() string {
+	return "email_preferences"
+}
+
+// Allows 해당 종류의 이메일 수신 여부
+func (p *EmailPreference) Allows(kind string) bool {
+	switch kind {
+	case EmailKindWorkspaceInvite:
+		return p.WorkspaceInvites
+	case EmailKindMeetingReminder:
+		return p.MeetingReminders
+	case EmailKindMeetingSummary:
+		return p.MeetingSummaries
+	}
+	return true
+}
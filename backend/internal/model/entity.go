@@ -2,6 +2,8 @@ package model
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 // User 사용자
@@ -105,18 +107,23 @@ type Meeting struct {
 	HostID      int64      `gorm:"not null" json:"host_id"`
 	Title       string     `gorm:"type:varchar(200);not null" json:"title"`
 	Code        string     `gorm:"type:varchar(100);uniqueIndex;not null" json:"code"`
-	Type        string     `gorm:"type:varchar(20);not null" json:"type"` // VIDEO, VOICE_ONLY
+	Type        string     `gorm:"type:varchar(20);not null" json:"type"` // VIDEO, VOICE_ONLY, WEBINAR
 	Status      string     `gorm:"type:varchar(20);default:'SCHEDULED'" json:"status"`
+	ScheduledAt *time.Time `gorm:"index" json:"scheduled_at,omitempty"` // 예정 시작 시각 (알림 기준, 없으면 즉석 회의)
 	StartedAt   *time.Time `json:"started_at,omitempty"`
 	EndedAt     *time.Time `json:"ended_at,omitempty"`
 	CreatedAt   time.Time  `gorm:"autoCreateTime" json:"created_at"`
 
+	// 웨비나 채팅 검토 (참석자 메시지는 호스트가 승인해야 공개)
+	ChatModerated bool `gorm:"not null;default:false" json:"chat_moderated"`
+
 	// Relations
 	Workspace         *Workspace         `gorm:"foreignKey:WorkspaceID" json:"workspace,omitempty"`
 	Host              User               `gorm:"foreignKey:HostID" json:"host,omitempty"`
 	Participants      []Participant      `gorm:"foreignKey:MeetingID" json:"participants,omitempty"`
 	Whiteboards       []Whiteboard       `gorm:"foreignKey:MeetingID" json:"whiteboards,omitempty"`
 	WhiteboardStrokes []WhiteboardStroke `gorm:"foreignKey:MeetingID" json:"whiteboard_strokes,omitempty"`
+	WhiteboardExports []WhiteboardExport `gorm:"foreignKey:MeetingID" json:"whiteboard_exports,omitempty"`
 	ChatLogs          []ChatLog          `gorm:"foreignKey:MeetingID" json:"chat_logs,omitempty"`
 	VoiceRecords      []VoiceRecord      `gorm:"foreignKey:MeetingID" json:"voice_records,omitempty"`
 }
@@ -165,32 +172,62 @@ func (Whiteboard) TableName() string {
 // ChatLog 채팅 로그
 type ChatLog struct {
 	ID        int64     `gorm:"primaryKey;autoIncrement" json:"id"`
-	MeetingID int64     `gorm:"not null" json:"meeting_id"`
-	SenderID  *int64    `json:"sender_id,omitempty"`
+	MeetingID int64     `gorm:"not null;uniqueIndex:idx_chat_idempotency,priority:1" json:"meeting_id"`
+	SenderID  *int64    `gorm:"uniqueIndex:idx_chat_idempotency,priority:2" json:"sender_id,omitempty"`
 	Message   *string   `gorm:"type:text" json:"message,omitempty"`
-	Type      string    `gorm:"type:varchar(20);default:'TEXT'" json:"type"` // TEXT, SYSTEM
+	Type      string    `gorm:"type:varchar(20);default:'TEXT'" json:"type"` // TEXT, SYSTEM, ATTACHMENT
 	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
 
+	// 클라이언트가 만든 전송 키 (재전송 시 중복 저장 방지, 없으면 nil)
+	// (meeting_id, sender_id, idempotency_key) 유니크 인덱스, 키가 NULL인 메시지끼리는 겹쳐도 됨
+	// 중복 판정 기간(24시간)이 지난 메시지는 같은 키로 다시 보낼 때 키가 비워짐
+	IdempotencyKey *string `gorm:"type:varchar(64);uniqueIndex:idx_chat_idempotency,priority:3" json:"-"`
+
+	// 작성자 수정/삭제 (삭제는 삭제 시각만 기록, 조회 시 제외)
+	EditedAt  *time.Time     `json:"edited_at,omitempty"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
+
 	// Relations
-	Meeting Meeting `gorm:"foreignKey:MeetingID" json:"meeting,omitempty"`
-	Sender  *User   `gorm:"foreignKey:SenderID" json:"sender,omitempty"`
+	Meeting    Meeting         `gorm:"foreignKey:MeetingID" json:"meeting,omitempty"`
+	Sender     *User           `gorm:"foreignKey:SenderID" json:"sender,omitempty"`
+	Attachment *ChatAttachment `gorm:"foreignKey:ChatLogID" json:"attachment,omitempty"`
 }
 
 func (ChatLog) TableName() string {
 	return "chat_logs"
 }
 
+// ChatAttachment 채팅 첨부 파일 (업로드 URL 발급 시 생성, "attachment" 메시지로 보내면 ChatLogID 연결)
+type ChatAttachment struct {
+	ID         int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	MeetingID  int64     `gorm:"not null;index" json:"meeting_id"`
+	UploaderID int64     `gorm:"not null" json:"uploader_id"`
+	ChatLogID  *int64    `gorm:"uniqueIndex" json:"chat_log_id,omitempty"` // 아직 보내지 않았으면 nil
+	FileName   string    `gorm:"type:varchar(255);not null" json:"file_name"`
+	MimeType   string    `gorm:"type:varchar(100);not null" json:"mime_type"`
+	FileSize   int64     `gorm:"not null" json:"file_size"`
+	S3Key      string    `gorm:"type:varchar(500);not null" json:"-"`
+	CreatedAt  time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (ChatAttachment) TableName() string {
+	return "chat_attachments"
+}
+
 // VoiceRecord 음성 기록 (STT 결과)
 type VoiceRecord struct {
-	ID            int64     `gorm:"primaryKey;autoIncrement" json:"id"`
-	MeetingID     int64     `gorm:"not null;index" json:"meeting_id"`
-	SpeakerID     *int64    `json:"speaker_id,omitempty"`
-	SpeakerName   string    `gorm:"type:varchar(100)" json:"speaker_name"`
-	Original      string    `gorm:"type:text;not null" json:"original"`            // STT 원본 텍스트
-	Translated    *string   `gorm:"type:text" json:"translated,omitempty"`         // 번역된 텍스트 (있는 경우)
-	SourceLang    *string   `gorm:"type:varchar(10)" json:"source_lang,omitempty"` // 원본 언어 (ko, en, ja, zh)
-	TargetLang    *string   `gorm:"type:varchar(10)" json:"target_lang,omitempty"` // 번역 대상 언어
-	CreatedAt     time.Time `gorm:"autoCreateTime;index" json:"created_at"`
+	ID          int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	MeetingID   int64     `gorm:"not null;index" json:"meeting_id"`
+	SpeakerID   *int64    `json:"speaker_id,omitempty"`
+	SpeakerName string    `gorm:"type:varchar(100)" json:"speaker_name"`
+	Original    string    `gorm:"type:text;not null" json:"original"`            // STT 원본 텍스트
+	Translated  *string   `gorm:"type:text" json:"translated,omitempty"`         // 번역된 텍스트 (있는 경우)
+	SourceLang  *string   `gorm:"type:varchar(10)" json:"source_lang,omitempty"` // 원본 언어 (ko, en, ja, zh)
+	TargetLang  *string   `gorm:"type:varchar(10)" json:"target_lang,omitempty"` // 번역 대상 언어
+	Crosstalk   bool      `gorm:"not null;default:false" json:"crosstalk"`       // 다른 화자와 겹친 발화 (STT 품질 낮음)
+	Breakout    *string   `gorm:"type:varchar(50)" json:"breakout,omitempty"`    // 브레이크아웃 룸에서 나온 발화면 룸 이름
+	Seq         int64     `gorm:"not null;default:0" json:"seq"`                 // 발화 순서 (조회/내보내기 정렬 기준)
+	CreatedAt   time.Time `gorm:"autoCreateTime;index" json:"created_at"`
 
 	// Relations
 	Meeting Meeting `gorm:"foreignKey:MeetingID" json:"meeting,omitempty"`
@@ -257,6 +294,20 @@ type WorkspaceFile struct {
 	RelatedMeetingID *int64    `json:"related_meeting_id,omitempty"`
 	CreatedAt        time.Time `gorm:"autoCreateTime" json:"created_at"`
 
+	// 썸네일/미리보기 (이미지/PDF만, 업로드 후 백그라운드에서 생성)
+	ThumbnailKey *string `gorm:"type:varchar(500)" json:"-"`
+	PreviewKey   *string `gorm:"type:varchar(500)" json:"-"`
+
+	// 바이러스 검사 (nil = 검사하지 않음, INFECTED면 격리되어 다운로드 차단)
+	ScanStatus    *string    `gorm:"type:varchar(20);index" json:"scan_status,omitempty"`
+	ScanSignature *string    `gorm:"type:varchar(255)" json:"scan_signature,omitempty"` // 발견된 악성 코드 이름
+	ScannedAt     *time.Time `json:"scanned_at,omitempty"`
+
+	// 휴지통 (Delete는 삭제 시각만 기록, 보관 기간이 지나면 영구 삭제)
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
+	DeletedBy   *int64         `json:"deleted_by,omitempty"`
+	TrashRootID *int64         `gorm:"index" json:"trash_root_id,omitempty"` // 함께 휴지통으로 옮겨진 항목의 최상위 항목 (복원 단위)
+
 	// Relations
 	Workspace      Workspace       `gorm:"foreignKey:WorkspaceID" json:"workspace,omitempty"`
 	Uploader       *User           `gorm:"foreignKey:UploaderID" json:"uploader,omitempty"`
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+)
+
+// WorkspaceFilePolicy 워크스페이스 파일 업로드 정책 (행이 없으면 서버 기본값: 실행 파일만 거부)
+// AllowedTypes/DeniedTypes: 쉼표로 구분한 MIME 타입 ("image/*"처럼 하위 타입 와일드카드 가능, AllowedTypes가 비어 있으면 거부 목록 외 모두 허용)
+type WorkspaceFilePolicy struct {
+	WorkspaceID      int64     `gorm:"primaryKey" json:"workspace_id"`
+	AllowedTypes     string    `gorm:"type:text;not null;default:''" json:"allowed_types"`
+	DeniedTypes      string    `gorm:"type:text;not null;default:''" json:"denied_types"`
+	MaxFileSize      int64     `gorm:"not null;default:0" json:"max_file_size"` // 바이트 (0 = 서버 기본값)
+	AllowExecutables bool      `gorm:"not null;default:false" json:"allow_executables"`
+	UpdatedBy        int64     `gorm:"not null" json:"updated_by"`
+	UpdatedAt        time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (WorkspaceFilePolicy) TableName() string {
+	return "workspace_file_policies"
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+)
+
+// 썸네일/미리보기 생성 작업 상태
+const (
+	PreviewJobPending = "PENDING"
+	PreviewJobDone    = "DONE"
+	PreviewJobFailed  = "FAILED" // 지원하지 않는 형식이거나 최대 시도 횟수 초과
+)
+
+// FilePreviewJob 업로드한 이미지/PDF의 썸네일·미리보기 생성 대기열 (실패하면 워커가 재시도)
+type FilePreviewJob struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	FileID    int64     `gorm:"not null;uniqueIndex" json:"file_id"`
+	Status    string    `gorm:"type:varchar(20);not null;default:'PENDING';index" json:"status"`
+	Attempts  int       `gorm:"not null;default:0" json:"attempts"`
+	LastError string    `gorm:"type:text" json:"last_error,omitempty"`
+	RunAfter  time.Time `gorm:"not null" json:"run_after"` // 다음 시도 시각 (실패하거나 선점하면 뒤로 미룸)
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (FilePreviewJob) TableName() string {
+	return "file_preview_jobs"
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+)
+
+// 파일 바이러스 검사 상태 (WorkspaceFile.ScanStatus)
+const (
+	ScanStatusPending  = "PENDING"
+	ScanStatusClean    = "CLEAN"
+	ScanStatusInfected = "INFECTED" // 격리: 다운로드 차단
+	ScanStatusFailed   = "FAILED"   // 검사할 수 없음 (크기 초과, 최대 시도 횟수 초과)
+)
+
+// FileScanJob 업로드한 파일의 바이러스 검사 대기열 (검사가 끝나면 삭제, 결과는 WorkspaceFile에 기록)
+type FileScanJob struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	FileID    int64     `gorm:"not null;uniqueIndex" json:"file_id"`
+	Attempts  int       `gorm:"not null;default:0" json:"attempts"`
+	LastError string    `gorm:"type:text" json:"last_error,omitempty"`
+	RunAfter  time.Time `gorm:"not null;index" json:"run_after"` // 다음 시도 시각 (실패하거나 선점하면 뒤로 미룸)
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (FileScanJob) TableName() string {
+	return "file_scan_jobs"
+}
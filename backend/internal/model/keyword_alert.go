@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+)
+
+// MeetingKeyword 호스트가 설정한 회의 키워드 감시 목록 항목
+// final 자막(원문/번역)에 키워드가 나오면 호스트에게 알림
+type MeetingKeyword struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	MeetingID int64     `gorm:"not null;uniqueIndex:idx_meeting_keyword" json:"meeting_id"`
+	Keyword   string    `gorm:"type:varchar(100);not null;uniqueIndex:idx_meeting_keyword" json:"keyword"` // 소문자
+	CreatedBy int64     `gorm:"not null" json:"created_by"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (MeetingKeyword) TableName() string {
+	return "meeting_keywords"
+}
+
+// KeywordAlert 회의 중 발견된 키워드 (회의 후 검토용)
+type KeywordAlert struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	MeetingID    int64     `gorm:"not null;index" json:"meeting_id"`
+	Keyword      string    `gorm:"type:varchar(100);not null" json:"keyword"`
+	TranscriptID string    `gorm:"type:varchar(100)" json:"transcript_id,omitempty"`
+	SpeakerID    string    `gorm:"type:varchar(100)" json:"speaker_id"`
+	Language     string    `gorm:"type:varchar(10);not null" json:"language"` // 키워드가 발견된 문장의 언어
+	Translated   bool      `gorm:"not null;default:false" json:"translated"`  // 번역문에서 발견
+	Context      string    `gorm:"type:text;not null" json:"context"`         // 키워드가 발견된 문장
+	Original     string    `gorm:"type:text" json:"original,omitempty"`       // 번역문에서 발견된 경우 원문
+	CreatedAt    time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (KeywordAlert) TableName() string {
+	return "keyword_alerts"
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"time"
+)
+
+// 회의 종료 처리 상태
+const (
+	FinalizationStatusPending   = "PENDING"
+	FinalizationStatusRunning   = "RUNNING"
+	FinalizationStatusCompleted = "COMPLETED"
+	FinalizationStatusFailed    = "FAILED"
+)
+
+// 회의 종료 처리 단계 (순서대로 실행)
+const (
+	FinalizeStepTranscripts = "FLUSH_TRANSCRIPTS" // Redis 자막 → voice_records
+	FinalizeStepSummary     = "SUMMARY"           // 회의 요약 생성
+	FinalizeStepAttendance  = "ATTENDANCE"        // 남아 있는 참가자 퇴장 처리
+)
+
+// MeetingFinalization 회의 종료 처리 상태 (meeting별 1건)
+// 동시에 한 곳에서만 실행되도록 lease(LockedUntil)로 잠그고, 중단되면 재시작 시 이어서 실행
+type MeetingFinalization struct {
+	ID               int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	MeetingID        int64      `gorm:"not null;uniqueIndex" json:"meeting_id"`
+	RoomID           string     `gorm:"type:varchar(100);not null" json:"room_id"`
+	Status           string     `gorm:"type:varchar(20);not null" json:"status"` // PENDING, RUNNING, COMPLETED, FAILED
+	Attempts         int        `gorm:"not null;default:0" json:"attempts"`
+	LockedUntil      *time.Time `json:"locked_until,omitempty"`
+	TranscriptCursor *time.Time `json:"transcript_cursor,omitempty"` // voice_records로 옮긴 마지막 자막 시각
+	LastError        *string    `gorm:"type:text" json:"last_error,omitempty"`
+	CreatedAt        time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt        time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (MeetingFinalization) TableName() string {
+	return "meeting_finalizations"
+}
+
+// MeetingFinalizationStep 회의 종료 처리 단계별 상태 (완료된 단계는 재실행 시 건너뜀)
+type MeetingFinalizationStep struct {
+	ID          int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	MeetingID   int64      `gorm:"not null;uniqueIndex:idx_meeting_finalization_step" json:"meeting_id"`
+	Step        string     `gorm:"type:varchar(30);not null;uniqueIndex:idx_meeting_finalization_step" json:"step"`
+	Status      string     `gorm:"type:varchar(20);not null" json:"status"` // COMPLETED, FAILED
+	Attempts    int        `gorm:"not null;default:0" json:"attempts"`
+	LastError   *string    `gorm:"type:text" json:"last_error,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	UpdatedAt   time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (MeetingFinalizationStep) TableName() string {
+	return "meeting_finalization_steps"
+}
+
+// TranscriptClaim Redis에서 꺼낸 자막 묶음(claim)의 DB 저장 기록
+// voice_records와 같은 트랜잭션으로 저장하므로, 있으면 그 묶음은 이미 저장된 것 (재시도 시 건너뜀)
+type TranscriptClaim struct {
+	Token     string    `gorm:"type:varchar(64);primaryKey" json:"token"`
+	MeetingID int64     `gorm:"not null;index" json:"meeting_id"`
+	RoomID    string    `gorm:"type:varchar(100);not null" json:"room_id"`
+	Entries   int       `gorm:"not null" json:"entries"` // claim한 자막 수 (final이 아닌 것 포함)
+	Records   int       `gorm:"not null" json:"records"` // 저장한 voice_records 수
+	CreatedAt time.Time `gorm:"autoCreateTime;index" json:"created_at"`
+}
+
+func (TranscriptClaim) TableName() string {
+	return "transcript_claims"
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+)
+
+// 녹음 파일 종류
+const (
+	RecordingKindSpeakers = "SPEAKERS" // 화자 원음 믹스 (16kHz mono WAV)
+	RecordingKindTTS      = "TTS"      // 대상 언어별 번역 TTS (MP3)
+)
+
+// MeetingRecording 회의 녹음 조각 (일정 길이마다 S3에 업로드)
+// 오프셋은 녹음 시작 시각 기준 (같은 세션의 조각을 이어 붙여 재생)
+type MeetingRecording struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	MeetingID     int64     `gorm:"not null;index:idx_recording_meeting" json:"meeting_id"`
+	SessionID     string    `gorm:"type:varchar(36);not null;index:idx_recording_meeting" json:"session_id"` // 녹음 시작~종료 단위
+	Kind          string    `gorm:"type:varchar(20);not null" json:"kind"`                                   // SPEAKERS, TTS
+	Language      string    `gorm:"type:varchar(10)" json:"language,omitempty"`                              // TTS 대상 언어
+	Sequence      int       `gorm:"not null" json:"sequence"`
+	S3Key         string    `gorm:"type:varchar(500);not null" json:"-"`
+	ContentType   string    `gorm:"type:varchar(50);not null" json:"content_type"`
+	SizeBytes     int64     `gorm:"not null" json:"size_bytes"`
+	StartOffsetMs int64     `gorm:"not null" json:"start_offset_ms"`
+	EndOffsetMs   int64     `gorm:"not null" json:"end_offset_ms"`
+	RecordedAt    time.Time `gorm:"not null" json:"recorded_at"` // 녹음 세션 시작 시각
+	CreatedAt     time.Time `gorm:"autoCreateTime;index" json:"created_at"`
+}
+
+func (MeetingRecording) TableName() string {
+	return "meeting_recordings"
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+)
+
+// MeetingReminder 예정 회의의 시작 전 알림 발송 기록 (회의/시점마다 한 번만 발송, 여러 인스턴스 간 중복 방지)
+type MeetingReminder struct {
+	ID            int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	MeetingID     int64     `gorm:"not null;uniqueIndex:idx_meeting_reminder" json:"meeting_id"`
+	OffsetMinutes int       `gorm:"not null;uniqueIndex:idx_meeting_reminder" json:"offset_minutes"` // 시작 몇 분 전 알림인지
+	ScheduledAt   time.Time `gorm:"not null" json:"scheduled_at"`                                    // 발송 당시 예정 시각
+	SentAt        time.Time `gorm:"autoCreateTime" json:"sent_at"`
+}
+
+func (MeetingReminder) TableName() string {
+	return "meeting_reminders"
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+)
+
+// MeetingSessionBreak 종료된 회의를 재개한 지점 (같은 회의록 타임라인에 세션 구분 표시)
+// 첫 세션은 기록하지 않으므로 Session은 2부터 시작
+type MeetingSessionBreak struct {
+	ID        int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	MeetingID int64     `gorm:"not null;uniqueIndex:idx_meeting_session_break" json:"meeting_id"`
+	Session   int       `gorm:"not null;uniqueIndex:idx_meeting_session_break" json:"session"` // 재개된 세션 번호
+	PausedAt  time.Time `gorm:"not null" json:"paused_at"`                                     // 직전 세션 종료 시각
+	ResumedAt time.Time `gorm:"not null" json:"resumed_at"`
+	ResumedBy int64     `gorm:"not null" json:"resumed_by"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (MeetingSessionBreak) TableName() string {
+	return "meeting_session_breaks"
+}
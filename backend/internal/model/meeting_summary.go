@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+)
+
+// MeetingSummary 회의 종료 시 LLM으로 생성한 회의 요약
+type MeetingSummary struct {
+	ID                int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	MeetingID         int64     `gorm:"not null;uniqueIndex" json:"meeting_id"`
+	KeyPoints         string    `gorm:"type:jsonb;not null" json:"key_points"`    // JSON array of strings
+	ActionItems       string    `gorm:"type:jsonb;not null" json:"action_items"`  // JSON array of {owner, task, due}
+	SpeakerStats      string    `gorm:"type:jsonb;not null" json:"speaker_stats"` // JSON array of {speakerName, utterances, talkTimeMs, share}
+	Provider          string    `gorm:"type:varchar(100)" json:"provider"`        // 예: bedrock:amazon.nova-lite-v1:0
+	UtteranceCount    int       `gorm:"not null;default:0" json:"utterance_count"`
+	TranscriptVersion string    `gorm:"type:varchar(64)" json:"transcript_version"` // 요약 시점의 회의록 버전 (기록 수:마지막 ID)
+	CreatedAt         time.Time `gorm:"autoCreateTime" json:"created_at"`
+
+	// Relations
+	Meeting Meeting `gorm:"foreignKey:MeetingID" json:"meeting,omitempty"`
+}
+
+func (MeetingSummary) TableName() string {
+	return "meeting_summaries"
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+)
+
+// NoiseFilterRule 워크스페이스 커스텀 노이즈 필터 패턴
+// STT가 자주 잘못 인식하는 문구(방송 자막, 추임새 등)를 자막/번역에서 제외
+type NoiseFilterRule struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	WorkspaceID  int64     `gorm:"not null;index" json:"workspace_id"`
+	LanguageCode string    `gorm:"type:varchar(10);not null;default:''" json:"language_code"` // 빈 값 = 모든 언어
+	Pattern      string    `gorm:"type:varchar(200);not null" json:"pattern"`
+	IsRegex      bool      `gorm:"not null;default:false" json:"is_regex"` // false: 문구 전체 일치, true: 정규식
+	CreatedBy    int64     `gorm:"not null" json:"created_by"`
+	CreatedAt    time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (NoiseFilterRule) TableName() string {
+	return "noise_filter_rules"
+}
+
+// NoiseFilterThreshold 워크스페이스 언어별 최소 STT confidence / partial 자막 최소 글자 수
+type NoiseFilterThreshold struct {
+	ID               int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	WorkspaceID      int64     `gorm:"not null;uniqueIndex:idx_noise_filter_threshold_lang" json:"workspace_id"`
+	LanguageCode     string    `gorm:"type:varchar(10);not null;uniqueIndex:idx_noise_filter_threshold_lang" json:"language_code"`
+	MinConfidence    float32   `gorm:"not null;default:0" json:"min_confidence"`     // 0 = 기본값 사용
+	PartialMinLength int       `gorm:"not null;default:0" json:"partial_min_length"` // 0 = 기본값 사용
+	UpdatedAt        time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (NoiseFilterThreshold) TableName() string {
+	return "noise_filter_thresholds"
+}
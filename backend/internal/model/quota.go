@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+)
+
+// WorkspaceQuota 워크스페이스 월간 AWS 사용 한도와 Room당 대상 언어 수 (0 = 무제한, 행이 없으면 서버 기본값)
+type WorkspaceQuota struct {
+	WorkspaceID        int64     `gorm:"primaryKey" json:"workspace_id"`
+	TranscribeMinutes  int64     `gorm:"not null;default:0" json:"transcribe_minutes"`   // 월간 음성 인식 분
+	PollyChars         int64     `gorm:"not null;default:0" json:"polly_chars"`          // 월간 TTS 글자 수
+	MaxTargetLanguages int       `gorm:"not null;default:0" json:"max_target_languages"` // Room당 동시 대상 언어 수
+	UpdatedBy          int64     `gorm:"not null" json:"updated_by"`
+	UpdatedAt          time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (WorkspaceQuota) TableName() string {
+	return "workspace_quotas"
+}
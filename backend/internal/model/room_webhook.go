@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+)
+
+// 외부 시스템에 알리는 Room 이벤트
+const (
+	RoomWebhookEventFirstJoin = "room.first_join" // 첫 참가자 입장
+)
+
+// RoomWebhook 미리 만든 Room의 이벤트 웹훅 (캘린더/LMS 연동용, 이벤트당 한 번만 발송)
+type RoomWebhook struct {
+	ID         int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	MeetingID  int64      `gorm:"not null;index" json:"meeting_id"`
+	Event      string     `gorm:"type:varchar(30);not null" json:"event"`
+	URL        string     `gorm:"type:varchar(500);not null" json:"url"`
+	Secret     string     `gorm:"type:varchar(100)" json:"-"` // 서명 키 (X-Eum-Signature: HMAC-SHA256)
+	FiredAt    *time.Time `json:"fired_at,omitempty"`         // 발송을 시작한 시각 (nil = 아직 발생 전)
+	Attempts   int        `gorm:"not null;default:0" json:"attempts"`
+	LastStatus int        `gorm:"not null;default:0" json:"last_status,omitempty"` // 마지막 응답 HTTP 상태
+	LastError  string     `gorm:"type:text" json:"last_error,omitempty"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (RoomWebhook) TableName() string {
+	return "room_webhooks"
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+)
+
+// WorkspaceStorageUsage 워크스페이스 파일 저장 용량 (업로드 완료 시 증가, 휴지통 영구 삭제 시 감소)
+// 휴지통에 있는 파일도 영구 삭제 전까지 S3 용량을 차지하므로 포함
+type WorkspaceStorageUsage struct {
+	WorkspaceID int64     `gorm:"primaryKey" json:"workspace_id"`
+	UsedBytes   int64     `gorm:"not null;default:0" json:"used_bytes"`
+	QuotaBytes  *int64    `json:"quota_bytes,omitempty"` // nil = 서버 기본값, 0 = 무제한
+	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (WorkspaceStorageUsage) TableName() string {
+	return "workspace_storage_usage"
+}
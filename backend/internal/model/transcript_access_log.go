@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+)
+
+// TranscriptAccessLog 회의록 접근 감사 로그 (누가 어떤 회의록에 접근했는지)
+type TranscriptAccessLog struct {
+	ID          int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	WorkspaceID *int64    `gorm:"index" json:"workspace_id,omitempty"`
+	MeetingID   int64     `gorm:"not null;index" json:"meeting_id"`
+	UserID      int64     `gorm:"not null;index" json:"user_id"`
+	Action      string    `gorm:"type:varchar(20);not null" json:"action"` // READ, EXPORT, DELETE
+	IPAddress   string    `gorm:"type:varchar(64)" json:"ip_address"`
+	UserAgent   string    `gorm:"type:varchar(255)" json:"user_agent"`
+	CreatedAt   time.Time `gorm:"autoCreateTime;index" json:"created_at"`
+
+	// Relations
+	User User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+}
+
+func (TranscriptAccessLog) TableName() string {
+	return "transcript_access_logs"
+}
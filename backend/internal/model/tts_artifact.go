@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+)
+
+// TTSArtifact 녹음 중 리스너에게 재생된 TTS 클립 (transcriptID + 언어 + 음성 단위로 S3에 보관)
+// 회의 후 리스너가 들은 음성을 그대로 재생하거나 내보내기에서 다시 합성하지 않고 재사용
+type TTSArtifact struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	MeetingID    int64     `gorm:"not null;index" json:"meeting_id"`
+	TranscriptID string    `gorm:"type:varchar(36);not null;uniqueIndex:idx_tts_artifact" json:"transcript_id"`
+	Language     string    `gorm:"type:varchar(10);not null;uniqueIndex:idx_tts_artifact" json:"language"`
+	VoiceKey     string    `gorm:"type:varchar(100);not null;default:'';uniqueIndex:idx_tts_artifact" json:"voice_key,omitempty"` // "" = 기본 음성
+	SpeakerID    string    `gorm:"type:varchar(100)" json:"speaker_id,omitempty"`                                                 // 발화한 참가자
+	S3Key        string    `gorm:"type:varchar(500);not null" json:"-"`
+	Format       string    `gorm:"type:varchar(20);not null" json:"format"` // mp3, ogg_vorbis, pcm
+	SampleRate   int       `gorm:"not null;default:0" json:"sample_rate"`
+	SizeBytes    int64     `gorm:"not null" json:"size_bytes"`
+	CreatedAt    time.Time `gorm:"autoCreateTime;index" json:"created_at"`
+}
+
+func (TTSArtifact) TableName() string {
+	return "tts_artifacts"
+}
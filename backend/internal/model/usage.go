@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+)
+
+// UsageRecord Room별 AWS 사용량 (주기적으로 누적분을 기록, 워크스페이스 비용 산정/쿼터용)
+type UsageRecord struct {
+	ID                int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	WorkspaceID       *int64    `gorm:"index:idx_usage_workspace_period" json:"workspace_id"` // 워크스페이스 없는 회의는 nil
+	MeetingID         *int64    `gorm:"index" json:"meeting_id"`
+	RoomID            string    `gorm:"type:varchar(100);not null;index" json:"room_id"`
+	PeriodStart       time.Time `gorm:"not null;index:idx_usage_workspace_period" json:"period_start"`
+	PeriodEnd         time.Time `gorm:"not null" json:"period_end"`
+	TranscribeSeconds float64   `gorm:"not null;default:0" json:"transcribe_seconds"`
+	TranslateChars    int64     `gorm:"not null;default:0" json:"translate_chars"`
+	PollyChars        int64     `gorm:"not null;default:0" json:"polly_chars"`
+	EstimatedCostUSD  float64   `gorm:"column:estimated_cost_usd;not null;default:0" json:"estimated_cost_usd"`
+	CreatedAt         time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (UsageRecord) TableName() string {
+	return "usage_records"
+}
+
+// LanguagePairQualityRecord Room별 언어쌍(원문→번역) 번역 품질 지표 (UsageRecord와 같은 주기로 누적분 기록)
+type LanguagePairQualityRecord struct {
+	ID                int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	WorkspaceID       *int64    `gorm:"index:idx_lang_quality_workspace_period" json:"workspace_id"` // 워크스페이스 없는 회의는 nil
+	MeetingID         *int64    `gorm:"index" json:"meeting_id"`
+	RoomID            string    `gorm:"type:varchar(100);not null;index" json:"room_id"`
+	SourceLang        string    `gorm:"type:varchar(10);not null" json:"source_lang"`
+	TargetLang        string    `gorm:"type:varchar(10);not null" json:"target_lang"`
+	PeriodStart       time.Time `gorm:"not null;index:idx_lang_quality_workspace_period" json:"period_start"`
+	PeriodEnd         time.Time `gorm:"not null" json:"period_end"`
+	Finals            int64     `gorm:"not null;default:0" json:"finals"`
+	ConfidenceSum     float64   `gorm:"not null;default:0" json:"confidence_sum"`
+	ConfidenceCount   int64     `gorm:"not null;default:0" json:"confidence_count"`
+	SourceChars       int64     `gorm:"not null;default:0" json:"source_chars"`
+	TargetChars       int64     `gorm:"not null;default:0" json:"target_chars"`
+	CacheHits         int64     `gorm:"not null;default:0" json:"cache_hits"`
+	CacheMisses       int64     `gorm:"not null;default:0" json:"cache_misses"`
+	TranslateFailures int64     `gorm:"not null;default:0" json:"translate_failures"`
+	TTSRequests       int64     `gorm:"column:tts_requests;not null;default:0" json:"tts_requests"`
+	TTSFailures       int64     `gorm:"column:tts_failures;not null;default:0" json:"tts_failures"`
+	CreatedAt         time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (LanguagePairQualityRecord) TableName() string {
+	return "language_pair_quality_records"
+}
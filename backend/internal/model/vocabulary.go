@@ -0,0 +1,45 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// WorkspaceVocabulary 워크스페이스 커스텀 용어집 (언어별)
+// 회사/제품명 등이 STT 및 번역 과정에서 유지되도록 사용
+type WorkspaceVocabulary struct {
+	ID                       int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	WorkspaceID              int64     `gorm:"not null;uniqueIndex:idx_workspace_vocabulary_lang" json:"workspace_id"`
+	LanguageCode             string    `gorm:"type:varchar(10);not null;uniqueIndex:idx_workspace_vocabulary_lang" json:"language_code"` // ko, en, ja, zh
+	TranscribeVocabularyName *string   `gorm:"type:varchar(200)" json:"transcribe_vocabulary_name,omitempty"`                            // AWS Transcribe 커스텀 어휘 이름
+	Phrases                  string    `gorm:"type:text;not null;default:''" json:"-"`                                                   // 줄바꿈으로 구분된 용어 목록
+	CreatedBy                int64     `gorm:"not null" json:"created_by"`
+	CreatedAt                time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt                time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (WorkspaceVocabulary) TableName() string {
+	return "workspace_vocabularies"
+}
+
+// PhraseList 용어 목록 반환
+func (v *WorkspaceVocabulary) PhraseList() []string {
+	phrases := make([]string, 0)
+	for _, p := range strings.Split(v.Phrases, "\n") {
+		if p = strings.TrimSpace(p); p != "" {
+			phrases = append(phrases, p)
+		}
+	}
+	return phrases
+}
+
+// SetPhraseList 용어 목록 설정
+func (v *WorkspaceVocabulary) SetPhraseList(phrases []string) {
+	cleaned := make([]string, 0, len(phrases))
+	for _, p := range phrases {
+		if p = strings.TrimSpace(p); p != "" {
+			cleaned = append(cleaned, p)
+		}
+	}
+	v.Phrases = strings.Join(cleaned, "\n")
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+)
+
+// 화이트보드 내보내기 형식
+const (
+	WhiteboardExportPNG = "PNG"
+	WhiteboardExportPDF = "PDF"
+)
+
+// WhiteboardExport 회의 화이트보드를 렌더링해 S3에 보관한 파일 (회의 화면이 없어도 남도록)
+type WhiteboardExport struct {
+	ID          int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	MeetingID   int64     `gorm:"not null;index" json:"meeting_id"`
+	Format      string    `gorm:"type:varchar(10);not null" json:"format"` // PNG, PDF
+	S3Key       string    `gorm:"type:varchar(500);not null" json:"-"`
+	ContentType string    `gorm:"type:varchar(50);not null" json:"content_type"`
+	SizeBytes   int64     `gorm:"not null" json:"size_bytes"`
+	StrokeCount int       `gorm:"not null" json:"stroke_count"` // 렌더링한 획 수
+	Width       int       `json:"width,omitempty"`              // PNG 픽셀 크기
+	Height      int       `json:"height,omitempty"`
+	CreatedBy   int64     `gorm:"not null" json:"created_by"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (WhiteboardExport) TableName() string {
+	return "whiteboard_exports"
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+)
+
+// 워크스페이스 웹훅으로 보내는 회의 이벤트
+const (
+	WebhookEventMeetingStarted      = "meeting.started"      // Room에 첫 참가자 입장
+	WebhookEventParticipantJoined   = "participant.joined"   // 리스너 입장
+	WebhookEventTranscriptFinalized = "transcript.finalized" // final 자막 확정
+	WebhookEventMeetingEnded        = "meeting.ended"        // 회의 종료 처리 시작
+	WebhookEventSummaryReady        = "summary.ready"        // 회의 요약 저장 완료
+)
+
+// WebhookEvents 구독할 수 있는 이벤트 목록
+var WebhookEvents = []string{
+	WebhookEventMeetingStarted,
+	WebhookEventParticipantJoined,
+	WebhookEventTranscriptFinalized,
+	WebhookEventMeetingEnded,
+	WebhookEventSummaryReady,
+}
+
+// 웹훅 발송 상태
+const (
+	WebhookDeliveryPending   = "PENDING"
+	WebhookDeliveryDelivered = "DELIVERED"
+	WebhookDeliveryFailed    = "FAILED"
+)
+
+// WorkspaceWebhook 워크스페이스에 등록된 회의 이벤트 콜백 URL
+type WorkspaceWebhook struct {
+	ID          int64     `gorm:"primaryKey;autoIncrement" json:"id"`
+	WorkspaceID int64     `gorm:"not null;index" json:"workspace_id"`
+	URL         string    `gorm:"type:varchar(500);not null" json:"url"`
+	Secret      string    `gorm:"type:varchar(100)" json:"-"`               // 서명 키 (X-Eum-Signature: HMAC-SHA256)
+	Events      string    `gorm:"type:varchar(200);not null" json:"events"` // 쉼표로 구분한 구독 이벤트
+	Active      bool      `gorm:"not null;default:true" json:"active"`
+	CreatedBy   int64     `gorm:"not null" json:"created_by"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+func (WorkspaceWebhook) TableName() string {
+	return "workspace_webhooks"
+}
+
+// WebhookDelivery 웹훅 이벤트 하나의 발송 기록 (재시도 포함)
+type WebhookDelivery struct {
+	ID          int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	WebhookID   int64      `gorm:"not null;index" json:"webhook_id"`
+	WorkspaceID int64      `gorm:"not null;index" json:"workspace_id"`
+	MeetingID   *int64     `json:"meeting_id,omitempty"`
+	Event       string     `gorm:"type:varchar(30);not null" json:"event"`
+	Payload     string     `gorm:"type:text;not null" json:"payload"`
+	Status      string     `gorm:"type:varchar(20);not null;default:'PENDING'" json:"status"`
+	Attempts    int        `gorm:"not null;default:0" json:"attempts"`
+	LastStatus  int        `gorm:"not null;default:0" json:"last_status,omitempty"` // 마지막 응답 HTTP 상태
+	LastError   string     `gorm:"type:text" json:"last_error,omitempty"`
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime;index" json:"created_at"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
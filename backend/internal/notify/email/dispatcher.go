@@ -0,0 +1,289 @@
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"realtime-backend/internal/config"
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/summary"
+)
+
+// 발송 스케줄러 설정
+const (
+	deliverBatchSize = 50               // 한 번에 발송하는 최대 메일 수
+	sendTimeout      = 30 * time.Second // 한 통 발송 한도
+	summaryLookback  = 24 * time.Hour   // 이 시간 안에 끝난 회의만 요약 메일 대상
+	summaryWait      = 30 * time.Minute // 요약이 아직 없으면 이 시간까지 기다렸다가 회의록 링크만 발송
+)
+
+// ErrUnsubscribed 사용자가 해당 종류의 메일을 받지 않도록 설정함
+var ErrUnsubscribed = errors.New("user unsubscribed from this email kind")
+
+// running 실행 중인 Dispatcher가 있을 때만 대기열에 추가 (이메일 비활성화 시 쌓이기만 하는 것 방지)
+var running atomic.Bool
+
+// Enqueue 사용자에게 보낼 메일을 대기열에 추가 (수신 거부면 ErrUnsubscribed, 같은 dedupKey가 이미 있으면 무시)
+// 이메일 알림이 꺼져 있으면 아무것도 하지 않음
+func Enqueue(db *gorm.DB, userID int64, kind, dedupKey string, data interface{}, sendAfter time.Time) error {
+	if !running.Load() {
+		return nil
+	}
+
+	var pref model.EmailPreference
+	if err := db.Where("user_id = ?", userID).Limit(1).Find(&pref).Error; err != nil {
+		return err
+	}
+	if pref.UserID != 0 && !pref.Allows(kind) {
+		return ErrUnsubscribed
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	notification := model.EmailNotification{
+		UserID:    userID,
+		Kind:      kind,
+		DedupKey:  dedupKey,
+		Payload:   string(payload),
+		Status:    model.EmailStatusPending,
+		SendAfter: sendAfter,
+	}
+	return db.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "dedup_key"}}, DoNothing: true}).
+		Create(&notification).Error
+}
+
+// EnqueueWorkspaceInvite 워크스페이스 초대 메일 (초대 알림과 함께 호출)
+func EnqueueWorkspaceInvite(db *gorm.DB, inviteeID, workspaceID int64, workspaceName, inviterName string) error {
+	return Enqueue(db, inviteeID, model.EmailKindWorkspaceInvite,
+		fmt.Sprintf("invite:%d:%d:%d", workspaceID, inviteeID, time.Now().Unix()),
+		InviteData{WorkspaceID: workspaceID, WorkspaceName: workspaceName, InviterName: inviterName},
+		time.Now())
+}
+
+// Dispatcher 예정 회의 알림/회의 요약 메일을 대기열에 추가하고 대기 중인 메일을 발송하는 백그라운드 스케줄러
+type Dispatcher struct {
+	db       *gorm.DB
+	sender   Sender
+	cfg      config.EmailConfig
+	renderer renderer
+
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewDispatcher Dispatcher 생성 (appURL은 메일 링크의 프론트엔드 주소)
+func NewDispatcher(db *gorm.DB, sender Sender, cfg config.EmailConfig, appURL string) *Dispatcher {
+	location, err := time.LoadLocation(cfg.TimeZone)
+	if err != nil {
+		log.Printf("⚠️ [Email] 알 수 없는 시각대 %q, 서버 시각대 사용: %v", cfg.TimeZone, err)
+		location = time.Local
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 5
+	}
+	if cfg.DispatchInterval <= 0 {
+		cfg.DispatchInterval = time.Minute
+	}
+	return &Dispatcher{
+		db:       db,
+		sender:   sender,
+		cfg:      cfg,
+		renderer: renderer{appURL: appURL, location: location},
+		stop:     make(chan struct{}),
+	}
+}
+
+// Start 주기적으로 알림 수집/발송 시작
+func (d *Dispatcher) Start() {
+	running.Store(true)
+	go func() {
+		ticker := time.NewTicker(d.cfg.DispatchInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				d.RunOnce(time.Now())
+			case <-d.stop:
+				return
+			}
+		}
+	}()
+	log.Printf("📧 [Email] 발송 스케줄러 시작 (주기 %v, 회의 알림 %v 전)", d.cfg.DispatchInterval, d.cfg.ReminderLead)
+}
+
+// Stop 스케줄러 중지
+func (d *Dispatcher) Stop() {
+	d.closeOnce.Do(func() {
+		running.Store(false)
+		close(d.stop)
+	})
+}
+
+// RunOnce 한 주기 실행: 회의 알림/요약 메일 추가 후 대기 중인 메일 발송
+func (d *Dispatcher) RunOnce(now time.Time) {
+	d.enqueueReminders(now)
+	d.enqueueSummaries(now)
+	d.deliverPending(now)
+}
+
+// enqueueReminders ReminderLead 안에 시작할 예정 회의의 참가자에게 알림 추가
+func (d *Dispatcher) enqueueReminders(now time.Time) {
+	var meetings []model.Meeting
+	err := d.db.Preload("Participants").
+		Where("status = ? AND scheduled_at > ? AND scheduled_at <= ?", "SCHEDULED", now, now.Add(d.cfg.ReminderLead)).
+		Find(&meetings).Error
+	if err != nil {
+		log.Printf("⚠️ [Email] 예정 회의 조회 실패: %v", err)
+		return
+	}
+
+	for _, m := range meetings {
+		data := ReminderData{MeetingID: m.ID, Title: m.Title, Code: m.Code, ScheduledAt: *m.ScheduledAt}
+		if m.WorkspaceID != nil {
+			data.WorkspaceID = *m.WorkspaceID
+		}
+		for _, userID := range meetingRecipients(&m) {
+			d.enqueue(userID, model.EmailKindMeetingReminder, fmt.Sprintf("reminder:%d:%d", m.ID, userID), data, now)
+		}
+	}
+}
+
+// enqueueSummaries 최근 끝난 회의 참가자에게 요약 메일 추가 (요약이 생성될 때까지 summaryWait만큼 대기)
+func (d *Dispatcher) enqueueSummaries(now time.Time) {
+	var meetings []model.Meeting
+	err := d.db.Preload("Participants").
+		Where("status = ? AND ended_at > ? AND type NOT IN ?", "ENDED", now.Add(-summaryLookback),
+			[]string{model.MeetingTypeChatRoom.String(), model.MeetingTypeDM.String()}).
+		Find(&meetings).Error
+	if err != nil {
+		log.Printf("⚠️ [Email] 종료 회의 조회 실패: %v", err)
+		return
+	}
+
+	for _, m := range meetings {
+		var sum model.MeetingSummary
+		hasSummary := d.db.Where("meeting_id = ?", m.ID).Limit(1).Find(&sum).RowsAffected > 0
+		if !hasSummary && now.Sub(*m.EndedAt) < summaryWait {
+			continue
+		}
+
+		data := SummaryData{MeetingID: m.ID, Title: m.Title, Code: m.Code, EndedAt: *m.EndedAt}
+		if m.WorkspaceID != nil {
+			data.WorkspaceID = *m.WorkspaceID
+		}
+		if hasSummary {
+			json.Unmarshal([]byte(sum.KeyPoints), &data.KeyPoints)
+			var items []summary.ActionItem
+			json.Unmarshal([]byte(sum.ActionItems), &items)
+			for _, item := range items {
+				line := item.Task
+				if item.Owner != "" {
+					line = item.Owner + ": " + line
+				}
+				if item.Due != "" {
+					line += " (" + item.Due + ")"
+				}
+				data.ActionItems = append(data.ActionItems, line)
+			}
+		}
+		for _, userID := range meetingRecipients(&m) {
+			d.enqueue(userID, model.EmailKindMeetingSummary, fmt.Sprintf("summary:%d:%d", m.ID, userID), data, now)
+		}
+	}
+}
+
+func (d *Dispatcher) enqueue(userID int64, kind, dedupKey string, data interface{}, now time.Time) {
+	if err := Enqueue(d.db, userID, kind, dedupKey, data, now); err != nil && !errors.Is(err, ErrUnsubscribed) {
+		log.Printf("⚠️ [Email] 대기열 추가 실패: %s: %v", dedupKey, err)
+	}
+}
+
+// meetingRecipients 호스트와 회원 참가자 (게스트 제외, 중복 제거)
+func meetingRecipients(m *model.Meeting) []int64 {
+	seen := map[int64]bool{m.HostID: true}
+	recipients := []int64{m.HostID}
+	for _, p := range m.Participants {
+		if p.UserID != nil && !seen[*p.UserID] {
+			seen[*p.UserID] = true
+			recipients = append(recipients, *p.UserID)
+		}
+	}
+	return recipients
+}
+
+// deliverPending 발송 시각이 된 메일 발송 (실패하면 시도 횟수에 따라 뒤로 미루고, 한도를 넘으면 FAILED)
+func (d *Dispatcher) deliverPending(now time.Time) {
+	var pending []model.EmailNotification
+	err := d.db.Where("status = ? AND send_after <= ?", model.EmailStatusPending, now).
+		Order("send_after ASC").
+		Limit(deliverBatchSize).
+		Find(&pending).Error
+	if err != nil {
+		log.Printf("⚠️ [Email] 대기열 조회 실패: %v", err)
+		return
+	}
+
+	for i := range pending {
+		n := &pending[i]
+		// 다른 인스턴스와 같은 메일을 보내지 않도록 먼저 발송 시각을 미뤄서 선점
+		claimed := d.db.Model(&model.EmailNotification{}).
+			Where("id = ? AND status = ? AND send_after = ?", n.ID, model.EmailStatusPending, n.SendAfter).
+			Update("send_after", now.Add(sendTimeout*2))
+		if claimed.Error != nil || claimed.RowsAffected == 0 {
+			continue
+		}
+
+		err := d.deliver(n)
+		updates := map[string]interface{}{"attempts": n.Attempts + 1}
+		switch {
+		case err == nil:
+			updates["status"] = model.EmailStatusSent
+			updates["sent_at"] = time.Now()
+			updates["last_error"] = ""
+		case n.Attempts+1 >= d.cfg.MaxAttempts:
+			updates["status"] = model.EmailStatusFailed
+			updates["last_error"] = err.Error()
+			log.Printf("❌ [Email] 발송 포기: id=%d, kind=%s: %v", n.ID, n.Kind, err)
+		default:
+			updates["last_error"] = err.Error()
+			updates["send_after"] = now.Add(retryBackoff(n.Attempts + 1))
+		}
+		d.db.Model(n).Updates(updates)
+	}
+}
+
+// deliver 받는 사람 주소 조회, 렌더링, 발송
+func (d *Dispatcher) deliver(n *model.EmailNotification) error {
+	var user model.User
+	if err := d.db.Select("id", "email").First(&user, n.UserID).Error; err != nil {
+		return fmt.Errorf("recipient not found: %w", err)
+	}
+	subject, text, html, err := d.renderer.render(n.Kind, n.Payload)
+	if err != nil {
+		return fmt.Errorf("render: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+	return d.sender.Send(ctx, Message{To: user.Email, Subject: subject, Text: text, HTML: html})
+}
+
+// retryBackoff 재시도 간격 (1, 2, 4, 8 ... 분, 최대 1시간)
+func retryBackoff(attempts int) time.Duration {
+	backoff := time.Minute << (attempts - 1)
+	if backoff <= 0 || backoff > time.Hour {
+		return time.Hour
+	}
+	return backoff
+}
@@ -0,0 +1,39 @@
+// Package email 템플릿 이메일 알림 (워크스페이스 초대, 회의 시작 전 알림, 회의 종료 후 요약)
+// 알림은 email_notifications 대기열에 템플릿 데이터로 쌓이고 Dispatcher가 주기적으로 렌더링/발송/재시도
+package email
+
+import (
+	"context"
+	"log"
+
+	"realtime-backend/internal/config"
+)
+
+// Message 렌더링된 이메일 한 통
+type Message struct {
+	To      string
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// Sender 이메일 발송 (SMTP 또는 다른 구현으로 교체 가능)
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewSender 설정에 맞는 Sender 생성 (SMTP 호스트가 없으면 발송하지 않고 로그만 남김)
+func NewSender(cfg *config.EmailConfig) Sender {
+	if cfg.SMTPHost == "" {
+		return logSender{}
+	}
+	return NewSMTPSender(cfg)
+}
+
+// logSender 개발 환경용 (실제로 보내지 않음)
+type logSender struct{}
+
+func (logSender) Send(_ context.Context, msg Message) error {
+	log.Printf("📧 [Email] SMTP 미설정, 발송 생략: to=%s, subject=%s", msg.To, msg.Subject)
+	return nil
+}
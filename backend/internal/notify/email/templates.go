@@ -0,0 +1,185 @@
+package email
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"net/url"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"realtime-backend/internal/model"
+)
+
+// InviteData 워크스페이스 초대 메일 데이터
+type InviteData struct {
+	WorkspaceID   int64  `json:"workspace_id"`
+	WorkspaceName string `json:"workspace_name"`
+	InviterName   string `json:"inviter_name"`
+}
+
+// ReminderData 회의 시작 전 알림 데이터
+type ReminderData struct {
+	MeetingID   int64     `json:"meeting_id"`
+	WorkspaceID int64     `json:"workspace_id"`
+	Title       string    `json:"title"`
+	Code        string    `json:"code"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+}
+
+// SummaryData 회의 종료 후 요약 메일 데이터 (요약이 없으면 회의록 링크만)
+type SummaryData struct {
+	MeetingID   int64     `json:"meeting_id"`
+	WorkspaceID int64     `json:"workspace_id"`
+	Title       string    `json:"title"`
+	Code        string    `json:"code"`
+	EndedAt     time.Time `json:"ended_at"`
+	KeyPoints   []string  `json:"key_points,omitempty"`
+	ActionItems []string  `json:"action_items,omitempty"`
+}
+
+// emailTemplate 알림 종류별 제목/텍스트/HTML 템플릿
+type emailTemplate struct {
+	subject *texttemplate.Template
+	text    *texttemplate.Template
+	html    *htmltemplate.Template
+}
+
+func newTemplate(name, subject, text, html string) emailTemplate {
+	return emailTemplate{
+		subject: texttemplate.Must(texttemplate.New(name + ".subject").Parse(subject)),
+		text:    texttemplate.Must(texttemplate.New(name + ".text").Parse(text)),
+		html:    htmltemplate.Must(htmltemplate.New(name + ".html").Parse(html)),
+	}
+}
+
+const htmlLayoutStart = `<div style="font-family:sans-serif;max-width:560px;margin:0 auto;color:#222">`
+const htmlLayoutEnd = `<p style="color:#888;font-size:12px;margin-top:32px">알림 설정은 EUM 계정 설정에서 변경할 수 있습니다.</p></div>`
+
+var templates = map[string]emailTemplate{
+	model.EmailKindWorkspaceInvite: newTemplate("invite",
+		`[EUM] {{.WorkspaceName}} 워크스페이스에 초대되었습니다`,
+		`{{.InviterName}}님이 {{.WorkspaceName}} 워크스페이스에 초대했습니다.
+
+초대 확인: {{.Link}}
+`,
+		htmlLayoutStart+`<p><b>{{.InviterName}}</b>님이 <b>{{.WorkspaceName}}</b> 워크스페이스에 초대했습니다.</p>
+<p><a href="{{.Link}}">초대 확인하기</a></p>`+htmlLayoutEnd),
+
+	model.EmailKindMeetingReminder: newTemplate("reminder",
+		`[EUM] {{.Title}} 회의가 {{.StartsIn}} 시작됩니다`,
+		`{{.Title}} 회의가 {{.StartsAt}}에 시작됩니다.
+
+참가하기: {{.Link}}
+`,
+		htmlLayoutStart+`<p><b>{{.Title}}</b> 회의가 <b>{{.StartsAt}}</b>에 시작됩니다.</p>
+<p><a href="{{.Link}}">회의 참가하기</a></p>`+htmlLayoutEnd),
+
+	model.EmailKindMeetingSummary: newTemplate("summary",
+		`[EUM] {{.Title}} 회의 요약`,
+		`{{.Title}} 회의가 {{.EndedAt}}에 종료되었습니다.
+{{if .KeyPoints}}
+주요 내용
+{{range .KeyPoints}}- {{.}}
+{{end}}{{end}}{{if .ActionItems}}
+할 일
+{{range .ActionItems}}- {{.}}
+{{end}}{{end}}
+회의록 보기: {{.Link}}
+`,
+		htmlLayoutStart+`<p><b>{{.Title}}</b> 회의가 {{.EndedAt}}에 종료되었습니다.</p>
+{{if .KeyPoints}}<h3>주요 내용</h3><ul>{{range .KeyPoints}}<li>{{.}}</li>{{end}}</ul>{{end}}
+{{if .ActionItems}}<h3>할 일</h3><ul>{{range .ActionItems}}<li>{{.}}</li>{{end}}</ul>{{end}}
+<p><a href="{{.Link}}">회의록 보기</a></p>`+htmlLayoutEnd),
+}
+
+// renderer 대기열 데이터를 메일 본문으로 변환 (링크는 프론트엔드 주소 기준)
+type renderer struct {
+	appURL   string
+	location *time.Location // 메일에 표시하는 시각대
+}
+
+// render 알림 종류와 JSON 데이터로 제목/본문 생성
+func (r renderer) render(kind, payload string) (subject, text, html string, err error) {
+	tmpl, ok := templates[kind]
+	if !ok {
+		return "", "", "", fmt.Errorf("unknown email kind: %s", kind)
+	}
+
+	var view interface{}
+	switch kind {
+	case model.EmailKindWorkspaceInvite:
+		var d InviteData
+		if err := json.Unmarshal([]byte(payload), &d); err != nil {
+			return "", "", "", err
+		}
+		view = struct {
+			InviteData
+			Link string
+		}{d, r.link("/workspace")}
+	case model.EmailKindMeetingReminder:
+		var d ReminderData
+		if err := json.Unmarshal([]byte(payload), &d); err != nil {
+			return "", "", "", err
+		}
+		view = struct {
+			ReminderData
+			StartsAt string
+			StartsIn string
+			Link     string
+		}{d, r.formatTime(d.ScheduledAt), formatStartsIn(time.Until(d.ScheduledAt)), r.meetingLink(d.WorkspaceID, d.Code, "")}
+	case model.EmailKindMeetingSummary:
+		var d SummaryData
+		if err := json.Unmarshal([]byte(payload), &d); err != nil {
+			return "", "", "", err
+		}
+		view = struct {
+			SummaryData
+			EndedAt string
+			Link    string
+		}{d, r.formatTime(d.EndedAt), r.meetingLink(d.WorkspaceID, d.Code, "transcript")}
+	}
+
+	var sb, tb, hb bytes.Buffer
+	if err := tmpl.subject.Execute(&sb, view); err != nil {
+		return "", "", "", err
+	}
+	if err := tmpl.text.Execute(&tb, view); err != nil {
+		return "", "", "", err
+	}
+	if err := tmpl.html.Execute(&hb, view); err != nil {
+		return "", "", "", err
+	}
+	return strings.TrimSpace(sb.String()), tb.String(), hb.String(), nil
+}
+
+func (r renderer) link(path string) string {
+	return r.appURL + path
+}
+
+// meetingLink 워크스페이스 회의 화면 링크 (view가 있으면 해당 탭)
+func (r renderer) meetingLink(workspaceID int64, code, view string) string {
+	link := fmt.Sprintf("%s/workspace/%d?meeting=%s", r.appURL, workspaceID, url.QueryEscape(code))
+	if view != "" {
+		link += "&view=" + view
+	}
+	return link
+}
+
+func (r renderer) formatTime(t time.Time) string {
+	return t.In(r.location).Format("2006-01-02 15:04 (MST)")
+}
+
+// formatStartsIn 남은 시간 표시 (분 단위, 1분 미만이면 "곧")
+func formatStartsIn(d time.Duration) string {
+	minutes := int(d.Round(time.Minute).Minutes())
+	if minutes <= 0 {
+		return "곧"
+	}
+	if minutes < 60 {
+		return fmt.Sprintf("%d분 후", minutes)
+	}
+	return fmt.Sprintf("%d시간 %d분 후", minutes/60, minutes%60)
+}
@@ -0,0 +1,70 @@
+package preview
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+)
+
+// pdfRenderer PDF 첫 페이지를 PNG로 래스터화하는 명령 (poppler-utils)
+const pdfRenderer = "pdftoppm"
+
+var (
+	pdfRendererOnce sync.Once
+	pdfRendererPath string
+)
+
+// pdfRendererAvailable pdftoppm 설치 여부 (처음 한 번만 확인)
+func pdfRendererAvailable() bool {
+	pdfRendererOnce.Do(func() {
+		pdfRendererPath, _ = exec.LookPath(pdfRenderer)
+	})
+	return pdfRendererPath != ""
+}
+
+// RasterizePDF PDF 첫 페이지를 긴 변 side 픽셀 이미지로 래스터화
+func RasterizePDF(ctx context.Context, data []byte, side int) (image.Image, error) {
+	if !pdfRendererAvailable() {
+		return nil, fmt.Errorf("%w: %s is not installed", ErrUnsupported, pdfRenderer)
+	}
+
+	dir, err := os.MkdirTemp("", "eum-preview-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "source.pdf")
+	if err := os.WriteFile(input, data, 0o600); err != nil {
+		return nil, err
+	}
+	output := filepath.Join(dir, "page")
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, pdfRendererPath,
+		"-png", "-f", "1", "-l", "1", "-singlefile",
+		"-scale-to", strconv.Itoa(side),
+		input, output)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		// 손상되었거나 암호가 걸린 PDF
+		return nil, fmt.Errorf("%w: %s: %v: %s", ErrUnsupported, pdfRenderer, err, bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	f, err := os.Open(output + ".png")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return png.Decode(f)
+}
@@ -0,0 +1,152 @@
+// Package preview 업로드한 이미지/PDF의 썸네일·미리보기 JPEG 생성
+// 이미지는 표준 라이브러리 디코더(JPEG/PNG/GIF), PDF는 pdftoppm(poppler)으로 첫 페이지를 래스터화
+package preview
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"strings"
+
+	_ "image/gif"
+	_ "image/png"
+)
+
+// 렌더링 설정
+const (
+	ThumbnailSide = 320  // 썸네일 긴 변 최대 픽셀 (파일 목록용)
+	PreviewSide   = 1280 // 미리보기 긴 변 최대 픽셀 (원본 없이 보기용)
+	jpegQuality   = 82
+
+	MaxSourceSize   = 50 * 1024 * 1024 // 이보다 큰 원본은 생성하지 않음
+	maxSourcePixels = 40_000_000       // 디코딩 폭탄 방지 (RGBA 기준 약 160MB)
+)
+
+// ErrUnsupported 썸네일을 만들 수 없는 파일 (형식/크기), 재시도하지 않음
+var ErrUnsupported = errors.New("preview is not supported for this file")
+
+// imageTypes 표준 라이브러리로 디코딩할 수 있는 이미지 형식
+var imageTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// Supported 썸네일을 만들 수 있는 MIME 타입인지 (PDF는 pdftoppm이 있을 때만)
+func Supported(mimeType string) bool {
+	mimeType = baseType(mimeType)
+	if imageTypes[mimeType] {
+		return true
+	}
+	return mimeType == "application/pdf" && pdfRendererAvailable()
+}
+
+// Rendition 생성한 JPEG 하나
+type Rendition struct {
+	Data   []byte
+	Width  int
+	Height int
+}
+
+// DecodeImage 이미지 원본 디코딩 (픽셀 수가 너무 많으면 ErrUnsupported)
+func DecodeImage(data []byte) (image.Image, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupported, err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxSourcePixels {
+		return nil, fmt.Errorf("%w: image is %dx%d", ErrUnsupported, cfg.Width, cfg.Height)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupported, err)
+	}
+	return img, nil
+}
+
+// Render 썸네일과 미리보기 JPEG 생성 (긴 변을 각각 ThumbnailSide/PreviewSide 이하로 축소, 투명 영역은 흰 배경, 확대하지 않음)
+func Render(src image.Image) (thumbnail, preview *Rendition, err error) {
+	b := src.Bounds()
+
+	// 흰 배경 위에 합성한 뒤 미리보기 크기로, 다시 썸네일 크기로 축소
+	flat := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(flat, flat.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), src, b.Min, draw.Over)
+
+	large := resize(flat, PreviewSide)
+	if preview, err = encode(large); err != nil {
+		return nil, nil, err
+	}
+	if thumbnail, err = encode(resize(large, ThumbnailSide)); err != nil {
+		return nil, nil, err
+	}
+	return thumbnail, preview, nil
+}
+
+// resize 긴 변이 maxSide보다 크면 비율을 유지하며 축소
+func resize(src *image.RGBA, maxSide int) *image.RGBA {
+	width, height := fit(src.Bounds().Dx(), src.Bounds().Dy(), maxSide)
+	if width == src.Bounds().Dx() && height == src.Bounds().Dy() {
+		return src
+	}
+	return downscale(src, width, height)
+}
+
+func encode(img *image.RGBA) (*Rendition, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, err
+	}
+	return &Rendition{Data: buf.Bytes(), Width: img.Bounds().Dx(), Height: img.Bounds().Dy()}, nil
+}
+
+// fit 비율을 유지하며 긴 변을 maxSide 이하로 줄인 크기
+func fit(width, height, maxSide int) (int, int) {
+	if width <= maxSide && height <= maxSide {
+		return width, height
+	}
+	if width >= height {
+		return maxSide, max(1, height*maxSide/width)
+	}
+	return max(1, width*maxSide/height), maxSide
+}
+
+// downscale 박스 필터 축소 (대상 픽셀이 덮는 원본 픽셀의 평균)
+func downscale(src *image.RGBA, width, height int) *image.RGBA {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	for y := 0; y < height; y++ {
+		y0, y1 := y*sh/height, max((y+1)*sh/height, y*sh/height+1)
+		for x := 0; x < width; x++ {
+			x0, x1 := x*sw/width, max((x+1)*sw/width, x*sw/width+1)
+			var r, g, bl, n int
+			for sy := y0; sy < y1; sy++ {
+				row := src.Pix[sy*src.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					p := row[sx*4 : sx*4+3]
+					r += int(p[0])
+					g += int(p[1])
+					bl += int(p[2])
+					n++
+				}
+			}
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(bl / n)
+			dst.Pix[i+3] = 0xff
+		}
+	}
+	return dst
+}
+
+// baseType "image/png; charset=..." → "image/png"
+func baseType(mimeType string) string {
+	if i := strings.IndexByte(mimeType, ';'); i >= 0 {
+		mimeType = mimeType[:i]
+	}
+	return strings.ToLower(strings.TrimSpace(mimeType))
+}
@@ -0,0 +1,220 @@
+package preview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"log"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/storage"
+)
+
+// 워커 설정
+const (
+	pollInterval = 30 * time.Second // 대기열 확인 주기 (Enqueue 시에는 바로 깨움)
+	batchSize    = 10               // 한 번에 처리하는 최대 작업 수
+	maxAttempts  = 3
+	jobTimeout   = 2 * time.Minute // 작업 하나의 다운로드/렌더링/업로드 한도
+
+	// 파생 객체 이름 (storage.DerivedKey)
+	thumbnailName = "thumbnail.jpg"
+	previewName   = "preview.jpg"
+)
+
+var (
+	errFileGone      = errors.New("file no longer exists")                   // 작업 대상 파일이 영구 삭제됨
+	errSourceChanged = errors.New("source object changed during generation") // 재시도하면 새 키로 생성
+)
+
+// Worker 썸네일/미리보기 생성 대기열을 처리하는 백그라운드 워커
+// 원본을 S3에서 받아 JPEG로 축소한 뒤 파생 키에 올리고 WorkspaceFile에 기록
+type Worker struct {
+	db *gorm.DB
+	s3 *storage.S3Service
+
+	wake      chan struct{}
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewWorker Worker 생성
+func NewWorker(db *gorm.DB, s3 *storage.S3Service) *Worker {
+	return &Worker{
+		db:   db,
+		s3:   s3,
+		wake: make(chan struct{}, 1),
+		stop: make(chan struct{}),
+	}
+}
+
+// Start 대기열 처리 시작
+func (w *Worker) Start() {
+	go func() {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			w.RunOnce(time.Now())
+			select {
+			case <-ticker.C:
+			case <-w.wake:
+			case <-w.stop:
+				return
+			}
+		}
+	}()
+	if !pdfRendererAvailable() {
+		log.Printf("⚠️ [Preview] %s not found in PATH: PDF thumbnails are disabled (install poppler-utils)", pdfRenderer)
+	}
+	log.Printf("🖼️ [Preview] Thumbnail worker started (pdf: %v)", pdfRendererAvailable())
+}
+
+// Stop 워커 중지 (남은 작업은 다음 실행 때 처리)
+func (w *Worker) Stop() {
+	w.closeOnce.Do(func() {
+		close(w.stop)
+	})
+}
+
+// Enqueue 파일 썸네일 생성 요청 (지원하지 않는 형식이면 무시, 이미 있는 작업은 처음부터 다시)
+func (w *Worker) Enqueue(file *model.WorkspaceFile) {
+	if w == nil || file.Type != "FILE" || file.S3Key == nil || file.MimeType == nil || !Supported(*file.MimeType) {
+		return
+	}
+
+	job := model.FilePreviewJob{
+		FileID:   file.ID,
+		Status:   model.PreviewJobPending,
+		RunAfter: time.Now(),
+	}
+	err := w.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "file_id"}},
+		DoUpdates: clause.Assignments(map[string]any{"status": job.Status, "attempts": 0, "last_error": "", "run_after": job.RunAfter}),
+	}).Create(&job).Error
+	if err != nil {
+		log.Printf("[Preview] Failed to enqueue file %d: %v", file.ID, err)
+		return
+	}
+
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// RunOnce 시각이 된 대기 작업 처리 (실패하면 시도 횟수에 따라 뒤로 미루고, 한도를 넘으면 FAILED)
+func (w *Worker) RunOnce(now time.Time) {
+	var jobs []model.FilePreviewJob
+	err := w.db.Where("status = ? AND run_after <= ?", model.PreviewJobPending, now).
+		Order("run_after ASC").
+		Limit(batchSize).
+		Find(&jobs).Error
+	if err != nil {
+		log.Printf("[Preview] Failed to load jobs: %v", err)
+		return
+	}
+
+	for i := range jobs {
+		job := &jobs[i]
+		// 다른 인스턴스와 같은 작업을 하지 않도록 먼저 실행 시각을 미뤄서 선점
+		claimed := w.db.Model(&model.FilePreviewJob{}).
+			Where("id = ? AND status = ? AND run_after = ?", job.ID, model.PreviewJobPending, job.RunAfter).
+			Update("run_after", now.Add(jobTimeout*2))
+		if claimed.Error != nil || claimed.RowsAffected == 0 {
+			continue
+		}
+
+		err := w.process(job.FileID)
+		if errors.Is(err, errFileGone) {
+			w.db.Delete(job)
+			continue
+		}
+		updates := map[string]any{"attempts": job.Attempts + 1}
+		switch {
+		case err == nil:
+			updates["status"] = model.PreviewJobDone
+			updates["last_error"] = ""
+		case errors.Is(err, ErrUnsupported) || job.Attempts+1 >= maxAttempts:
+			updates["status"] = model.PreviewJobFailed
+			updates["last_error"] = err.Error()
+			log.Printf("[Preview] Giving up on file %d: %v", job.FileID, err)
+		default:
+			updates["last_error"] = err.Error()
+			updates["run_after"] = now.Add(time.Minute << job.Attempts)
+		}
+		w.db.Model(job).Updates(updates)
+	}
+}
+
+// process 원본을 받아 썸네일/미리보기를 만들고 파일 행에 키 기록
+// 휴지통에 있는 파일도 복원될 수 있으므로 생성
+func (w *Worker) process(fileID int64) error {
+	var file model.WorkspaceFile
+	if err := w.db.Unscoped().First(&file, fileID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errFileGone
+		}
+		return err
+	}
+	if file.S3Key == nil || file.MimeType == nil || !Supported(*file.MimeType) {
+		return ErrUnsupported
+	}
+	key := *file.S3Key
+
+	ctx, cancel := context.WithTimeout(context.Background(), jobTimeout)
+	defer cancel()
+
+	info, err := w.s3.HeadObject(ctx, key)
+	if err != nil {
+		return err
+	}
+	if info.Size <= 0 || info.Size > MaxSourceSize {
+		return fmt.Errorf("%w: source is %d bytes", ErrUnsupported, info.Size)
+	}
+	data, err := w.s3.ReadObjectPrefix(ctx, key, info.Size)
+	if err != nil {
+		return err
+	}
+
+	var src image.Image
+	if baseType(*file.MimeType) == "application/pdf" {
+		src, err = RasterizePDF(ctx, data, PreviewSide)
+	} else {
+		src, err = DecodeImage(data)
+	}
+	if err != nil {
+		return err
+	}
+	thumbnail, preview, err := Render(src)
+	if err != nil {
+		return err
+	}
+
+	thumbnailKey := storage.DerivedKey(key, thumbnailName)
+	previewKey := storage.DerivedKey(key, previewName)
+	if err := w.s3.PutObject(ctx, file.WorkspaceID, thumbnailKey, "image/jpeg", thumbnail.Data); err != nil {
+		return err
+	}
+	if err := w.s3.PutObject(ctx, file.WorkspaceID, previewKey, "image/jpeg", preview.Data); err != nil {
+		return err
+	}
+
+	// 그 사이 이름 변경으로 원본 키가 바뀌었으면 기록하지 않고 다음 시도에서 새 키로 생성
+	result := w.db.Unscoped().Model(&model.WorkspaceFile{}).
+		Where("id = ? AND s3_key = ?", file.ID, key).
+		Updates(map[string]any{"thumbnail_key": thumbnailKey, "preview_key": previewKey})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		w.s3.DeleteFiles(ctx, []string{thumbnailKey, previewKey})
+		return errSourceChanged
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+// Package ratelimit 토큰 버킷 요청 제한 (Redis가 있으면 인스턴스 간 공유, 없거나 장애 시 인스턴스 내 버킷)
+package ratelimit
+
+import (
+	"context"
+	"log"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// 제한기 설정
+const (
+	storeTimeout     = 100 * time.Millisecond // Redis 호출 한 번 (넘기면 로컬 버킷으로 판단)
+	sweepInterval    = time.Minute            // 유휴 로컬 버킷 정리 주기
+	storeErrorLogGap = time.Minute            // Redis 오류 로그 최소 간격
+)
+
+// Rule 토큰 버킷 규칙 (초당 Rate개 충전, 최대 Burst개 보관; Rate 또는 Burst가 0 이하면 제한 없음)
+type Rule struct {
+	Rate  float64
+	Burst int
+}
+
+// PerMinute 분당 n회 + 버스트 규칙
+func PerMinute(n float64, burst int) Rule {
+	return Rule{Rate: n / 60, Burst: burst}
+}
+
+// Enabled 제한이 걸리는 규칙인지 확인
+func (r Rule) Enabled() bool {
+	return r.Rate > 0 && r.Burst > 0
+}
+
+// Store 인스턴스 간 공유 버킷 저장소 (cache.RedisClient)
+type Store interface {
+	TakeRateToken(ctx context.Context, key string, rate float64, burst int) (bool, error)
+}
+
+// Limiter 키별 토큰 버킷 제한기 (nil Limiter는 모든 요청 허용)
+type Limiter struct {
+	store Store // nil = 인스턴스 내 버킷만
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+
+	lastStoreError atomic.Int64 // 마지막 Redis 오류 로그 시각 (UnixNano)
+	stopCh         chan struct{}
+	closeOnce      sync.Once
+}
+
+// bucket 인스턴스 내 토큰 버킷
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	rule    Rule
+}
+
+// New Limiter 생성 (store nil이면 인스턴스 내 버킷만 사용, 유휴 버킷 정리 루프 시작)
+func New(store Store) *Limiter {
+	l := &Limiter{
+		store:   store,
+		buckets: make(map[string]*bucket),
+		stopCh:  make(chan struct{}),
+	}
+	go l.runSweep()
+	return l
+}
+
+// Allow key의 버킷에서 토큰 하나 사용 (공유 저장소 우선, 오류/시간 초과 시 인스턴스 내 버킷)
+// WebSocket 업그레이드, 채팅 메시지처럼 여러 인스턴스로 나뉘어 들어오는 요청용
+func (l *Limiter) Allow(ctx context.Context, key string, rule Rule) bool {
+	if l == nil || !rule.Enabled() {
+		return true
+	}
+	if l.store != nil {
+		ctx, cancel := context.WithTimeout(ctx, storeTimeout)
+		allowed, err := l.store.TakeRateToken(ctx, key, rule.Rate, rule.Burst)
+		cancel()
+		if err == nil {
+			return allowed
+		}
+		l.logStoreError(err)
+	}
+	return l.AllowLocal(key, rule)
+}
+
+// AllowLocal 인스턴스 내 버킷에서만 토큰 사용 (한 연결로만 들어오는 오디오 프레임처럼 잦은 요청용)
+func (l *Limiter) AllowLocal(key string, rule Rule) bool {
+	if l == nil || !rule.Enabled() {
+		return true
+	}
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[key]
+	if !ok || b.rule != rule {
+		b = &bucket{tokens: float64(rule.Burst), updated: now, rule: rule}
+		l.buckets[key] = b
+	}
+	b.tokens = math.Min(float64(rule.Burst), b.tokens+now.Sub(b.updated).Seconds()*rule.Rate)
+	b.updated = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// Close 유휴 버킷 정리 루프 중지
+func (l *Limiter) Close() {
+	if l == nil {
+		return
+	}
+	l.closeOnce.Do(func() {
+		close(l.stopCh)
+	})
+}
+
+// runSweep 가득 찰 만큼 시간이 지난 버킷 삭제 (다시 만들어도 같은 상태)
+func (l *Limiter) runSweep() {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			l.mu.Lock()
+			for key, b := range l.buckets {
+				refill := time.Duration(float64(b.rule.Burst) / b.rule.Rate * float64(time.Second))
+				if now.Sub(b.updated) > refill {
+					delete(l.buckets, key)
+				}
+			}
+			l.mu.Unlock()
+		case <-l.stopCh:
+			return
+		}
+	}
+}
+
+func (l *Limiter) logStoreError(err error) {
+	now := time.Now().UnixNano()
+	last := l.lastStoreError.Load()
+	if now-last < int64(storeErrorLogGap) || !l.lastStoreError.CompareAndSwap(last, now) {
+		return
+	}
+	log.Printf("[RateLimit] Shared store unavailable, using local buckets: %v", err)
+}
@@ -0,0 +1,458 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+
+	"realtime-backend/internal/auth"
+	awsai "realtime-backend/internal/aws"
+	"realtime-backend/pb"
+)
+
+// 릴레이 동작 설정
+const (
+	listenerQueueSize = 64
+	minRetryBackoff   = time.Second
+	maxRetryBackoff   = 30 * time.Second
+	keepAliveTime     = 10 * time.Second
+	keepAliveTimeout  = 5 * time.Second
+	secretMetadata    = "x-relay-secret"
+)
+
+// Config 릴레이 노드 설정
+type Config struct {
+	ID           string // 노드 ID (로그/백엔드 표시용)
+	Region       string // 노드 리전 (예: "us-east-1")
+	BackendAddr  string // 백엔드 릴레이 gRPC 주소
+	Secret       string // 백엔드 RELAY_SECRET
+	JWTSecret    string // 백엔드 JWT_SECRET (리스너의 Room 토큰 검증)
+	WriteTimeout time.Duration
+}
+
+// Node 엣지 릴레이 노드
+// 리스너 WebSocket을 직접 받고, Room마다 백엔드 gRPC 구독 하나로 받은 자막/TTS를 나눠줌
+// (Room당 백엔드 연결 1개, 번역/TTS 파이프라인은 백엔드에 그대로 있음)
+type Node struct {
+	cfg    Config
+	jwt    *auth.JWTManager
+	conn   *grpc.ClientConn
+	client pb.RelayServiceClient
+
+	mu    sync.Mutex
+	rooms map[string]*edgeRoom
+}
+
+// edgeRoom 릴레이에서 본 Room (리스너가 한 명이라도 있는 동안 구독 유지)
+type edgeRoom struct {
+	id     string
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu        sync.RWMutex
+	listeners map[string]*edgeListener
+
+	changed chan struct{} // 리스너 목록 변경 → 백엔드에 알림
+}
+
+// edgeListener 릴레이에 접속한 리스너
+type edgeListener struct {
+	id         string
+	targetLang string
+	conn       *websocket.Conn
+	out        chan frame
+	done       chan struct{}
+}
+
+type frame struct {
+	messageType int
+	data        []byte
+}
+
+// Stats 노드 상태 (/health 응답)
+type Stats struct {
+	ID        string `json:"id"`
+	Region    string `json:"region"`
+	Rooms     int    `json:"rooms"`
+	Listeners int    `json:"listeners"`
+}
+
+// Authenticate 업그레이드 요청의 Room 토큰 검증 (?token= 또는 Authorization: Bearer)
+// 백엔드 /ws/room 과 같은 토큰을 쓰고, roomId/listenerId는 토큰 값과 같아야 함 (생략하면 토큰 값 사용)
+func (n *Node) Authenticate(c *fiber.Ctx) error {
+	token := c.Query("token")
+	if parts := strings.Fields(c.Get("Authorization")); token == "" && len(parts) == 2 && strings.EqualFold(parts[0], "bearer") {
+		token = parts[1]
+	}
+	if token == "" {
+		return c.SendStatus(fiber.StatusUnauthorized)
+	}
+	claims, err := n.jwt.ValidateRoomToken(token)
+	if err != nil {
+		return c.SendStatus(fiber.StatusUnauthorized)
+	}
+	if roomID := c.Query("roomId"); roomID != "" && roomID != claims.RoomID {
+		return c.SendStatus(fiber.StatusForbidden)
+	}
+	if listenerID := c.Query("listenerId"); listenerID != "" && listenerID != claims.ParticipantID {
+		return c.SendStatus(fiber.StatusForbidden)
+	}
+
+	c.Locals("roomClaims", claims)
+	return c.Next()
+}
+
+// NewNode 백엔드 gRPC 연결 생성 (실제 구독은 리스너가 들어올 때 Room 단위로 시작)
+func NewNode(cfg Config) (*Node, error) {
+	if cfg.BackendAddr == "" {
+		return nil, errors.New("backend address is required")
+	}
+	if cfg.Secret == "" {
+		return nil, errors.New("relay secret is required")
+	}
+	if cfg.JWTSecret == "" {
+		return nil, errors.New("JWT secret is required to verify room tokens")
+	}
+	conn, err := grpc.NewClient(cfg.BackendAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                keepAliveTime,
+			Timeout:             keepAliveTimeout,
+			PermitWithoutStream: true,
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to backend: %w", err)
+	}
+	return &Node{
+		cfg:    cfg,
+		jwt:    auth.NewJWTManager(cfg.JWTSecret, 0, 0),
+		conn:   conn,
+		client: pb.NewRelayServiceClient(conn),
+		rooms:  make(map[string]*edgeRoom),
+	}, nil
+}
+
+// Close 모든 Room 구독과 백엔드 연결 종료
+func (n *Node) Close() error {
+	n.mu.Lock()
+	for id, room := range n.rooms {
+		room.cancel()
+		delete(n.rooms, id)
+	}
+	n.mu.Unlock()
+	return n.conn.Close()
+}
+
+// Stats 현재 Room/리스너 수
+func (n *Node) Stats() Stats {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	stats := Stats{ID: n.cfg.ID, Region: n.cfg.Region, Rooms: len(n.rooms)}
+	for _, room := range n.rooms {
+		room.mu.RLock()
+		stats.Listeners += len(room.listeners)
+		room.mu.RUnlock()
+	}
+	return stats
+}
+
+// HandleWebSocket 리스너 WebSocket (/ws/room?roomId=&listenerId=&targetLang=)
+// 듣기 전용: 오디오를 보내는 참가자는 백엔드에 직접 접속
+func (n *Node) HandleWebSocket(c *websocket.Conn) {
+	claims, _ := c.Locals("roomClaims").(*auth.RoomClaims)
+	if claims == nil {
+		return
+	}
+	roomID := claims.RoomID
+	listenerID := claims.ParticipantID
+	targetLang := awsai.NormalizeLanguage(c.Query("targetLang", "en"))
+	if !awsai.IsSupportedLanguage(targetLang) {
+		targetLang = "en"
+	}
+	if !claims.AllowsLanguage(targetLang) {
+		_ = c.WriteMessage(websocket.TextMessage, []byte(`{"status":"error","code":"LANGUAGE_NOT_ALLOWED","message":"target language is not allowed by the room token"}`))
+		return
+	}
+
+	listener := &edgeListener{
+		id:         listenerID,
+		targetLang: targetLang,
+		conn:       c,
+		out:        make(chan frame, listenerQueueSize),
+		done:       make(chan struct{}),
+	}
+
+	ready, _ := json.Marshal(map[string]any{
+		"status":     "ready",
+		"roomId":     roomID,
+		"listenerId": listenerID,
+		"targetLang": targetLang,
+		"relay":      map[string]string{"id": n.cfg.ID, "region": n.cfg.Region},
+	})
+	if err := c.WriteMessage(websocket.TextMessage, ready); err != nil {
+		return
+	}
+
+	room := n.join(roomID, listener)
+	log.Printf("🛰️ [Relay %s] Listener %s joined (target: %s)", roomID, listenerID, targetLang)
+	defer func() {
+		n.leave(room, listener)
+		log.Printf("🔌 [Relay %s] Listener %s left", roomID, listenerID)
+	}()
+
+	go n.runWriter(listener)
+
+	for {
+		messageType, msg, err := c.ReadMessage()
+		if err != nil {
+			return
+		}
+		if messageType != websocket.TextMessage {
+			continue
+		}
+		var control struct {
+			Type       string `json:"type"`
+			TargetLang string `json:"targetLang"`
+		}
+		if json.Unmarshal(msg, &control) != nil || control.Type != "update_target_language" {
+			continue
+		}
+		if lang := awsai.NormalizeLanguage(control.TargetLang); awsai.IsSupportedLanguage(lang) && claims.AllowsLanguage(lang) {
+			room.setLanguage(listener, lang)
+		}
+	}
+}
+
+// runWriter 리스너 송신 큐를 소켓에 기록
+func (n *Node) runWriter(l *edgeListener) {
+	for {
+		select {
+		case <-l.done:
+			return
+		case f := <-l.out:
+			if n.cfg.WriteTimeout > 0 {
+				_ = l.conn.SetWriteDeadline(time.Now().Add(n.cfg.WriteTimeout))
+			}
+			if err := l.conn.WriteMessage(f.messageType, f.data); err != nil {
+				log.Printf("⚠️ [Relay] Failed to send to listener %s: %v", l.id, err)
+				_ = l.conn.Close()
+				return
+			}
+		}
+	}
+}
+
+// join 리스너를 Room에 추가 (첫 리스너면 백엔드 구독 시작)
+func (n *Node) join(roomID string, l *edgeListener) *edgeRoom {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	room, ok := n.rooms[roomID]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		room = &edgeRoom{
+			id:        roomID,
+			ctx:       ctx,
+			cancel:    cancel,
+			listeners: make(map[string]*edgeListener),
+			changed:   make(chan struct{}, 1),
+		}
+		n.rooms[roomID] = room
+		go n.runSubscription(room)
+	}
+
+	room.mu.Lock()
+	// 같은 ID로 다시 접속하면 이전 연결 정리
+	if previous, exists := room.listeners[l.id]; exists {
+		close(previous.done)
+		_ = previous.conn.Close()
+	}
+	room.listeners[l.id] = l
+	room.mu.Unlock()
+	room.notifyChanged()
+	return room
+}
+
+// leave 리스너 제거 (마지막 리스너면 구독 종료)
+func (n *Node) leave(room *edgeRoom, l *edgeListener) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	room.mu.Lock()
+	if room.listeners[l.id] == l {
+		delete(room.listeners, l.id)
+		close(l.done)
+	}
+	empty := len(room.listeners) == 0
+	room.mu.Unlock()
+
+	if empty && n.rooms[room.id] == room {
+		room.cancel()
+		delete(n.rooms, room.id)
+		return
+	}
+	room.notifyChanged()
+}
+
+// runSubscription Room 방송 구독 (끊기면 지수 백오프로 재접속)
+func (n *Node) runSubscription(room *edgeRoom) {
+	backoff := minRetryBackoff
+	for room.ctx.Err() == nil {
+		started := time.Now()
+		err := n.subscribe(room)
+		if room.ctx.Err() != nil {
+			return
+		}
+		// 한동안 잘 유지된 구독이 끊긴 것이면 백오프를 처음부터 다시 시작
+		if time.Since(started) > maxRetryBackoff {
+			backoff = minRetryBackoff
+		}
+		log.Printf("⚠️ [Relay %s] Subscription ended: %v (retry in %v)", room.id, err, backoff)
+
+		select {
+		case <-room.ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxRetryBackoff)
+	}
+}
+
+// subscribe 구독 한 번 (hello → 리스너 목록 → 방송 수신)
+func (n *Node) subscribe(room *edgeRoom) error {
+	ctx, cancel := context.WithCancel(room.ctx)
+	defer cancel()
+	if n.cfg.Secret != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, secretMetadata, n.cfg.Secret)
+	}
+
+	stream, err := n.client.SubscribeRoom(ctx)
+	if err != nil {
+		return err
+	}
+	hello := &pb.RelayRequest{Payload: &pb.RelayRequest_Hello{Hello: &pb.RelayHello{
+		RelayId: n.cfg.ID,
+		Region:  n.cfg.Region,
+		RoomId:  room.id,
+	}}}
+	if err := stream.Send(hello); err != nil {
+		return err
+	}
+	if err := stream.Send(room.listenerUpdate()); err != nil {
+		return err
+	}
+	log.Printf("🛰️ [Relay %s] Subscribed to backend", room.id)
+
+	// 리스너 목록이 바뀔 때마다 백엔드에 알림 (번역 대상 언어 갱신)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-room.changed:
+				if err := stream.Send(room.listenerUpdate()); err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		room.dispatch(event)
+	}
+}
+
+func (r *edgeRoom) notifyChanged() {
+	select {
+	case r.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (r *edgeRoom) setLanguage(l *edgeListener, lang string) {
+	r.mu.Lock()
+	l.targetLang = lang
+	r.mu.Unlock()
+	r.notifyChanged()
+}
+
+// listenerUpdate 현재 리스너 전체 목록
+func (r *edgeRoom) listenerUpdate() *pb.RelayRequest {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	listeners := make([]*pb.RelayListener, 0, len(r.listeners))
+	for _, l := range r.listeners {
+		listeners = append(listeners, &pb.RelayListener{ListenerId: l.id, TargetLanguage: l.targetLang})
+	}
+	return &pb.RelayRequest{Payload: &pb.RelayRequest_Listeners{Listeners: &pb.RelayListeners{Listeners: listeners}}}
+}
+
+// dispatch 방송 메시지를 받을 리스너에게 전달 (백엔드 Room과 같은 규칙)
+func (r *edgeRoom) dispatch(event *pb.RelayEvent) {
+	var f frame
+	if len(event.AudioData) > 0 {
+		f = frame{messageType: websocket.BinaryMessage, data: event.AudioData}
+	} else {
+		data, err := json.Marshal(struct {
+			Type       string          `json:"type"`
+			Seq        uint64          `json:"seq,omitempty"`
+			SpeakerID  string          `json:"speakerId"`
+			TargetLang string          `json:"targetLang,omitempty"`
+			Data       json.RawMessage `json:"data,omitempty"`
+		}{event.Type, event.Seq, event.SpeakerId, event.TargetLanguage, event.Data})
+		if err != nil {
+			return
+		}
+		f = frame{messageType: websocket.TextMessage, data: data}
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, l := range r.listeners {
+		if !l.wants(event) {
+			continue
+		}
+		select {
+		case l.out <- f:
+		default:
+			log.Printf("⚠️ [Relay %s] Listener %s queue full, dropping %s", r.id, l.id, event.Type)
+		}
+	}
+}
+
+// wants 리스너가 받을 메시지인지 (r.mu held)
+func (l *edgeListener) wants(event *pb.RelayEvent) bool {
+	if event.TargetListenerId != "" {
+		return event.TargetListenerId == l.id
+	}
+	if event.SpeakerId == l.id {
+		return false
+	}
+	switch event.Type {
+	case "transcript":
+		return event.TargetLanguage == "" || event.TargetLanguage == l.targetLang
+	case "audio":
+		return event.TargetLanguage == l.targetLang
+	}
+	return true
+}
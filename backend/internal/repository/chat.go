@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/model"
+)
+
+// ChatFilter 채팅 메시지 조건 (MeetingID 필수, 나머지는 빈 값이면 조건 없음)
+type ChatFilter struct {
+	MeetingID int64
+	SenderID  *int64
+	Type      string // TEXT, SYSTEM
+	Query     string // 메시지 부분 일치 (대소문자 무시)
+}
+
+// ChatRepo 채팅 메시지 조회
+type ChatRepo interface {
+	// List 최신 메시지부터 (ID 내림차순, 커서는 더 오래된 메시지 방향)
+	List(ctx context.Context, filter ChatFilter, page PageRequest, opts ...Option) (Page[model.ChatLog], error)
+	// Count 조건에 맞는 메시지 수
+	Count(ctx context.Context, filter ChatFilter) (int64, error)
+}
+
+type chatRepo struct {
+	db *gorm.DB
+}
+
+// NewChatRepo gorm 기반 ChatRepo 생성
+func NewChatRepo(db *gorm.DB) ChatRepo {
+	return &chatRepo{db: db}
+}
+
+func (r *chatRepo) where(q *gorm.DB, filter ChatFilter) *gorm.DB {
+	q = q.Where("meeting_id = ?", filter.MeetingID)
+	if filter.SenderID != nil {
+		q = q.Where("sender_id = ?", *filter.SenderID)
+	}
+	if filter.Type != "" {
+		q = q.Where("type = ?", filter.Type)
+	}
+	if filter.Query != "" {
+		q = q.Where("message ILIKE ?", "%"+escapeLike(filter.Query)+"%")
+	}
+	return q
+}
+
+func (r *chatRepo) List(ctx context.Context, filter ChatFilter, page PageRequest, opts ...Option) (Page[model.ChatLog], error) {
+	q := r.where(apply(r.db.WithContext(ctx).Model(&model.ChatLog{}), opts), filter)
+	if page.Cursor != "" {
+		cursor, err := DecodeCursor(page.Cursor)
+		if err != nil {
+			return Page[model.ChatLog]{}, err
+		}
+		q = q.Where("id < ?", cursor.ID)
+	}
+
+	return paginate(q.Order("id DESC"), page, page.LimitOr(DefaultLimit), func(l *model.ChatLog) Cursor {
+		return Cursor{Key: l.ID, ID: l.ID}
+	})
+}
+
+func (r *chatRepo) Count(ctx context.Context, filter ChatFilter) (int64, error) {
+	var total int64
+	err := r.where(r.db.WithContext(ctx).Model(&model.ChatLog{}), filter).Count(&total).Error
+	return total, err
+}
+
+// escapeLike LIKE 패턴 특수 문자(%, _, \) 이스케이프
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/model"
+)
+
+// MeetingFilter 회의 목록 조건 (0/빈 값은 조건 없음)
+type MeetingFilter struct {
+	WorkspaceID  int64
+	Types        []string // 이 타입만
+	ExcludeTypes []string // 이 타입 제외 (워크스페이스 채팅용 미팅 등)
+	Status       string
+}
+
+// MeetingRepo 회의 조회
+type MeetingRepo interface {
+	// Get ID로 회의 조회 (없으면 gorm.ErrRecordNotFound)
+	Get(ctx context.Context, id int64, opts ...Option) (*model.Meeting, error)
+	// GetInWorkspace 워크스페이스에 속한 회의 조회 (다른 워크스페이스 회의면 gorm.ErrRecordNotFound)
+	GetInWorkspace(ctx context.Context, workspaceID, id int64, opts ...Option) (*model.Meeting, error)
+	// List 최근 회의부터 (ID 내림차순)
+	List(ctx context.Context, filter MeetingFilter, page PageRequest, opts ...Option) (Page[model.Meeting], error)
+}
+
+type meetingRepo struct {
+	db *gorm.DB
+}
+
+// NewMeetingRepo gorm 기반 MeetingRepo 생성
+func NewMeetingRepo(db *gorm.DB) MeetingRepo {
+	return &meetingRepo{db: db}
+}
+
+func (r *meetingRepo) Get(ctx context.Context, id int64, opts ...Option) (*model.Meeting, error) {
+	var meeting model.Meeting
+	if err := apply(r.db.WithContext(ctx), opts).First(&meeting, id).Error; err != nil {
+		return nil, err
+	}
+	return &meeting, nil
+}
+
+func (r *meetingRepo) GetInWorkspace(ctx context.Context, workspaceID, id int64, opts ...Option) (*model.Meeting, error) {
+	var meeting model.Meeting
+	err := apply(r.db.WithContext(ctx), opts).
+		Where("id = ? AND workspace_id = ?", id, workspaceID).
+		First(&meeting).Error
+	if err != nil {
+		return nil, err
+	}
+	return &meeting, nil
+}
+
+func (r *meetingRepo) List(ctx context.Context, filter MeetingFilter, page PageRequest, opts ...Option) (Page[model.Meeting], error) {
+	q := apply(r.db.WithContext(ctx).Model(&model.Meeting{}), opts)
+	if filter.WorkspaceID != 0 {
+		q = q.Where("workspace_id = ?", filter.WorkspaceID)
+	}
+	if len(filter.Types) > 0 {
+		q = q.Where("type IN ?", filter.Types)
+	}
+	if len(filter.ExcludeTypes) > 0 {
+		q = q.Where("type NOT IN ?", filter.ExcludeTypes)
+	}
+	if filter.Status != "" {
+		q = q.Where("status = ?", filter.Status)
+	}
+	if page.Cursor != "" {
+		cursor, err := DecodeCursor(page.Cursor)
+		if err != nil {
+			return Page[model.Meeting]{}, err
+		}
+		q = q.Where("id < ?", cursor.ID)
+	}
+
+	return paginate(q.Order("id DESC"), page, page.LimitOr(DefaultLimit), func(m *model.Meeting) Cursor {
+		return Cursor{Key: m.ID, ID: m.ID}
+	})
+}
@@ -0,0 +1,124 @@
+// Package repository 핸들러가 쓰는 DB 조회 계층 (페이지네이션, 필터, Preload 제어)
+// 핸들러는 인터페이스(MeetingRepo, VoiceRecordRepo, ChatRepo)에 의존하므로 테스트에서 가짜 구현으로 대체 가능
+package repository
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// 페이지 크기 한도
+const (
+	DefaultLimit = 50
+	MaxLimit     = 500
+)
+
+// ErrInvalidCursor 디코딩할 수 없는 커서
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// PageRequest 페이지 요청 (Cursor가 있으면 keyset 페이지네이션, 없으면 Offset)
+type PageRequest struct {
+	Limit  int
+	Offset int
+	Cursor string
+}
+
+// Page 조회 결과 한 페이지
+type Page[T any] struct {
+	Items      []T
+	NextCursor string // 다음 페이지 커서 (마지막 페이지면 빈 값)
+	HasMore    bool
+}
+
+// Cursor keyset 위치 (정렬 키 + 같은 키 안에서의 ID), 클라이언트에는 불투명한 문자열로 전달
+type Cursor struct {
+	Key int64
+	ID  int64
+}
+
+// EncodeCursor 커서를 URL에 넣을 수 있는 문자열로 변환
+func EncodeCursor(c Cursor) string {
+	raw := strconv.FormatInt(c.Key, 10) + ":" + strconv.FormatInt(c.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor EncodeCursor로 만든 문자열을 커서로 변환
+func DecodeCursor(s string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	key, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return Cursor{}, ErrInvalidCursor
+	}
+	var c Cursor
+	if c.Key, err = strconv.ParseInt(key, 10, 64); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	if c.ID, err = strconv.ParseInt(id, 10, 64); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+	return c, nil
+}
+
+// LimitOr 요청 페이지 크기 (0 이하면 def, MaxLimit 초과면 MaxLimit)
+func (p PageRequest) LimitOr(def int) int {
+	switch {
+	case p.Limit <= 0:
+		return def
+	case p.Limit > MaxLimit:
+		return MaxLimit
+	default:
+		return p.Limit
+	}
+}
+
+// Option 조회 옵션 (Preload, 추가 조건 등)
+type Option func(*gorm.DB) *gorm.DB
+
+// WithPreload 연관 데이터 함께 조회 (gorm Preload 인자 그대로)
+func WithPreload(association string, args ...any) Option {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Preload(association, args...)
+	}
+}
+
+// WithSelect 일부 컬럼만 조회
+func WithSelect(columns ...string) Option {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Select(columns)
+	}
+}
+
+func apply(db *gorm.DB, opts []Option) *gorm.DB {
+	for _, opt := range opts {
+		db = opt(db)
+	}
+	return db
+}
+
+// paginate 정렬/커서 조건이 적용된 쿼리로 한 페이지 조회 (limit+1개를 읽어 다음 페이지 여부 판단)
+func paginate[T any](q *gorm.DB, page PageRequest, limit int, cursorOf func(*T) Cursor) (Page[T], error) {
+	if page.Cursor == "" && page.Offset > 0 {
+		q = q.Offset(page.Offset)
+	}
+
+	var items []T
+	if err := q.Limit(limit + 1).Find(&items).Error; err != nil {
+		return Page[T]{}, fmt.Errorf("paginate: %w", err)
+	}
+
+	result := Page[T]{Items: items}
+	if len(items) > limit {
+		result.Items = items[:limit]
+		result.HasMore = true
+		result.NextCursor = EncodeCursor(cursorOf(&result.Items[limit-1]))
+	}
+	return result, nil
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"realtime-backend/internal/model"
+)
+
+// VoiceRecordFilter 음성 기록 조건 (MeetingID 필수, 나머지는 빈 값이면 조건 없음)
+type VoiceRecordFilter struct {
+	MeetingID  int64
+	SpeakerID  *int64
+	TargetLang string
+	Query      string // 원문/번역 부분 일치 (대소문자 무시)
+}
+
+// VoiceRecordRepo 음성 기록 조회
+type VoiceRecordRepo interface {
+	// List 발화 순서대로 (seq, id 오름차순)
+	List(ctx context.Context, filter VoiceRecordFilter, page PageRequest, opts ...Option) (Page[model.VoiceRecord], error)
+	// Count 조건에 맞는 기록 수
+	Count(ctx context.Context, filter VoiceRecordFilter) (int64, error)
+}
+
+type voiceRecordRepo struct {
+	db *gorm.DB
+}
+
+// NewVoiceRecordRepo gorm 기반 VoiceRecordRepo 생성
+func NewVoiceRecordRepo(db *gorm.DB) VoiceRecordRepo {
+	return &voiceRecordRepo{db: db}
+}
+
+func (r *voiceRecordRepo) where(q *gorm.DB, filter VoiceRecordFilter) *gorm.DB {
+	q = q.Where("meeting_id = ?", filter.MeetingID)
+	if filter.SpeakerID != nil {
+		q = q.Where("speaker_id = ?", *filter.SpeakerID)
+	}
+	if filter.TargetLang != "" {
+		q = q.Where("target_lang = ?", filter.TargetLang)
+	}
+	if filter.Query != "" {
+		pattern := "%" + escapeLike(filter.Query) + "%"
+		q = q.Where("(original ILIKE ? OR translated ILIKE ?)", pattern, pattern)
+	}
+	return q
+}
+
+func (r *voiceRecordRepo) List(ctx context.Context, filter VoiceRecordFilter, page PageRequest, opts ...Option) (Page[model.VoiceRecord], error) {
+	q := r.where(apply(r.db.WithContext(ctx).Model(&model.VoiceRecord{}), opts), filter)
+	if page.Cursor != "" {
+		cursor, err := DecodeCursor(page.Cursor)
+		if err != nil {
+			return Page[model.VoiceRecord]{}, err
+		}
+		q = q.Where("(seq > ? OR (seq = ? AND id > ?))", cursor.Key, cursor.Key, cursor.ID)
+	}
+
+	return paginate(q.Order("seq ASC, id ASC"), page, page.LimitOr(DefaultLimit), func(v *model.VoiceRecord) Cursor {
+		return Cursor{Key: v.Seq, ID: v.ID}
+	})
+}
+
+func (r *voiceRecordRepo) Count(ctx context.Context, filter VoiceRecordFilter) (int64, error) {
+	var total int64
+	err := r.where(r.db.WithContext(ctx).Model(&model.VoiceRecord{}), filter).Count(&total).Error
+	return total, err
+}
@@ -0,0 +1,189 @@
+// Package retry 지수 백오프 + 지터 재시도 (context 취소, 최대 경과 시간, 오류 종류별 정책)
+package retry
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/aws/smithy-go"
+)
+
+// Class 오류 종류 (재시도 여부와 백오프 배율 결정)
+type Class int
+
+const (
+	Permanent Class = iota // 재시도해도 소용없음 (잘못된 요청, 권한, 없는 키)
+	Transient              // 일시적 오류 (네트워크, 서버 5xx)
+	Throttled              // 요청 한도 초과 (더 오래 기다린 뒤 재시도)
+)
+
+// Policy 재시도 정책
+type Policy struct {
+	MaxAttempts    int           // 첫 시도 포함 최대 시도 횟수 (0 = 무제한, MaxElapsed로 제한)
+	InitialBackoff time.Duration // 첫 재시도 전 대기
+	MaxBackoff     time.Duration // 대기 상한 (지터 제외)
+	Multiplier     float64       // 시도마다 대기 배율 (0 = 2)
+	Jitter         float64       // 대기에 더하는 무작위 비율 [Jitter, 2*Jitter) (0 = 지터 없음)
+	MaxElapsed     time.Duration // 첫 시도부터 이 시간을 넘기면 더 재시도하지 않음 (0 = 제한 없음)
+
+	// ThrottleMultiplier Throttled 오류의 대기 배율 (0 = 2)
+	ThrottleMultiplier float64
+
+	// Classify 오류 분류 (nil = DefaultClassify)
+	Classify func(error) Class
+
+	// OnRetry 재시도 직전 호출 (로그용, nil 가능)
+	OnRetry func(attempt int, err error, wait time.Duration)
+}
+
+// Backoff attempt번째 재시도(1부터) 전 대기 시간
+func (p Policy) Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	backoff := time.Duration(float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1)))
+	if p.MaxBackoff > 0 && (backoff > p.MaxBackoff || backoff < 0) {
+		backoff = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		backoff += time.Duration(float64(backoff) * p.Jitter * (1 + rand.Float64()))
+	}
+	return backoff
+}
+
+// Do fn이 성공하거나, 재시도할 수 없는 오류를 내거나, 시도/시간 한도에 닿을 때까지 실행
+// ctx가 취소되면 대기를 멈추고 마지막 오류(없으면 ctx 오류)를 반환
+func Do(ctx context.Context, p Policy, fn func(ctx context.Context) error) error {
+	_, err := DoValue(ctx, p, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, fn(ctx)
+	})
+	return err
+}
+
+// DoValue 값을 반환하는 fn용 Do
+func DoValue[T any](ctx context.Context, p Policy, fn func(ctx context.Context) (T, error)) (T, error) {
+	classify := p.Classify
+	if classify == nil {
+		classify = DefaultClassify
+	}
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		value, err := fn(ctx)
+		if err == nil {
+			return value, nil
+		}
+
+		class := classify(err)
+		if class == Permanent || ctx.Err() != nil {
+			return value, err
+		}
+		if p.MaxAttempts > 0 && attempt >= p.MaxAttempts {
+			return value, err
+		}
+
+		wait := p.Backoff(attempt)
+		if class == Throttled {
+			throttle := p.ThrottleMultiplier
+			if throttle <= 0 {
+				throttle = 2
+			}
+			wait = time.Duration(float64(wait) * throttle)
+		}
+		if p.MaxElapsed > 0 && time.Since(start)+wait > p.MaxElapsed {
+			return value, err
+		}
+
+		if p.OnRetry != nil {
+			p.OnRetry(attempt, err, wait)
+		}
+		if Sleep(ctx, wait) != nil {
+			return value, err
+		}
+	}
+}
+
+// Sleep d만큼 대기 (ctx가 먼저 끝나면 ctx 오류)
+func Sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// permanentError 재시도하지 않도록 표시된 오류
+type permanentError struct{ err error }
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Stop err를 재시도하지 않도록 표시 (fn 안에서 분류기 판단을 덮어쓸 때)
+func Stop(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// throttleCodes AWS 요청 한도 초과 오류 코드
+var throttleCodes = map[string]bool{
+	"Throttling":                             true,
+	"ThrottlingException":                    true,
+	"ThrottledException":                     true,
+	"TooManyRequestsException":               true,
+	"RequestLimitExceeded":                   true,
+	"RequestThrottled":                       true,
+	"RequestThrottledException":              true,
+	"ProvisionedThroughputExceededException": true,
+	"LimitExceededException":                 true,
+	"SlowDown":                               true,
+}
+
+// transientCodes 클라이언트 오류로 분류되지만 재시도하면 성공할 수 있는 AWS 오류 코드
+var transientCodes = map[string]bool{
+	"RequestTimeout":          true,
+	"RequestTimeoutException": true,
+	"ServiceUnavailable":      true,
+	"InternalError":           true,
+	"InternalFailure":         true,
+	"InternalServerException": true,
+}
+
+// DefaultClassify context 취소와 Stop은 Permanent, AWS 한도 초과는 Throttled,
+// 네트워크 오류와 AWS 서버 오류는 Transient, 나머지 AWS API 오류는 Permanent.
+// 분류할 수 없는 오류는 Transient로 봄
+func DefaultClassify(err error) Class {
+	var stop *permanentError
+	if errors.As(err, &stop) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return Permanent
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		switch {
+		case throttleCodes[apiErr.ErrorCode()]:
+			return Throttled
+		case transientCodes[apiErr.ErrorCode()], apiErr.ErrorFault() == smithy.FaultServer:
+			return Transient
+		default:
+			return Permanent
+		}
+	}
+	return Transient
+}
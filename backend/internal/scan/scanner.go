@@ -0,0 +1,26 @@
+// Package scan 업로드한 파일의 바이러스 검사
+// Scanner 인터페이스로 검사 엔진을 바꿀 수 있고, 기본 구현은 ClamAV(clamd INSTREAM)
+package scan
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrTooLarge 검사 엔진이 받을 수 있는 크기를 넘음 (재시도하지 않음)
+var ErrTooLarge = errors.New("file is too large to scan")
+
+// Result 검사 결과
+type Result struct {
+	Infected  bool
+	Signature string // 발견된 악성 코드 이름 (Infected일 때만)
+}
+
+// Scanner 바이러스 검사 엔진
+type Scanner interface {
+	// Scan 본문을 끝까지 읽어 검사
+	Scan(ctx context.Context, r io.Reader) (Result, error)
+	// Ping 엔진 연결 확인
+	Ping(ctx context.Context) error
+}
@@ -0,0 +1,213 @@
+package scan
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"realtime-backend/internal/model"
+	"realtime-backend/internal/storage"
+)
+
+// 워커 설정
+const (
+	pollInterval = 30 * time.Second // 대기열 확인 주기 (Enqueue 시에는 바로 깨움)
+	batchSize    = 10               // 한 번에 처리하는 최대 작업 수
+	maxAttempts  = 5
+)
+
+// errFileGone 검사 대상 파일이 영구 삭제됨
+var errFileGone = errors.New("file no longer exists")
+
+// InfectedHandler 악성 코드가 발견된 파일 처리 (관리자 알림 등)
+type InfectedHandler func(file *model.WorkspaceFile, signature string)
+
+// Worker 업로드한 파일을 S3에서 받아 검사하고 결과를 WorkspaceFile.ScanStatus에 기록하는 백그라운드 워커
+type Worker struct {
+	db      *gorm.DB
+	s3      *storage.S3Service
+	scanner Scanner
+	timeout time.Duration
+
+	onInfected InfectedHandler
+
+	wake      chan struct{}
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewWorker Worker 생성 (timeout은 파일 하나 검사 한도)
+func NewWorker(db *gorm.DB, s3 *storage.S3Service, scanner Scanner, timeout time.Duration) *Worker {
+	if timeout <= 0 {
+		timeout = 5 * time.Minute
+	}
+	return &Worker{
+		db:      db,
+		s3:      s3,
+		scanner: scanner,
+		timeout: timeout,
+		wake:    make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+	}
+}
+
+// OnInfected 악성 코드 발견 시 호출할 함수 등록 (Start 전에 호출)
+func (w *Worker) OnInfected(fn InfectedHandler) {
+	w.onInfected = fn
+}
+
+// Scanner 검사 엔진 (헬스체크용)
+func (w *Worker) Scanner() Scanner {
+	return w.scanner
+}
+
+// Start 대기열 처리 시작
+func (w *Worker) Start() {
+	go func() {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			w.RunOnce(time.Now())
+			select {
+			case <-ticker.C:
+			case <-w.wake:
+			case <-w.stop:
+				return
+			}
+		}
+	}()
+	log.Printf("🛡️ [Scan] Virus scan worker started")
+}
+
+// Stop 워커 중지 (남은 작업은 다음 실행 때 처리)
+func (w *Worker) Stop() {
+	w.closeOnce.Do(func() {
+		close(w.stop)
+	})
+}
+
+// Enqueue 파일 검사 요청 (파일을 PENDING으로 표시, 이미 있는 작업은 처음부터 다시)
+func (w *Worker) Enqueue(file *model.WorkspaceFile) {
+	if w == nil || file.Type != "FILE" || file.S3Key == nil || *file.S3Key == "" {
+		return
+	}
+
+	status := model.ScanStatusPending
+	if err := w.db.Unscoped().Model(&model.WorkspaceFile{}).Where("id = ?", file.ID).
+		Updates(map[string]any{"scan_status": status, "scan_signature": nil, "scanned_at": nil}).Error; err != nil {
+		log.Printf("[Scan] Failed to mark file %d pending: %v", file.ID, err)
+		return
+	}
+	file.ScanStatus = &status
+
+	job := model.FileScanJob{FileID: file.ID, RunAfter: time.Now()}
+	err := w.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "file_id"}},
+		DoUpdates: clause.Assignments(map[string]any{"attempts": 0, "last_error": "", "run_after": job.RunAfter}),
+	}).Create(&job).Error
+	if err != nil {
+		log.Printf("[Scan] Failed to enqueue file %d: %v", file.ID, err)
+		return
+	}
+
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// RunOnce 시각이 된 검사 작업 처리 (실패하면 시도 횟수에 따라 뒤로 미루고, 한도를 넘으면 FAILED로 기록)
+func (w *Worker) RunOnce(now time.Time) {
+	var jobs []model.FileScanJob
+	err := w.db.Where("run_after <= ?", now).
+		Order("run_after ASC").
+		Limit(batchSize).
+		Find(&jobs).Error
+	if err != nil {
+		log.Printf("[Scan] Failed to load jobs: %v", err)
+		return
+	}
+
+	for i := range jobs {
+		job := &jobs[i]
+		// 다른 인스턴스와 같은 작업을 하지 않도록 먼저 실행 시각을 미뤄서 선점
+		claimed := w.db.Model(&model.FileScanJob{}).
+			Where("id = ? AND run_after = ?", job.ID, job.RunAfter).
+			Update("run_after", now.Add(w.timeout*2))
+		if claimed.Error != nil || claimed.RowsAffected == 0 {
+			continue
+		}
+
+		err := w.process(job.FileID)
+		switch {
+		case err == nil || errors.Is(err, errFileGone):
+			w.db.Delete(job)
+		case errors.Is(err, ErrTooLarge) || job.Attempts+1 >= maxAttempts:
+			log.Printf("[Scan] Giving up on file %d: %v", job.FileID, err)
+			w.record(job.FileID, model.ScanStatusFailed, nil)
+			w.db.Delete(job)
+		default:
+			w.db.Model(job).Updates(map[string]any{
+				"attempts":   job.Attempts + 1,
+				"last_error": err.Error(),
+				"run_after":  now.Add(time.Minute << job.Attempts),
+			})
+		}
+	}
+}
+
+// process S3 객체를 스트리밍으로 검사하고 결과 기록 (휴지통에 있는 파일도 복원될 수 있으므로 검사)
+func (w *Worker) process(fileID int64) error {
+	var file model.WorkspaceFile
+	if err := w.db.Unscoped().First(&file, fileID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errFileGone
+		}
+		return err
+	}
+	if file.S3Key == nil || *file.S3Key == "" {
+		return errFileGone
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), w.timeout)
+	defer cancel()
+
+	body, err := w.s3.OpenObject(ctx, *file.S3Key)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	result, err := w.scanner.Scan(ctx, body)
+	if err != nil {
+		return err
+	}
+	if !result.Infected {
+		w.record(file.ID, model.ScanStatusClean, nil)
+		return nil
+	}
+
+	log.Printf("🦠 [Scan] Quarantined file %d (workspace %d): %s", file.ID, file.WorkspaceID, result.Signature)
+	w.record(file.ID, model.ScanStatusInfected, &result.Signature)
+	if w.onInfected != nil {
+		w.onInfected(&file, result.Signature)
+	}
+	return nil
+}
+
+// record 검사 결과를 파일 행에 기록
+func (w *Worker) record(fileID int64, status string, signature *string) {
+	err := w.db.Unscoped().Model(&model.WorkspaceFile{}).Where("id = ?", fileID).Updates(map[string]any{
+		"scan_status":    status,
+		"scan_signature": signature,
+		"scanned_at":     time.Now(),
+	}).Error
+	if err != nil {
+		log.Printf("[Scan] Failed to record scan result for file %d: %v", fileID, err)
+	}
+}
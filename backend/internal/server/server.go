@@ -1,9 +1,13 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"log"
+	"net"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -12,18 +16,26 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/limiter"
 	"github.com/gofiber/fiber/v2/middleware/logger"
+	"github.com/gofiber/fiber/v2/middleware/pprof"
 	"github.com/gofiber/fiber/v2/middleware/recover"
+	"google.golang.org/grpc"
 	"gorm.io/gorm"
 
 	"realtime-backend/internal/auth"
+	awsai "realtime-backend/internal/aws"
 	"realtime-backend/internal/cache"
 	"realtime-backend/internal/config"
 	"realtime-backend/internal/handler"
+	"realtime-backend/internal/metrics"
 	"realtime-backend/internal/middleware"
 	"realtime-backend/internal/model"
+	"realtime-backend/internal/notify/email"
 	"realtime-backend/internal/presence"
+	"realtime-backend/internal/ratelimit"
 	"realtime-backend/internal/service"
+	"realtime-backend/internal/session"
 	"realtime-backend/internal/storage"
+	"realtime-backend/pb"
 )
 
 // Server Fiber 서버 래퍼
@@ -43,20 +55,41 @@ type Server struct {
 	meetingHandler             *handler.MeetingHandler
 	calendarHandler            *handler.CalendarHandler
 	storageHandler             *handler.StorageHandler
+	emailDispatcher            *email.Dispatcher // nil = 이메일 알림 비활성화
 	roleHandler                *handler.RoleHandler
 	videoHandler               *handler.VideoHandler
 	whiteboardHandler          *handler.WhiteboardHandler
+	whiteboardWSHandler        *handler.WhiteboardWSHandler
 	voiceRecordHandler         *handler.VoiceRecordHandler
 	voiceParticipantsWSHandler *handler.VoiceParticipantsWSHandler
 	healthHandler              *handler.HealthHandler
 	pollHandler                *handler.PollHandler
+	vocabularyHandler          *handler.VocabularyHandler
+	noiseFilterHandler         *handler.NoiseFilterHandler
+	complianceHandler          *handler.ComplianceHandler
+	usageHandler               *handler.UsageHandler
+	warmupHandler              *handler.WarmupHandler
+	meetingSummaryHandler      *handler.MeetingSummaryHandler
+	recordingHandler           *handler.RecordingHandler
+	ttsArtifactHandler         *handler.TTSArtifactHandler
+	keywordAlertHandler        *handler.KeywordAlertHandler
+	roomProvisionHandler       *handler.RoomProvisionHandler
+	meetingResumeHandler       *handler.MeetingResumeHandler
+	adminHandler               *handler.AdminHandler
+	webhookHandler             *handler.WebhookHandler
+	relayServer                *grpc.Server
 	jwtManager                 *auth.JWTManager
 	memberService              *service.MemberService
 	workspaceMW                *middleware.WorkspaceMiddleware
+	roomWSMW                   *middleware.RoomWSMiddleware
+	corsOrigins                *middleware.OriginAllowlist
+	configReloader             *config.Reloader
 }
 
 // New 새 서버 인스턴스 생성
 func New(cfg *config.Config, db *gorm.DB) *Server {
+	handler.SetProfileRates(cfg.Server.BlockProfileRate, cfg.Server.MutexProfileFraction)
+
 	app := fiber.New(fiber.Config{
 		AppName:               "Realtime Voice AI Gateway",
 		ServerHeader:          "Fiber",
@@ -94,12 +127,11 @@ func New(cfg *config.Config, db *gorm.DB) *Server {
 	notificationWSHandler := handler.NewNotificationWSHandler(db, presenceManager)
 	chatHandler := handler.NewChatHandler(db)
 	chatWSHandler := handler.NewChatWSHandler(db)
-	meetingHandler := handler.NewMeetingHandler(db)
+	meetingHandler := handler.NewMeetingHandler(db, jwtManager, cfg.WebSocket.JoinTokenTTL)
 	calendarHandler := handler.NewCalendarHandler(db)
 	roleHandler := handler.NewRoleHandler(db)
-	videoHandler := handler.NewVideoHandler(cfg, db)
+	videoHandler := handler.NewVideoHandler(cfg, db, jwtManager, meetingHandler.RoomRole)
 	whiteboardHandler := handler.NewWhiteboardHandler(db)
-	voiceRecordHandler := handler.NewVoiceRecordHandler(db)
 	voiceParticipantsWSHandler := handler.NewVoiceParticipantsWSHandler(cfg)
 
 	// S3 서비스 초기화 (선택적)
@@ -110,13 +142,37 @@ func New(cfg *config.Config, db *gorm.DB) *Server {
 		if err != nil {
 			log.Printf("⚠️ S3 service initialization failed: %v (file upload will be disabled)", err)
 		} else {
-			log.Printf("✅ S3 service initialized (bucket: %s)", cfg.S3.BucketName)
+			// 워크스페이스 컴플라이언스 설정의 KMS 키로 암호화
+			s3Service.SetKMSKeyResolver(handler.WorkspaceKMSKeyResolver(db))
+			log.Printf("✅ S3 service initialized (bucket: %s, encryption: %q)", cfg.S3.BucketName, cfg.S3.Encryption)
 		}
 	} else {
 		log.Println("ℹ️ S3 service not configured (file upload will be disabled)")
 	}
-	storageHandler := handler.NewStorageHandler(db, s3Service)
+	storageHandler := handler.NewStorageHandler(db, s3Service, &cfg.S3)
+	storageHandler.StartTrashPurge(time.Hour)
+	storageHandler.StartPreviewWorker()
+	storageHandler.StartScanWorker()
+	if cfg.S3.ManageLifecycle {
+		go storageHandler.EnsureTrashLifecycle()
+	}
+	chatHandler.SetStorage(s3Service)
+	chatWSHandler.SetStorage(s3Service)
+	whiteboardHandler.SetStorage(s3Service)
+
+	// 이메일 알림 (초대, 회의 시작 전 알림, 회의 요약)
+	var emailDispatcher *email.Dispatcher
+	if cfg.Email.Enabled {
+		emailDispatcher = email.NewDispatcher(db, email.NewSender(&cfg.Email), cfg.Email, cfg.Server.AppURL)
+		emailDispatcher.Start()
+	}
 	healthHandler := handler.NewHealthHandler(db, cfg.AI.ServerAddr)
+	if s3Service != nil {
+		healthHandler.SetStorage(s3Service)
+	}
+	if scanner := storageHandler.Scanner(); scanner != nil {
+		healthHandler.SetScanner(scanner)
+	}
 
 	// Service 레이어 초기화
 	memberService := service.NewMemberService(db)
@@ -124,49 +180,131 @@ func New(cfg *config.Config, db *gorm.DB) *Server {
 
 	// Audio handler 생성 및 DB 설정
 	audioHandler := handler.NewAudioHandler(cfg, db)
+
+	// 채팅 메시지 전송 제한 (오디오 핸들러의 Redis 공유 제한기 사용)
+	chatWSHandler.SetRateLimiter(audioHandler.RateLimiter(), ratelimit.Rule{
+		Rate:  cfg.RateLimit.ChatMessagesPerSecond,
+		Burst: cfg.RateLimit.ChatMessageBurst,
+	})
 	if roomHub := audioHandler.GetRoomHub(); roomHub != nil {
 		roomHub.SetDB(db)
+		roomHub.StartFinalizationRecovery(5 * time.Minute)
+		roomHub.StartUsageFlush(time.Minute)
+		roomHub.StartJanitor(5 * time.Minute)
+		roomHub.SetStorage(s3Service)
+		roomHub.StartRecordingCleanup(time.Hour)
+		if cfg.Scheduler.Enabled {
+			roomHub.StartMeetingScheduler(cfg.Scheduler)
+		}
+		// 채팅 자동 번역 (음성 파이프라인과 같은 Translate 클라이언트, 캐시는 Redis 공유 계층 공유)
+		if translator := roomHub.GetTranslateClient(); translator != nil {
+			chatWSHandler.SetTranslator(translator, roomHub.NewTranslationCache())
+		}
 	}
+	vocabularyHandler := handler.NewVocabularyHandler(db, audioHandler.GetRoomHub())
+	noiseFilterHandler := handler.NewNoiseFilterHandler(db, audioHandler.GetRoomHub())
+	complianceHandler := handler.NewComplianceHandler(db, audioHandler.GetRoomHub())
+	usageHandler := handler.NewUsageHandler(db, audioHandler.GetRoomHub())
+	warmupHandler := handler.NewWarmupHandler(db, audioHandler.GetRoomHub())
+	meetingSummaryHandler := handler.NewMeetingSummaryHandler(db, audioHandler.GetRoomHub())
+	recordingHandler := handler.NewRecordingHandler(db, audioHandler.GetRoomHub(), s3Service)
+	ttsArtifactHandler := handler.NewTTSArtifactHandler(db, s3Service)
+	keywordAlertHandler := handler.NewKeywordAlertHandler(db)
+	roomProvisionHandler := handler.NewRoomProvisionHandler(db, cfg)
+	meetingResumeHandler := handler.NewMeetingResumeHandler(db, audioHandler.GetRoomHub())
+	voiceRecordHandler := handler.NewVoiceRecordHandler(db, s3Service, audioHandler.GetRoomHub())
+
+	// WebSocket 세션 메트릭 (오디오/Room/채팅 공통 세션 관리자)
+	session.Default.OnOpen(func(h *session.Handle) {
+		metrics.WebSocketSessionsTotal.Inc(string(h.Kind))
+	})
+	metrics.Default.OnScrape(func() {
+		for kind, count := range session.Default.Counts() {
+			metrics.WebSocketSessions.Set(float64(count), string(kind))
+		}
+	})
 
 	// Poll Handler 초기화 (Redis 재사용 또는 신규 생성)
 	var pollHandler *handler.PollHandler
+	var redisClient *cache.RedisClient
 	if cfg.Redis.Enabled && cfg.Redis.Addr != "" {
 		// 오디오 핸들러와 별도로 Redis 연결 생성 (커넥션 풀링으로 효율적)
-		redisClient, err := cache.NewRedisClient(cfg.Redis.Addr, cfg.Redis.Password)
+		client, err := cache.NewRedisClient(cfg.Redis.Addr, cfg.Redis.Password)
 		if err != nil {
 			log.Printf("⚠️ PollHandler Redis connection failed: %v", err)
 		} else {
+			redisClient = client
 			pollHandler = handler.NewPollHandler(redisClient)
 			log.Println("📊 PollHandler initialized with Redis")
 		}
 	}
 
+	// 화이트보드 실시간 동기화 (Redis 버퍼 → 스냅샷 압축, Redis가 없으면 획마다 DB 저장)
+	whiteboardWSHandler := handler.NewWhiteboardWSHandler(db, redisClient)
+	whiteboardHandler.SetBuffer(redisClient)
+	if redisClient != nil {
+		healthHandler.SetRedis(redisClient)
+	}
+
+	// 설정 다시 읽기 (SIGHUP/설정 파일 변경): CORS origin과 Room 튜닝 값은 재시작 없이 적용
+	corsOrigins, err := middleware.NewOriginAllowlist(cfg.CORS.AllowOrigins)
+	if err != nil {
+		log.Fatalf("❌ Invalid CORS_ALLOW_ORIGINS: %v", err)
+	}
+	configReloader := config.NewReloader(cfg)
+	configReloader.OnReload(func(t config.Tunables) {
+		if err := corsOrigins.Set(t.CORSAllowOrigins); err != nil {
+			log.Printf("⚠️ [Config] CORS origins not updated: %v", err)
+		}
+	})
+	if roomHub := audioHandler.GetRoomHub(); roomHub != nil {
+		configReloader.OnReload(roomHub.ApplyTunables)
+	}
+
 	return &Server{
-		app:                   app,
-		cfg:                   cfg,
-		db:                    db,
-		handler:               audioHandler,
-		authHandler:           authHandler,
-		userHandler:           userHandler,
-		workspaceHandler:      workspaceHandler,
-		categoryHandler:       categoryHandler,
-		notificationHandler:   notificationHandler,
-		notificationWSHandler: notificationWSHandler,
-		chatHandler:           chatHandler,
-		chatWSHandler:         chatWSHandler,
-		meetingHandler:        meetingHandler,
-		calendarHandler:       calendarHandler,
-		storageHandler:        storageHandler,
-		roleHandler:           roleHandler,
+		app:                        app,
+		cfg:                        cfg,
+		db:                         db,
+		handler:                    audioHandler,
+		authHandler:                authHandler,
+		userHandler:                userHandler,
+		workspaceHandler:           workspaceHandler,
+		categoryHandler:            categoryHandler,
+		notificationHandler:        notificationHandler,
+		notificationWSHandler:      notificationWSHandler,
+		chatHandler:                chatHandler,
+		chatWSHandler:              chatWSHandler,
+		meetingHandler:             meetingHandler,
+		calendarHandler:            calendarHandler,
+		storageHandler:             storageHandler,
+		emailDispatcher:            emailDispatcher,
+		roleHandler:                roleHandler,
 		videoHandler:               videoHandler,
 		whiteboardHandler:          whiteboardHandler,
+		whiteboardWSHandler:        whiteboardWSHandler,
 		voiceRecordHandler:         voiceRecordHandler,
 		voiceParticipantsWSHandler: voiceParticipantsWSHandler,
 		healthHandler:              healthHandler,
 		pollHandler:                pollHandler, // Added
+		vocabularyHandler:          vocabularyHandler,
+		noiseFilterHandler:         noiseFilterHandler,
+		complianceHandler:          complianceHandler,
+		usageHandler:               usageHandler,
+		warmupHandler:              warmupHandler,
+		meetingSummaryHandler:      meetingSummaryHandler,
+		recordingHandler:           recordingHandler,
+		ttsArtifactHandler:         ttsArtifactHandler,
+		keywordAlertHandler:        keywordAlertHandler,
+		roomProvisionHandler:       roomProvisionHandler,
+		meetingResumeHandler:       meetingResumeHandler,
+		adminHandler:               handler.NewAdminHandler(audioHandler.GetRoomHub()),
+		webhookHandler:             handler.NewWebhookHandler(db, audioHandler.GetRoomHub()),
 		jwtManager:                 jwtManager,
 		memberService:              memberService,
 		workspaceMW:                workspaceMW,
+		roomWSMW:                   middleware.NewRoomWSMiddleware(jwtManager, cfg.WebSocket.RequireAuth, meetingHandler.RoomRole, handler.ParseBreakoutRoomID),
+		corsOrigins:                corsOrigins,
+		configReloader:             configReloader,
 	}
 }
 
@@ -186,7 +324,7 @@ func (s *Server) SetupMiddleware() {
 
 	// CORS
 	s.app.Use(cors.New(cors.Config{
-		AllowOrigins:     s.cfg.CORS.AllowOrigins,
+		AllowOriginsFunc: s.corsOrigins.Allow, // CORS_ALLOW_ORIGINS (설정을 다시 읽으면 바로 반영)
 		AllowHeaders:     "Origin, Content-Type, Accept, Authorization",
 		AllowMethods:     "GET, POST, PUT, DELETE, OPTIONS",
 		AllowCredentials: true,
@@ -200,9 +338,9 @@ func (s *Server) SetupMiddleware() {
 func (s *Server) SetupRoutes() {
 	// 헬스체크 엔드포인트
 	s.app.Get("/", s.healthHandler.Liveness)              // ALB 헬스체크용
-	s.app.Get("/health", s.healthHandler.Check)           // 전체 상태 (DB + AI)
-	s.app.Get("/health/live", s.healthHandler.Liveness)   // K8s liveness probe
-	s.app.Get("/health/ready", s.healthHandler.Readiness) // K8s readiness probe
+	s.app.Get("/health", s.healthHandler.Check)           // 전체 상태 (DB, Redis, S3, 바이러스 검사, AI)
+	s.app.Get("/health/live", s.healthHandler.Liveness)   // K8s liveness probe (프로세스만)
+	s.app.Get("/health/ready", s.healthHandler.Readiness) // K8s readiness probe (DB/Redis 장애 시 503)
 
 	// Rate Limiter 설정 (인증 엔드포인트용 - Brute Force 방지)
 	authLimiter := limiter.New(limiter.Config{
@@ -239,6 +377,8 @@ func (s *Server) SetupRoutes() {
 	authGroup.Get("/me", auth.AuthMiddleware(s.jwtManager), s.authHandler.GetMe)
 	authGroup.Put("/me", auth.AuthMiddleware(s.jwtManager), s.userHandler.UpdateUser)
 	authGroup.Put("/me/status", auth.AuthMiddleware(s.jwtManager), s.userHandler.UpdateUserStatus) // 상태 업데이트 엔드포인트 추가
+	authGroup.Get("/me/email-preferences", auth.AuthMiddleware(s.jwtManager), s.userHandler.GetEmailPreferences)
+	authGroup.Put("/me/email-preferences", auth.AuthMiddleware(s.jwtManager), s.userHandler.UpdateEmailPreferences)
 
 	// User 라우트 그룹 (인증 필요)
 	userGroup := s.app.Group("/api/users", auth.AuthMiddleware(s.jwtManager))
@@ -271,6 +411,14 @@ func (s *Server) SetupRoutes() {
 	workspaceGroup.Delete("/:id/members/:userId", s.workspaceHandler.KickMember)
 	workspaceGroup.Put("/:id", s.workspaceHandler.UpdateWorkspace)
 	workspaceGroup.Delete("/:id", s.workspaceHandler.DeleteWorkspace)
+	workspaceGroup.Get("/:id/compliance", s.complianceHandler.GetCompliance)
+	workspaceGroup.Put("/:id/compliance", s.complianceHandler.UpdateCompliance)
+
+	// 워크스페이스 AWS 사용량/비용
+	workspaceGroup.Get("/:id/usage", s.usageHandler.GetWorkspaceUsage)
+	workspaceGroup.Get("/:id/language-quality", s.usageHandler.GetLanguageQuality)
+	workspaceGroup.Get("/:id/quota", s.usageHandler.GetWorkspaceQuota)
+	workspaceGroup.Put("/:id/quota", s.usageHandler.UpdateWorkspaceQuota)
 
 	// Role 라우트 (워크스페이스 하위)
 	workspaceGroup.Get("/:id/roles", s.roleHandler.GetRoles)
@@ -294,19 +442,56 @@ func (s *Server) SetupRoutes() {
 	// Meeting 라우트 (워크스페이스 하위)
 	workspaceGroup.Get("/:workspaceId/meetings", s.meetingHandler.GetWorkspaceMeetings)
 	workspaceGroup.Post("/:workspaceId/meetings", s.meetingHandler.CreateMeeting)
+	workspaceGroup.Post("/:workspaceId/rooms", s.roomProvisionHandler.ProvisionRoom) // 외부 연동용 Room 사전 생성 + 첫 입장 웹훅
 	workspaceGroup.Get("/:workspaceId/meetings/:meetingId", s.meetingHandler.GetMeeting)
 	workspaceGroup.Post("/:workspaceId/meetings/:meetingId/start", s.meetingHandler.StartMeeting)
+	workspaceGroup.Post("/:workspaceId/meetings/:meetingId/warmup", s.warmupHandler.WarmMeeting)
 
 	// DM 라우트
 	workspaceGroup.Post("/:workspaceId/dm", s.chatHandler.GetOrCreateDMRoom)
 	workspaceGroup.Get("/:workspaceId/dm", s.chatHandler.GetMyDMs)
 	workspaceGroup.Post("/:workspaceId/meetings/:meetingId/end", s.meetingHandler.EndMeeting)
+	workspaceGroup.Post("/:workspaceId/meetings/:meetingId/resume", s.meetingResumeHandler.ResumeMeeting)
 
 	// Voice Record 라우트 (미팅 하위)
 	workspaceGroup.Get("/:workspaceId/meetings/:meetingId/voice-records", s.voiceRecordHandler.GetVoiceRecords)
 	workspaceGroup.Post("/:workspaceId/meetings/:meetingId/voice-records", s.voiceRecordHandler.CreateVoiceRecord)
 	workspaceGroup.Post("/:workspaceId/meetings/:meetingId/voice-records/bulk", s.voiceRecordHandler.CreateVoiceRecordBulk)
 	workspaceGroup.Delete("/:workspaceId/meetings/:meetingId/voice-records", s.voiceRecordHandler.DeleteVoiceRecords)
+	workspaceGroup.Get("/:workspaceId/meetings/:meetingId/voice-records/export", s.voiceRecordHandler.ExportVoiceRecords)
+	workspaceGroup.Post("/:workspaceId/meetings/:meetingId/voice-records/export/docx", s.voiceRecordHandler.ExportVoiceRecordsDOCX)
+	workspaceGroup.Get("/:workspaceId/meetings/:meetingId/transcript/export", s.voiceRecordHandler.ExportTranscript)
+	workspaceGroup.Get("/:workspaceId/meetings/:meetingId/voice-records/access-logs", s.voiceRecordHandler.GetTranscriptAccessLogs)
+	workspaceGroup.Get("/:workspaceId/meetings/:meetingId/summary", s.meetingSummaryHandler.GetMeetingSummary)
+	workspaceGroup.Post("/:workspaceId/meetings/:meetingId/summary/regenerate", s.meetingSummaryHandler.RegenerateMeetingSummary)
+	workspaceGroup.Post("/:workspaceId/meetings/:meetingId/recording", s.recordingHandler.StartRecording)
+	workspaceGroup.Delete("/:workspaceId/meetings/:meetingId/recording", s.recordingHandler.StopRecording)
+	workspaceGroup.Get("/:workspaceId/meetings/:meetingId/recordings", s.recordingHandler.GetRecordings)
+	// 화이트보드 PNG/PDF 내보내기 (S3 보관)
+	workspaceGroup.Post("/:workspaceId/meetings/:meetingId/whiteboard/exports", s.whiteboardHandler.ExportWhiteboard)
+	workspaceGroup.Get("/:workspaceId/meetings/:meetingId/whiteboard/exports", s.whiteboardHandler.GetWhiteboardExports)
+	workspaceGroup.Get("/:workspaceId/meetings/:meetingId/tts-artifacts", s.ttsArtifactHandler.GetTTSArtifacts)
+	workspaceGroup.Get("/:workspaceId/meetings/:meetingId/keyword-alerts", s.keywordAlertHandler.GetKeywordAlerts)
+
+	// Vocabulary 라우트 (워크스페이스 커스텀 용어집)
+	workspaceGroup.Get("/:workspaceId/vocabularies", s.vocabularyHandler.GetVocabularies)
+	workspaceGroup.Put("/:workspaceId/vocabularies/:lang", s.vocabularyHandler.UpsertVocabulary)
+	workspaceGroup.Delete("/:workspaceId/vocabularies/:lang", s.vocabularyHandler.DeleteVocabulary)
+
+	// Noise filter 라우트 (STT 노이즈 패턴, 언어별 confidence 임계값)
+	workspaceGroup.Get("/:workspaceId/noise-filter", s.noiseFilterHandler.GetNoiseFilter)
+	workspaceGroup.Post("/:workspaceId/noise-filter/rules", s.noiseFilterHandler.CreateNoiseRule)
+	workspaceGroup.Delete("/:workspaceId/noise-filter/rules/:ruleId", s.noiseFilterHandler.DeleteNoiseRule)
+	workspaceGroup.Put("/:workspaceId/noise-filter/thresholds/:lang", s.noiseFilterHandler.UpsertNoiseThreshold)
+	workspaceGroup.Delete("/:workspaceId/noise-filter/thresholds/:lang", s.noiseFilterHandler.DeleteNoiseThreshold)
+	workspaceGroup.Put("/:workspaceId/noise-filter/partial-lengths/:lang", s.noiseFilterHandler.UpsertPartialMinLength)
+	workspaceGroup.Delete("/:workspaceId/noise-filter/partial-lengths/:lang", s.noiseFilterHandler.DeletePartialMinLength)
+
+	// Webhook 라우트 (회의 이벤트 콜백 URL, 발송 기록)
+	workspaceGroup.Get("/:workspaceId/webhooks", s.webhookHandler.GetWebhooks)
+	workspaceGroup.Post("/:workspaceId/webhooks", s.webhookHandler.CreateWebhook)
+	workspaceGroup.Delete("/:workspaceId/webhooks/:webhookId", s.webhookHandler.DeleteWebhook)
+	workspaceGroup.Get("/:workspaceId/webhooks/:webhookId/deliveries", s.webhookHandler.GetWebhookDeliveries)
 
 	// Calendar 라우트 (워크스페이스 하위)
 	workspaceGroup.Get("/:workspaceId/events", s.calendarHandler.GetWorkspaceEvents)
@@ -317,14 +502,28 @@ func (s *Server) SetupRoutes() {
 
 	// Storage 라우트 (워크스페이스 하위)
 	workspaceGroup.Get("/:workspaceId/files", s.storageHandler.GetWorkspaceFiles)
+	workspaceGroup.Get("/:workspaceId/files/trash", s.storageHandler.GetTrash) // 휴지통 (보관 기간 후 영구 삭제)
+	workspaceGroup.Post("/:workspaceId/files/:fileId/restore", s.storageHandler.RestoreFile)
 	workspaceGroup.Post("/:workspaceId/files/folder", s.storageHandler.CreateFolder)
 	workspaceGroup.Post("/:workspaceId/files", s.storageHandler.UploadFile)
+	workspaceGroup.Delete("/:workspaceId/files/multipart", s.storageHandler.AbortMultipartUpload) // :fileId보다 먼저 등록
 	workspaceGroup.Delete("/:workspaceId/files/:fileId", s.storageHandler.DeleteFile)
+	workspaceGroup.Get("/:workspaceId/files/usage", s.storageHandler.GetStorageUsage)    // 저장 용량 사용량/한도
+	workspaceGroup.Put("/:workspaceId/files/quota", s.storageHandler.UpdateStorageQuota) // :fileId보다 먼저 등록
+	workspaceGroup.Get("/:workspaceId/files/policy", s.storageHandler.GetFilePolicy)
+	workspaceGroup.Put("/:workspaceId/files/policy", s.storageHandler.UpdateFilePolicy) // :fileId보다 먼저 등록
 	workspaceGroup.Put("/:workspaceId/files/:fileId", s.storageHandler.RenameFile)
+	workspaceGroup.Post("/:workspaceId/files/:fileId/move", s.storageHandler.MoveFile) // 다른 폴더로 이동
+	workspaceGroup.Post("/:workspaceId/files/:fileId/copy", s.storageHandler.CopyFile) // 하위 항목까지 재귀 복사
 
 	// S3 파일 업로드 라우트
 	workspaceGroup.Post("/:workspaceId/files/presign", s.storageHandler.GetPresignedURL)
 	workspaceGroup.Post("/:workspaceId/files/confirm", s.storageHandler.ConfirmUpload)
+	// 큰 파일 멀티파트 업로드 (조각별 Presigned URL, 올라간 조각 조회로 이어 올리기)
+	workspaceGroup.Post("/:workspaceId/files/multipart", s.storageHandler.CreateMultipartUpload)
+	workspaceGroup.Post("/:workspaceId/files/multipart/parts", s.storageHandler.PresignMultipartParts)
+	workspaceGroup.Get("/:workspaceId/files/multipart/parts", s.storageHandler.GetMultipartParts)
+	workspaceGroup.Post("/:workspaceId/files/multipart/complete", s.storageHandler.CompleteMultipartUpload)
 	workspaceGroup.Get("/:workspaceId/files/:fileId/download", s.storageHandler.GetDownloadURL)
 
 	// Video Call 라우트
@@ -332,65 +531,111 @@ func (s *Server) SetupRoutes() {
 	s.app.Get("/api/video/participants", auth.AuthMiddleware(s.jwtManager), s.videoHandler.GetRoomParticipants)
 	s.app.Get("/api/video/rooms/participants", auth.AuthMiddleware(s.jwtManager), s.videoHandler.GetAllRoomsParticipants)
 
+	// 회의 입장 토큰 (워크스페이스 멤버 확인 후 Room/참가자/역할/허용 언어가 담긴 짧은 토큰 발급, /ws/room·/ws/audio 의 ?token=)
+	s.app.Post("/api/meetings/:id/join-token", auth.AuthMiddleware(s.jwtManager), s.meetingHandler.IssueJoinToken)
+
+	// 회의 채팅 기록 (?before=<cursor>&limit=50, 최근 메시지부터 이전 방향으로)
+	s.app.Get("/api/meetings/:id/chat", auth.AuthMiddleware(s.jwtManager), s.chatHandler.GetMeetingChat)
+	// 채팅 첨부 파일 업로드 URL (업로드 후 /ws/chat 에 "attachment" 메시지로 게시)
+	s.app.Post("/api/meetings/:id/chat/attachments", auth.AuthMiddleware(s.jwtManager), s.chatHandler.CreateChatAttachmentUpload)
+
+	// 지원 언어 목록 (서비스별 지원 여부 포함)
+	s.app.Get("/api/languages", s.handleGetLanguages)
+
+	// 엣지 릴레이 목록 (?region= 과 같은 리전이 앞에 옴, 클라이언트가 /health 로 지연시간 측정)
+	s.app.Get("/api/relays", s.handleGetRelays)
+
+	// 운영자 API: 이 인스턴스의 활성 Room 조회 (파이프라인 상태, 스트림/워커 풀 통계)와 강제 종료 (ADMIN_EMAILS)
+	adminGroup := s.app.Group("/api/admin", auth.AuthMiddleware(s.jwtManager), auth.RequireAdmin(s.cfg.Auth.AdminEmails))
+	adminGroup.Get("/rooms", s.adminHandler.ListRooms)
+	adminGroup.Get("/rooms/:roomId", s.adminHandler.GetRoom)
+	adminGroup.Post("/rooms/:roomId/close", s.adminHandler.CloseRoom)
+	adminGroup.Get("/runtime", s.adminHandler.RuntimeStats)
+	adminGroup.Put("/runtime/profile-rates", s.adminHandler.UpdateProfileRates)
+	// AI 파이프라인 통계 (캐시 적중률/절감 비용, 공유 AWS 클라이언트 풀, ?sessions=true 면 접속별 세션 정보)
+	adminGroup.Get("/stats", s.handleGetStats)
+	// 내부 큐 깊이/최대 적재량 (파이프라인 x-ray 디버그 화면, ?room=), reset 은 최대 적재량을 다시 측정
+	adminGroup.Get("/stats/queues", s.handleGetQueueStats)
+	adminGroup.Post("/stats/queues/reset", s.handleResetQueueStats)
+	// Prometheus 메트릭 (파이프라인/Room 상태, Room ID 라벨이 있어 관리자 전용)
+	adminGroup.Get("/metrics", s.handleMetrics)
+	// net/http/pprof (/api/admin/debug/pprof/heap, goroutine?debug=2, block, mutex, profile?seconds=N)
+	// CPU 프로파일 seconds는 WRITE_TIMEOUT보다 짧게
+	adminGroup.Use(pprof.New(pprof.Config{Prefix: "/api/admin"}))
+
 	// Room Transcripts API (실시간 음성 기록 동기화)
-	s.app.Get("/api/room/:roomId/transcripts", s.handleGetRoomTranscripts)
+	s.app.Get("/api/room/:roomId/transcripts", auth.AuthMiddleware(s.jwtManager), s.handleGetRoomTranscripts)
+
+	// 실시간 참가자 목록 (접속 중인 리스너/화자, 변경은 WebSocket roster 이벤트로 전달)
+	s.app.Get("/api/rooms/:id/participants", auth.AuthMiddleware(s.jwtManager), s.handleGetRoomParticipants)
 
 	// Whiteboard 라우트
 	// Whiteboard 라우트
 	s.app.Get("/api/whiteboard", auth.AuthMiddleware(s.jwtManager), s.whiteboardHandler.GetWhiteboard)
 	s.app.Post("/api/whiteboard", auth.AuthMiddleware(s.jwtManager), s.whiteboardHandler.HandleWhiteboard)
 
-	// WebSocket 업그레이드 체크 미들웨어
+	// WebSocket 업그레이드 체크 미들웨어 (IP당 업그레이드 횟수 제한, 인스턴스 간 공유)
+	wsUpgradeRule := ratelimit.PerMinute(s.cfg.RateLimit.WSUpgradesPerMinute, s.cfg.RateLimit.WSUpgradeBurst)
 	s.app.Use("/ws", func(c *fiber.Ctx) error {
 		if websocket.IsWebSocketUpgrade(c) {
+			if !s.handler.RateLimiter().Allow(c.UserContext(), "ws:ip:"+c.IP(), wsUpgradeRule) {
+				metrics.RateLimited.Inc(metrics.RateLimitWSUpgrade)
+				return c.SendStatus(fiber.StatusTooManyRequests)
+			}
 			c.Locals("allowed", true)
 			return c.Next()
 		}
 		return fiber.ErrUpgradeRequired
 	})
 
-	// WebSocket 오디오 스트리밍 엔드포인트
-	s.app.Get("/ws/audio", func(c *fiber.Ctx) error {
+	// WebSocket 오디오 스트리밍 엔드포인트 (speaker 역할 Room 토큰 필요)
+	s.app.Get("/ws/audio", s.roomWSMW.Authenticate(true), func(c *fiber.Ctx) error {
 		if !websocket.IsWebSocketUpgrade(c) {
 			return fiber.ErrUpgradeRequired
 		}
 
 		// 소스 언어 파라미터 추출 (발화자가 말하는 언어, 기본값: ko)
-		sourceLang := c.Query("sourceLang", "ko")
-		switch sourceLang {
-		case "ko", "en", "ja", "zh":
-			// 유효한 언어
-		default:
+		sourceLang := awsai.NormalizeLanguage(c.Query("sourceLang", "ko"))
+		if !awsai.SupportsTranscribe(sourceLang) {
 			sourceLang = "ko"
 		}
 		c.Locals("sourceLang", sourceLang)
 
-		// 타겟 언어 파라미터 추출 (듣고 싶은 언어, 기본값: en)
-		targetLang := c.Query("targetLang", "en")
-		switch targetLang {
-		case "ko", "en", "ja", "zh":
-			// 유효한 언어
-		default:
+		// 타겟 언어 파라미터 추출 (듣고 싶은 언어, 기본값: en, 기존 lang 파라미터도 지원)
+		requestedLang := c.Query("targetLang", "en")
+		if c.Query("lang") != "" && c.Query("sourceLang") == "" {
+			requestedLang = c.Query("lang")
+		}
+		targetLang := awsai.NormalizeLanguage(requestedLang)
+		if !awsai.IsSupportedLanguage(targetLang) {
 			targetLang = "en"
 		}
-		c.Locals("targetLang", targetLang)
+		c.Locals("targetLang", targetLang) // 입장 토큰의 허용 언어가 아니면 핸들러에서 LANGUAGE_NOT_ALLOWED
 
-		// 기존 lang 파라미터도 지원 (하위 호환성)
-		if c.Query("lang") != "" && c.Query("sourceLang") == "" {
-			legacyLang := c.Query("lang", "en")
-			c.Locals("targetLang", legacyLang)
-		}
+		claims := auth.GetRoomClaimsFromContext(c)
 
-		// 발화자 식별 ID 추출 (원격 참가자의 identity)
+		// 발화자 식별 ID 추출 (인증된 연결은 토큰의 참가자, 다른 참가자로 발화 불가)
 		participantId := c.Query("participantId", "")
+		if claims != nil {
+			if participantId != "" && participantId != claims.ParticipantID {
+				return c.SendStatus(fiber.StatusForbidden)
+			}
+			participantId = claims.ParticipantID
+		}
 		c.Locals("participantId", participantId)
 
 		// Room ID 추출 (같은 방의 동일 언어 그룹을 묶기 위해)
 		roomId := c.Query("roomId", "")
-		c.Locals("roomId", roomId)
 
 		// Listener ID 추출 (듣는 사람의 identity)
 		listenerId := c.Query("listenerId", "")
+
+		// 인증된 연결은 토큰의 Room/참가자로 고정
+		if claims != nil {
+			roomId = claims.RoomID
+			listenerId = claims.ParticipantID
+		}
+		c.Locals("roomId", roomId)
 		c.Locals("listenerId", listenerId)
 
 		return c.Next()
@@ -401,13 +646,18 @@ func (s *Server) SetupRoutes() {
 
 	// WebSocket Room 기반 오디오 스트리밍 엔드포인트 (새로운 아키텍처)
 	// Room당 1 gRPC 스트림 공유로 연결 효율화 (N² → N)
-	s.app.Get("/ws/room", func(c *fiber.Ctx) error {
+	// Room 토큰 필요 (listener 역할은 오디오/화자 정보 전송 불가, 핸들러에서 강제)
+	s.app.Get("/ws/room", s.roomWSMW.Authenticate(false), func(c *fiber.Ctx) error {
 		if !websocket.IsWebSocketUpgrade(c) {
 			return fiber.ErrUpgradeRequired
 		}
+		claims := auth.GetRoomClaimsFromContext(c)
 
-		// Room ID (필수)
+		// Room ID (필수, 인증된 연결은 토큰의 Room)
 		roomId := c.Query("roomId", "")
+		if claims != nil {
+			roomId = claims.RoomID
+		}
 		if roomId == "" {
 			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 				"error": "roomId is required",
@@ -415,8 +665,11 @@ func (s *Server) SetupRoutes() {
 		}
 		c.Locals("roomId", roomId)
 
-		// Listener ID (필수) - 듣는 사람의 identity
+		// Listener ID (필수) - 듣는 사람의 identity, 인증된 연결은 토큰의 참가자
 		listenerId := c.Query("listenerId", "")
+		if claims != nil {
+			listenerId = claims.ParticipantID
+		}
 		if listenerId == "" {
 			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 				"error": "listenerId is required",
@@ -425,14 +678,60 @@ func (s *Server) SetupRoutes() {
 		c.Locals("listenerId", listenerId)
 
 		// Target Language (선택, 기본값: en)
-		targetLang := c.Query("targetLang", "en")
-		switch targetLang {
-		case "ko", "en", "ja", "zh":
-			// 유효한 언어
-		default:
+		targetLang := awsai.NormalizeLanguage(c.Query("targetLang", "en"))
+		if !awsai.IsSupportedLanguage(targetLang) {
 			targetLang = "en"
 		}
-		c.Locals("targetLang", targetLang)
+		c.Locals("targetLang", targetLang) // 입장 토큰의 허용 언어가 아니면 핸들러에서 LANGUAGE_NOT_ALLOWED
+
+		// Capabilities (선택) - 클라이언트 지원 기능, 미지정 시 기존 프로토콜
+		c.Locals("capabilities", c.Query("capabilities", ""))
+
+		// Audio Mode (선택) - tts(기본) | original | both
+		audioMode, ok := handler.ParseAudioMode(c.Query("audioMode", ""))
+		if !ok {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "audioMode must be tts, original or both",
+			})
+		}
+		c.Locals("audioMode", audioMode)
+
+		// TTS Interrupt (선택) - keep(기본) | drop: 새 final에 대체된 이전 TTS 버림
+		ttsInterrupt, ok := handler.ParseTTSInterrupt(c.Query("ttsInterrupt", ""))
+		if !ok {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "ttsInterrupt must be keep or drop",
+			})
+		}
+		c.Locals("ttsInterrupt", ttsInterrupt)
+
+		// Audio Format (선택) - 수신 가능한 TTS 코덱/샘플레이트/최대 비트레이트, 미지정 시 mp3 24kHz
+		audioProfile, err := handler.ParseAudioFormatQuery(c.Query("audioCodecs", ""), c.Query("audioSampleRates", ""), c.Query("maxBitrate", ""))
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+		c.Locals("audioProfile", audioProfile)
+
+		// History (선택) - 입장 시 받을 최근 자막 수, 0이면 받지 않음 (기본값: AI_HISTORY_TRANSCRIPTS)
+		historyCount, ok := handler.ParseHistoryCount(c.Query("history", ""), s.cfg.AI.HistoryTranscripts)
+		if !ok {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "history must be a non-negative number",
+			})
+		}
+		c.Locals("historyCount", historyCount)
+
+		// Resume (선택) - 재접속 시 이전 ready 응답의 resumeToken과 마지막으로 받은 seq
+		resumeFrom, ok := handler.ParseResumeFrom(c.Query("resumeFrom", ""))
+		if !ok {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": handler.ErrInvalidResumeFrom.Error(),
+			})
+		}
+		c.Locals("resumeToken", c.Query("resumeToken", ""))
+		c.Locals("resumeFrom", resumeFrom)
 
 		return c.Next()
 	}, websocket.New(s.handler.HandleRoomWebSocket, websocket.Config{
@@ -507,7 +806,7 @@ func (s *Server) SetupRoutes() {
 		// 채팅방이 해당 워크스페이스에 속하는지 확인
 		var roomCount int64
 		s.db.Table("meetings").
-			Where("id = ? AND workspace_id = ? AND type IN ?", roomID, workspaceID, []string{model.MeetingTypeChatRoom.String(), model.MeetingTypeDM.String()}).
+			Where("id = ? AND workspace_id = ? AND type IN ?", roomID, workspaceID, []string{model.MeetingTypeChatRoom.String(), model.MeetingTypeDM.String(), model.MeetingTypeWebinar.String()}).
 			Count(&roomCount)
 		if roomCount == 0 {
 			return c.SendStatus(fiber.StatusNotFound)
@@ -523,6 +822,8 @@ func (s *Server) SetupRoutes() {
 		c.Locals("workspaceId", int64(workspaceID))
 		c.Locals("userId", claims.UserID)
 		c.Locals("nickname", user.Nickname)
+		// 읽는 언어 (?lang=, 다른 언어 메시지는 번역 함께 전송)
+		c.Locals("lang", strings.Clone(c.Query("lang")))
 
 		return c.Next()
 	}, websocket.New(s.chatWSHandler.HandleWebSocket, websocket.Config{
@@ -530,6 +831,57 @@ func (s *Server) SetupRoutes() {
 		WriteBufferSize: 4096,
 	}))
 
+	// WebSocket 화이트보드 엔드포인트 (meetingId 기반)
+	s.app.Get("/ws/whiteboard/:meetingId", func(c *fiber.Ctx) error {
+		if !websocket.IsWebSocketUpgrade(c) {
+			return fiber.ErrUpgradeRequired
+		}
+
+		// 쿠키에서 JWT 토큰 추출
+		accessToken := c.Cookies("access_token")
+		if accessToken == "" {
+			return c.SendStatus(fiber.StatusUnauthorized)
+		}
+
+		// JWT 검증
+		claims, err := s.jwtManager.ValidateAccessToken(accessToken)
+		if err != nil {
+			return c.SendStatus(fiber.StatusUnauthorized)
+		}
+
+		meetingID, err := c.ParamsInt("meetingId")
+		if err != nil {
+			return c.SendStatus(fiber.StatusBadRequest)
+		}
+
+		var meeting model.Meeting
+		if err := s.db.First(&meeting, meetingID).Error; err != nil {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		access, err := handler.GetMeetingAccess(s.db, &meeting, claims.UserID)
+		if err != nil {
+			return c.SendStatus(fiber.StatusInternalServerError)
+		}
+		if !access.CanAccess() {
+			return c.SendStatus(fiber.StatusForbidden)
+		}
+
+		// 유저 정보 조회
+		var user struct {
+			Nickname string
+		}
+		s.db.Table("users").Select("nickname").Where("id = ?", claims.UserID).Scan(&user)
+
+		c.Locals("meetingId", meeting.ID)
+		c.Locals("userId", claims.UserID)
+		c.Locals("nickname", user.Nickname)
+
+		return c.Next()
+	}, websocket.New(s.whiteboardWSHandler.HandleWebSocket, websocket.Config{
+		ReadBufferSize:  4096,
+		WriteBufferSize: 4096,
+	}))
+
 	// WebSocket 음성 참가자 엔드포인트
 	s.app.Get("/ws/voice-participants/:workspaceId", func(c *fiber.Ctx) error {
 		if !websocket.IsWebSocketUpgrade(c) {
@@ -578,9 +930,21 @@ func (s *Server) Start() error {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
+	if err := s.startRelayServer(); err != nil {
+		return err
+	}
+	s.configReloader.Start()
+
 	go func() {
 		<-quit
 		log.Println("🛑 Shutting down server...")
+		s.configReloader.Stop()
+		s.drain()
+		s.stopRelayServer()
+		s.chatWSHandler.Close()
+		s.whiteboardWSHandler.Close()
+		s.storageHandler.Close()
+		s.stopEmailDispatcher()
 		if err := s.app.ShutdownWithTimeout(30 * time.Second); err != nil {
 			log.Fatalf("Server shutdown error: %v", err)
 		}
@@ -592,11 +956,180 @@ func (s *Server) Start() error {
 	return s.app.Listen(s.cfg.Server.Port)
 }
 
+// stopEmailDispatcher 이메일 발송 스케줄러 중지 (대기 중인 메일은 다음 실행 때 발송)
+func (s *Server) stopEmailDispatcher() {
+	if s.emailDispatcher != nil {
+		s.emailDispatcher.Stop()
+	}
+}
+
 // Shutdown 서버 종료
 func (s *Server) Shutdown() error {
+	s.configReloader.Stop()
+	s.drain()
+	s.stopRelayServer()
+	s.chatWSHandler.Close()
+	s.whiteboardWSHandler.Close()
+	s.storageHandler.Close()
+	s.stopEmailDispatcher()
 	return s.app.ShutdownWithTimeout(30 * time.Second)
 }
 
+// startRelayServer 엣지 릴레이용 gRPC 서버 시작 (RELAY_GRPC_ADDR 미설정 시 비활성)
+func (s *Server) startRelayServer() error {
+	if s.cfg.Relay.GRPCAddr == "" {
+		return nil
+	}
+	if s.cfg.Relay.Secret == "" {
+		return errors.New("RELAY_SECRET is required when RELAY_GRPC_ADDR is set")
+	}
+	roomHub := s.handler.GetRoomHub()
+	if roomHub == nil {
+		log.Println("⚠️ Relay gRPC server disabled: room hub not available")
+		return nil
+	}
+
+	lis, err := net.Listen("tcp", s.cfg.Relay.GRPCAddr)
+	if err != nil {
+		return err
+	}
+	s.relayServer = grpc.NewServer()
+	pb.RegisterRelayServiceServer(s.relayServer, handler.NewRelayServer(roomHub, s.cfg.Relay.Secret))
+
+	go func() {
+		if err := s.relayServer.Serve(lis); err != nil {
+			log.Printf("Relay gRPC server error: %v", err)
+		}
+	}()
+	log.Printf("🛰️ Relay gRPC server listening on %s", s.cfg.Relay.GRPCAddr)
+	return nil
+}
+
+// stopRelayServer 릴레이 구독 스트림을 끊고 gRPC 서버 종료 (drain 이후 호출)
+func (s *Server) stopRelayServer() {
+	if s.relayServer != nil {
+		s.relayServer.Stop()
+	}
+}
+
+// drain 연결된 오디오/Room 클라이언트에 재접속 안내(server_closing)를 보내고,
+// 진행 중인 TTS 전송을 기다린 뒤 Room 자막을 DB에 저장 (DrainTimeout까지)
+func (s *Server) drain() {
+	roomHub := s.handler.GetRoomHub()
+	if roomHub == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.Server.DrainTimeout)
+	defer cancel()
+
+	roomHub.Drain(ctx, session.DrainNotice{
+		Reason:           "shutdown",
+		ReconnectAfterMs: s.cfg.Server.ReconnectDelay.Milliseconds(),
+	})
+}
+
+// handleGetLanguages returns selectable languages and per-service capabilities
+func (s *Server) handleGetLanguages(c *fiber.Ctx) error {
+	languages := awsai.SupportedLanguages()
+
+	responses := make([]fiber.Map, 0, len(languages))
+	for _, lang := range languages {
+		responses = append(responses, fiber.Map{
+			"code":       lang.Code,
+			"name":       lang.Name,
+			"transcribe": lang.SupportsTranscribe(),
+			"translate":  lang.SupportsTranslate(),
+			"tts":        lang.SupportsTTS(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"languages": responses,
+		"total":     len(responses),
+	})
+}
+
+// handleGetRelays returns the configured edge relays; relays in ?region= come first so
+// clients can probe the nearest ones' /health latency before picking one
+func (s *Server) handleGetRelays(c *fiber.Ctx) error {
+	region := c.Query("region")
+
+	local := make([]fiber.Map, 0)
+	others := make([]fiber.Map, 0)
+	for _, node := range s.cfg.Relay.Nodes {
+		nodeRegion, url, ok := strings.Cut(node, "=")
+		if !ok || url == "" {
+			continue
+		}
+		relay := fiber.Map{"region": nodeRegion, "url": url}
+		if region != "" && nodeRegion == region {
+			local = append(local, relay)
+		} else {
+			others = append(others, relay)
+		}
+	}
+	relays := append(local, others...)
+
+	return c.JSON(fiber.Map{
+		"relays": relays,
+		"total":  len(relays),
+	})
+}
+
+// handleGetStats returns AI pipeline statistics: cache hit rates and estimated savings
+// (per room and global), the shared AWS client pool state, chat room counters, WebSocket
+// session counts (?sessions=true adds per-session stats) and the last janitor run
+func (s *Server) handleGetStats(c *fiber.Ctx) error {
+	roomHub := s.handler.GetRoomHub()
+	if roomHub == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "room hub not available",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"cache":      roomHub.GetCacheStats(),
+		"clientPool": roomHub.GetClientPoolStats(),
+		"chat":       s.chatWSHandler.Stats(),
+		"sessions":   session.Default.Stats(c.QueryBool("sessions")),
+		"janitor":    roomHub.LastJanitorReport(),
+	})
+}
+
+// handleGetQueueStats returns current depths and high watermarks of every internal queue
+// (stream audio input, pipeline output channels, worker pools, room broadcast/relay and
+// per-listener writes); ?room= limits it to one room
+func (s *Server) handleGetQueueStats(c *fiber.Ctx) error {
+	return s.queueStats(c, false)
+}
+
+// handleResetQueueStats returns the queue stats like handleGetQueueStats and then restarts
+// the high watermarks; ?room= limits the reset to one room
+func (s *Server) handleResetQueueStats(c *fiber.Ctx) error {
+	return s.queueStats(c, true)
+}
+
+func (s *Server) queueStats(c *fiber.Ctx, reset bool) error {
+	roomHub := s.handler.GetRoomHub()
+	if roomHub == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "room hub not available",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"rooms": roomHub.QueueDepths(c.Query("room"), reset),
+	})
+}
+
+// handleMetrics exposes pipeline and room metrics in the Prometheus text format
+func (s *Server) handleMetrics(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, metrics.ContentType)
+	metrics.WriteText(c)
+	return nil
+}
+
 // handleGetRoomTranscripts retrieves transcripts from Redis for a room
 func (s *Server) handleGetRoomTranscripts(c *fiber.Ctx) error {
 	roomID := c.Params("roomId")
@@ -613,6 +1146,27 @@ func (s *Server) handleGetRoomTranscripts(c *fiber.Ctx) error {
 		})
 	}
 
+	// 회의록 접근 권한 확인 (참가자만 조회 가능, 게스트 불가)
+	claims := c.Locals("claims").(*auth.Claims)
+	meeting, err := handler.FindMeetingByRoomID(s.db, roomID)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "meeting not found",
+		})
+	}
+	access, err := handler.GetTranscriptAccess(s.db, meeting, claims.UserID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to check permission",
+		})
+	}
+	if !access.CanRead {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you do not have permission to read this transcript",
+		})
+	}
+	handler.RecordTranscriptAccess(s.db, c, meeting, claims.UserID, model.TranscriptAccessRead)
+
 	transcripts, err := roomHub.GetTranscripts(roomID)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
@@ -632,6 +1186,7 @@ func (s *Server) handleGetRoomTranscripts(c *fiber.Ctx) error {
 			SourceLang:  t.SourceLang,
 			TargetLang:  t.TargetLang,
 			IsFinal:     t.IsFinal,
+			Seq:         t.Sequence,
 			Timestamp:   t.Timestamp,
 		}
 	}
@@ -642,3 +1197,60 @@ func (s *Server) handleGetRoomTranscripts(c *fiber.Ctx) error {
 		"count":       len(responses),
 	})
 }
+
+// handleGetRoomParticipants Room의 실시간 참가자 목록 (미팅 호스트, 참가자, 워크스페이스 멤버만 조회 가능)
+// 이 인스턴스에 활성 Room이 없으면 빈 목록 (active: false)
+func (s *Server) handleGetRoomParticipants(c *fiber.Ctx) error {
+	roomID := c.Params("id")
+	if roomID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "room id is required",
+		})
+	}
+
+	roomHub := s.handler.GetRoomHub()
+	if roomHub == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error": "room hub not available",
+		})
+	}
+
+	claims := c.Locals("claims").(*auth.Claims)
+	meeting, err := handler.FindMeetingByRoomID(s.db, roomID)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "meeting not found",
+		})
+	}
+	access, err := handler.GetMeetingAccess(s.db, meeting, claims.UserID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "failed to check permission",
+		})
+	}
+	if !access.CanAccess() {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": "you are not a participant of this meeting",
+		})
+	}
+
+	room := roomHub.GetRoom(roomID)
+	if room == nil {
+		return c.JSON(fiber.Map{
+			"roomId":         roomID,
+			"active":         false,
+			"participants":   []handler.RosterParticipant{},
+			"relayListeners": 0,
+			"count":          0,
+		})
+	}
+
+	roster := room.Roster()
+	return c.JSON(fiber.Map{
+		"roomId":         roomID,
+		"active":         true,
+		"participants":   roster.Participants,
+		"relayListeners": roster.RelayListeners,
+		"count":          len(roster.Participants),
+	})
+}
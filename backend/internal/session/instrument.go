@@ -0,0 +1,147 @@
+package session
+
+import (
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+
+	"realtime-backend/internal/metrics"
+)
+
+// 메시지 종류 라벨 제한 (클라이언트가 보낸 type 값으로 메트릭 라벨이 끝없이 늘지 않도록)
+const (
+	maxTypeLabels   = 64
+	maxTypeLabelLen = 32
+	typeLabelBinary = "binary"
+	typeLabelOther  = "other"
+)
+
+// MessageReader 메시지를 읽는 WebSocket 연결 (*websocket.Conn)
+type MessageReader interface {
+	ReadMessage() (messageType int, p []byte, err error)
+}
+
+// MessageWriter 메시지를 쓰는 WebSocket 연결 (*websocket.Conn)
+type MessageWriter interface {
+	WriteMessage(messageType int, data []byte) error
+}
+
+// typeLabels 지금까지 본 메시지 종류 라벨 (maxTypeLabels개까지)
+var typeLabels = struct {
+	sync.RWMutex
+	seen map[string]bool
+}{seen: make(map[string]bool)}
+
+// Read 메시지 하나를 읽고 수/크기를 세션 통계와 메트릭에 기록
+// 오디오/Room/채팅 핸들러가 ReadMessage 대신 사용 (h가 nil이면 기록하지 않음)
+func (h *Handle) Read(r MessageReader) (int, []byte, error) {
+	messageType, data, err := r.ReadMessage()
+	if err != nil || h == nil {
+		return messageType, data, err
+	}
+
+	h.RecordIn(len(data))
+	label := MessageTypeLabel(messageType, data)
+	metrics.WebSocketMessages.Inc(string(h.Kind), metrics.DirectionIn, label)
+	metrics.WebSocketMessageBytes.Add(float64(len(data)), string(h.Kind), metrics.DirectionIn, label)
+	return messageType, data, nil
+}
+
+// Write 메시지 하나를 쓰고 수/크기/쓰기 지연을 세션 통계와 메트릭에 기록
+// msgType은 메트릭 라벨 (빈 값이면 본문의 type 필드, 바이너리는 "binary")
+// 호출 측 쓰기 잠금/데드라인은 그대로 유지 (h가 nil이면 기록 없이 전송만)
+func (h *Handle) Write(w MessageWriter, messageType int, msgType string, data []byte) error {
+	if h == nil {
+		return w.WriteMessage(messageType, data)
+	}
+
+	start := time.Now()
+	err := w.WriteMessage(messageType, data)
+	elapsed := time.Since(start)
+
+	kind := string(h.Kind)
+	h.recordWrite(elapsed)
+	metrics.WebSocketWriteLatency.Observe(elapsed.Seconds(), kind)
+	if err != nil {
+		metrics.WebSocketWriteErrors.Inc(kind)
+		return err
+	}
+
+	h.RecordOut(len(data))
+	label := typeLabelBinary
+	if messageType != websocket.BinaryMessage {
+		if msgType == "" {
+			label = MessageTypeLabel(messageType, data)
+		} else {
+			label = boundedTypeLabel(msgType)
+		}
+	}
+	metrics.WebSocketMessages.Inc(kind, metrics.DirectionOut, label)
+	metrics.WebSocketMessageBytes.Add(float64(len(data)), kind, metrics.DirectionOut, label)
+	return nil
+}
+
+// recordWrite 세션별 쓰기 지연 통계 (평균/최대)
+func (h *Handle) recordWrite(elapsed time.Duration) {
+	atomic.AddInt64(&h.writes, 1)
+	atomic.AddInt64(&h.writeNanos, int64(elapsed))
+	for {
+		prev := atomic.LoadInt64(&h.maxWriteNanos)
+		if int64(elapsed) <= prev || atomic.CompareAndSwapInt64(&h.maxWriteNanos, prev, int64(elapsed)) {
+			return
+		}
+	}
+}
+
+// MessageTypeLabel 메시지 종류 라벨 (바이너리는 "binary", 텍스트는 JSON type 또는 status 필드)
+func MessageTypeLabel(messageType int, data []byte) string {
+	if messageType == websocket.BinaryMessage {
+		return typeLabelBinary
+	}
+	var envelope struct {
+		Type   string `json:"type"`
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return typeLabelOther
+	}
+	if envelope.Type == "" {
+		envelope.Type = envelope.Status
+	}
+	return boundedTypeLabel(envelope.Type)
+}
+
+// boundedTypeLabel 라벨로 쓸 수 있는 값이면 그대로, 아니면 "other"
+// (영소문자/숫자/_.- 만, 길이 제한, 서로 다른 값은 maxTypeLabels개까지)
+func boundedTypeLabel(label string) string {
+	if label == "" || len(label) > maxTypeLabelLen {
+		return typeLabelOther
+	}
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_' || c == '.' || c == '-') {
+			return typeLabelOther
+		}
+	}
+
+	typeLabels.RLock()
+	seen := typeLabels.seen[label]
+	typeLabels.RUnlock()
+	if seen {
+		return label
+	}
+
+	typeLabels.Lock()
+	defer typeLabels.Unlock()
+	if typeLabels.seen[label] {
+		return label
+	}
+	if len(typeLabels.seen) >= maxTypeLabels {
+		return typeLabelOther
+	}
+	typeLabels.seen[label] = true
+	return label
+}
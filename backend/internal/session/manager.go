@@ -0,0 +1,356 @@
+package session
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Kind WebSocket 세션 종류
+type Kind string
+
+const (
+	KindAudio Kind = "audio" // /ws/audio 1:1 오디오 스트리밍
+	KindRoom  Kind = "room"  // /ws/room 리스너 (Room 단위 자막/TTS)
+	KindChat  Kind = "chat"  // /ws/chat 채팅
+
+	KindWhiteboard Kind = "whiteboard" // /ws/whiteboard 화이트보드 실시간 동기화
+)
+
+// Default 서버 전역 세션 관리자
+var Default = NewManager()
+
+// NewID 세션 ID 생성
+func NewID() string {
+	return uuid.New().String()
+}
+
+// Handle 관리자에 등록된 WebSocket 세션 (Thread-Safe)
+type Handle struct {
+	ID          string
+	Kind        Kind
+	ConnectedAt time.Time
+
+	roomID   string
+	userID   string
+	metadata map[string]string
+	mu       sync.RWMutex
+
+	// 통계 (atomic)
+	messagesIn  int64
+	messagesOut int64
+	bytesIn     int64
+	bytesOut    int64
+	lastActive  int64 // UnixNano
+
+	// 쓰기 지연 (Write로 보낸 메시지만)
+	writes        int64
+	writeNanos    int64
+	maxWriteNanos int64
+
+	inRate  RateCounter
+	outRate RateCounter
+
+	manager   *Manager
+	closeOnce sync.Once
+
+	onDrain func(DrainNotice) // 서버 종료 알림 전송 (nil = 알림 없음)
+}
+
+// DrainNotice 서버 종료 전 클라이언트에 보내는 "server_closing" 알림
+type DrainNotice struct {
+	Reason           string `json:"reason"`
+	ReconnectAfterMs int64  `json:"reconnectAfterMs"` // 이 시간 뒤 다른 인스턴스로 재접속 권장
+}
+
+// Info 세션 상태 스냅샷 (통계 API용)
+type Info struct {
+	ID          string            `json:"id"`
+	Kind        Kind              `json:"kind"`
+	RoomID      string            `json:"roomId,omitempty"`
+	UserID      string            `json:"userId,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	ConnectedAt time.Time         `json:"connectedAt"`
+	LastActive  time.Time         `json:"lastActive"`
+	MessagesIn  int64             `json:"messagesIn"`
+	MessagesOut int64             `json:"messagesOut"`
+	BytesIn     int64             `json:"bytesIn"`
+	BytesOut    int64             `json:"bytesOut"`
+	InPerSec    float64           `json:"inPerSec"`
+	OutPerSec   float64           `json:"outPerSec"`
+	AvgWriteMs  float64           `json:"avgWriteMs"`
+	MaxWriteMs  float64           `json:"maxWriteMs"`
+}
+
+// SetRoomID 세션이 속한 방 설정
+func (h *Handle) SetRoomID(roomID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.roomID = roomID
+}
+
+// SetUserID 세션 사용자(참가자/리스너) 설정
+func (h *Handle) SetUserID(userID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.userID = userID
+}
+
+// RoomID 세션이 속한 방 ID
+func (h *Handle) RoomID() string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.roomID
+}
+
+// UserID 세션 사용자 ID
+func (h *Handle) UserID() string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.userID
+}
+
+// SetMeta 메타데이터 설정 (빈 값이면 삭제)
+func (h *Handle) SetMeta(key, value string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if value == "" {
+		delete(h.metadata, key)
+		return
+	}
+	h.metadata[key] = value
+}
+
+// Meta 메타데이터 조회
+func (h *Handle) Meta(key string) string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.metadata[key]
+}
+
+// OnDrain 서버 종료 시 클라이언트에 알림을 보낼 함수 등록
+func (h *Handle) OnDrain(fn func(DrainNotice)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.onDrain = fn
+}
+
+// RecordIn 수신 메시지 기록
+func (h *Handle) RecordIn(bytes int) {
+	atomic.AddInt64(&h.messagesIn, 1)
+	atomic.AddInt64(&h.bytesIn, int64(bytes))
+	atomic.StoreInt64(&h.lastActive, time.Now().UnixNano())
+	h.inRate.Add(1)
+}
+
+// RecordOut 송신 메시지 기록
+func (h *Handle) RecordOut(bytes int) {
+	atomic.AddInt64(&h.messagesOut, 1)
+	atomic.AddInt64(&h.bytesOut, int64(bytes))
+	h.outRate.Add(1)
+}
+
+// InRate 최근 초당 수신 메시지 수 (클라이언트 속도 제한 판단용)
+func (h *Handle) InRate() float64 {
+	return h.inRate.PerSecond()
+}
+
+// Snapshot 현재 상태 스냅샷
+func (h *Handle) Snapshot() Info {
+	h.mu.RLock()
+	metadata := make(map[string]string, len(h.metadata))
+	for k, v := range h.metadata {
+		metadata[k] = v
+	}
+	info := Info{
+		ID:          h.ID,
+		Kind:        h.Kind,
+		RoomID:      h.roomID,
+		UserID:      h.userID,
+		Metadata:    metadata,
+		ConnectedAt: h.ConnectedAt,
+	}
+	h.mu.RUnlock()
+
+	info.LastActive = time.Unix(0, atomic.LoadInt64(&h.lastActive))
+	info.MessagesIn = atomic.LoadInt64(&h.messagesIn)
+	info.MessagesOut = atomic.LoadInt64(&h.messagesOut)
+	info.BytesIn = atomic.LoadInt64(&h.bytesIn)
+	info.BytesOut = atomic.LoadInt64(&h.bytesOut)
+	info.InPerSec = h.inRate.PerSecond()
+	info.OutPerSec = h.outRate.PerSecond()
+	if writes := atomic.LoadInt64(&h.writes); writes > 0 {
+		info.AvgWriteMs = float64(atomic.LoadInt64(&h.writeNanos)) / float64(writes) / float64(time.Millisecond)
+	}
+	info.MaxWriteMs = float64(atomic.LoadInt64(&h.maxWriteNanos)) / float64(time.Millisecond)
+	return info
+}
+
+// Close 관리자에서 세션 제거 (여러 번 호출해도 안전)
+func (h *Handle) Close() {
+	h.closeOnce.Do(func() {
+		h.manager.remove(h)
+	})
+}
+
+// Manager 오디오/Room/채팅 WebSocket 세션 공통 관리 (ID 발급, 통계, 생명주기 콜백)
+type Manager struct {
+	sessions map[string]*Handle
+	mu       sync.RWMutex
+
+	onOpen  []func(*Handle)
+	onClose []func(*Handle, Info)
+	hooksMu sync.RWMutex
+}
+
+// Stats 세션 종류별 통계
+type Stats struct {
+	Total    int          `json:"total"`
+	ByKind   map[Kind]int `json:"byKind"`
+	Sessions []Info       `json:"sessions,omitempty"`
+}
+
+// NewManager 세션 관리자 생성
+func NewManager() *Manager {
+	return &Manager{
+		sessions: make(map[string]*Handle),
+	}
+}
+
+// OnOpen 세션 등록 시 호출할 콜백 추가
+func (m *Manager) OnOpen(fn func(*Handle)) {
+	m.hooksMu.Lock()
+	defer m.hooksMu.Unlock()
+	m.onOpen = append(m.onOpen, fn)
+}
+
+// OnClose 세션 종료 시 호출할 콜백 추가 (마지막 스냅샷 전달)
+func (m *Manager) OnClose(fn func(*Handle, Info)) {
+	m.hooksMu.Lock()
+	defer m.hooksMu.Unlock()
+	m.onClose = append(m.onClose, fn)
+}
+
+// Open 새 세션 등록 (id가 빈 값이면 새로 발급)
+func (m *Manager) Open(kind Kind, id string) *Handle {
+	if id == "" {
+		id = NewID()
+	}
+	now := time.Now()
+	h := &Handle{
+		ID:          id,
+		Kind:        kind,
+		ConnectedAt: now,
+		metadata:    make(map[string]string),
+		lastActive:  now.UnixNano(),
+		manager:     m,
+	}
+
+	m.mu.Lock()
+	m.sessions[id] = h
+	m.mu.Unlock()
+
+	m.hooksMu.RLock()
+	hooks := m.onOpen
+	m.hooksMu.RUnlock()
+	for _, fn := range hooks {
+		fn(h)
+	}
+	return h
+}
+
+// remove 세션 제거 후 종료 콜백 호출 (같은 ID로 다시 등록된 세션은 유지)
+func (m *Manager) remove(h *Handle) {
+	m.mu.Lock()
+	if m.sessions[h.ID] == h {
+		delete(m.sessions, h.ID)
+	}
+	m.mu.Unlock()
+
+	m.hooksMu.RLock()
+	hooks := m.onClose
+	m.hooksMu.RUnlock()
+	if len(hooks) == 0 {
+		return
+	}
+	info := h.Snapshot()
+	for _, fn := range hooks {
+		fn(h, info)
+	}
+}
+
+// Get 세션 조회
+func (m *Manager) Get(id string) (*Handle, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	h, ok := m.sessions[id]
+	return h, ok
+}
+
+// Drain OnDrain을 등록한 모든 세션에 종료 알림 전송, 알림을 보낸 세션 수 반환
+func (m *Manager) Drain(notice DrainNotice) int {
+	m.mu.RLock()
+	handles := make([]*Handle, 0, len(m.sessions))
+	for _, h := range m.sessions {
+		handles = append(handles, h)
+	}
+	m.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	notified := 0
+	for _, h := range handles {
+		h.mu.RLock()
+		fn := h.onDrain
+		h.mu.RUnlock()
+		if fn == nil {
+			continue
+		}
+		notified++
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn(notice)
+		}()
+	}
+	wg.Wait()
+	return notified
+}
+
+// Counts 종류별 세션 수
+func (m *Manager) Counts() map[Kind]int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	counts := map[Kind]int{KindAudio: 0, KindRoom: 0, KindChat: 0, KindWhiteboard: 0}
+	for _, h := range m.sessions {
+		counts[h.Kind]++
+	}
+	return counts
+}
+
+// Stats 세션 통계 (withSessions면 세션별 스냅샷 포함, 최근 연결 순)
+func (m *Manager) Stats(withSessions bool) Stats {
+	m.mu.RLock()
+	handles := make([]*Handle, 0, len(m.sessions))
+	for _, h := range m.sessions {
+		handles = append(handles, h)
+	}
+	m.mu.RUnlock()
+
+	stats := Stats{Total: len(handles), ByKind: map[Kind]int{KindAudio: 0, KindRoom: 0, KindChat: 0, KindWhiteboard: 0}}
+	for _, h := range handles {
+		stats.ByKind[h.Kind]++
+	}
+	if withSessions {
+		sort.Slice(handles, func(i, j int) bool { return handles[i].ConnectedAt.After(handles[j].ConnectedAt) })
+		stats.Sessions = make([]Info, 0, len(handles))
+		for _, h := range handles {
+			stats.Sessions = append(stats.Sessions, h.Snapshot())
+		}
+	}
+	return stats
+}
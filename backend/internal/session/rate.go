@@ -0,0 +1,55 @@
+package session
+
+import (
+	"sync"
+	"time"
+)
+
+// rateWindow 초당 비율 계산 구간 (1초 버킷 개수)
+const rateWindow = 10
+
+// RateCounter 최근 rateWindow초 동안의 초당 발생 횟수 (Thread-Safe)
+type RateCounter struct {
+	buckets [rateWindow]int64
+	seconds [rateWindow]int64 // 각 버킷의 Unix 초 (오래된 버킷 판별용)
+	mu      sync.Mutex
+}
+
+// Add 현재 초에 n회 기록
+func (r *RateCounter) Add(n int64) {
+	r.addAt(time.Now(), n)
+}
+
+func (r *RateCounter) addAt(now time.Time, n int64) {
+	sec := now.Unix()
+	i := sec % rateWindow
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.seconds[i] != sec {
+		r.seconds[i] = sec
+		r.buckets[i] = 0
+	}
+	r.buckets[i] += n
+}
+
+// PerSecond 최근 구간의 초당 평균
+func (r *RateCounter) PerSecond() float64 {
+	return r.perSecondAt(time.Now())
+}
+
+func (r *RateCounter) perSecondAt(now time.Time) float64 {
+	sec := now.Unix()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var total int64
+	for i := range r.buckets {
+		if sec-r.seconds[i] < rateWindow {
+			total += r.buckets[i]
+		}
+	}
+	return float64(total) / rateWindow
+}
@@ -5,8 +5,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/google/uuid"
-
 	"realtime-backend/internal/model"
 )
 
@@ -71,14 +69,19 @@ type Session struct {
 
 	// 자막(Transcript) 전송용 채널
 	TranscriptChan chan *TranscriptMessage
+
+	// 세션 관리자 등록 정보 (통계, 생명주기 콜백)
+	Handle *Handle
 }
 
-// New 새 세션 생성
+// New 새 오디오 세션 생성 (Default 관리자에 등록)
 func New(bufferSize int) *Session {
 	ctx, cancel := context.WithCancel(context.Background())
+	handle := Default.Open(KindAudio, "")
 
 	return &Session{
-		ID:             uuid.New().String(),
+		ID:             handle.ID,
+		Handle:         handle,
 		State:          StateAwaitingHeader,
 		ConnectedAt:    time.Now(),
 		AudioPackets:   make(chan *model.AudioPacket, bufferSize),
@@ -117,6 +120,7 @@ func (s *Session) SetSourceLanguage(lang string) {
 	defer s.mu.Unlock()
 
 	s.SourceLanguage = lang
+	s.Handle.SetMeta("sourceLang", lang)
 }
 
 // GetSourceLanguage 발화자가 말하는 언어 조회
@@ -136,6 +140,7 @@ func (s *Session) SetLanguage(lang string) {
 	defer s.mu.Unlock()
 
 	s.Language = lang
+	s.Handle.SetMeta("targetLang", lang)
 }
 
 // GetLanguage 번역 대상 언어 조회
@@ -155,6 +160,7 @@ func (s *Session) SetParticipantID(participantID string) {
 	defer s.mu.Unlock()
 
 	s.ParticipantID = participantID
+	s.Handle.SetUserID(participantID)
 }
 
 // GetParticipantID 발화자 식별 ID 조회
@@ -171,6 +177,7 @@ func (s *Session) SetRoomID(roomID string) {
 	defer s.mu.Unlock()
 
 	s.RoomID = roomID
+	s.Handle.SetRoomID(roomID)
 }
 
 // GetRoomID 방 ID 조회
@@ -245,6 +252,7 @@ func (s *Session) Close() {
 	}
 
 	s.State = StateClosed
+	s.Handle.Close()
 	s.cancel()
 	close(s.AudioPackets)
 	close(s.EchoPackets)
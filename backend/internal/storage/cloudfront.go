@@ -0,0 +1,179 @@
+package storage
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	appconfig "realtime-backend/internal/config"
+)
+
+// cloudFrontSigner CloudFront 서명 URL/쿠키 생성 (신뢰할 수 있는 키 그룹의 공개 키 ID + 개인 키)
+type cloudFrontSigner struct {
+	domain       string
+	keyPairID    string
+	key          *rsa.PrivateKey
+	cookieDomain string
+}
+
+// cloudFrontEncoding CloudFront용 base64 (+ → -, = → _, / → ~)
+var cloudFrontEncoding = strings.NewReplacer("+", "-", "=", "_", "/", "~")
+
+// newCloudFrontSigner 배포 도메인이 설정된 경우에만 생성 (nil = S3 presigned URL 사용)
+func newCloudFrontSigner(cfg *appconfig.S3Config) (*cloudFrontSigner, error) {
+	if cfg.CloudFrontDomain == "" {
+		return nil, nil
+	}
+	if cfg.CloudFrontKeyPairID == "" {
+		return nil, errors.New("CloudFront key pair ID is not set")
+	}
+
+	keyPEM := []byte(strings.ReplaceAll(cfg.CloudFrontPrivateKey, `\n`, "\n"))
+	if cfg.CloudFrontPrivateKeyPath != "" {
+		data, err := os.ReadFile(cfg.CloudFrontPrivateKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CloudFront private key: %w", err)
+		}
+		keyPEM = data
+	}
+	key, err := parseRSAPrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	domain := strings.TrimSuffix(strings.TrimPrefix(cfg.CloudFrontDomain, "https://"), "/")
+	return &cloudFrontSigner{
+		domain:       domain,
+		keyPairID:    cfg.CloudFrontKeyPairID,
+		key:          key,
+		cookieDomain: cfg.CloudFrontCookieDomain,
+	}, nil
+}
+
+func parseRSAPrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("CloudFront private key is not PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CloudFront private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("CloudFront private key must be an RSA key")
+	}
+	return key, nil
+}
+
+// resourceURL 객체 키의 배포 URL (경로 조각별 이스케이프)
+func (cf *cloudFrontSigner) resourceURL(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return "https://" + cf.domain + "/" + strings.Join(segments, "/")
+}
+
+// sign 정책 서명 (CloudFront는 RSA-SHA1만 지원)
+func (cf *cloudFrontSigner) sign(policy []byte) (string, error) {
+	digest := sha1.Sum(policy)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, cf.key, crypto.SHA1, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign CloudFront policy: %w", err)
+	}
+	return cloudFrontEncoding.Replace(base64.StdEncoding.EncodeToString(signature)), nil
+}
+
+// cloudFrontPolicy 만료 시각만 지정한 정책 (resource 끝의 *는 접두어 일치)
+func cloudFrontPolicy(resource string, expiresAt time.Time) ([]byte, error) {
+	type condition struct {
+		DateLessThan map[string]int64 `json:"DateLessThan"`
+	}
+	type statement struct {
+		Resource  string    `json:"Resource"`
+		Condition condition `json:"Condition"`
+	}
+	return json.Marshal(struct {
+		Statement []statement `json:"Statement"`
+	}{
+		Statement: []statement{{
+			Resource:  resource,
+			Condition: condition{DateLessThan: map[string]int64{"AWS:EpochTime": expiresAt.Unix()}},
+		}},
+	})
+}
+
+// signURL 고정 정책(canned policy) 서명 URL
+func (cf *cloudFrontSigner) signURL(key string, expiresAt time.Time) (string, error) {
+	resource := cf.resourceURL(key)
+	policy, err := cloudFrontPolicy(resource, expiresAt)
+	if err != nil {
+		return "", err
+	}
+	signature, err := cf.sign(policy)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s?Expires=%d&Signature=%s&Key-Pair-Id=%s", resource, expiresAt.Unix(), signature, cf.keyPairID), nil
+}
+
+// signCookies 접두어 아래 모든 객체에 쓸 수 있는 사용자 정책(custom policy) 서명 쿠키
+func (cf *cloudFrontSigner) signCookies(prefix string, expiresAt time.Time) ([]*http.Cookie, error) {
+	policy, err := cloudFrontPolicy(cf.resourceURL(prefix)+"*", expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := cf.sign(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	values := []struct{ name, value string }{
+		{"CloudFront-Policy", cloudFrontEncoding.Replace(base64.StdEncoding.EncodeToString(policy))},
+		{"CloudFront-Signature", signature},
+		{"CloudFront-Key-Pair-Id", cf.keyPairID},
+	}
+	cookies := make([]*http.Cookie, len(values))
+	for i, v := range values {
+		cookies[i] = &http.Cookie{
+			Name:     v.name,
+			Value:    v.value,
+			Domain:   cf.cookieDomain,
+			Path:     "/" + strings.TrimSuffix(prefix, "/"),
+			Expires:  expiresAt,
+			Secure:   true,
+			HttpOnly: true,
+			SameSite: http.SameSiteNoneMode,
+		}
+	}
+	return cookies, nil
+}
+
+// CloudFrontEnabled 다운로드를 CloudFront로 제공하는지 여부
+func (s *S3Service) CloudFrontEnabled() bool {
+	return s.cloudFront != nil
+}
+
+// SignedCookies 접두어 아래 객체를 CloudFront에서 내려받을 서명 쿠키 (여러 조각을 재생할 때 URL마다 서명하지 않도록)
+func (s *S3Service) SignedCookies(prefix string) ([]*http.Cookie, error) {
+	if s.cloudFront == nil {
+		return nil, errors.New("CloudFront is not configured")
+	}
+	return s.cloudFront.signCookies(prefix, time.Now().Add(s.presignExpiry))
+}
@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/uuid"
+
+	"realtime-backend/internal/retry"
+)
+
+// copyPartSize 5GB보다 큰 객체를 UploadPartCopy로 복사할 때 조각 크기
+const copyPartSize = 512 * 1024 * 1024
+
+// NewWorkspaceKey 워크스페이스 파일 새 키 (GenerateUploadURL과 같은 형식)
+func NewWorkspaceKey(workspaceID int64, fileName string) string {
+	return fmt.Sprintf("workspaces/%d/%s/%s", workspaceID, uuid.New().String(), sanitizeFileName(fileName))
+}
+
+// RenamedKey 같은 경로에서 파일명만 바꾼 키 (키 마지막 부분이 파일명이므로 다운로드 이름과 일치시키기 위함)
+func RenamedKey(key, fileName string) string {
+	return path.Join(path.Dir(key), sanitizeFileName(fileName))
+}
+
+// DerivedKey 원본에서 만든 파생 객체(썸네일 등) 키: derived/{원본 키}/{name}
+// 사용자가 정한 파일명과 겹치지 않도록 원본과 다른 접두어 아래에 둠
+func DerivedKey(key, name string) string {
+	return path.Join("derived", key, name)
+}
+
+// CopyObject 버킷 안에서 객체 복사 (대상 워크스페이스 암호화 설정 적용, 5GB보다 크면 조각 복사)
+func (s *S3Service) CopyObject(ctx context.Context, workspaceID int64, srcKey, dstKey string) error {
+	info, err := s.HeadObject(ctx, srcKey)
+	if err != nil {
+		return err
+	}
+	if info.Size > MaxPutSize {
+		return s.copyMultipart(ctx, workspaceID, srcKey, dstKey, info)
+	}
+
+	input := &s3.CopyObjectInput{
+		Bucket:     aws.String(s.bucketName),
+		Key:        aws.String(dstKey),
+		CopySource: aws.String(s.copySource(srcKey)),
+		// 휴지통 태그 등은 복사하지 않음
+		TaggingDirective: types.TaggingDirectiveReplace,
+	}
+	s.encryptionFor(workspaceID).applyCopy(input)
+	err = retry.Do(ctx, s3RetryPolicy, func(ctx context.Context) error {
+		_, err := s.client.CopyObject(ctx, input)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to copy object: %w", err)
+	}
+	return nil
+}
+
+// copyMultipart UploadPartCopy로 큰 객체 복사 (실패하면 업로드 취소)
+func (s *S3Service) copyMultipart(ctx context.Context, workspaceID int64, srcKey, dstKey string, info *ObjectInfo) error {
+	partSize := int64(copyPartSize)
+	for (info.Size+partSize-1)/partSize > MaxParts {
+		partSize *= 2
+	}
+	uploadID, err := s.createMultipart(ctx, workspaceID, dstKey, info.ContentType)
+	if err != nil {
+		return err
+	}
+
+	var parts []CompletedPart
+	for offset, partNumber := int64(0), int32(1); offset < info.Size; offset, partNumber = offset+partSize, partNumber+1 {
+		byteRange := fmt.Sprintf("bytes=%d-%d", offset, min(offset+partSize, info.Size)-1)
+		etag, err := retry.DoValue(ctx, s3RetryPolicy, func(ctx context.Context) (string, error) {
+			out, err := s.client.UploadPartCopy(ctx, &s3.UploadPartCopyInput{
+				Bucket:          aws.String(s.bucketName),
+				Key:             aws.String(dstKey),
+				UploadId:        aws.String(uploadID),
+				PartNumber:      aws.Int32(partNumber),
+				CopySource:      aws.String(s.copySource(srcKey)),
+				CopySourceRange: aws.String(byteRange),
+			})
+			if err != nil {
+				return "", err
+			}
+			return aws.ToString(out.CopyPartResult.ETag), nil
+		})
+		if err != nil {
+			s.AbortMultipartUpload(context.Background(), dstKey, uploadID)
+			return fmt.Errorf("failed to copy part %d: %w", partNumber, err)
+		}
+		parts = append(parts, CompletedPart{PartNumber: partNumber, ETag: etag})
+	}
+
+	if err := s.CompleteMultipartUpload(ctx, dstKey, uploadID, parts); err != nil {
+		s.AbortMultipartUpload(context.Background(), dstKey, uploadID)
+		return err
+	}
+	return nil
+}
+
+// copySource CopySource 헤더 값 (버킷/키, 키는 경로 조각별 URL 인코딩)
+func (s *S3Service) copySource(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return s.bucketName + "/" + strings.Join(segments, "/")
+}
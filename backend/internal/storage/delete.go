@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"realtime-backend/internal/retry"
+)
+
+// 일괄 삭제 설정
+const (
+	deleteBatchSize     = 1000 // DeleteObjects 한 번에 보낼 수 있는 최대 키 수
+	deleteKeyAttempts   = 3    // 일시적 오류로 실패한 키 재시도 횟수
+	deleteRetryInterval = 500 * time.Millisecond
+)
+
+// retryableDeleteCodes 다시 시도하면 성공할 수 있는 키별 오류 코드
+var retryableDeleteCodes = map[string]bool{
+	"InternalError":      true,
+	"SlowDown":           true,
+	"ServiceUnavailable": true,
+	"RequestTimeout":     true,
+}
+
+// DeleteFailure 삭제하지 못한 객체
+type DeleteFailure struct {
+	Key     string `json:"key"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// DeleteFilesError 일부 객체 삭제 실패 (나머지는 삭제됨)
+type DeleteFilesError struct {
+	Failed []DeleteFailure
+}
+
+func (e *DeleteFilesError) Error() string {
+	codes := make([]string, 0, min(len(e.Failed), 3))
+	for _, f := range e.Failed[:min(len(e.Failed), 3)] {
+		codes = append(codes, f.Key+": "+f.Code)
+	}
+	return fmt.Sprintf("failed to delete %d object(s) (%s)", len(e.Failed), strings.Join(codes, ", "))
+}
+
+// FailedKeys 삭제하지 못한 키 집합
+func (e *DeleteFilesError) FailedKeys() map[string]bool {
+	keys := make(map[string]bool, len(e.Failed))
+	for _, f := range e.Failed {
+		keys[f.Key] = true
+	}
+	return keys
+}
+
+// FailedDeleteKeys DeleteFiles 오류에서 삭제하지 못한 키 집합 (오류가 없으면 nil)
+func FailedDeleteKeys(err error) map[string]bool {
+	var deleteErr *DeleteFilesError
+	if errors.As(err, &deleteErr) {
+		return deleteErr.FailedKeys()
+	}
+	return nil
+}
+
+// DeleteFiles 여러 파일을 DeleteObjects로 1000개씩 일괄 삭제
+// 일시적 오류로 실패한 키는 다시 시도하고, 끝까지 실패한 키는 *DeleteFilesError로 반환
+func (s *S3Service) DeleteFiles(ctx context.Context, keys []string) error {
+	seen := make(map[string]bool, len(keys))
+	unique := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key != "" && !seen[key] {
+			seen[key] = true
+			unique = append(unique, key)
+		}
+	}
+
+	var failed []DeleteFailure
+	for start := 0; start < len(unique); start += deleteBatchSize {
+		batch := unique[start:min(start+deleteBatchSize, len(unique))]
+		failed = append(failed, s.deleteBatch(ctx, batch)...)
+	}
+	if len(failed) > 0 {
+		return &DeleteFilesError{Failed: failed}
+	}
+	return nil
+}
+
+// deleteBatch 키 묶음 하나 삭제 (요청 오류는 retry 정책, 키별 일시적 오류는 deleteKeyAttempts번까지 재시도)
+func (s *S3Service) deleteBatch(ctx context.Context, keys []string) []DeleteFailure {
+	var failed []DeleteFailure
+	pending := keys
+	for attempt := 1; attempt <= deleteKeyAttempts && len(pending) > 0; attempt++ {
+		objects := make([]types.ObjectIdentifier, len(pending))
+		for i, key := range pending {
+			objects[i] = types.ObjectIdentifier{Key: aws.String(key)}
+		}
+
+		out, err := retry.DoValue(ctx, s3RetryPolicy, func(ctx context.Context) (*s3.DeleteObjectsOutput, error) {
+			return s.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+				Bucket: aws.String(s.bucketName),
+				Delete: &types.Delete{Objects: objects, Quiet: aws.Bool(true)},
+			})
+		})
+		if err != nil {
+			// 요청 자체가 실패하면 남은 키 모두 실패
+			for _, key := range pending {
+				failed = append(failed, DeleteFailure{Key: key, Code: "RequestFailed", Message: err.Error()})
+			}
+			return failed
+		}
+
+		var retryKeys []string
+		for _, e := range out.Errors {
+			f := DeleteFailure{Key: aws.ToString(e.Key), Code: aws.ToString(e.Code), Message: aws.ToString(e.Message)}
+			if retryableDeleteCodes[f.Code] && attempt < deleteKeyAttempts {
+				retryKeys = append(retryKeys, f.Key)
+				continue
+			}
+			failed = append(failed, f)
+		}
+		pending = retryKeys
+
+		if len(pending) > 0 {
+			select {
+			case <-ctx.Done():
+				for _, key := range pending {
+					failed = append(failed, DeleteFailure{Key: key, Code: "Canceled", Message: ctx.Err().Error()})
+				}
+				return failed
+			case <-time.After(deleteRetryInterval * time.Duration(attempt)):
+			}
+		}
+	}
+	return failed
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+
+	"realtime-backend/internal/retry"
+)
+
+// 서버가 관리하는 버킷 수명 주기 규칙 ID (다른 규칙은 그대로 유지)
+const (
+	trashExpirationRuleID = "eum-trash-expiration"
+	abortMultipartRuleID  = "eum-abort-incomplete-multipart"
+
+	// trashExpirationGrace 영구 삭제 작업이 먼저 처리하도록 보관 기간보다 늦게 만료
+	trashExpirationGrace = 7 * 24 * time.Hour
+	// abortMultipartDays 완료되지 않은 멀티파트 업로드 조각 보관 일수
+	abortMultipartDays = 7
+)
+
+// EnsureLifecycleRules 휴지통 태그 객체 만료와 미완료 멀티파트 업로드 정리 규칙을 버킷에 설정
+// 영구 삭제 작업이 놓친 객체(서버 중단, 삭제 실패 등)도 보관 기간 + 유예 기간 뒤 S3가 삭제
+func (s *S3Service) EnsureLifecycleRules(ctx context.Context, trashRetention time.Duration) error {
+	var rules []types.LifecycleRule
+	out, err := retry.DoValue(ctx, s3RetryPolicy, func(ctx context.Context) (*s3.GetBucketLifecycleConfigurationOutput, error) {
+		return s.client.GetBucketLifecycleConfiguration(ctx, &s3.GetBucketLifecycleConfigurationInput{
+			Bucket: aws.String(s.bucketName),
+		})
+	})
+	var apiErr smithy.APIError
+	switch {
+	case err == nil:
+		rules = out.Rules
+	case errors.As(err, &apiErr) && apiErr.ErrorCode() == "NoSuchLifecycleConfiguration":
+	default:
+		return fmt.Errorf("failed to get bucket lifecycle: %w", err)
+	}
+
+	expireDays := int32((trashRetention + trashExpirationGrace + 24*time.Hour - 1) / (24 * time.Hour))
+	managed := []types.LifecycleRule{
+		{
+			ID:     aws.String(trashExpirationRuleID),
+			Status: types.ExpirationStatusEnabled,
+			Filter: &types.LifecycleRuleFilter{
+				Tag: &types.Tag{Key: aws.String(TrashTagKey), Value: aws.String("true")},
+			},
+			Expiration: &types.LifecycleExpiration{Days: aws.Int32(expireDays)},
+		},
+		{
+			ID:                             aws.String(abortMultipartRuleID),
+			Status:                         types.ExpirationStatusEnabled,
+			Filter:                         &types.LifecycleRuleFilter{Prefix: aws.String("")},
+			AbortIncompleteMultipartUpload: &types.AbortIncompleteMultipartUpload{DaysAfterInitiation: aws.Int32(abortMultipartDays)},
+		},
+	}
+
+	merged := make([]types.LifecycleRule, 0, len(rules)+len(managed))
+	for _, rule := range rules {
+		id := aws.ToString(rule.ID)
+		if id != trashExpirationRuleID && id != abortMultipartRuleID {
+			merged = append(merged, rule)
+		}
+	}
+	merged = append(merged, managed...)
+
+	err = retry.Do(ctx, s3RetryPolicy, func(ctx context.Context) error {
+		_, err := s.client.PutBucketLifecycleConfiguration(ctx, &s3.PutBucketLifecycleConfigurationInput{
+			Bucket:                 aws.String(s.bucketName),
+			LifecycleConfiguration: &types.BucketLifecycleConfiguration{Rules: merged},
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put bucket lifecycle: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"realtime-backend/internal/retry"
+)
+
+// 멀티파트 업로드 제한 (S3 규격)
+// 중단된 업로드의 조각은 버킷 수명 주기 규칙(AbortIncompleteMultipartUpload)으로 정리
+const (
+	MinPartSize      = 5 * 1024 * 1024  // 마지막 조각을 제외한 최소 크기
+	DefaultPartSize  = 16 * 1024 * 1024 // 기본 조각 크기
+	MaxParts         = 10000
+	MaxMultipartSize = 5 * 1024 * 1024 * 1024 * 1024 // 객체 최대 크기 (5TB)
+	MaxPutSize       = 5 * 1024 * 1024 * 1024        // 단일 PUT 최대 크기 (5GB)
+
+	// MultipartThreshold 서버 사이드 업로드에서 이보다 크면 멀티파트로 업로드
+	MultipartThreshold = 64 * 1024 * 1024
+)
+
+// ErrTooLarge 멀티파트로도 올릴 수 없는 크기
+var ErrTooLarge = errors.New("file exceeds the maximum object size")
+
+// MultipartUpload 시작한 멀티파트 업로드 (클라이언트는 UploadID와 Key로 이어 올림)
+type MultipartUpload struct {
+	UploadID  string `json:"upload_id"`
+	Key       string `json:"key"`
+	PartSize  int64  `json:"part_size"`
+	PartCount int32  `json:"part_count"`
+}
+
+// CompletedPart 업로드를 마친 조각 (PUT 응답의 ETag)
+type CompletedPart struct {
+	PartNumber int32  `json:"part_number"`
+	ETag       string `json:"etag"`
+}
+
+// UploadedPart S3에 이미 올라간 조각 (이어 올리기용)
+type UploadedPart struct {
+	PartNumber int32  `json:"part_number"`
+	ETag       string `json:"etag"`
+	Size       int64  `json:"size"`
+}
+
+// PartSizeFor 조각 수가 MaxParts를 넘지 않는 조각 크기
+func PartSizeFor(size int64) (int64, int32, error) {
+	if size > MaxMultipartSize {
+		return 0, 0, ErrTooLarge
+	}
+	partSize := int64(DefaultPartSize)
+	for (size+partSize-1)/partSize > MaxParts {
+		partSize *= 2
+	}
+	count := max(1, (size+partSize-1)/partSize)
+	return partSize, int32(count), nil
+}
+
+// CreateMultipartUpload 워크스페이스 파일 멀티파트 업로드 시작 (키 형식은 GenerateUploadURL과 같음)
+func (s *S3Service) CreateMultipartUpload(ctx context.Context, workspaceID int64, fileName, contentType string, size int64) (*MultipartUpload, error) {
+	partSize, partCount, err := PartSizeFor(size)
+	if err != nil {
+		return nil, err
+	}
+
+	key := NewWorkspaceKey(workspaceID, fileName)
+	uploadID, err := s.createMultipart(ctx, workspaceID, key, contentType)
+	if err != nil {
+		return nil, err
+	}
+	return &MultipartUpload{UploadID: uploadID, Key: key, PartSize: partSize, PartCount: partCount}, nil
+}
+
+// createMultipart 멀티파트 업로드 시작 (암호화는 여기서 지정하면 조각에도 적용됨)
+func (s *S3Service) createMultipart(ctx context.Context, workspaceID int64, key, contentType string) (string, error) {
+	input := &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(s.bucketName),
+		Key:         aws.String(key),
+		ContentType: aws.String(contentType),
+	}
+	s.encryptionFor(workspaceID).applyMultipart(input)
+	out, err := retry.DoValue(ctx, s3RetryPolicy, func(ctx context.Context) (*s3.CreateMultipartUploadOutput, error) {
+		return s.client.CreateMultipartUpload(ctx, input)
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create multipart upload: %w", err)
+	}
+	return aws.ToString(out.UploadId), nil
+}
+
+// PresignUploadPart 조각 하나를 올릴 PUT Presigned URL 생성
+func (s *S3Service) PresignUploadPart(ctx context.Context, key, uploadID string, partNumber int32) (*PresignedURL, error) {
+	if partNumber < 1 || partNumber > MaxParts {
+		return nil, fmt.Errorf("invalid part number %d", partNumber)
+	}
+
+	expiresAt := time.Now().Add(s.presignExpiry)
+	presignResult, err := s.presignClient.PresignUploadPart(ctx, &s3.UploadPartInput{
+		Bucket:     aws.String(s.bucketName),
+		Key:        aws.String(key),
+		UploadId:   aws.String(uploadID),
+		PartNumber: aws.Int32(partNumber),
+	}, func(opts *s3.PresignOptions) {
+		opts.Expires = s.presignExpiry
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate part upload URL: %w", err)
+	}
+
+	return &PresignedURL{
+		URL:       presignResult.URL,
+		Key:       key,
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// ListUploadedParts 지금까지 올라간 조각 목록 (조각 번호 순, 이어 올릴 때 빠진 조각 확인용)
+func (s *S3Service) ListUploadedParts(ctx context.Context, key, uploadID string) ([]UploadedPart, error) {
+	var parts []UploadedPart
+	paginator := s3.NewListPartsPaginator(s.client, &s3.ListPartsInput{
+		Bucket:   aws.String(s.bucketName),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	})
+	for paginator.HasMorePages() {
+		page, err := retry.DoValue(ctx, s3RetryPolicy, func(ctx context.Context) (*s3.ListPartsOutput, error) {
+			return paginator.NextPage(ctx)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list parts: %w", err)
+		}
+		for _, p := range page.Parts {
+			parts = append(parts, UploadedPart{
+				PartNumber: aws.ToInt32(p.PartNumber),
+				ETag:       aws.ToString(p.ETag),
+				Size:       aws.ToInt64(p.Size),
+			})
+		}
+	}
+	return parts, nil
+}
+
+// CompleteMultipartUpload 올라간 조각을 하나의 객체로 합침
+func (s *S3Service) CompleteMultipartUpload(ctx context.Context, key, uploadID string, parts []CompletedPart) error {
+	if len(parts) == 0 {
+		return errors.New("no parts to complete")
+	}
+
+	sorted := make([]types.CompletedPart, len(parts))
+	for i, p := range parts {
+		sorted[i] = types.CompletedPart{PartNumber: aws.Int32(p.PartNumber), ETag: aws.String(p.ETag)}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return aws.ToInt32(sorted[i].PartNumber) < aws.ToInt32(sorted[j].PartNumber)
+	})
+
+	err := retry.Do(ctx, s3RetryPolicy, func(ctx context.Context) error {
+		_, err := s.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+			Bucket:          aws.String(s.bucketName),
+			Key:             aws.String(key),
+			UploadId:        aws.String(uploadID),
+			MultipartUpload: &types.CompletedMultipartUpload{Parts: sorted},
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+	return nil
+}
+
+// AbortMultipartUpload 업로드 취소 (올라간 조각 삭제)
+func (s *S3Service) AbortMultipartUpload(ctx context.Context, key, uploadID string) error {
+	err := retry.Do(ctx, s3RetryPolicy, func(ctx context.Context) error {
+		_, err := s.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(s.bucketName),
+			Key:      aws.String(key),
+			UploadId: aws.String(uploadID),
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to abort multipart upload: %w", err)
+	}
+	return nil
+}
+
+// uploadMultipart 서버 사이드 스트림을 조각으로 나눠 업로드 (조각 단위로 재시도, 실패하면 업로드 취소)
+func (s *S3Service) uploadMultipart(ctx context.Context, workspaceID int64, key, contentType string, reader io.Reader, size int64) error {
+	partSize, _, err := PartSizeFor(size)
+	if err != nil {
+		return err
+	}
+	uploadID, err := s.createMultipart(ctx, workspaceID, key, contentType)
+	if err != nil {
+		return err
+	}
+
+	var parts []CompletedPart
+	buf := make([]byte, partSize)
+	for partNumber := int32(1); ; partNumber++ {
+		n, readErr := io.ReadFull(reader, buf)
+		if n > 0 {
+			body := buf[:n]
+			etag, err := retry.DoValue(ctx, s3RetryPolicy, func(ctx context.Context) (string, error) {
+				out, err := s.client.UploadPart(ctx, &s3.UploadPartInput{
+					Bucket:        aws.String(s.bucketName),
+					Key:           aws.String(key),
+					UploadId:      aws.String(uploadID),
+					PartNumber:    aws.Int32(partNumber),
+					Body:          bytes.NewReader(body),
+					ContentLength: aws.Int64(int64(len(body))),
+				})
+				if err != nil {
+					return "", err
+				}
+				return aws.ToString(out.ETag), nil
+			})
+			if err != nil {
+				s.AbortMultipartUpload(context.Background(), key, uploadID)
+				return fmt.Errorf("failed to upload part %d: %w", partNumber, err)
+			}
+			parts = append(parts, CompletedPart{PartNumber: partNumber, ETag: etag})
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			s.AbortMultipartUpload(context.Background(), key, uploadID)
+			return fmt.Errorf("failed to read upload body: %w", readErr)
+		}
+	}
+
+	if err := s.CompleteMultipartUpload(ctx, key, uploadID, parts); err != nil {
+		s.AbortMultipartUpload(context.Background(), key, uploadID)
+		return err
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -12,9 +13,26 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/google/uuid"
 
 	appconfig "realtime-backend/internal/config"
+	"realtime-backend/internal/retry"
+)
+
+// s3RetryPolicy S3 요청 재시도 (SlowDown/5xx/네트워크 오류)
+var s3RetryPolicy = retry.Policy{
+	MaxAttempts:    4,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+	Jitter:         0.2,
+	MaxElapsed:     30 * time.Second,
+}
+
+// 휴지통 객체 태그 (버킷 수명 주기 규칙으로 영구 삭제 작업이 놓친 객체도 만료 가능)
+const (
+	TrashTagKey     = "eum-trash"
+	TrashedAtTagKey = "eum-trashed-at"
 )
 
 // S3Service S3 스토리지 서비스
@@ -24,6 +42,14 @@ type S3Service struct {
 	bucketName    string
 	region        string
 	presignExpiry time.Duration
+
+	// 서버 측 암호화 (SSE-S3 / SSE-KMS)
+	sse            string
+	kmsKeyID       string
+	kmsKeyResolver KMSKeyResolver
+
+	// 설정되면 다운로드 URL을 CloudFront 서명 URL로 발급
+	cloudFront *cloudFrontSigner
 }
 
 // UploadResult 업로드 결과
@@ -37,9 +63,10 @@ type UploadResult struct {
 
 // PresignedURL Presigned URL 정보
 type PresignedURL struct {
-	URL       string `json:"url"`
-	Key       string `json:"key"`
-	ExpiresAt string `json:"expires_at"`
+	URL       string            `json:"url"`
+	Key       string            `json:"key"`
+	ExpiresAt string            `json:"expires_at"`
+	Headers   map[string]string `json:"headers,omitempty"` // PUT 요청에 그대로 보내야 하는 헤더 (암호화 설정 등)
 }
 
 // NewS3Service S3 서비스 생성
@@ -47,6 +74,11 @@ func NewS3Service(cfg *appconfig.S3Config) (*S3Service, error) {
 	if cfg.BucketName == "" || cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
 		return nil, fmt.Errorf("S3 configuration is incomplete")
 	}
+	switch cfg.Encryption {
+	case SSENone, SSES3, SSEKMS:
+	default:
+		return nil, fmt.Errorf("unsupported S3 encryption %q (use AES256 or aws:kms)", cfg.Encryption)
+	}
 
 	// AWS 설정
 	awsCfg, err := config.LoadDefaultConfig(context.TODO(),
@@ -61,6 +93,11 @@ func NewS3Service(cfg *appconfig.S3Config) (*S3Service, error) {
 		return nil, fmt.Errorf("failed to load AWS config: %w", err)
 	}
 
+	cloudFront, err := newCloudFrontSigner(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	client := s3.NewFromConfig(awsCfg)
 	presignClient := s3.NewPresignClient(client)
 
@@ -70,21 +107,58 @@ func NewS3Service(cfg *appconfig.S3Config) (*S3Service, error) {
 		bucketName:    cfg.BucketName,
 		region:        cfg.Region,
 		presignExpiry: cfg.PresignExpiry,
+		sse:           cfg.Encryption,
+		kmsKeyID:      cfg.KMSKeyID,
+		cloudFront:    cloudFront,
 	}, nil
 }
 
+// Ping 버킷 접근 확인 (HeadBucket, 헬스체크용이라 재시도하지 않음)
+func (s *S3Service) Ping(ctx context.Context) error {
+	_, err := s.client.HeadBucket(ctx, &s3.HeadBucketInput{Bucket: aws.String(s.bucketName)})
+	return err
+}
+
+// ObjectInfo 업로드된 객체 메타데이터
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+}
+
 // GenerateUploadURL 파일 업로드용 Presigned URL 생성
-func (s *S3Service) GenerateUploadURL(workspaceID int64, fileName, contentType string) (*PresignedURL, error) {
+// 크기가 서명에 포함되므로 선언한 크기와 다른 본문은 S3가 거부 (MaxPutSize보다 크면 멀티파트 업로드 사용)
+func (s *S3Service) GenerateUploadURL(workspaceID int64, fileName, contentType string, size int64) (*PresignedURL, error) {
+	if size > MaxPutSize {
+		return nil, ErrTooLarge
+	}
 	// 파일 키 생성: workspaces/{workspace_id}/{uuid}/{filename}
-	key := fmt.Sprintf("workspaces/%d/%s/%s", workspaceID, uuid.New().String(), sanitizeFileName(fileName))
+	key := NewWorkspaceKey(workspaceID, fileName)
+	return s.presignPut(workspaceID, key, contentType, size)
+}
+
+// GenerateChatAttachmentURL 채팅 첨부 파일 업로드용 Presigned URL 생성
+// 크기가 서명에 포함되므로 선언한 크기와 다른 본문은 S3가 거부
+// workspaceID는 암호화 키 선택용 (0 = 워크스페이스 밖 회의)
+func (s *S3Service) GenerateChatAttachmentURL(workspaceID, meetingID int64, fileName, contentType string, size int64) (*PresignedURL, error) {
+	// 파일 키 생성: chats/{meeting_id}/{uuid}/{filename}
+	key := fmt.Sprintf("chats/%d/%s/%s", meetingID, uuid.New().String(), sanitizeFileName(fileName))
+	return s.presignPut(workspaceID, key, contentType, size)
+}
 
+// presignPut PUT Presigned URL 생성 (size > 0이면 Content-Length 고정, 암호화 헤더도 서명에 포함)
+func (s *S3Service) presignPut(workspaceID int64, key, contentType string, size int64) (*PresignedURL, error) {
 	expiresAt := time.Now().Add(s.presignExpiry)
 
-	presignResult, err := s.presignClient.PresignPutObject(context.TODO(), &s3.PutObjectInput{
+	input := &s3.PutObjectInput{
 		Bucket:      aws.String(s.bucketName),
 		Key:         aws.String(key),
 		ContentType: aws.String(contentType),
-	}, func(opts *s3.PresignOptions) {
+	}
+	if size > 0 {
+		input.ContentLength = aws.Int64(size)
+	}
+	s.encryptionFor(workspaceID).applyPut(input)
+	presignResult, err := s.presignClient.PresignPutObject(context.TODO(), input, func(opts *s3.PresignOptions) {
 		opts.Expires = s.presignExpiry
 	})
 	if err != nil {
@@ -95,11 +169,16 @@ func (s *S3Service) GenerateUploadURL(workspaceID int64, fileName, contentType s
 		URL:       presignResult.URL,
 		Key:       key,
 		ExpiresAt: expiresAt.Format(time.RFC3339),
+		Headers:   uploadHeaders(presignResult.SignedHeader),
 	}, nil
 }
 
-// GetFileURL 파일 다운로드용 Presigned URL 생성 (비공개 버킷용)
+// GetFileURL 파일 다운로드용 Presigned URL 생성 (비공개 버킷용, CloudFront가 설정되면 CloudFront 서명 URL)
 func (s *S3Service) GetFileURL(key string) (string, error) {
+	if s.cloudFront != nil {
+		return s.cloudFront.signURL(key, time.Now().Add(s.presignExpiry))
+	}
+
 	presignResult, err := s.presignClient.PresignGetObject(context.TODO(), &s3.GetObjectInput{
 		Bucket: aws.String(s.bucketName),
 		Key:    aws.String(key),
@@ -113,21 +192,107 @@ func (s *S3Service) GetFileURL(key string) (string, error) {
 	return presignResult.URL, nil
 }
 
+// HeadObject 객체 크기/타입 조회 (없으면 에러)
+func (s *S3Service) HeadObject(ctx context.Context, key string) (*ObjectInfo, error) {
+	out, err := retry.DoValue(ctx, s3RetryPolicy, func(ctx context.Context) (*s3.HeadObjectOutput, error) {
+		return s.client.HeadObject(ctx, &s3.HeadObjectInput{
+			Bucket: aws.String(s.bucketName),
+			Key:    aws.String(key),
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to head object: %w", err)
+	}
+	return &ObjectInfo{
+		Size:        aws.ToInt64(out.ContentLength),
+		ContentType: aws.ToString(out.ContentType),
+	}, nil
+}
+
+// ReadObjectPrefix 객체 앞부분 n바이트 읽기 (파일 형식 확인용)
+func (s *S3Service) ReadObjectPrefix(ctx context.Context, key string, n int64) ([]byte, error) {
+	data, err := retry.DoValue(ctx, s3RetryPolicy, func(ctx context.Context) ([]byte, error) {
+		out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(s.bucketName),
+			Key:    aws.String(key),
+			Range:  aws.String(fmt.Sprintf("bytes=0-%d", n-1)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		defer out.Body.Close()
+		return io.ReadAll(io.LimitReader(out.Body, n))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read object: %w", err)
+	}
+	return data, nil
+}
+
+// OpenObject 객체 본문 스트림 (호출한 쪽에서 Close, 연결까지만 재시도)
+func (s *S3Service) OpenObject(ctx context.Context, key string) (io.ReadCloser, error) {
+	out, err := retry.DoValue(ctx, s3RetryPolicy, func(ctx context.Context) (*s3.GetObjectOutput, error) {
+		return s.client.GetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws.String(s.bucketName),
+			Key:    aws.String(key),
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open object: %w", err)
+	}
+	return out.Body, nil
+}
+
 // GetPublicURL 퍼블릭 URL 반환 (퍼블릭 버킷용)
 func (s *S3Service) GetPublicURL(key string) string {
 	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", s.bucketName, s.region, key)
 }
 
-// UploadFile 파일 직접 업로드 (서버 사이드)
+// KeyFromPublicURL GetPublicURL의 역변환 (이 버킷의 URL이 아니면 false)
+func (s *S3Service) KeyFromPublicURL(url string) (string, bool) {
+	key, ok := strings.CutPrefix(url, s.GetPublicURL(""))
+	return key, ok && key != ""
+}
+
+// UploadFile 파일 직접 업로드 (서버 사이드, MultipartThreshold보다 크면 멀티파트)
 func (s *S3Service) UploadFile(workspaceID int64, fileName, contentType string, reader io.Reader, size int64) (*UploadResult, error) {
-	key := fmt.Sprintf("workspaces/%d/%s/%s", workspaceID, uuid.New().String(), sanitizeFileName(fileName))
-
-	_, err := s.client.PutObject(context.TODO(), &s3.PutObjectInput{
-		Bucket:        aws.String(s.bucketName),
-		Key:           aws.String(key),
-		Body:          reader,
-		ContentType:   aws.String(contentType),
-		ContentLength: aws.Int64(size),
+	key := NewWorkspaceKey(workspaceID, fileName)
+
+	if size > MultipartThreshold {
+		if err := s.uploadMultipart(context.TODO(), workspaceID, key, contentType, reader, size); err != nil {
+			return nil, fmt.Errorf("failed to upload file: %w", err)
+		}
+		return &UploadResult{
+			Key:      key,
+			URL:      s.GetPublicURL(key),
+			FileName: fileName,
+			FileSize: size,
+			MimeType: contentType,
+		}, nil
+	}
+
+	// 다시 읽을 수 있는 본문만 재시도 (스트림은 첫 시도에서 소진됨)
+	policy := s3RetryPolicy
+	seeker, seekable := reader.(io.Seeker)
+	if !seekable {
+		policy.MaxAttempts = 1
+	}
+	err := retry.Do(context.TODO(), policy, func(ctx context.Context) error {
+		if seekable {
+			if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+				return retry.Stop(err)
+			}
+		}
+		input := &s3.PutObjectInput{
+			Bucket:        aws.String(s.bucketName),
+			Key:           aws.String(key),
+			Body:          reader,
+			ContentType:   aws.String(contentType),
+			ContentLength: aws.Int64(size),
+		}
+		s.encryptionFor(workspaceID).applyPut(input)
+		_, err := s.client.PutObject(ctx, input)
+		return err
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to upload file: %w", err)
@@ -142,11 +307,36 @@ func (s *S3Service) UploadFile(workspaceID int64, fileName, contentType string,
 	}, nil
 }
 
+// PutObject 지정한 키로 서버에서 만든 데이터 업로드 (녹음 등)
+// workspaceID는 암호화 키 선택용 (0 = 워크스페이스 밖 회의)
+func (s *S3Service) PutObject(ctx context.Context, workspaceID int64, key, contentType string, data []byte) error {
+	enc := s.encryptionFor(workspaceID)
+	err := retry.Do(ctx, s3RetryPolicy, func(ctx context.Context) error {
+		input := &s3.PutObjectInput{
+			Bucket:        aws.String(s.bucketName),
+			Key:           aws.String(key),
+			Body:          bytes.NewReader(data),
+			ContentType:   aws.String(contentType),
+			ContentLength: aws.Int64(int64(len(data))),
+		}
+		enc.applyPut(input)
+		_, err := s.client.PutObject(ctx, input)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload object: %w", err)
+	}
+	return nil
+}
+
 // DeleteFile 파일 삭제
 func (s *S3Service) DeleteFile(key string) error {
-	_, err := s.client.DeleteObject(context.TODO(), &s3.DeleteObjectInput{
-		Bucket: aws.String(s.bucketName),
-		Key:    aws.String(key),
+	err := retry.Do(context.TODO(), s3RetryPolicy, func(ctx context.Context) error {
+		_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(s.bucketName),
+			Key:    aws.String(key),
+		})
+		return err
 	})
 	if err != nil {
 		return fmt.Errorf("failed to delete file: %w", err)
@@ -154,12 +344,36 @@ func (s *S3Service) DeleteFile(key string) error {
 	return nil
 }
 
-// DeleteFiles 여러 파일 삭제
-func (s *S3Service) DeleteFiles(keys []string) error {
-	for _, key := range keys {
-		if err := s.DeleteFile(key); err != nil {
-			return err
-		}
+// MarkTrashed 휴지통으로 옮긴 객체에 태그 지정 (객체는 보관 기간 동안 그대로 유지)
+func (s *S3Service) MarkTrashed(ctx context.Context, key string, trashedAt time.Time) error {
+	err := retry.Do(ctx, s3RetryPolicy, func(ctx context.Context) error {
+		_, err := s.client.PutObjectTagging(ctx, &s3.PutObjectTaggingInput{
+			Bucket: aws.String(s.bucketName),
+			Key:    aws.String(key),
+			Tagging: &types.Tagging{TagSet: []types.Tag{
+				{Key: aws.String(TrashTagKey), Value: aws.String("true")},
+				{Key: aws.String(TrashedAtTagKey), Value: aws.String(trashedAt.UTC().Format(time.RFC3339))},
+			}},
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to tag object as trashed: %w", err)
+	}
+	return nil
+}
+
+// UnmarkTrashed 복원한 객체의 휴지통 태그 제거
+func (s *S3Service) UnmarkTrashed(ctx context.Context, key string) error {
+	err := retry.Do(ctx, s3RetryPolicy, func(ctx context.Context) error {
+		_, err := s.client.DeleteObjectTagging(ctx, &s3.DeleteObjectTaggingInput{
+			Bucket: aws.String(s.bucketName),
+			Key:    aws.String(key),
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove trash tags: %w", err)
 	}
 	return nil
 }
@@ -0,0 +1,138 @@
+package summary
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+// DefaultBedrockModelID 기본 요약 모델
+const DefaultBedrockModelID = "amazon.nova-lite-v1:0"
+
+// BedrockClient Amazon Bedrock Converse API 클라이언트 (SigV4 서명 HTTP 호출)
+type BedrockClient struct {
+	awsCfg     aws.Config
+	region     string
+	modelID    string
+	maxTokens  int
+	httpClient *http.Client
+	signer     *v4.Signer
+}
+
+// NewBedrockClient BedrockClient 생성 (region이 비어 있으면 awsCfg의 region 사용)
+func NewBedrockClient(awsCfg aws.Config, region, modelID string) *BedrockClient {
+	if region == "" {
+		region = awsCfg.Region
+	}
+	if modelID == "" {
+		modelID = DefaultBedrockModelID
+	}
+	return &BedrockClient{
+		awsCfg:     awsCfg,
+		region:     region,
+		modelID:    modelID,
+		maxTokens:  2048,
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+		signer:     v4.NewSigner(),
+	}
+}
+
+// Name provider/model 식별자
+func (c *BedrockClient) Name() string {
+	return "bedrock:" + c.modelID
+}
+
+type converseContent struct {
+	Text string `json:"text"`
+}
+
+type converseMessage struct {
+	Role    string            `json:"role"`
+	Content []converseContent `json:"content"`
+}
+
+type converseRequest struct {
+	System          []converseContent `json:"system,omitempty"`
+	Messages        []converseMessage `json:"messages"`
+	InferenceConfig struct {
+		MaxTokens   int     `json:"maxTokens"`
+		Temperature float64 `json:"temperature"`
+	} `json:"inferenceConfig"`
+}
+
+type converseResponse struct {
+	Output struct {
+		Message converseMessage `json:"message"`
+	} `json:"output"`
+	Message string `json:"message"` // 오류 응답
+}
+
+// Complete Converse API 호출
+func (c *BedrockClient) Complete(ctx context.Context, system, prompt string) (string, error) {
+	reqBody := converseRequest{
+		Messages: []converseMessage{{Role: "user", Content: []converseContent{{Text: prompt}}}},
+	}
+	if system != "" {
+		reqBody.System = []converseContent{{Text: system}}
+	}
+	reqBody.InferenceConfig.MaxTokens = c.maxTokens
+	reqBody.InferenceConfig.Temperature = 0.2
+
+	payload, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", err
+	}
+
+	endpoint := fmt.Sprintf("https://bedrock-runtime.%s.amazonaws.com/model/%s/converse", c.region, url.PathEscape(c.modelID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	creds, err := c.awsCfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve AWS credentials: %w", err)
+	}
+	hash := sha256.Sum256(payload)
+	if err := c.signer.SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), "bedrock", c.region, time.Now()); err != nil {
+		return "", fmt.Errorf("failed to sign request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	var out converseResponse
+	if err := json.Unmarshal(body, &out); err != nil {
+		return "", fmt.Errorf("invalid Bedrock response (status %d): %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("bedrock returned status %d: %s", resp.StatusCode, out.Message)
+	}
+
+	var text strings.Builder
+	for _, part := range out.Output.Message.Content {
+		text.WriteString(part.Text)
+	}
+	return text.String(), nil
+}
@@ -0,0 +1,166 @@
+package summary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxTranscriptChars LLM에 보내는 회의록 최대 길이 (초과분은 잘라냄)
+const MaxTranscriptChars = 60000
+
+// LLMClient 요약에 사용하는 LLM 클라이언트 (Bedrock 또는 다른 구현으로 교체 가능)
+type LLMClient interface {
+	// Complete 시스템 프롬프트와 사용자 프롬프트로 응답 텍스트 생성
+	Complete(ctx context.Context, system, prompt string) (string, error)
+	// Name 저장용 provider/model 식별자
+	Name() string
+}
+
+// Utterance 요약 입력 발화 한 건
+type Utterance struct {
+	SpeakerName string
+	Text        string
+	Language    string
+	Timestamp   time.Time
+}
+
+// ActionItem 회의에서 나온 할 일
+type ActionItem struct {
+	Owner string `json:"owner,omitempty"`
+	Task  string `json:"task"`
+	Due   string `json:"due,omitempty"`
+}
+
+// SpeakerTalkTime 화자별 발언 시간 (텍스트 길이 기반 추정)
+type SpeakerTalkTime struct {
+	SpeakerName string  `json:"speakerName"`
+	Utterances  int     `json:"utterances"`
+	TalkTimeMs  int64   `json:"talkTimeMs"`
+	Share       float64 `json:"share"` // 전체 발언 시간 대비 비율 (0~1)
+}
+
+// Result 회의 요약 결과
+type Result struct {
+	KeyPoints   []string          `json:"keyPoints"`
+	ActionItems []ActionItem      `json:"actionItems"`
+	Speakers    []SpeakerTalkTime `json:"speakers"`
+	Provider    string            `json:"provider"`
+}
+
+// Summarizer 회의록을 LLM으로 요약
+type Summarizer struct {
+	client LLMClient
+}
+
+// NewSummarizer Summarizer 생성
+func NewSummarizer(client LLMClient) *Summarizer {
+	return &Summarizer{client: client}
+}
+
+const systemPrompt = `You summarize meeting transcripts. Reply with JSON only, no prose, in this exact shape:
+{"keyPoints": ["..."], "actionItems": [{"owner": "...", "task": "...", "due": "..."}]}
+Write key points and action items in the language most used in the meeting. Leave owner or due empty when not stated.`
+
+// Summarize 발화 목록을 요약 (발언 시간은 LLM 없이 직접 계산)
+func (s *Summarizer) Summarize(ctx context.Context, utterances []Utterance) (*Result, error) {
+	if len(utterances) == 0 {
+		return nil, errors.New("no utterances to summarize")
+	}
+
+	reply, err := s.client.Complete(ctx, systemPrompt, buildPrompt(utterances))
+	if err != nil {
+		return nil, fmt.Errorf("llm completion failed: %w", err)
+	}
+
+	result, err := parseReply(reply)
+	if err != nil {
+		return nil, err
+	}
+	result.Speakers = TalkTime(utterances)
+	result.Provider = s.client.Name()
+	return result, nil
+}
+
+// buildPrompt 발화 목록을 "[HH:MM:SS] 화자: 내용" 형식의 회의록으로 변환
+func buildPrompt(utterances []Utterance) string {
+	var b strings.Builder
+	b.WriteString("Meeting transcript:\n")
+	for _, u := range utterances {
+		line := fmt.Sprintf("[%s] %s: %s\n", u.Timestamp.Format("15:04:05"), u.SpeakerName, u.Text)
+		if b.Len()+len(line) > MaxTranscriptChars {
+			b.WriteString("(transcript truncated)\n")
+			break
+		}
+		b.WriteString(line)
+	}
+	return b.String()
+}
+
+// parseReply LLM 응답에서 JSON 객체를 추출
+func parseReply(reply string) (*Result, error) {
+	start := strings.Index(reply, "{")
+	end := strings.LastIndex(reply, "}")
+	if start < 0 || end <= start {
+		return nil, errors.New("llm reply does not contain a JSON object")
+	}
+
+	var result Result
+	if err := json.Unmarshal([]byte(reply[start:end+1]), &result); err != nil {
+		return nil, fmt.Errorf("invalid summary JSON: %w", err)
+	}
+	if result.KeyPoints == nil {
+		result.KeyPoints = []string{}
+	}
+	if result.ActionItems == nil {
+		result.ActionItems = []ActionItem{}
+	}
+	return &result, nil
+}
+
+// charsPerSecond 언어별 평균 발화 속도 (CJK는 글자 단위, 그 외는 알파벳 포함 문자 단위)
+func charsPerSecond(lang string) float64 {
+	switch strings.ToLower(lang) {
+	case "ko", "ja", "zh":
+		return 7
+	default:
+		return 15
+	}
+}
+
+// TalkTime 화자별 발언 시간 추정 (발언 시간 내림차순)
+func TalkTime(utterances []Utterance) []SpeakerTalkTime {
+	bySpeaker := make(map[string]*SpeakerTalkTime)
+	var total int64
+	for _, u := range utterances {
+		ms := int64(float64(utf8.RuneCountInString(u.Text)) / charsPerSecond(u.Language) * 1000)
+		st, ok := bySpeaker[u.SpeakerName]
+		if !ok {
+			st = &SpeakerTalkTime{SpeakerName: u.SpeakerName}
+			bySpeaker[u.SpeakerName] = st
+		}
+		st.Utterances++
+		st.TalkTimeMs += ms
+		total += ms
+	}
+
+	speakers := make([]SpeakerTalkTime, 0, len(bySpeaker))
+	for _, st := range bySpeaker {
+		if total > 0 {
+			st.Share = float64(st.TalkTimeMs) / float64(total)
+		}
+		speakers = append(speakers, *st)
+	}
+	sort.Slice(speakers, func(i, j int) bool {
+		if speakers[i].TalkTimeMs != speakers[j].TalkTimeMs {
+			return speakers[i].TalkTimeMs > speakers[j].TalkTimeMs
+		}
+		return speakers[i].SpeakerName < speakers[j].SpeakerName
+	})
+	return speakers
+}
@@ -0,0 +1,90 @@
+package whiteboard
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strconv"
+)
+
+// MaxPDFSide PDF 페이지 긴 변 최대 크기 (pt, A3 긴 변)
+const MaxPDFSide = 1190
+
+// RenderPDF 선분을 한 페이지짜리 벡터 PDF로 렌더링 (확대해도 선이 깨지지 않음)
+func RenderPDF(segments []Segment) ([]byte, error) {
+	bounds := MeasureBounds(segments)
+	scale := fitScale(bounds, MaxPDFSide)
+	width := bounds.Width() * scale
+	height := bounds.Height() * scale
+
+	var content bytes.Buffer
+	fmt.Fprintf(&content, "1 1 1 rg 0 0 %s %s re f\n1 J 1 j\n", num(width), num(height))
+
+	// PDF 좌표는 왼쪽 아래가 원점이라 y를 뒤집음
+	px := func(x float64) string { return num((x - bounds.MinX) * scale) }
+	py := func(y float64) string { return num(height - (y-bounds.MinY)*scale) }
+
+	var prev *Segment
+	open := false
+	for i := range segments {
+		s := &segments[i]
+		styleChanged := prev == nil || prev.Color != s.Color || prev.Width != s.Width
+		connected := !styleChanged && prev.X1 == s.X0 && prev.Y1 == s.Y0
+
+		if !connected && open {
+			content.WriteString("S\n")
+			open = false
+		}
+		if styleChanged {
+			r, g, b := rgb(s.Color)
+			fmt.Fprintf(&content, "%s %s %s RG %s w\n",
+				num(float64(r)/255), num(float64(g)/255), num(float64(b)/255), num(s.Width*scale))
+		}
+		if !connected {
+			fmt.Fprintf(&content, "%s %s m ", px(s.X0), py(s.Y0))
+		}
+		fmt.Fprintf(&content, "%s %s l\n", px(s.X1), py(s.Y1))
+		open = true
+		prev = s
+	}
+	if open {
+		content.WriteString("S\n")
+	}
+
+	var stream bytes.Buffer
+	zw := zlib.NewWriter(&stream)
+	if _, err := zw.Write(content.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Contents 4 0 R /Resources << >> >>", num(width), num(height)),
+		fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", stream.Len(), stream.String()),
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return out.Bytes(), nil
+}
+
+// num PDF 숫자 (소수점 둘째 자리까지)
+func num(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
@@ -0,0 +1,67 @@
+package whiteboard
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+)
+
+// MaxPNGSide PNG 긴 변 최대 픽셀 (넘으면 비율을 유지하며 축소)
+const MaxPNGSide = 4096
+
+// RenderPNG 선분을 흰 배경 PNG로 렌더링 (둥근 끝/이음 선)
+func RenderPNG(segments []Segment) (data []byte, width, height int, err error) {
+	bounds := MeasureBounds(segments)
+	scale := fitScale(bounds, MaxPNGSide)
+	width = max(1, int(math.Ceil(bounds.Width()*scale)))
+	height = max(1, int(math.Ceil(bounds.Height()*scale)))
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i := range img.Pix {
+		img.Pix[i] = 0xff
+	}
+
+	for _, s := range segments {
+		r, g, b := rgb(s.Color)
+		drawSegment(img,
+			(s.X0-bounds.MinX)*scale, (s.Y0-bounds.MinY)*scale,
+			(s.X1-bounds.MinX)*scale, (s.Y1-bounds.MinY)*scale,
+			math.Max(s.Width*scale/2, 0.5), color.RGBA{R: r, G: g, B: b, A: 0xff})
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, 0, 0, err
+	}
+	return buf.Bytes(), width, height, nil
+}
+
+// drawSegment 선분에서 radius 이내 픽셀을 칠함 (양 끝은 반원)
+func drawSegment(img *image.RGBA, x0, y0, x1, y1, radius float64, c color.RGBA) {
+	rect := img.Bounds()
+	minX := max(rect.Min.X, int(math.Floor(math.Min(x0, x1)-radius)))
+	maxX := min(rect.Max.X-1, int(math.Ceil(math.Max(x0, x1)+radius)))
+	minY := max(rect.Min.Y, int(math.Floor(math.Min(y0, y1)-radius)))
+	maxY := min(rect.Max.Y-1, int(math.Ceil(math.Max(y0, y1)+radius)))
+
+	dx, dy := x1-x0, y1-y0
+	lengthSq := dx*dx + dy*dy
+	radiusSq := radius * radius
+
+	for py := minY; py <= maxY; py++ {
+		for px := minX; px <= maxX; px++ {
+			// 픽셀 중심에서 선분까지 거리
+			cx, cy := float64(px)+0.5, float64(py)+0.5
+			t := 0.0
+			if lengthSq > 0 {
+				t = math.Max(0, math.Min(1, ((cx-x0)*dx+(cy-y0)*dy)/lengthSq))
+			}
+			ex, ey := cx-(x0+t*dx), cy-(y0+t*dy)
+			if ex*ex+ey*ey <= radiusSq {
+				img.SetRGBA(px, py, c)
+			}
+		}
+	}
+}
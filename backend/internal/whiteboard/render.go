@@ -0,0 +1,142 @@
+// Package whiteboard 화이트보드 획 기록을 이미지(PNG)/문서(PDF)로 렌더링
+// 획 형식은 프론트엔드 DrawEvent ({type:"draw", x, y, prevX, prevY, color, width}) 배열 또는 draw_batch
+package whiteboard
+
+import (
+	"encoding/json"
+	"math"
+)
+
+// 렌더링 설정
+const (
+	margin       = 20.0  // 그림 둘레 여백 (캔버스 좌표)
+	emptyWidth   = 800.0 // 획이 없을 때 빈 캔버스 크기
+	emptyHeight  = 600.0
+	defaultColor = 0x000000
+	minLineWidth = 1.0
+	maxLineWidth = 200.0
+)
+
+// Segment 선분 하나 (캔버스 좌표)
+type Segment struct {
+	X0, Y0, X1, Y1 float64
+	Width          float64
+	Color          uint32 // 0xRRGGBB (지우개는 흰색)
+}
+
+// Bounds 그림 영역 (여백 포함)
+type Bounds struct {
+	MinX, MinY, MaxX, MaxY float64
+}
+
+// Width 영역 너비
+func (b Bounds) Width() float64 { return b.MaxX - b.MinX }
+
+// Height 영역 높이
+func (b Bounds) Height() float64 { return b.MaxY - b.MinY }
+
+// drawEvent 프론트엔드 DrawEvent / DrawBatchEvent
+type drawEvent struct {
+	Type   string      `json:"type"`
+	X      float64     `json:"x"`
+	Y      float64     `json:"y"`
+	PrevX  float64     `json:"prevX"`
+	PrevY  float64     `json:"prevY"`
+	Color  *float64    `json:"color"`
+	Width  float64     `json:"width"`
+	Points []drawEvent `json:"points"`
+}
+
+// ParseStrokes 저장된 획 기록(스냅샷 청크를 푼 순서대로)을 선분 목록으로 변환
+// 알 수 없는 형식은 건너뜀
+func ParseStrokes(strokes []json.RawMessage) []Segment {
+	var segments []Segment
+	for _, raw := range strokes {
+		segments = appendStroke(segments, raw)
+	}
+	return segments
+}
+
+func appendStroke(segments []Segment, raw json.RawMessage) []Segment {
+	var events []drawEvent
+	if err := json.Unmarshal(raw, &events); err != nil {
+		var single drawEvent
+		if err := json.Unmarshal(raw, &single); err != nil {
+			return segments
+		}
+		events = []drawEvent{single}
+	}
+
+	for _, e := range events {
+		switch {
+		case e.Type == "draw_batch" || len(e.Points) > 0:
+			for _, p := range e.Points {
+				segments = appendEvent(segments, p)
+			}
+		case e.Type == "draw" || e.Type == "":
+			segments = appendEvent(segments, e)
+		}
+	}
+	return segments
+}
+
+func appendEvent(segments []Segment, e drawEvent) []Segment {
+	if !finite(e.X, e.Y, e.PrevX, e.PrevY, e.Width) {
+		return segments
+	}
+	color := uint32(defaultColor)
+	if e.Color != nil && *e.Color >= 0 && *e.Color <= 0xffffff {
+		color = uint32(*e.Color)
+	}
+	return append(segments, Segment{
+		X0:    e.PrevX,
+		Y0:    e.PrevY,
+		X1:    e.X,
+		Y1:    e.Y,
+		Width: math.Min(math.Max(e.Width, minLineWidth), maxLineWidth),
+		Color: color,
+	})
+}
+
+func finite(values ...float64) bool {
+	for _, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// MeasureBounds 모든 선분을 담는 영역 (선 두께와 여백 포함, 획이 없으면 빈 캔버스)
+func MeasureBounds(segments []Segment) Bounds {
+	if len(segments) == 0 {
+		return Bounds{MaxX: emptyWidth, MaxY: emptyHeight}
+	}
+
+	b := Bounds{MinX: math.Inf(1), MinY: math.Inf(1), MaxX: math.Inf(-1), MaxY: math.Inf(-1)}
+	for _, s := range segments {
+		half := s.Width / 2
+		b.MinX = math.Min(b.MinX, math.Min(s.X0, s.X1)-half)
+		b.MinY = math.Min(b.MinY, math.Min(s.Y0, s.Y1)-half)
+		b.MaxX = math.Max(b.MaxX, math.Max(s.X0, s.X1)+half)
+		b.MaxY = math.Max(b.MaxY, math.Max(s.Y0, s.Y1)+half)
+	}
+	b.MinX -= margin
+	b.MinY -= margin
+	b.MaxX += margin
+	b.MaxY += margin
+	return b
+}
+
+// fitScale 긴 변이 maxSide를 넘지 않도록 축소 비율 (확대하지 않음)
+func fitScale(b Bounds, maxSide float64) float64 {
+	longest := math.Max(b.Width(), b.Height())
+	if longest <= maxSide {
+		return 1
+	}
+	return maxSide / longest
+}
+
+func rgb(color uint32) (r, g, b uint8) {
+	return uint8(color >> 16), uint8(color >> 8), uint8(color)
+}
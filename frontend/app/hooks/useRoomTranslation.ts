@@ -44,6 +44,7 @@ interface UseRoomTranslationOptions {
     enabled: boolean;
     targetLanguage: TargetLanguage;
     listenerId?: string;
+    roomToken?: string;   // /api/video/token 의 roomToken (Room/참가자/역할이 고정된 WebSocket 인증)
     autoPlayTTS?: boolean;
     onTranscript?: (data: RoomTranscriptData) => void;
     onError?: (error: Error) => void;
@@ -135,6 +136,7 @@ export function useRoomTranslation({
     enabled,
     targetLanguage,
     listenerId,
+    roomToken,
     autoPlayTTS = true,
     onTranscript,
     onError,
@@ -446,10 +448,11 @@ export function useRoomTranslation({
         const actualListenerId = listenerId || localId;
 
         // Connect WebSocket (use ref for targetLanguage to avoid reconnection)
+        const tokenParam = roomToken ? `&token=${encodeURIComponent(roomToken)}` : '';
         const wsUrl = `${WS_ROOM_URL}?roomId=${encodeURIComponent(roomId)}&listenerId=${encodeURIComponent(actualListenerId)}&targetLang=${targetLanguageRef.current}`;
         console.log(`[RoomTranslation] Connecting to ${wsUrl}`);
 
-        const ws = new WebSocket(wsUrl);
+        const ws = new WebSocket(wsUrl + tokenParam);
         ws.binaryType = 'arraybuffer';
         wsRef.current = ws;
 
@@ -531,7 +534,7 @@ export function useRoomTranslation({
             cleanupAll();
         };
     // targetLanguage는 ref로 관리하여 재연결 없이 업데이트
-    }, [roomId, enabled, listenerId, localParticipant?.identity, cleanupAll]);
+    }, [roomId, roomToken, enabled, listenerId, localParticipant?.identity, cleanupAll]);
 
     // Effect: Update target language without reconnecting
     useEffect(() => {
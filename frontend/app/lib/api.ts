@@ -236,6 +236,9 @@ interface WorkspaceFile {
   mime_type?: string;
   related_meeting_id?: number;
   created_at: string;
+  thumbnail_url?: string;
+  preview_url?: string;
+  scan_status?: 'PENDING' | 'CLEAN' | 'INFECTED' | 'FAILED';
   uploader?: UserSearchResult;
   children?: WorkspaceFile[];
 }
@@ -815,11 +818,26 @@ class ApiClient {
     });
   }
 
+  async moveFile(workspaceId: number, fileId: number, parentFolderId: number | null): Promise<WorkspaceFile> {
+    return this.request<WorkspaceFile>(`/api/workspaces/${workspaceId}/files/${fileId}/move`, {
+      method: 'POST',
+      body: JSON.stringify({ parent_folder_id: parentFolderId }),
+    });
+  }
+
+  async copyFile(workspaceId: number, fileId: number, parentFolderId: number | null, name?: string): Promise<WorkspaceFile> {
+    return this.request<WorkspaceFile>(`/api/workspaces/${workspaceId}/files/${fileId}/copy`, {
+      method: 'POST',
+      body: JSON.stringify({ parent_folder_id: parentFolderId, name }),
+    });
+  }
+
   // ========== S3 파일 업로드 API ==========
-  async getPresignedURL(workspaceId: number, fileName: string, contentType: string, parentFolderId?: number): Promise<{
+  async getPresignedURL(workspaceId: number, fileName: string, contentType: string, fileSize: number, parentFolderId?: number): Promise<{
     upload_url: string;
     key: string;
     expires_at: string;
+    headers?: Record<string, string>;
     parent_folder_id?: number;
   }> {
     return this.request(`/api/workspaces/${workspaceId}/files/presign`, {
@@ -827,6 +845,7 @@ class ApiClient {
       body: JSON.stringify({
         file_name: fileName,
         content_type: contentType,
+        file_size: fileSize,
         parent_folder_id: parentFolderId,
       }),
     });
@@ -849,13 +868,14 @@ class ApiClient {
     return this.request(`/api/workspaces/${workspaceId}/files/${fileId}/download`);
   }
 
-  // 파일을 S3에 직접 업로드 (Presigned URL 사용)
-  async uploadFileToS3(uploadUrl: string, file: File): Promise<void> {
+  // 파일을 S3에 직접 업로드 (Presigned URL 사용, 서명에 포함된 헤더는 그대로 전송)
+  async uploadFileToS3(uploadUrl: string, file: File, signedHeaders?: Record<string, string>): Promise<void> {
     const response = await fetch(uploadUrl, {
       method: 'PUT',
       body: file,
       headers: {
         'Content-Type': file.type,
+        ...signedHeaders,
       },
     });
 
@@ -888,8 +908,9 @@ class ApiClient {
   }
 
   // ========== 비디오 통화 API ==========
-  async getVideoToken(roomName: string, participantName?: string): Promise<{ token: string }> {
-    return this.request<{ token: string }>('/api/video/token', {
+  // roomToken: 번역 WebSocket(/ws/room, /ws/audio) 접속용 (?token=)
+  async getVideoToken(roomName: string, participantName?: string): Promise<{ token: string; roomToken?: string }> {
+    return this.request<{ token: string; roomToken?: string }>('/api/video/token', {
       method: 'POST',
       body: JSON.stringify({ roomName, participantName }),
     });
@@ -12,6 +12,7 @@ export const NotificationType = {
     WORKSPACE_INVITE: 'WORKSPACE_INVITE',
     MEETING_ALERT: 'MEETING_ALERT',
     COMMENT_MENTION: 'COMMENT_MENTION',
+    FILE_QUARANTINED: 'FILE_QUARANTINED',
 } as const;
 
 export type NotificationTypeType = typeof NotificationType[keyof typeof NotificationType];
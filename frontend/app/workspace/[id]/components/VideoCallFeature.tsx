@@ -29,11 +29,13 @@ interface VideoCallFeatureProps {
 // LiveKitRoom 내부에서 사용할 컴포넌트 (번역 훅 사용)
 function VideoCallContent({
     roomId,
+    roomToken,
     roomTitle,
     onLeave,
     user,
 }: {
     roomId: string;
+    roomToken?: string;
     roomTitle?: string;
     onLeave: () => void;
     user: { nickname?: string; profileImg?: string } | null;
@@ -241,6 +243,7 @@ function VideoCallContent({
         isActive: isTranslationActive,
     } = useRoomTranslation({
         roomId,                                   // 방 ID
+        roomToken,                                // 번역 WebSocket 인증 토큰
         enabled: true,                            // 항상 연결 유지 (STT 활성화)
         targetLanguage,                           // 듣고 싶은 언어
         listenerId: localParticipant?.identity,   // 리스너 ID
@@ -352,6 +355,7 @@ function VideoCallContent({
 export default function VideoCallFeature({ roomId, roomTitle, onLeave }: VideoCallFeatureProps) {
     const { user } = useAuth();
     const [token, setToken] = useState<string>('');
+    const [roomToken, setRoomToken] = useState<string | undefined>();
     const [error, setError] = useState<string | null>(null);
 
     const participantName = user?.nickname || 'Anonymous';
@@ -361,6 +365,7 @@ export default function VideoCallFeature({ roomId, roomTitle, onLeave }: VideoCa
             try {
                 const response = await apiClient.getVideoToken(roomId, participantName);
                 setToken(response.token);
+                setRoomToken(response.roomToken);
             } catch (err) {
                 console.error('Failed to get token:', err);
                 setError('연결에 실패했습니다');
@@ -437,6 +442,7 @@ export default function VideoCallFeature({ roomId, roomTitle, onLeave }: VideoCa
             >
                 <VideoCallContent
                     roomId={roomId}
+                    roomToken={roomToken}
                     roomTitle={roomTitle}
                     onLeave={onLeave}
                     user={user}
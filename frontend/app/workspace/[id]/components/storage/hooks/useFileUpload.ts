@@ -45,10 +45,11 @@ export function useFileUpload({
       workspaceId,
       file.name,
       file.type || "application/octet-stream",
+      file.size,
       parentId
     );
 
-    await apiClient.uploadFileToS3(presigned.upload_url, file);
+    await apiClient.uploadFileToS3(presigned.upload_url, file, presigned.headers);
 
     const uploadedFile = await apiClient.confirmUpload(workspaceId, {
       name: file.name,
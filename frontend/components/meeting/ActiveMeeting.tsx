@@ -24,6 +24,7 @@ import SubtitlePills from './SubtitlePills';
 
 interface ActiveMeetingProps {
   roomId: string;
+  roomToken?: string;
   roomTitle: string;
   onLeave: () => void;
   currentUser: {
@@ -34,6 +35,7 @@ interface ActiveMeetingProps {
 
 export default function ActiveMeeting({
   roomId,
+  roomToken,
   roomTitle,
   onLeave,
   currentUser,
@@ -237,6 +239,7 @@ export default function ActiveMeeting({
   // Room translation hook
   const { isActive: isTranslationActive } = useRoomTranslation({
     roomId,
+    roomToken,
     enabled: true,
     targetLanguage,
     listenerId: localParticipant?.identity,
@@ -22,6 +22,7 @@ export default function NotionMeetingWorkspace({
 }: NotionMeetingWorkspaceProps) {
   const { user } = useAuth();
   const [token, setToken] = useState<string>('');
+  const [roomToken, setRoomToken] = useState<string | undefined>();
   const [error, setError] = useState<string | null>(null);
   const [isRetrying, setIsRetrying] = useState(false);
   const [liveKitUrl, setLiveKitUrl] = useState<string>(process.env.NEXT_PUBLIC_LIVEKIT_URL || '');
@@ -43,6 +44,7 @@ export default function NotionMeetingWorkspace({
         setError(null);
         const response = await apiClient.getVideoToken(roomId, participantName);
         setToken(response.token);
+        setRoomToken(response.roomToken);
       } catch (err) {
         console.error('[NotionMeetingWorkspace] Failed to get token:', err);
         setError('연결에 실패했습니다. 다시 시도해주세요.');
@@ -61,6 +63,7 @@ export default function NotionMeetingWorkspace({
       setError(null);
       const response = await apiClient.getVideoToken(roomId, participantName);
       setToken(response.token);
+      setRoomToken(response.roomToken);
     } catch (err) {
       console.error('[NotionMeetingWorkspace] Retry failed:', err);
       setError('연결에 실패했습니다. 네트워크를 확인해주세요.');
@@ -151,6 +154,7 @@ export default function NotionMeetingWorkspace({
       >
         <ActiveMeeting
           roomId={roomId}
+          roomToken={roomToken}
           roomTitle={roomTitle}
           onLeave={onLeave}
           currentUser={{